@@ -715,6 +715,46 @@ func deepCopy_api_Build(in buildapi.Build, out *buildapi.Build, c *conversion.Cl
 	return nil
 }
 
+func deepCopy_api_BuildCache(in buildapi.BuildCache, out *buildapi.BuildCache, c *conversion.Cloner) error {
+	if in.Paths != nil {
+		out.Paths = make([]buildapi.ImageSourcePath, len(in.Paths))
+		for i := range in.Paths {
+			if err := deepCopy_api_ImageSourcePath(in.Paths[i], &out.Paths[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Paths = nil
+	}
+	if in.KeyFiles != nil {
+		out.KeyFiles = make([]string, len(in.KeyFiles))
+		for i := range in.KeyFiles {
+			out.KeyFiles[i] = in.KeyFiles[i]
+		}
+	} else {
+		out.KeyFiles = nil
+	}
+	if in.PersistentVolumeClaim != nil {
+		if newVal, err := c.DeepCopy(in.PersistentVolumeClaim); err != nil {
+			return err
+		} else {
+			out.PersistentVolumeClaim = newVal.(*pkgapi.LocalObjectReference)
+		}
+	} else {
+		out.PersistentVolumeClaim = nil
+	}
+	if in.Image != nil {
+		if newVal, err := c.DeepCopy(in.Image); err != nil {
+			return err
+		} else {
+			out.Image = newVal.(*pkgapi.ObjectReference)
+		}
+	} else {
+		out.Image = nil
+	}
+	return nil
+}
+
 func deepCopy_api_BuildConfig(in buildapi.BuildConfig, out *buildapi.BuildConfig, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -770,6 +810,19 @@ func deepCopy_api_BuildConfigSpec(in buildapi.BuildConfigSpec, out *buildapi.Bui
 	} else {
 		out.Triggers = nil
 	}
+	out.RunPolicy = in.RunPolicy
+	if in.SuccessfulBuildsHistoryLimit != nil {
+		out.SuccessfulBuildsHistoryLimit = new(int)
+		*out.SuccessfulBuildsHistoryLimit = *in.SuccessfulBuildsHistoryLimit
+	} else {
+		out.SuccessfulBuildsHistoryLimit = nil
+	}
+	if in.FailedBuildsHistoryLimit != nil {
+		out.FailedBuildsHistoryLimit = new(int)
+		*out.FailedBuildsHistoryLimit = *in.FailedBuildsHistoryLimit
+	} else {
+		out.FailedBuildsHistoryLimit = nil
+	}
 	if err := deepCopy_api_BuildSpec(in.BuildSpec, &out.BuildSpec, c); err != nil {
 		return err
 	}
@@ -880,6 +933,34 @@ func deepCopy_api_BuildOutput(in buildapi.BuildOutput, out *buildapi.BuildOutput
 	} else {
 		out.PushSecret = nil
 	}
+	if in.Destinations != nil {
+		out.Destinations = make([]buildapi.BuildOutputDestination, len(in.Destinations))
+		for i := range in.Destinations {
+			if err := deepCopy_api_BuildOutputDestination(in.Destinations[i], &out.Destinations[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Destinations = nil
+	}
+	return nil
+}
+
+func deepCopy_api_BuildOutputDestination(in buildapi.BuildOutputDestination, out *buildapi.BuildOutputDestination, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.To); err != nil {
+		return err
+	} else {
+		out.To = newVal.(pkgapi.ObjectReference)
+	}
+	if in.PushSecret != nil {
+		if newVal, err := c.DeepCopy(in.PushSecret); err != nil {
+			return err
+		} else {
+			out.PushSecret = newVal.(*pkgapi.LocalObjectReference)
+		}
+	} else {
+		out.PushSecret = nil
+	}
 	return nil
 }
 
@@ -967,6 +1048,14 @@ func deepCopy_api_BuildRequest(in buildapi.BuildRequest, out *buildapi.BuildRequ
 	} else {
 		out.Env = nil
 	}
+	if in.PullRequest != nil {
+		out.PullRequest = new(buildapi.PullRequestReference)
+		if err := deepCopy_api_PullRequestReference(*in.PullRequest, out.PullRequest, c); err != nil {
+			return err
+		}
+	} else {
+		out.PullRequest = nil
+	}
 	return nil
 }
 
@@ -1059,6 +1148,22 @@ func deepCopy_api_BuildSpec(in buildapi.BuildSpec, out *buildapi.BuildSpec, c *c
 	} else {
 		out.CompletionDeadlineSeconds = nil
 	}
+	if in.Cache != nil {
+		out.Cache = new(buildapi.BuildCache)
+		if err := deepCopy_api_BuildCache(*in.Cache, out.Cache, c); err != nil {
+			return err
+		}
+	} else {
+		out.Cache = nil
+	}
+	if in.CommitStatus != nil {
+		out.CommitStatus = new(buildapi.CommitStatusReporting)
+		if err := deepCopy_api_CommitStatusReporting(*in.CommitStatus, out.CommitStatus, c); err != nil {
+			return err
+		}
+	} else {
+		out.CommitStatus = nil
+	}
 	return nil
 }
 
@@ -1096,6 +1201,32 @@ func deepCopy_api_BuildStatus(in buildapi.BuildStatus, out *buildapi.BuildStatus
 	} else {
 		out.Config = nil
 	}
+	if in.Stages != nil {
+		out.Stages = make([]buildapi.StageInfo, len(in.Stages))
+		for i := range in.Stages {
+			if err := deepCopy_api_StageInfo(in.Stages[i], &out.Stages[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Stages = nil
+	}
+	if in.Outputs != nil {
+		out.Outputs = make([]buildapi.BuildStatusOutput, len(in.Outputs))
+		for i := range in.Outputs {
+			if err := deepCopy_api_BuildStatusOutput(in.Outputs[i], &out.Outputs[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Outputs = nil
+	}
+	return nil
+}
+
+func deepCopy_api_BuildStatusOutput(in buildapi.BuildStatusOutput, out *buildapi.BuildStatusOutput, c *conversion.Cloner) error {
+	out.DockerImageReference = in.DockerImageReference
+	out.ImageDigest = in.ImageDigest
 	return nil
 }
 
@@ -1124,6 +1255,14 @@ func deepCopy_api_BuildStrategy(in buildapi.BuildStrategy, out *buildapi.BuildSt
 	} else {
 		out.CustomStrategy = nil
 	}
+	if in.JenkinsPipelineStrategy != nil {
+		out.JenkinsPipelineStrategy = new(buildapi.JenkinsPipelineBuildStrategy)
+		if err := deepCopy_api_JenkinsPipelineBuildStrategy(*in.JenkinsPipelineStrategy, out.JenkinsPipelineStrategy, c); err != nil {
+			return err
+		}
+	} else {
+		out.JenkinsPipelineStrategy = nil
+	}
 	return nil
 }
 
@@ -1145,6 +1284,22 @@ func deepCopy_api_BuildTriggerPolicy(in buildapi.BuildTriggerPolicy, out *builda
 	} else {
 		out.GenericWebHook = nil
 	}
+	if in.GitLabWebHook != nil {
+		out.GitLabWebHook = new(buildapi.WebHookTrigger)
+		if err := deepCopy_api_WebHookTrigger(*in.GitLabWebHook, out.GitLabWebHook, c); err != nil {
+			return err
+		}
+	} else {
+		out.GitLabWebHook = nil
+	}
+	if in.BitbucketWebHook != nil {
+		out.BitbucketWebHook = new(buildapi.WebHookTrigger)
+		if err := deepCopy_api_WebHookTrigger(*in.BitbucketWebHook, out.BitbucketWebHook, c); err != nil {
+			return err
+		}
+	} else {
+		out.BitbucketWebHook = nil
+	}
 	if in.ImageChange != nil {
 		out.ImageChange = new(buildapi.ImageChangeTrigger)
 		if err := deepCopy_api_ImageChangeTrigger(*in.ImageChange, out.ImageChange, c); err != nil {
@@ -1156,6 +1311,18 @@ func deepCopy_api_BuildTriggerPolicy(in buildapi.BuildTriggerPolicy, out *builda
 	return nil
 }
 
+func deepCopy_api_CommitStatusReporting(in buildapi.CommitStatusReporting, out *buildapi.CommitStatusReporting, c *conversion.Cloner) error {
+	out.Provider = in.Provider
+	out.APIURL = in.APIURL
+	if newVal, err := c.DeepCopy(in.Secret); err != nil {
+		return err
+	} else {
+		out.Secret = newVal.(pkgapi.LocalObjectReference)
+	}
+	out.Context = in.Context
+	return nil
+}
+
 func deepCopy_api_CustomBuildStrategy(in buildapi.CustomBuildStrategy, out *buildapi.CustomBuildStrategy, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.From); err != nil {
 		return err
@@ -1233,6 +1400,18 @@ func deepCopy_api_DockerBuildStrategy(in buildapi.DockerBuildStrategy, out *buil
 	}
 	out.ForcePull = in.ForcePull
 	out.DockerfilePath = in.DockerfilePath
+	if in.BuildArgs != nil {
+		out.BuildArgs = make([]pkgapi.EnvVar, len(in.BuildArgs))
+		for i := range in.BuildArgs {
+			if newVal, err := c.DeepCopy(in.BuildArgs[i]); err != nil {
+				return err
+			} else {
+				out.BuildArgs[i] = newVal.(pkgapi.EnvVar)
+			}
+		}
+	} else {
+		out.BuildArgs = nil
+	}
 	return nil
 }
 
@@ -1314,6 +1493,26 @@ func deepCopy_api_ImageSourcePath(in buildapi.ImageSourcePath, out *buildapi.Ima
 	return nil
 }
 
+func deepCopy_api_JenkinsPipelineBuildStrategy(in buildapi.JenkinsPipelineBuildStrategy, out *buildapi.JenkinsPipelineBuildStrategy, c *conversion.Cloner) error {
+	out.JenkinsfilePath = in.JenkinsfilePath
+	out.Jenkinsfile = in.Jenkinsfile
+	return nil
+}
+
+func deepCopy_api_PullRequestBuildPolicy(in buildapi.PullRequestBuildPolicy, out *buildapi.PullRequestBuildPolicy, c *conversion.Cloner) error {
+	out.OutputTagPrefix = in.OutputTagPrefix
+	out.AllowForkSecrets = in.AllowForkSecrets
+	return nil
+}
+
+func deepCopy_api_PullRequestReference(in buildapi.PullRequestReference, out *buildapi.PullRequestReference, c *conversion.Cloner) error {
+	out.Number = in.Number
+	out.Ref = in.Ref
+	out.Fork = in.Fork
+	out.OutputTag = in.OutputTag
+	return nil
+}
+
 func deepCopy_api_SecretBuildSource(in buildapi.SecretBuildSource, out *buildapi.SecretBuildSource, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.Secret); err != nil {
 		return err
@@ -1385,8 +1584,101 @@ func deepCopy_api_SourceRevision(in buildapi.SourceRevision, out *buildapi.Sourc
 	return nil
 }
 
+func deepCopy_api_StageInfo(in buildapi.StageInfo, out *buildapi.StageInfo, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.Phase = in.Phase
+	if in.StartTimestamp != nil {
+		if newVal, err := c.DeepCopy(in.StartTimestamp); err != nil {
+			return err
+		} else {
+			out.StartTimestamp = newVal.(*unversioned.Time)
+		}
+	} else {
+		out.StartTimestamp = nil
+	}
+	if in.CompletionTimestamp != nil {
+		if newVal, err := c.DeepCopy(in.CompletionTimestamp); err != nil {
+			return err
+		} else {
+			out.CompletionTimestamp = newVal.(*unversioned.Time)
+		}
+	} else {
+		out.CompletionTimestamp = nil
+	}
+	return nil
+}
+
 func deepCopy_api_WebHookTrigger(in buildapi.WebHookTrigger, out *buildapi.WebHookTrigger, c *conversion.Cloner) error {
 	out.Secret = in.Secret
+	if in.SecretReference != nil {
+		if newVal, err := c.DeepCopy(in.SecretReference); err != nil {
+			return err
+		} else {
+			out.SecretReference = newVal.(*pkgapi.LocalObjectReference)
+		}
+	} else {
+		out.SecretReference = nil
+	}
+	if in.PullRequests != nil {
+		out.PullRequests = new(buildapi.PullRequestBuildPolicy)
+		if err := deepCopy_api_PullRequestBuildPolicy(*in.PullRequests, out.PullRequests, c); err != nil {
+			return err
+		}
+	} else {
+		out.PullRequests = nil
+	}
+	return nil
+}
+
+func deepCopy_api_CanaryDeploymentStrategyParams(in deployapi.CanaryDeploymentStrategyParams, out *deployapi.CanaryDeploymentStrategyParams, c *conversion.Cloner) error {
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	out.Traffic = in.Traffic
+	out.RouteName = in.RouteName
+	if in.Steps != nil {
+		out.Steps = make([]deployapi.CanaryStep, len(in.Steps))
+		for i := range in.Steps {
+			if err := deepCopy_api_CanaryStep(in.Steps[i], &out.Steps[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Steps = nil
+	}
+	if in.Pre != nil {
+		out.Pre = new(deployapi.LifecycleHook)
+		if err := deepCopy_api_LifecycleHook(*in.Pre, out.Pre, c); err != nil {
+			return err
+		}
+	} else {
+		out.Pre = nil
+	}
+	if in.Post != nil {
+		out.Post = new(deployapi.LifecycleHook)
+		if err := deepCopy_api_LifecycleHook(*in.Post, out.Post, c); err != nil {
+			return err
+		}
+	} else {
+		out.Post = nil
+	}
+	return nil
+}
+
+func deepCopy_api_CanaryStep(in deployapi.CanaryStep, out *deployapi.CanaryStep, c *conversion.Cloner) error {
+	out.Weight = in.Weight
+	if in.Verify != nil {
+		out.Verify = new(deployapi.LifecycleHook)
+		if err := deepCopy_api_LifecycleHook(*in.Verify, out.Verify, c); err != nil {
+			return err
+		}
+	} else {
+		out.Verify = nil
+	}
+	out.Approve = in.Approve
 	return nil
 }
 
@@ -1425,6 +1717,14 @@ func deepCopy_api_DeploymentCause(in deployapi.DeploymentCause, out *deployapi.D
 	} else {
 		out.ImageTrigger = nil
 	}
+	if in.ObjectTrigger != nil {
+		out.ObjectTrigger = new(deployapi.DeploymentCauseObjectTrigger)
+		if err := deepCopy_api_DeploymentCauseObjectTrigger(*in.ObjectTrigger, out.ObjectTrigger, c); err != nil {
+			return err
+		}
+	} else {
+		out.ObjectTrigger = nil
+	}
 	return nil
 }
 
@@ -1437,6 +1737,28 @@ func deepCopy_api_DeploymentCauseImageTrigger(in deployapi.DeploymentCauseImageT
 	return nil
 }
 
+func deepCopy_api_DeploymentCauseObjectTrigger(in deployapi.DeploymentCauseObjectTrigger, out *deployapi.DeploymentCauseObjectTrigger, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.From); err != nil {
+		return err
+	} else {
+		out.From = newVal.(pkgapi.ObjectReference)
+	}
+	return nil
+}
+
+func deepCopy_api_DeploymentCondition(in deployapi.DeploymentCondition, out *deployapi.DeploymentCondition, c *conversion.Cloner) error {
+	out.Type = in.Type
+	out.Status = in.Status
+	if newVal, err := c.DeepCopy(in.LastTransitionTime); err != nil {
+		return err
+	} else {
+		out.LastTransitionTime = newVal.(unversioned.Time)
+	}
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
 func deepCopy_api_DeploymentConfig(in deployapi.DeploymentConfig, out *deployapi.DeploymentConfig, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -1457,6 +1779,14 @@ func deepCopy_api_DeploymentConfig(in deployapi.DeploymentConfig, out *deployapi
 	return nil
 }
 
+func deepCopy_api_DeploymentConfigChange(in deployapi.DeploymentConfigChange, out *deployapi.DeploymentConfigChange, c *conversion.Cloner) error {
+	out.Type = in.Type
+	out.Field = in.Field
+	out.Current = in.Current
+	out.RolledBack = in.RolledBack
+	return nil
+}
+
 func deepCopy_api_DeploymentConfigList(in deployapi.DeploymentConfigList, out *deployapi.DeploymentConfigList, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -1493,6 +1823,31 @@ func deepCopy_api_DeploymentConfigRollback(in deployapi.DeploymentConfigRollback
 	return nil
 }
 
+func deepCopy_api_DeploymentConfigRollbackDiff(in deployapi.DeploymentConfigRollbackDiff, out *deployapi.DeploymentConfigRollbackDiff, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	out.Name = in.Name
+	if newVal, err := c.DeepCopy(in.From); err != nil {
+		return err
+	} else {
+		out.From = newVal.(pkgapi.ObjectReference)
+	}
+	if in.Changes != nil {
+		out.Changes = make([]deployapi.DeploymentConfigChange, len(in.Changes))
+		for i := range in.Changes {
+			if err := deepCopy_api_DeploymentConfigChange(in.Changes[i], &out.Changes[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Changes = nil
+	}
+	return nil
+}
+
 func deepCopy_api_DeploymentConfigRollbackSpec(in deployapi.DeploymentConfigRollbackSpec, out *deployapi.DeploymentConfigRollbackSpec, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.From); err != nil {
 		return err
@@ -1503,6 +1858,7 @@ func deepCopy_api_DeploymentConfigRollbackSpec(in deployapi.DeploymentConfigRoll
 	out.IncludeTemplate = in.IncludeTemplate
 	out.IncludeReplicationMeta = in.IncludeReplicationMeta
 	out.IncludeStrategy = in.IncludeStrategy
+	out.DiffOnly = in.DiffOnly
 	return nil
 }
 
@@ -1522,6 +1878,20 @@ func deepCopy_api_DeploymentConfigSpec(in deployapi.DeploymentConfigSpec, out *d
 	}
 	out.Replicas = in.Replicas
 	out.Test = in.Test
+	out.Paused = in.Paused
+	if in.ProgressDeadlineSeconds != nil {
+		out.ProgressDeadlineSeconds = new(int64)
+		*out.ProgressDeadlineSeconds = *in.ProgressDeadlineSeconds
+	} else {
+		out.ProgressDeadlineSeconds = nil
+	}
+	out.AutoRollback = in.AutoRollback
+	if in.RevisionHistoryLimit != nil {
+		out.RevisionHistoryLimit = new(int)
+		*out.RevisionHistoryLimit = *in.RevisionHistoryLimit
+	} else {
+		out.RevisionHistoryLimit = nil
+	}
 	if in.Selector != nil {
 		out.Selector = make(map[string]string)
 		for key, val := range in.Selector {
@@ -1552,6 +1922,30 @@ func deepCopy_api_DeploymentConfigStatus(in deployapi.DeploymentConfigStatus, ou
 	} else {
 		out.Details = nil
 	}
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Replicas = in.Replicas
+	out.UpdatedReplicas = in.UpdatedReplicas
+	out.AvailableReplicas = in.AvailableReplicas
+	if in.Conditions != nil {
+		out.Conditions = make([]deployapi.DeploymentCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := deepCopy_api_DeploymentCondition(in.Conditions[i], &out.Conditions[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
+	if in.TriggeredObjects != nil {
+		out.TriggeredObjects = make([]deployapi.DeploymentTriggeredObject, len(in.TriggeredObjects))
+		for i := range in.TriggeredObjects {
+			if err := deepCopy_api_DeploymentTriggeredObject(in.TriggeredObjects[i], &out.TriggeredObjects[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.TriggeredObjects = nil
+	}
 	return nil
 }
 
@@ -1656,6 +2050,14 @@ func deepCopy_api_DeploymentStrategy(in deployapi.DeploymentStrategy, out *deplo
 	} else {
 		out.RollingParams = nil
 	}
+	if in.CanaryParams != nil {
+		out.CanaryParams = new(deployapi.CanaryDeploymentStrategyParams)
+		if err := deepCopy_api_CanaryDeploymentStrategyParams(*in.CanaryParams, out.CanaryParams, c); err != nil {
+			return err
+		}
+	} else {
+		out.CanaryParams = nil
+	}
 	if newVal, err := c.DeepCopy(in.Resources); err != nil {
 		return err
 	} else {
@@ -1699,6 +2101,11 @@ func deepCopy_api_DeploymentTriggerImageChangeParams(in deployapi.DeploymentTrig
 	return nil
 }
 
+func deepCopy_api_DeploymentTriggerObjectChangeParams(in deployapi.DeploymentTriggerObjectChangeParams, out *deployapi.DeploymentTriggerObjectChangeParams, c *conversion.Cloner) error {
+	out.Name = in.Name
+	return nil
+}
+
 func deepCopy_api_DeploymentTriggerPolicy(in deployapi.DeploymentTriggerPolicy, out *deployapi.DeploymentTriggerPolicy, c *conversion.Cloner) error {
 	out.Type = in.Type
 	if in.ImageChangeParams != nil {
@@ -1709,6 +2116,29 @@ func deepCopy_api_DeploymentTriggerPolicy(in deployapi.DeploymentTriggerPolicy,
 	} else {
 		out.ImageChangeParams = nil
 	}
+	if in.SecretChangeParams != nil {
+		out.SecretChangeParams = new(deployapi.DeploymentTriggerObjectChangeParams)
+		if err := deepCopy_api_DeploymentTriggerObjectChangeParams(*in.SecretChangeParams, out.SecretChangeParams, c); err != nil {
+			return err
+		}
+	} else {
+		out.SecretChangeParams = nil
+	}
+	if in.ConfigMapChangeParams != nil {
+		out.ConfigMapChangeParams = new(deployapi.DeploymentTriggerObjectChangeParams)
+		if err := deepCopy_api_DeploymentTriggerObjectChangeParams(*in.ConfigMapChangeParams, out.ConfigMapChangeParams, c); err != nil {
+			return err
+		}
+	} else {
+		out.ConfigMapChangeParams = nil
+	}
+	return nil
+}
+
+func deepCopy_api_DeploymentTriggeredObject(in deployapi.DeploymentTriggeredObject, out *deployapi.DeploymentTriggeredObject, c *conversion.Cloner) error {
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.DataHash = in.DataHash
 	return nil
 }
 
@@ -1742,6 +2172,70 @@ func deepCopy_api_ExecNewPodHook(in deployapi.ExecNewPodHook, out *deployapi.Exe
 	} else {
 		out.Volumes = nil
 	}
+	if in.ExtraVolumes != nil {
+		out.ExtraVolumes = make([]deployapi.HookVolume, len(in.ExtraVolumes))
+		for i := range in.ExtraVolumes {
+			if err := deepCopy_api_HookVolume(in.ExtraVolumes[i], &out.ExtraVolumes[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ExtraVolumes = nil
+	}
+	if in.Resources != nil {
+		if newVal, err := c.DeepCopy(in.Resources); err != nil {
+			return err
+		} else {
+			out.Resources = newVal.(*pkgapi.ResourceRequirements)
+		}
+	} else {
+		out.Resources = nil
+	}
+	out.ServiceAccountName = in.ServiceAccountName
+	if in.NodeSelector != nil {
+		out.NodeSelector = make(map[string]string)
+		for key, val := range in.NodeSelector {
+			out.NodeSelector[key] = val
+		}
+	} else {
+		out.NodeSelector = nil
+	}
+	return nil
+}
+
+func deepCopy_api_HTTPGetHook(in deployapi.HTTPGetHook, out *deployapi.HTTPGetHook, c *conversion.Cloner) error {
+	out.URL = in.URL
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	return nil
+}
+
+func deepCopy_api_HookVolume(in deployapi.HookVolume, out *deployapi.HookVolume, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.MountPath = in.MountPath
+	out.ReadOnly = in.ReadOnly
+	if in.Secret != nil {
+		if newVal, err := c.DeepCopy(in.Secret); err != nil {
+			return err
+		} else {
+			out.Secret = newVal.(*pkgapi.SecretVolumeSource)
+		}
+	} else {
+		out.Secret = nil
+	}
+	if in.EmptyDir != nil {
+		if newVal, err := c.DeepCopy(in.EmptyDir); err != nil {
+			return err
+		} else {
+			out.EmptyDir = newVal.(*pkgapi.EmptyDirVolumeSource)
+		}
+	} else {
+		out.EmptyDir = nil
+	}
 	return nil
 }
 
@@ -1765,6 +2259,22 @@ func deepCopy_api_LifecycleHook(in deployapi.LifecycleHook, out *deployapi.Lifec
 	} else {
 		out.TagImages = nil
 	}
+	if in.HTTPGet != nil {
+		out.HTTPGet = new(deployapi.HTTPGetHook)
+		if err := deepCopy_api_HTTPGetHook(*in.HTTPGet, out.HTTPGet, c); err != nil {
+			return err
+		}
+	} else {
+		out.HTTPGet = nil
+	}
+	if in.WaitForImage != nil {
+		out.WaitForImage = new(deployapi.WaitForImageHook)
+		if err := deepCopy_api_WaitForImageHook(*in.WaitForImage, out.WaitForImage, c); err != nil {
+			return err
+		}
+	} else {
+		out.WaitForImage = nil
+	}
 	return nil
 }
 
@@ -1866,6 +2376,23 @@ func deepCopy_api_TagImageHook(in deployapi.TagImageHook, out *deployapi.TagImag
 	return nil
 }
 
+func deepCopy_api_WaitForImageHook(in deployapi.WaitForImageHook, out *deployapi.WaitForImageHook, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.From); err != nil {
+		return err
+	} else {
+		out.From = newVal.(pkgapi.ObjectReference)
+	}
+	out.Digest = in.Digest
+	out.ContainerName = in.ContainerName
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	return nil
+}
+
 func deepCopy_api_DockerConfig(in imageapi.DockerConfig, out *imageapi.DockerConfig, c *conversion.Cloner) error {
 	out.Hostname = in.Hostname
 	out.Domainname = in.Domainname
@@ -2856,6 +3383,22 @@ func deepCopy_api_RouteSpec(in routeapi.RouteSpec, out *routeapi.RouteSpec, c *c
 	} else {
 		out.To = newVal.(pkgapi.ObjectReference)
 	}
+	if in.Weight != nil {
+		out.Weight = new(int32)
+		*out.Weight = *in.Weight
+	} else {
+		out.Weight = nil
+	}
+	if in.AlternateBackends != nil {
+		out.AlternateBackends = make([]routeapi.RouteTargetReference, len(in.AlternateBackends))
+		for i := range in.AlternateBackends {
+			if err := deepCopy_api_RouteTargetReference(in.AlternateBackends[i], &out.AlternateBackends[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.AlternateBackends = nil
+	}
 	if in.Port != nil {
 		out.Port = new(routeapi.RoutePort)
 		if err := deepCopy_api_RoutePort(*in.Port, out.Port, c); err != nil {
@@ -2872,6 +3415,7 @@ func deepCopy_api_RouteSpec(in routeapi.RouteSpec, out *routeapi.RouteSpec, c *c
 	} else {
 		out.TLS = nil
 	}
+	out.WildcardPolicy = in.WildcardPolicy
 	return nil
 }
 
@@ -2889,6 +3433,18 @@ func deepCopy_api_RouteStatus(in routeapi.RouteStatus, out *routeapi.RouteStatus
 	return nil
 }
 
+func deepCopy_api_RouteTargetReference(in routeapi.RouteTargetReference, out *routeapi.RouteTargetReference, c *conversion.Cloner) error {
+	out.Kind = in.Kind
+	out.Name = in.Name
+	if in.Weight != nil {
+		out.Weight = new(int32)
+		*out.Weight = *in.Weight
+	} else {
+		out.Weight = nil
+	}
+	return nil
+}
+
 func deepCopy_api_TLSConfig(in routeapi.TLSConfig, out *routeapi.TLSConfig, c *conversion.Cloner) error {
 	out.Termination = in.Termination
 	out.Certificate = in.Certificate
@@ -3310,6 +3866,7 @@ func init() {
 		deepCopy_api_BinaryBuildRequestOptions,
 		deepCopy_api_BinaryBuildSource,
 		deepCopy_api_Build,
+		deepCopy_api_BuildCache,
 		deepCopy_api_BuildConfig,
 		deepCopy_api_BuildConfigList,
 		deepCopy_api_BuildConfigSpec,
@@ -3318,13 +3875,16 @@ func init() {
 		deepCopy_api_BuildLog,
 		deepCopy_api_BuildLogOptions,
 		deepCopy_api_BuildOutput,
+		deepCopy_api_BuildOutputDestination,
 		deepCopy_api_BuildPostCommitSpec,
 		deepCopy_api_BuildRequest,
 		deepCopy_api_BuildSource,
 		deepCopy_api_BuildSpec,
 		deepCopy_api_BuildStatus,
+		deepCopy_api_BuildStatusOutput,
 		deepCopy_api_BuildStrategy,
 		deepCopy_api_BuildTriggerPolicy,
+		deepCopy_api_CommitStatusReporting,
 		deepCopy_api_CustomBuildStrategy,
 		deepCopy_api_DockerBuildStrategy,
 		deepCopy_api_GitBuildSource,
@@ -3332,18 +3892,28 @@ func init() {
 		deepCopy_api_ImageChangeTrigger,
 		deepCopy_api_ImageSource,
 		deepCopy_api_ImageSourcePath,
+		deepCopy_api_JenkinsPipelineBuildStrategy,
+		deepCopy_api_PullRequestBuildPolicy,
+		deepCopy_api_PullRequestReference,
 		deepCopy_api_SecretBuildSource,
 		deepCopy_api_SecretSpec,
 		deepCopy_api_SourceBuildStrategy,
 		deepCopy_api_SourceControlUser,
 		deepCopy_api_SourceRevision,
+		deepCopy_api_StageInfo,
 		deepCopy_api_WebHookTrigger,
+		deepCopy_api_CanaryDeploymentStrategyParams,
+		deepCopy_api_CanaryStep,
 		deepCopy_api_CustomDeploymentStrategyParams,
 		deepCopy_api_DeploymentCause,
 		deepCopy_api_DeploymentCauseImageTrigger,
+		deepCopy_api_DeploymentCauseObjectTrigger,
+		deepCopy_api_DeploymentCondition,
 		deepCopy_api_DeploymentConfig,
+		deepCopy_api_DeploymentConfigChange,
 		deepCopy_api_DeploymentConfigList,
 		deepCopy_api_DeploymentConfigRollback,
+		deepCopy_api_DeploymentConfigRollbackDiff,
 		deepCopy_api_DeploymentConfigRollbackSpec,
 		deepCopy_api_DeploymentConfigSpec,
 		deepCopy_api_DeploymentConfigStatus,
@@ -3352,12 +3922,17 @@ func init() {
 		deepCopy_api_DeploymentLogOptions,
 		deepCopy_api_DeploymentStrategy,
 		deepCopy_api_DeploymentTriggerImageChangeParams,
+		deepCopy_api_DeploymentTriggerObjectChangeParams,
 		deepCopy_api_DeploymentTriggerPolicy,
+		deepCopy_api_DeploymentTriggeredObject,
 		deepCopy_api_ExecNewPodHook,
+		deepCopy_api_HTTPGetHook,
+		deepCopy_api_HookVolume,
 		deepCopy_api_LifecycleHook,
 		deepCopy_api_RecreateDeploymentStrategyParams,
 		deepCopy_api_RollingDeploymentStrategyParams,
 		deepCopy_api_TagImageHook,
+		deepCopy_api_WaitForImageHook,
 		deepCopy_api_DockerConfig,
 		deepCopy_api_DockerImage,
 		deepCopy_api_Image,
@@ -3403,6 +3978,7 @@ func init() {
 		deepCopy_api_RoutePort,
 		deepCopy_api_RouteSpec,
 		deepCopy_api_RouteStatus,
+		deepCopy_api_RouteTargetReference,
 		deepCopy_api_TLSConfig,
 		deepCopy_api_ClusterNetwork,
 		deepCopy_api_ClusterNetworkList,
@@ -221,6 +221,11 @@ func fuzzInternalObject(t *testing.T, forVersion unversioned.GroupVersion, item
 			j.From.ResourceVersion = ""
 			j.From.FieldPath = ""
 		},
+		func(j *build.BuildConfigSpec, c fuzz.Continue) {
+			c.FuzzNoCustom(j)
+			policies := []build.BuildRunPolicy{build.BuildRunPolicyParallel, build.BuildRunPolicySerial, build.BuildRunPolicySerialLatestOnly}
+			j.RunPolicy = policies[c.Intn(len(policies))]
+		},
 		func(j *build.BuildOutput, c fuzz.Continue) {
 			c.FuzzNoCustom(j)
 			if j.To != nil && (len(j.To.Kind) == 0 || j.To.Kind == "ImageStream") {
@@ -236,6 +241,14 @@ func fuzzInternalObject(t *testing.T, forVersion unversioned.GroupVersion, item
 				Kind: "Service",
 				Name: j.To.Name,
 			}
+			for i := range j.AlternateBackends {
+				if len(j.AlternateBackends[i].Kind) == 0 {
+					j.AlternateBackends[i].Kind = "Service"
+				}
+			}
+			if len(j.WildcardPolicy) == 0 {
+				j.WildcardPolicy = route.WildcardPolicyNone
+			}
 		},
 		func(j *route.TLSConfig, c fuzz.Continue) {
 			c.FuzzNoCustom(j)
@@ -249,8 +262,8 @@ func fuzzInternalObject(t *testing.T, forVersion unversioned.GroupVersion, item
 		},
 		func(j *deploy.DeploymentStrategy, c fuzz.Continue) {
 			c.FuzzNoCustom(j)
-			j.RecreateParams, j.RollingParams, j.CustomParams = nil, nil, nil
-			strategyTypes := []deploy.DeploymentStrategyType{deploy.DeploymentStrategyTypeRecreate, deploy.DeploymentStrategyTypeRolling, deploy.DeploymentStrategyTypeCustom}
+			j.RecreateParams, j.RollingParams, j.CustomParams, j.CanaryParams = nil, nil, nil, nil
+			strategyTypes := []deploy.DeploymentStrategyType{deploy.DeploymentStrategyTypeRecreate, deploy.DeploymentStrategyTypeRolling, deploy.DeploymentStrategyTypeCustom, deploy.DeploymentStrategyTypeCanary}
 			j.Type = strategyTypes[c.Rand.Intn(len(strategyTypes))]
 			switch j.Type {
 			case deploy.DeploymentStrategyTypeRecreate:
@@ -305,6 +318,21 @@ func fuzzInternalObject(t *testing.T, forVersion unversioned.GroupVersion, item
 					params.MaxUnavailable = intstr.FromString(fmt.Sprintf("%d%%", c.RandUint64()))
 				}
 				j.RollingParams = params
+			case deploy.DeploymentStrategyTypeCanary:
+				params := &deploy.CanaryDeploymentStrategyParams{}
+				c.Fuzz(params)
+				if params.TimeoutSeconds == nil {
+					s := int64(120)
+					params.TimeoutSeconds = &s
+				}
+				trafficTypes := []deploy.CanaryTrafficType{deploy.CanaryTrafficReplicas, deploy.CanaryTrafficRouteWeight, deploy.CanaryTrafficBlueGreen}
+				params.Traffic = trafficTypes[c.Rand.Intn(len(trafficTypes))]
+				defaultLifecycleHook(params.Pre)
+				defaultLifecycleHook(params.Post)
+				for i := range params.Steps {
+					defaultLifecycleHook(params.Steps[i].Verify)
+				}
+				j.CanaryParams = params
 			}
 		},
 		func(j *deploy.DeploymentCauseImageTrigger, c fuzz.Continue) {
@@ -415,6 +443,14 @@ func defaultLifecycleHook(hook *deploy.LifecycleHook) {
 			hook.TagImages[i].ContainerName = "test"
 		}
 	}
+	if hook.HTTPGet != nil && hook.HTTPGet.TimeoutSeconds == nil {
+		s := deploy.DefaultHTTPGetHookTimeoutSeconds
+		hook.HTTPGet.TimeoutSeconds = &s
+	}
+	if hook.WaitForImage != nil && hook.WaitForImage.TimeoutSeconds == nil {
+		s := deploy.DefaultWaitForImageHookTimeoutSeconds
+		hook.WaitForImage.TimeoutSeconds = &s
+	}
 }
 
 func roundTrip(t *testing.T, codec runtime.Codec, originalItem runtime.Object) {
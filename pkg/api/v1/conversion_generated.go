@@ -987,6 +987,53 @@ func Convert_api_Build_To_v1_Build(in *buildapi.Build, out *v1.Build, s conversi
 	return autoConvert_api_Build_To_v1_Build(in, out, s)
 }
 
+func autoConvert_api_BuildCache_To_v1_BuildCache(in *buildapi.BuildCache, out *v1.BuildCache, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.BuildCache))(in)
+	}
+	if in.Paths != nil {
+		out.Paths = make([]v1.ImageSourcePath, len(in.Paths))
+		for i := range in.Paths {
+			if err := Convert_api_ImageSourcePath_To_v1_ImageSourcePath(&in.Paths[i], &out.Paths[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Paths = nil
+	}
+	if in.KeyFiles != nil {
+		out.KeyFiles = make([]string, len(in.KeyFiles))
+		for i := range in.KeyFiles {
+			out.KeyFiles[i] = in.KeyFiles[i]
+		}
+	} else {
+		out.KeyFiles = nil
+	}
+	// unable to generate simple pointer conversion for api.LocalObjectReference -> v1.LocalObjectReference
+	if in.PersistentVolumeClaim != nil {
+		out.PersistentVolumeClaim = new(apiv1.LocalObjectReference)
+		if err := Convert_api_LocalObjectReference_To_v1_LocalObjectReference(in.PersistentVolumeClaim, out.PersistentVolumeClaim, s); err != nil {
+			return err
+		}
+	} else {
+		out.PersistentVolumeClaim = nil
+	}
+	// unable to generate simple pointer conversion for api.ObjectReference -> v1.ObjectReference
+	if in.Image != nil {
+		out.Image = new(apiv1.ObjectReference)
+		if err := Convert_api_ObjectReference_To_v1_ObjectReference(in.Image, out.Image, s); err != nil {
+			return err
+		}
+	} else {
+		out.Image = nil
+	}
+	return nil
+}
+
+func Convert_api_BuildCache_To_v1_BuildCache(in *buildapi.BuildCache, out *v1.BuildCache, s conversion.Scope) error {
+	return autoConvert_api_BuildCache_To_v1_BuildCache(in, out, s)
+}
+
 func autoConvert_api_BuildConfig_To_v1_BuildConfig(in *buildapi.BuildConfig, out *v1.BuildConfig, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.BuildConfig))(in)
@@ -1041,6 +1088,19 @@ func autoConvert_api_BuildConfigSpec_To_v1_BuildConfigSpec(in *buildapi.BuildCon
 	} else {
 		out.Triggers = nil
 	}
+	out.RunPolicy = v1.BuildRunPolicy(in.RunPolicy)
+	if in.SuccessfulBuildsHistoryLimit != nil {
+		out.SuccessfulBuildsHistoryLimit = new(int)
+		*out.SuccessfulBuildsHistoryLimit = *in.SuccessfulBuildsHistoryLimit
+	} else {
+		out.SuccessfulBuildsHistoryLimit = nil
+	}
+	if in.FailedBuildsHistoryLimit != nil {
+		out.FailedBuildsHistoryLimit = new(int)
+		*out.FailedBuildsHistoryLimit = *in.FailedBuildsHistoryLimit
+	} else {
+		out.FailedBuildsHistoryLimit = nil
+	}
 	if err := Convert_api_BuildSpec_To_v1_BuildSpec(&in.BuildSpec, &out.BuildSpec, s); err != nil {
 		return err
 	}
@@ -1169,9 +1229,42 @@ func autoConvert_api_BuildOutput_To_v1_BuildOutput(in *buildapi.BuildOutput, out
 	} else {
 		out.PushSecret = nil
 	}
+	if in.Destinations != nil {
+		out.Destinations = make([]v1.BuildOutputDestination, len(in.Destinations))
+		for i := range in.Destinations {
+			if err := Convert_api_BuildOutputDestination_To_v1_BuildOutputDestination(&in.Destinations[i], &out.Destinations[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Destinations = nil
+	}
+	return nil
+}
+
+func autoConvert_api_BuildOutputDestination_To_v1_BuildOutputDestination(in *buildapi.BuildOutputDestination, out *v1.BuildOutputDestination, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.BuildOutputDestination))(in)
+	}
+	if err := Convert_api_ObjectReference_To_v1_ObjectReference(&in.To, &out.To, s); err != nil {
+		return err
+	}
+	// unable to generate simple pointer conversion for api.LocalObjectReference -> v1.LocalObjectReference
+	if in.PushSecret != nil {
+		out.PushSecret = new(apiv1.LocalObjectReference)
+		if err := Convert_api_LocalObjectReference_To_v1_LocalObjectReference(in.PushSecret, out.PushSecret, s); err != nil {
+			return err
+		}
+	} else {
+		out.PushSecret = nil
+	}
 	return nil
 }
 
+func Convert_api_BuildOutputDestination_To_v1_BuildOutputDestination(in *buildapi.BuildOutputDestination, out *v1.BuildOutputDestination, s conversion.Scope) error {
+	return autoConvert_api_BuildOutputDestination_To_v1_BuildOutputDestination(in, out, s)
+}
+
 func autoConvert_api_BuildPostCommitSpec_To_v1_BuildPostCommitSpec(in *buildapi.BuildPostCommitSpec, out *v1.BuildPostCommitSpec, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.BuildPostCommitSpec))(in)
@@ -1258,6 +1351,15 @@ func autoConvert_api_BuildRequest_To_v1_BuildRequest(in *buildapi.BuildRequest,
 	} else {
 		out.Env = nil
 	}
+	// unable to generate simple pointer conversion for api.PullRequestReference -> v1.PullRequestReference
+	if in.PullRequest != nil {
+		out.PullRequest = new(v1.PullRequestReference)
+		if err := Convert_api_PullRequestReference_To_v1_PullRequestReference(in.PullRequest, out.PullRequest, s); err != nil {
+			return err
+		}
+	} else {
+		out.PullRequest = nil
+	}
 	return nil
 }
 
@@ -1360,6 +1462,24 @@ func autoConvert_api_BuildSpec_To_v1_BuildSpec(in *buildapi.BuildSpec, out *v1.B
 	} else {
 		out.CompletionDeadlineSeconds = nil
 	}
+	// unable to generate simple pointer conversion for api.BuildCache -> v1.BuildCache
+	if in.Cache != nil {
+		out.Cache = new(v1.BuildCache)
+		if err := Convert_api_BuildCache_To_v1_BuildCache(in.Cache, out.Cache, s); err != nil {
+			return err
+		}
+	} else {
+		out.Cache = nil
+	}
+	// unable to generate simple pointer conversion for api.CommitStatusReporting -> v1.CommitStatusReporting
+	if in.CommitStatus != nil {
+		out.CommitStatus = new(v1.CommitStatusReporting)
+		if err := Convert_api_CommitStatusReporting_To_v1_CommitStatusReporting(in.CommitStatus, out.CommitStatus, s); err != nil {
+			return err
+		}
+	} else {
+		out.CommitStatus = nil
+	}
 	return nil
 }
 
@@ -1404,6 +1524,26 @@ func autoConvert_api_BuildStatus_To_v1_BuildStatus(in *buildapi.BuildStatus, out
 	} else {
 		out.Config = nil
 	}
+	if in.Stages != nil {
+		out.Stages = make([]v1.StageInfo, len(in.Stages))
+		for i := range in.Stages {
+			if err := Convert_api_StageInfo_To_v1_StageInfo(&in.Stages[i], &out.Stages[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Stages = nil
+	}
+	if in.Outputs != nil {
+		out.Outputs = make([]v1.BuildStatusOutput, len(in.Outputs))
+		for i := range in.Outputs {
+			if err := Convert_api_BuildStatusOutput_To_v1_BuildStatusOutput(&in.Outputs[i], &out.Outputs[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Outputs = nil
+	}
 	return nil
 }
 
@@ -1411,6 +1551,19 @@ func Convert_api_BuildStatus_To_v1_BuildStatus(in *buildapi.BuildStatus, out *v1
 	return autoConvert_api_BuildStatus_To_v1_BuildStatus(in, out, s)
 }
 
+func autoConvert_api_BuildStatusOutput_To_v1_BuildStatusOutput(in *buildapi.BuildStatusOutput, out *v1.BuildStatusOutput, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.BuildStatusOutput))(in)
+	}
+	out.DockerImageReference = in.DockerImageReference
+	out.ImageDigest = in.ImageDigest
+	return nil
+}
+
+func Convert_api_BuildStatusOutput_To_v1_BuildStatusOutput(in *buildapi.BuildStatusOutput, out *v1.BuildStatusOutput, s conversion.Scope) error {
+	return autoConvert_api_BuildStatusOutput_To_v1_BuildStatusOutput(in, out, s)
+}
+
 func autoConvert_api_BuildStrategy_To_v1_BuildStrategy(in *buildapi.BuildStrategy, out *v1.BuildStrategy, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.BuildStrategy))(in)
@@ -1439,6 +1592,15 @@ func autoConvert_api_BuildStrategy_To_v1_BuildStrategy(in *buildapi.BuildStrateg
 	} else {
 		out.CustomStrategy = nil
 	}
+	// unable to generate simple pointer conversion for api.JenkinsPipelineBuildStrategy -> v1.JenkinsPipelineBuildStrategy
+	if in.JenkinsPipelineStrategy != nil {
+		out.JenkinsPipelineStrategy = new(v1.JenkinsPipelineBuildStrategy)
+		if err := Convert_api_JenkinsPipelineBuildStrategy_To_v1_JenkinsPipelineBuildStrategy(in.JenkinsPipelineStrategy, out.JenkinsPipelineStrategy, s); err != nil {
+			return err
+		}
+	} else {
+		out.JenkinsPipelineStrategy = nil
+	}
 	return nil
 }
 
@@ -1465,6 +1627,24 @@ func autoConvert_api_BuildTriggerPolicy_To_v1_BuildTriggerPolicy(in *buildapi.Bu
 	} else {
 		out.GenericWebHook = nil
 	}
+	// unable to generate simple pointer conversion for api.WebHookTrigger -> v1.WebHookTrigger
+	if in.GitLabWebHook != nil {
+		out.GitLabWebHook = new(v1.WebHookTrigger)
+		if err := Convert_api_WebHookTrigger_To_v1_WebHookTrigger(in.GitLabWebHook, out.GitLabWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.GitLabWebHook = nil
+	}
+	// unable to generate simple pointer conversion for api.WebHookTrigger -> v1.WebHookTrigger
+	if in.BitbucketWebHook != nil {
+		out.BitbucketWebHook = new(v1.WebHookTrigger)
+		if err := Convert_api_WebHookTrigger_To_v1_WebHookTrigger(in.BitbucketWebHook, out.BitbucketWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.BitbucketWebHook = nil
+	}
 	// unable to generate simple pointer conversion for api.ImageChangeTrigger -> v1.ImageChangeTrigger
 	if in.ImageChange != nil {
 		out.ImageChange = new(v1.ImageChangeTrigger)
@@ -1477,6 +1657,23 @@ func autoConvert_api_BuildTriggerPolicy_To_v1_BuildTriggerPolicy(in *buildapi.Bu
 	return nil
 }
 
+func autoConvert_api_CommitStatusReporting_To_v1_CommitStatusReporting(in *buildapi.CommitStatusReporting, out *v1.CommitStatusReporting, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.CommitStatusReporting))(in)
+	}
+	out.Provider = v1.CommitStatusProvider(in.Provider)
+	out.APIURL = in.APIURL
+	if err := Convert_api_LocalObjectReference_To_v1_LocalObjectReference(&in.Secret, &out.Secret, s); err != nil {
+		return err
+	}
+	out.Context = in.Context
+	return nil
+}
+
+func Convert_api_CommitStatusReporting_To_v1_CommitStatusReporting(in *buildapi.CommitStatusReporting, out *v1.CommitStatusReporting, s conversion.Scope) error {
+	return autoConvert_api_CommitStatusReporting_To_v1_CommitStatusReporting(in, out, s)
+}
+
 func autoConvert_api_CustomBuildStrategy_To_v1_CustomBuildStrategy(in *buildapi.CustomBuildStrategy, out *v1.CustomBuildStrategy, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.CustomBuildStrategy))(in)
@@ -1554,6 +1751,16 @@ func autoConvert_api_DockerBuildStrategy_To_v1_DockerBuildStrategy(in *buildapi.
 	}
 	out.ForcePull = in.ForcePull
 	out.DockerfilePath = in.DockerfilePath
+	if in.BuildArgs != nil {
+		out.BuildArgs = make([]apiv1.EnvVar, len(in.BuildArgs))
+		for i := range in.BuildArgs {
+			if err := Convert_api_EnvVar_To_v1_EnvVar(&in.BuildArgs[i], &out.BuildArgs[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.BuildArgs = nil
+	}
 	return nil
 }
 
@@ -1668,6 +1875,47 @@ func Convert_api_ImageSourcePath_To_v1_ImageSourcePath(in *buildapi.ImageSourceP
 	return autoConvert_api_ImageSourcePath_To_v1_ImageSourcePath(in, out, s)
 }
 
+func autoConvert_api_JenkinsPipelineBuildStrategy_To_v1_JenkinsPipelineBuildStrategy(in *buildapi.JenkinsPipelineBuildStrategy, out *v1.JenkinsPipelineBuildStrategy, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.JenkinsPipelineBuildStrategy))(in)
+	}
+	out.JenkinsfilePath = in.JenkinsfilePath
+	out.Jenkinsfile = in.Jenkinsfile
+	return nil
+}
+
+func Convert_api_JenkinsPipelineBuildStrategy_To_v1_JenkinsPipelineBuildStrategy(in *buildapi.JenkinsPipelineBuildStrategy, out *v1.JenkinsPipelineBuildStrategy, s conversion.Scope) error {
+	return autoConvert_api_JenkinsPipelineBuildStrategy_To_v1_JenkinsPipelineBuildStrategy(in, out, s)
+}
+
+func autoConvert_api_PullRequestBuildPolicy_To_v1_PullRequestBuildPolicy(in *buildapi.PullRequestBuildPolicy, out *v1.PullRequestBuildPolicy, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.PullRequestBuildPolicy))(in)
+	}
+	out.OutputTagPrefix = in.OutputTagPrefix
+	out.AllowForkSecrets = in.AllowForkSecrets
+	return nil
+}
+
+func Convert_api_PullRequestBuildPolicy_To_v1_PullRequestBuildPolicy(in *buildapi.PullRequestBuildPolicy, out *v1.PullRequestBuildPolicy, s conversion.Scope) error {
+	return autoConvert_api_PullRequestBuildPolicy_To_v1_PullRequestBuildPolicy(in, out, s)
+}
+
+func autoConvert_api_PullRequestReference_To_v1_PullRequestReference(in *buildapi.PullRequestReference, out *v1.PullRequestReference, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.PullRequestReference))(in)
+	}
+	out.Number = in.Number
+	out.Ref = in.Ref
+	out.Fork = in.Fork
+	out.OutputTag = in.OutputTag
+	return nil
+}
+
+func Convert_api_PullRequestReference_To_v1_PullRequestReference(in *buildapi.PullRequestReference, out *v1.PullRequestReference, s conversion.Scope) error {
+	return autoConvert_api_PullRequestReference_To_v1_PullRequestReference(in, out, s)
+}
+
 func autoConvert_api_SecretBuildSource_To_v1_SecretBuildSource(in *buildapi.SecretBuildSource, out *v1.SecretBuildSource, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.SecretBuildSource))(in)
@@ -1759,11 +2007,60 @@ func autoConvert_api_SourceRevision_To_v1_SourceRevision(in *buildapi.SourceRevi
 	return nil
 }
 
+func autoConvert_api_StageInfo_To_v1_StageInfo(in *buildapi.StageInfo, out *v1.StageInfo, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.StageInfo))(in)
+	}
+	out.Name = in.Name
+	out.Phase = v1.BuildPhase(in.Phase)
+	// unable to generate simple pointer conversion for unversioned.Time -> unversioned.Time
+	if in.StartTimestamp != nil {
+		out.StartTimestamp = new(unversioned.Time)
+		if err := api.Convert_unversioned_Time_To_unversioned_Time(in.StartTimestamp, out.StartTimestamp, s); err != nil {
+			return err
+		}
+	} else {
+		out.StartTimestamp = nil
+	}
+	// unable to generate simple pointer conversion for unversioned.Time -> unversioned.Time
+	if in.CompletionTimestamp != nil {
+		out.CompletionTimestamp = new(unversioned.Time)
+		if err := api.Convert_unversioned_Time_To_unversioned_Time(in.CompletionTimestamp, out.CompletionTimestamp, s); err != nil {
+			return err
+		}
+	} else {
+		out.CompletionTimestamp = nil
+	}
+	return nil
+}
+
+func Convert_api_StageInfo_To_v1_StageInfo(in *buildapi.StageInfo, out *v1.StageInfo, s conversion.Scope) error {
+	return autoConvert_api_StageInfo_To_v1_StageInfo(in, out, s)
+}
+
 func autoConvert_api_WebHookTrigger_To_v1_WebHookTrigger(in *buildapi.WebHookTrigger, out *v1.WebHookTrigger, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.WebHookTrigger))(in)
 	}
 	out.Secret = in.Secret
+	// unable to generate simple pointer conversion for api.LocalObjectReference -> v1.LocalObjectReference
+	if in.SecretReference != nil {
+		out.SecretReference = new(apiv1.LocalObjectReference)
+		if err := Convert_api_LocalObjectReference_To_v1_LocalObjectReference(in.SecretReference, out.SecretReference, s); err != nil {
+			return err
+		}
+	} else {
+		out.SecretReference = nil
+	}
+	// unable to generate simple pointer conversion for api.PullRequestBuildPolicy -> v1.PullRequestBuildPolicy
+	if in.PullRequests != nil {
+		out.PullRequests = new(v1.PullRequestBuildPolicy)
+		if err := Convert_api_PullRequestBuildPolicy_To_v1_PullRequestBuildPolicy(in.PullRequests, out.PullRequests, s); err != nil {
+			return err
+		}
+	} else {
+		out.PullRequests = nil
+	}
 	return nil
 }
 
@@ -1824,6 +2121,53 @@ func Convert_v1_Build_To_api_Build(in *v1.Build, out *buildapi.Build, s conversi
 	return autoConvert_v1_Build_To_api_Build(in, out, s)
 }
 
+func autoConvert_v1_BuildCache_To_api_BuildCache(in *v1.BuildCache, out *buildapi.BuildCache, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1.BuildCache))(in)
+	}
+	if in.Paths != nil {
+		out.Paths = make([]buildapi.ImageSourcePath, len(in.Paths))
+		for i := range in.Paths {
+			if err := Convert_v1_ImageSourcePath_To_api_ImageSourcePath(&in.Paths[i], &out.Paths[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Paths = nil
+	}
+	if in.KeyFiles != nil {
+		out.KeyFiles = make([]string, len(in.KeyFiles))
+		for i := range in.KeyFiles {
+			out.KeyFiles[i] = in.KeyFiles[i]
+		}
+	} else {
+		out.KeyFiles = nil
+	}
+	// unable to generate simple pointer conversion for v1.LocalObjectReference -> api.LocalObjectReference
+	if in.PersistentVolumeClaim != nil {
+		out.PersistentVolumeClaim = new(api.LocalObjectReference)
+		if err := Convert_v1_LocalObjectReference_To_api_LocalObjectReference(in.PersistentVolumeClaim, out.PersistentVolumeClaim, s); err != nil {
+			return err
+		}
+	} else {
+		out.PersistentVolumeClaim = nil
+	}
+	// unable to generate simple pointer conversion for v1.ObjectReference -> api.ObjectReference
+	if in.Image != nil {
+		out.Image = new(api.ObjectReference)
+		if err := Convert_v1_ObjectReference_To_api_ObjectReference(in.Image, out.Image, s); err != nil {
+			return err
+		}
+	} else {
+		out.Image = nil
+	}
+	return nil
+}
+
+func Convert_v1_BuildCache_To_api_BuildCache(in *v1.BuildCache, out *buildapi.BuildCache, s conversion.Scope) error {
+	return autoConvert_v1_BuildCache_To_api_BuildCache(in, out, s)
+}
+
 func autoConvert_v1_BuildConfig_To_api_BuildConfig(in *v1.BuildConfig, out *buildapi.BuildConfig, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*v1.BuildConfig))(in)
@@ -1878,6 +2222,19 @@ func autoConvert_v1_BuildConfigSpec_To_api_BuildConfigSpec(in *v1.BuildConfigSpe
 	} else {
 		out.Triggers = nil
 	}
+	out.RunPolicy = buildapi.BuildRunPolicy(in.RunPolicy)
+	if in.SuccessfulBuildsHistoryLimit != nil {
+		out.SuccessfulBuildsHistoryLimit = new(int)
+		*out.SuccessfulBuildsHistoryLimit = *in.SuccessfulBuildsHistoryLimit
+	} else {
+		out.SuccessfulBuildsHistoryLimit = nil
+	}
+	if in.FailedBuildsHistoryLimit != nil {
+		out.FailedBuildsHistoryLimit = new(int)
+		*out.FailedBuildsHistoryLimit = *in.FailedBuildsHistoryLimit
+	} else {
+		out.FailedBuildsHistoryLimit = nil
+	}
 	if err := Convert_v1_BuildSpec_To_api_BuildSpec(&in.BuildSpec, &out.BuildSpec, s); err != nil {
 		return err
 	}
@@ -2006,9 +2363,42 @@ func autoConvert_v1_BuildOutput_To_api_BuildOutput(in *v1.BuildOutput, out *buil
 	} else {
 		out.PushSecret = nil
 	}
+	if in.Destinations != nil {
+		out.Destinations = make([]buildapi.BuildOutputDestination, len(in.Destinations))
+		for i := range in.Destinations {
+			if err := Convert_v1_BuildOutputDestination_To_api_BuildOutputDestination(&in.Destinations[i], &out.Destinations[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Destinations = nil
+	}
+	return nil
+}
+
+func autoConvert_v1_BuildOutputDestination_To_api_BuildOutputDestination(in *v1.BuildOutputDestination, out *buildapi.BuildOutputDestination, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1.BuildOutputDestination))(in)
+	}
+	if err := Convert_v1_ObjectReference_To_api_ObjectReference(&in.To, &out.To, s); err != nil {
+		return err
+	}
+	// unable to generate simple pointer conversion for v1.LocalObjectReference -> api.LocalObjectReference
+	if in.PushSecret != nil {
+		out.PushSecret = new(api.LocalObjectReference)
+		if err := Convert_v1_LocalObjectReference_To_api_LocalObjectReference(in.PushSecret, out.PushSecret, s); err != nil {
+			return err
+		}
+	} else {
+		out.PushSecret = nil
+	}
 	return nil
 }
 
+func Convert_v1_BuildOutputDestination_To_api_BuildOutputDestination(in *v1.BuildOutputDestination, out *buildapi.BuildOutputDestination, s conversion.Scope) error {
+	return autoConvert_v1_BuildOutputDestination_To_api_BuildOutputDestination(in, out, s)
+}
+
 func autoConvert_v1_BuildPostCommitSpec_To_api_BuildPostCommitSpec(in *v1.BuildPostCommitSpec, out *buildapi.BuildPostCommitSpec, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*v1.BuildPostCommitSpec))(in)
@@ -2095,6 +2485,15 @@ func autoConvert_v1_BuildRequest_To_api_BuildRequest(in *v1.BuildRequest, out *b
 	} else {
 		out.Env = nil
 	}
+	// unable to generate simple pointer conversion for v1.PullRequestReference -> api.PullRequestReference
+	if in.PullRequest != nil {
+		out.PullRequest = new(buildapi.PullRequestReference)
+		if err := Convert_v1_PullRequestReference_To_api_PullRequestReference(in.PullRequest, out.PullRequest, s); err != nil {
+			return err
+		}
+	} else {
+		out.PullRequest = nil
+	}
 	return nil
 }
 
@@ -2198,6 +2597,24 @@ func autoConvert_v1_BuildSpec_To_api_BuildSpec(in *v1.BuildSpec, out *buildapi.B
 	} else {
 		out.CompletionDeadlineSeconds = nil
 	}
+	// unable to generate simple pointer conversion for v1.BuildCache -> api.BuildCache
+	if in.Cache != nil {
+		out.Cache = new(buildapi.BuildCache)
+		if err := Convert_v1_BuildCache_To_api_BuildCache(in.Cache, out.Cache, s); err != nil {
+			return err
+		}
+	} else {
+		out.Cache = nil
+	}
+	// unable to generate simple pointer conversion for v1.CommitStatusReporting -> api.CommitStatusReporting
+	if in.CommitStatus != nil {
+		out.CommitStatus = new(buildapi.CommitStatusReporting)
+		if err := Convert_v1_CommitStatusReporting_To_api_CommitStatusReporting(in.CommitStatus, out.CommitStatus, s); err != nil {
+			return err
+		}
+	} else {
+		out.CommitStatus = nil
+	}
 	return nil
 }
 
@@ -2242,13 +2659,46 @@ func autoConvert_v1_BuildStatus_To_api_BuildStatus(in *v1.BuildStatus, out *buil
 	} else {
 		out.Config = nil
 	}
-	return nil
-}
-
+	if in.Stages != nil {
+		out.Stages = make([]buildapi.StageInfo, len(in.Stages))
+		for i := range in.Stages {
+			if err := Convert_v1_StageInfo_To_api_StageInfo(&in.Stages[i], &out.Stages[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Stages = nil
+	}
+	if in.Outputs != nil {
+		out.Outputs = make([]buildapi.BuildStatusOutput, len(in.Outputs))
+		for i := range in.Outputs {
+			if err := Convert_v1_BuildStatusOutput_To_api_BuildStatusOutput(&in.Outputs[i], &out.Outputs[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Outputs = nil
+	}
+	return nil
+}
+
 func Convert_v1_BuildStatus_To_api_BuildStatus(in *v1.BuildStatus, out *buildapi.BuildStatus, s conversion.Scope) error {
 	return autoConvert_v1_BuildStatus_To_api_BuildStatus(in, out, s)
 }
 
+func autoConvert_v1_BuildStatusOutput_To_api_BuildStatusOutput(in *v1.BuildStatusOutput, out *buildapi.BuildStatusOutput, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1.BuildStatusOutput))(in)
+	}
+	out.DockerImageReference = in.DockerImageReference
+	out.ImageDigest = in.ImageDigest
+	return nil
+}
+
+func Convert_v1_BuildStatusOutput_To_api_BuildStatusOutput(in *v1.BuildStatusOutput, out *buildapi.BuildStatusOutput, s conversion.Scope) error {
+	return autoConvert_v1_BuildStatusOutput_To_api_BuildStatusOutput(in, out, s)
+}
+
 func autoConvert_v1_BuildStrategy_To_api_BuildStrategy(in *v1.BuildStrategy, out *buildapi.BuildStrategy, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*v1.BuildStrategy))(in)
@@ -2278,6 +2728,15 @@ func autoConvert_v1_BuildStrategy_To_api_BuildStrategy(in *v1.BuildStrategy, out
 	} else {
 		out.CustomStrategy = nil
 	}
+	// unable to generate simple pointer conversion for v1.JenkinsPipelineBuildStrategy -> api.JenkinsPipelineBuildStrategy
+	if in.JenkinsPipelineStrategy != nil {
+		out.JenkinsPipelineStrategy = new(buildapi.JenkinsPipelineBuildStrategy)
+		if err := Convert_v1_JenkinsPipelineBuildStrategy_To_api_JenkinsPipelineBuildStrategy(in.JenkinsPipelineStrategy, out.JenkinsPipelineStrategy, s); err != nil {
+			return err
+		}
+	} else {
+		out.JenkinsPipelineStrategy = nil
+	}
 	return nil
 }
 
@@ -2304,6 +2763,24 @@ func autoConvert_v1_BuildTriggerPolicy_To_api_BuildTriggerPolicy(in *v1.BuildTri
 	} else {
 		out.GenericWebHook = nil
 	}
+	// unable to generate simple pointer conversion for v1.WebHookTrigger -> api.WebHookTrigger
+	if in.GitLabWebHook != nil {
+		out.GitLabWebHook = new(buildapi.WebHookTrigger)
+		if err := Convert_v1_WebHookTrigger_To_api_WebHookTrigger(in.GitLabWebHook, out.GitLabWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.GitLabWebHook = nil
+	}
+	// unable to generate simple pointer conversion for v1.WebHookTrigger -> api.WebHookTrigger
+	if in.BitbucketWebHook != nil {
+		out.BitbucketWebHook = new(buildapi.WebHookTrigger)
+		if err := Convert_v1_WebHookTrigger_To_api_WebHookTrigger(in.BitbucketWebHook, out.BitbucketWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.BitbucketWebHook = nil
+	}
 	// unable to generate simple pointer conversion for v1.ImageChangeTrigger -> api.ImageChangeTrigger
 	if in.ImageChange != nil {
 		out.ImageChange = new(buildapi.ImageChangeTrigger)
@@ -2316,6 +2793,23 @@ func autoConvert_v1_BuildTriggerPolicy_To_api_BuildTriggerPolicy(in *v1.BuildTri
 	return nil
 }
 
+func autoConvert_v1_CommitStatusReporting_To_api_CommitStatusReporting(in *v1.CommitStatusReporting, out *buildapi.CommitStatusReporting, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1.CommitStatusReporting))(in)
+	}
+	out.Provider = buildapi.CommitStatusProvider(in.Provider)
+	out.APIURL = in.APIURL
+	if err := Convert_v1_LocalObjectReference_To_api_LocalObjectReference(&in.Secret, &out.Secret, s); err != nil {
+		return err
+	}
+	out.Context = in.Context
+	return nil
+}
+
+func Convert_v1_CommitStatusReporting_To_api_CommitStatusReporting(in *v1.CommitStatusReporting, out *buildapi.CommitStatusReporting, s conversion.Scope) error {
+	return autoConvert_v1_CommitStatusReporting_To_api_CommitStatusReporting(in, out, s)
+}
+
 func autoConvert_v1_CustomBuildStrategy_To_api_CustomBuildStrategy(in *v1.CustomBuildStrategy, out *buildapi.CustomBuildStrategy, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*v1.CustomBuildStrategy))(in)
@@ -2393,6 +2887,16 @@ func autoConvert_v1_DockerBuildStrategy_To_api_DockerBuildStrategy(in *v1.Docker
 	}
 	out.ForcePull = in.ForcePull
 	out.DockerfilePath = in.DockerfilePath
+	if in.BuildArgs != nil {
+		out.BuildArgs = make([]api.EnvVar, len(in.BuildArgs))
+		for i := range in.BuildArgs {
+			if err := Convert_v1_EnvVar_To_api_EnvVar(&in.BuildArgs[i], &out.BuildArgs[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.BuildArgs = nil
+	}
 	return nil
 }
 
@@ -2507,6 +3011,47 @@ func Convert_v1_ImageSourcePath_To_api_ImageSourcePath(in *v1.ImageSourcePath, o
 	return autoConvert_v1_ImageSourcePath_To_api_ImageSourcePath(in, out, s)
 }
 
+func autoConvert_v1_JenkinsPipelineBuildStrategy_To_api_JenkinsPipelineBuildStrategy(in *v1.JenkinsPipelineBuildStrategy, out *buildapi.JenkinsPipelineBuildStrategy, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1.JenkinsPipelineBuildStrategy))(in)
+	}
+	out.JenkinsfilePath = in.JenkinsfilePath
+	out.Jenkinsfile = in.Jenkinsfile
+	return nil
+}
+
+func Convert_v1_JenkinsPipelineBuildStrategy_To_api_JenkinsPipelineBuildStrategy(in *v1.JenkinsPipelineBuildStrategy, out *buildapi.JenkinsPipelineBuildStrategy, s conversion.Scope) error {
+	return autoConvert_v1_JenkinsPipelineBuildStrategy_To_api_JenkinsPipelineBuildStrategy(in, out, s)
+}
+
+func autoConvert_v1_PullRequestBuildPolicy_To_api_PullRequestBuildPolicy(in *v1.PullRequestBuildPolicy, out *buildapi.PullRequestBuildPolicy, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1.PullRequestBuildPolicy))(in)
+	}
+	out.OutputTagPrefix = in.OutputTagPrefix
+	out.AllowForkSecrets = in.AllowForkSecrets
+	return nil
+}
+
+func Convert_v1_PullRequestBuildPolicy_To_api_PullRequestBuildPolicy(in *v1.PullRequestBuildPolicy, out *buildapi.PullRequestBuildPolicy, s conversion.Scope) error {
+	return autoConvert_v1_PullRequestBuildPolicy_To_api_PullRequestBuildPolicy(in, out, s)
+}
+
+func autoConvert_v1_PullRequestReference_To_api_PullRequestReference(in *v1.PullRequestReference, out *buildapi.PullRequestReference, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1.PullRequestReference))(in)
+	}
+	out.Number = in.Number
+	out.Ref = in.Ref
+	out.Fork = in.Fork
+	out.OutputTag = in.OutputTag
+	return nil
+}
+
+func Convert_v1_PullRequestReference_To_api_PullRequestReference(in *v1.PullRequestReference, out *buildapi.PullRequestReference, s conversion.Scope) error {
+	return autoConvert_v1_PullRequestReference_To_api_PullRequestReference(in, out, s)
+}
+
 func autoConvert_v1_SecretBuildSource_To_api_SecretBuildSource(in *v1.SecretBuildSource, out *buildapi.SecretBuildSource, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*v1.SecretBuildSource))(in)
@@ -2599,11 +3144,60 @@ func autoConvert_v1_SourceRevision_To_api_SourceRevision(in *v1.SourceRevision,
 	return nil
 }
 
+func autoConvert_v1_StageInfo_To_api_StageInfo(in *v1.StageInfo, out *buildapi.StageInfo, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1.StageInfo))(in)
+	}
+	out.Name = in.Name
+	out.Phase = buildapi.BuildPhase(in.Phase)
+	// unable to generate simple pointer conversion for unversioned.Time -> unversioned.Time
+	if in.StartTimestamp != nil {
+		out.StartTimestamp = new(unversioned.Time)
+		if err := api.Convert_unversioned_Time_To_unversioned_Time(in.StartTimestamp, out.StartTimestamp, s); err != nil {
+			return err
+		}
+	} else {
+		out.StartTimestamp = nil
+	}
+	// unable to generate simple pointer conversion for unversioned.Time -> unversioned.Time
+	if in.CompletionTimestamp != nil {
+		out.CompletionTimestamp = new(unversioned.Time)
+		if err := api.Convert_unversioned_Time_To_unversioned_Time(in.CompletionTimestamp, out.CompletionTimestamp, s); err != nil {
+			return err
+		}
+	} else {
+		out.CompletionTimestamp = nil
+	}
+	return nil
+}
+
+func Convert_v1_StageInfo_To_api_StageInfo(in *v1.StageInfo, out *buildapi.StageInfo, s conversion.Scope) error {
+	return autoConvert_v1_StageInfo_To_api_StageInfo(in, out, s)
+}
+
 func autoConvert_v1_WebHookTrigger_To_api_WebHookTrigger(in *v1.WebHookTrigger, out *buildapi.WebHookTrigger, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*v1.WebHookTrigger))(in)
 	}
 	out.Secret = in.Secret
+	// unable to generate simple pointer conversion for v1.LocalObjectReference -> api.LocalObjectReference
+	if in.SecretReference != nil {
+		out.SecretReference = new(api.LocalObjectReference)
+		if err := Convert_v1_LocalObjectReference_To_api_LocalObjectReference(in.SecretReference, out.SecretReference, s); err != nil {
+			return err
+		}
+	} else {
+		out.SecretReference = nil
+	}
+	// unable to generate simple pointer conversion for v1.PullRequestBuildPolicy -> api.PullRequestBuildPolicy
+	if in.PullRequests != nil {
+		out.PullRequests = new(buildapi.PullRequestBuildPolicy)
+		if err := Convert_v1_PullRequestBuildPolicy_To_api_PullRequestBuildPolicy(in.PullRequests, out.PullRequests, s); err != nil {
+			return err
+		}
+	} else {
+		out.PullRequests = nil
+	}
 	return nil
 }
 
@@ -2611,6 +3205,75 @@ func Convert_v1_WebHookTrigger_To_api_WebHookTrigger(in *v1.WebHookTrigger, out
 	return autoConvert_v1_WebHookTrigger_To_api_WebHookTrigger(in, out, s)
 }
 
+func autoConvert_api_CanaryDeploymentStrategyParams_To_v1_CanaryDeploymentStrategyParams(in *deployapi.CanaryDeploymentStrategyParams, out *deployapiv1.CanaryDeploymentStrategyParams, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.CanaryDeploymentStrategyParams))(in)
+	}
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	out.Traffic = deployapiv1.CanaryTrafficType(in.Traffic)
+	out.RouteName = in.RouteName
+	if in.Steps != nil {
+		out.Steps = make([]deployapiv1.CanaryStep, len(in.Steps))
+		for i := range in.Steps {
+			if err := Convert_api_CanaryStep_To_v1_CanaryStep(&in.Steps[i], &out.Steps[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Steps = nil
+	}
+	// unable to generate simple pointer conversion for api.LifecycleHook -> v1.LifecycleHook
+	if in.Pre != nil {
+		out.Pre = new(deployapiv1.LifecycleHook)
+		if err := Convert_api_LifecycleHook_To_v1_LifecycleHook(in.Pre, out.Pre, s); err != nil {
+			return err
+		}
+	} else {
+		out.Pre = nil
+	}
+	// unable to generate simple pointer conversion for api.LifecycleHook -> v1.LifecycleHook
+	if in.Post != nil {
+		out.Post = new(deployapiv1.LifecycleHook)
+		if err := Convert_api_LifecycleHook_To_v1_LifecycleHook(in.Post, out.Post, s); err != nil {
+			return err
+		}
+	} else {
+		out.Post = nil
+	}
+	return nil
+}
+
+func Convert_api_CanaryDeploymentStrategyParams_To_v1_CanaryDeploymentStrategyParams(in *deployapi.CanaryDeploymentStrategyParams, out *deployapiv1.CanaryDeploymentStrategyParams, s conversion.Scope) error {
+	return autoConvert_api_CanaryDeploymentStrategyParams_To_v1_CanaryDeploymentStrategyParams(in, out, s)
+}
+
+func autoConvert_api_CanaryStep_To_v1_CanaryStep(in *deployapi.CanaryStep, out *deployapiv1.CanaryStep, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.CanaryStep))(in)
+	}
+	out.Weight = in.Weight
+	// unable to generate simple pointer conversion for api.LifecycleHook -> v1.LifecycleHook
+	if in.Verify != nil {
+		out.Verify = new(deployapiv1.LifecycleHook)
+		if err := Convert_api_LifecycleHook_To_v1_LifecycleHook(in.Verify, out.Verify, s); err != nil {
+			return err
+		}
+	} else {
+		out.Verify = nil
+	}
+	out.Approve = in.Approve
+	return nil
+}
+
+func Convert_api_CanaryStep_To_v1_CanaryStep(in *deployapi.CanaryStep, out *deployapiv1.CanaryStep, s conversion.Scope) error {
+	return autoConvert_api_CanaryStep_To_v1_CanaryStep(in, out, s)
+}
+
 func autoConvert_api_CustomDeploymentStrategyParams_To_v1_CustomDeploymentStrategyParams(in *deployapi.CustomDeploymentStrategyParams, out *deployapiv1.CustomDeploymentStrategyParams, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapi.CustomDeploymentStrategyParams))(in)
@@ -2655,6 +3318,15 @@ func autoConvert_api_DeploymentCause_To_v1_DeploymentCause(in *deployapi.Deploym
 	} else {
 		out.ImageTrigger = nil
 	}
+	// unable to generate simple pointer conversion for api.DeploymentCauseObjectTrigger -> v1.DeploymentCauseObjectTrigger
+	if in.ObjectTrigger != nil {
+		out.ObjectTrigger = new(deployapiv1.DeploymentCauseObjectTrigger)
+		if err := Convert_api_DeploymentCauseObjectTrigger_To_v1_DeploymentCauseObjectTrigger(in.ObjectTrigger, out.ObjectTrigger, s); err != nil {
+			return err
+		}
+	} else {
+		out.ObjectTrigger = nil
+	}
 	return nil
 }
 
@@ -2676,6 +3348,38 @@ func Convert_api_DeploymentCauseImageTrigger_To_v1_DeploymentCauseImageTrigger(i
 	return autoConvert_api_DeploymentCauseImageTrigger_To_v1_DeploymentCauseImageTrigger(in, out, s)
 }
 
+func autoConvert_api_DeploymentCauseObjectTrigger_To_v1_DeploymentCauseObjectTrigger(in *deployapi.DeploymentCauseObjectTrigger, out *deployapiv1.DeploymentCauseObjectTrigger, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.DeploymentCauseObjectTrigger))(in)
+	}
+	if err := Convert_api_ObjectReference_To_v1_ObjectReference(&in.From, &out.From, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func Convert_api_DeploymentCauseObjectTrigger_To_v1_DeploymentCauseObjectTrigger(in *deployapi.DeploymentCauseObjectTrigger, out *deployapiv1.DeploymentCauseObjectTrigger, s conversion.Scope) error {
+	return autoConvert_api_DeploymentCauseObjectTrigger_To_v1_DeploymentCauseObjectTrigger(in, out, s)
+}
+
+func autoConvert_api_DeploymentCondition_To_v1_DeploymentCondition(in *deployapi.DeploymentCondition, out *deployapiv1.DeploymentCondition, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.DeploymentCondition))(in)
+	}
+	out.Type = deployapiv1.DeploymentConditionType(in.Type)
+	out.Status = apiv1.ConditionStatus(in.Status)
+	if err := api.Convert_unversioned_Time_To_unversioned_Time(&in.LastTransitionTime, &out.LastTransitionTime, s); err != nil {
+		return err
+	}
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+func Convert_api_DeploymentCondition_To_v1_DeploymentCondition(in *deployapi.DeploymentCondition, out *deployapiv1.DeploymentCondition, s conversion.Scope) error {
+	return autoConvert_api_DeploymentCondition_To_v1_DeploymentCondition(in, out, s)
+}
+
 func autoConvert_api_DeploymentConfig_To_v1_DeploymentConfig(in *deployapi.DeploymentConfig, out *deployapiv1.DeploymentConfig, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapi.DeploymentConfig))(in)
@@ -2696,6 +3400,21 @@ func Convert_api_DeploymentConfig_To_v1_DeploymentConfig(in *deployapi.Deploymen
 	return autoConvert_api_DeploymentConfig_To_v1_DeploymentConfig(in, out, s)
 }
 
+func autoConvert_api_DeploymentConfigChange_To_v1_DeploymentConfigChange(in *deployapi.DeploymentConfigChange, out *deployapiv1.DeploymentConfigChange, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.DeploymentConfigChange))(in)
+	}
+	out.Type = deployapiv1.DeploymentConfigChangeType(in.Type)
+	out.Field = in.Field
+	out.Current = in.Current
+	out.RolledBack = in.RolledBack
+	return nil
+}
+
+func Convert_api_DeploymentConfigChange_To_v1_DeploymentConfigChange(in *deployapi.DeploymentConfigChange, out *deployapiv1.DeploymentConfigChange, s conversion.Scope) error {
+	return autoConvert_api_DeploymentConfigChange_To_v1_DeploymentConfigChange(in, out, s)
+}
+
 func autoConvert_api_DeploymentConfigList_To_v1_DeploymentConfigList(in *deployapi.DeploymentConfigList, out *deployapiv1.DeploymentConfigList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapi.DeploymentConfigList))(in)
@@ -2734,6 +3453,31 @@ func Convert_api_DeploymentConfigRollback_To_v1_DeploymentConfigRollback(in *dep
 	return autoConvert_api_DeploymentConfigRollback_To_v1_DeploymentConfigRollback(in, out, s)
 }
 
+func autoConvert_api_DeploymentConfigRollbackDiff_To_v1_DeploymentConfigRollbackDiff(in *deployapi.DeploymentConfigRollbackDiff, out *deployapiv1.DeploymentConfigRollbackDiff, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.DeploymentConfigRollbackDiff))(in)
+	}
+	out.Name = in.Name
+	if err := Convert_api_ObjectReference_To_v1_ObjectReference(&in.From, &out.From, s); err != nil {
+		return err
+	}
+	if in.Changes != nil {
+		out.Changes = make([]deployapiv1.DeploymentConfigChange, len(in.Changes))
+		for i := range in.Changes {
+			if err := Convert_api_DeploymentConfigChange_To_v1_DeploymentConfigChange(&in.Changes[i], &out.Changes[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Changes = nil
+	}
+	return nil
+}
+
+func Convert_api_DeploymentConfigRollbackDiff_To_v1_DeploymentConfigRollbackDiff(in *deployapi.DeploymentConfigRollbackDiff, out *deployapiv1.DeploymentConfigRollbackDiff, s conversion.Scope) error {
+	return autoConvert_api_DeploymentConfigRollbackDiff_To_v1_DeploymentConfigRollbackDiff(in, out, s)
+}
+
 func autoConvert_api_DeploymentConfigRollbackSpec_To_v1_DeploymentConfigRollbackSpec(in *deployapi.DeploymentConfigRollbackSpec, out *deployapiv1.DeploymentConfigRollbackSpec, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapi.DeploymentConfigRollbackSpec))(in)
@@ -2745,6 +3489,7 @@ func autoConvert_api_DeploymentConfigRollbackSpec_To_v1_DeploymentConfigRollback
 	out.IncludeTemplate = in.IncludeTemplate
 	out.IncludeReplicationMeta = in.IncludeReplicationMeta
 	out.IncludeStrategy = in.IncludeStrategy
+	out.DiffOnly = in.DiffOnly
 	return nil
 }
 
@@ -2771,6 +3516,20 @@ func autoConvert_api_DeploymentConfigSpec_To_v1_DeploymentConfigSpec(in *deploya
 	}
 	out.Replicas = in.Replicas
 	out.Test = in.Test
+	out.Paused = in.Paused
+	if in.ProgressDeadlineSeconds != nil {
+		out.ProgressDeadlineSeconds = new(int64)
+		*out.ProgressDeadlineSeconds = *in.ProgressDeadlineSeconds
+	} else {
+		out.ProgressDeadlineSeconds = nil
+	}
+	out.AutoRollback = in.AutoRollback
+	if in.RevisionHistoryLimit != nil {
+		out.RevisionHistoryLimit = new(int)
+		*out.RevisionHistoryLimit = *in.RevisionHistoryLimit
+	} else {
+		out.RevisionHistoryLimit = nil
+	}
 	if in.Selector != nil {
 		out.Selector = make(map[string]string)
 		for key, val := range in.Selector {
@@ -2809,6 +3568,30 @@ func autoConvert_api_DeploymentConfigStatus_To_v1_DeploymentConfigStatus(in *dep
 	} else {
 		out.Details = nil
 	}
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Replicas = in.Replicas
+	out.UpdatedReplicas = in.UpdatedReplicas
+	out.AvailableReplicas = in.AvailableReplicas
+	if in.Conditions != nil {
+		out.Conditions = make([]deployapiv1.DeploymentCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := Convert_api_DeploymentCondition_To_v1_DeploymentCondition(&in.Conditions[i], &out.Conditions[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
+	if in.TriggeredObjects != nil {
+		out.TriggeredObjects = make([]deployapiv1.DeploymentTriggeredObject, len(in.TriggeredObjects))
+		for i := range in.TriggeredObjects {
+			if err := Convert_api_DeploymentTriggeredObject_To_v1_DeploymentTriggeredObject(&in.TriggeredObjects[i], &out.TriggeredObjects[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.TriggeredObjects = nil
+	}
 	return nil
 }
 
@@ -2929,6 +3712,15 @@ func autoConvert_api_DeploymentStrategy_To_v1_DeploymentStrategy(in *deployapi.D
 	} else {
 		out.RollingParams = nil
 	}
+	// unable to generate simple pointer conversion for api.CanaryDeploymentStrategyParams -> v1.CanaryDeploymentStrategyParams
+	if in.CanaryParams != nil {
+		out.CanaryParams = new(deployapiv1.CanaryDeploymentStrategyParams)
+		if err := Convert_api_CanaryDeploymentStrategyParams_To_v1_CanaryDeploymentStrategyParams(in.CanaryParams, out.CanaryParams, s); err != nil {
+			return err
+		}
+	} else {
+		out.CanaryParams = nil
+	}
 	if err := Convert_api_ResourceRequirements_To_v1_ResourceRequirements(&in.Resources, &out.Resources, s); err != nil {
 		return err
 	}
@@ -2975,6 +3767,18 @@ func autoConvert_api_DeploymentTriggerImageChangeParams_To_v1_DeploymentTriggerI
 	return nil
 }
 
+func autoConvert_api_DeploymentTriggerObjectChangeParams_To_v1_DeploymentTriggerObjectChangeParams(in *deployapi.DeploymentTriggerObjectChangeParams, out *deployapiv1.DeploymentTriggerObjectChangeParams, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.DeploymentTriggerObjectChangeParams))(in)
+	}
+	out.Name = in.Name
+	return nil
+}
+
+func Convert_api_DeploymentTriggerObjectChangeParams_To_v1_DeploymentTriggerObjectChangeParams(in *deployapi.DeploymentTriggerObjectChangeParams, out *deployapiv1.DeploymentTriggerObjectChangeParams, s conversion.Scope) error {
+	return autoConvert_api_DeploymentTriggerObjectChangeParams_To_v1_DeploymentTriggerObjectChangeParams(in, out, s)
+}
+
 func autoConvert_api_DeploymentTriggerPolicy_To_v1_DeploymentTriggerPolicy(in *deployapi.DeploymentTriggerPolicy, out *deployapiv1.DeploymentTriggerPolicy, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapi.DeploymentTriggerPolicy))(in)
@@ -2988,6 +3792,24 @@ func autoConvert_api_DeploymentTriggerPolicy_To_v1_DeploymentTriggerPolicy(in *d
 	} else {
 		out.ImageChangeParams = nil
 	}
+	// unable to generate simple pointer conversion for api.DeploymentTriggerObjectChangeParams -> v1.DeploymentTriggerObjectChangeParams
+	if in.SecretChangeParams != nil {
+		out.SecretChangeParams = new(deployapiv1.DeploymentTriggerObjectChangeParams)
+		if err := Convert_api_DeploymentTriggerObjectChangeParams_To_v1_DeploymentTriggerObjectChangeParams(in.SecretChangeParams, out.SecretChangeParams, s); err != nil {
+			return err
+		}
+	} else {
+		out.SecretChangeParams = nil
+	}
+	// unable to generate simple pointer conversion for api.DeploymentTriggerObjectChangeParams -> v1.DeploymentTriggerObjectChangeParams
+	if in.ConfigMapChangeParams != nil {
+		out.ConfigMapChangeParams = new(deployapiv1.DeploymentTriggerObjectChangeParams)
+		if err := Convert_api_DeploymentTriggerObjectChangeParams_To_v1_DeploymentTriggerObjectChangeParams(in.ConfigMapChangeParams, out.ConfigMapChangeParams, s); err != nil {
+			return err
+		}
+	} else {
+		out.ConfigMapChangeParams = nil
+	}
 	return nil
 }
 
@@ -2995,6 +3817,20 @@ func Convert_api_DeploymentTriggerPolicy_To_v1_DeploymentTriggerPolicy(in *deplo
 	return autoConvert_api_DeploymentTriggerPolicy_To_v1_DeploymentTriggerPolicy(in, out, s)
 }
 
+func autoConvert_api_DeploymentTriggeredObject_To_v1_DeploymentTriggeredObject(in *deployapi.DeploymentTriggeredObject, out *deployapiv1.DeploymentTriggeredObject, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.DeploymentTriggeredObject))(in)
+	}
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.DataHash = in.DataHash
+	return nil
+}
+
+func Convert_api_DeploymentTriggeredObject_To_v1_DeploymentTriggeredObject(in *deployapi.DeploymentTriggeredObject, out *deployapiv1.DeploymentTriggeredObject, s conversion.Scope) error {
+	return autoConvert_api_DeploymentTriggeredObject_To_v1_DeploymentTriggeredObject(in, out, s)
+}
+
 func autoConvert_api_ExecNewPodHook_To_v1_ExecNewPodHook(in *deployapi.ExecNewPodHook, out *deployapiv1.ExecNewPodHook, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapi.ExecNewPodHook))(in)
@@ -3026,6 +3862,34 @@ func autoConvert_api_ExecNewPodHook_To_v1_ExecNewPodHook(in *deployapi.ExecNewPo
 	} else {
 		out.Volumes = nil
 	}
+	if in.ExtraVolumes != nil {
+		out.ExtraVolumes = make([]deployapiv1.HookVolume, len(in.ExtraVolumes))
+		for i := range in.ExtraVolumes {
+			if err := Convert_api_HookVolume_To_v1_HookVolume(&in.ExtraVolumes[i], &out.ExtraVolumes[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ExtraVolumes = nil
+	}
+	// unable to generate simple pointer conversion for api.ResourceRequirements -> v1.ResourceRequirements
+	if in.Resources != nil {
+		out.Resources = new(apiv1.ResourceRequirements)
+		if err := Convert_api_ResourceRequirements_To_v1_ResourceRequirements(in.Resources, out.Resources, s); err != nil {
+			return err
+		}
+	} else {
+		out.Resources = nil
+	}
+	out.ServiceAccountName = in.ServiceAccountName
+	if in.NodeSelector != nil {
+		out.NodeSelector = make(map[string]string)
+		for key, val := range in.NodeSelector {
+			out.NodeSelector[key] = val
+		}
+	} else {
+		out.NodeSelector = nil
+	}
 	return nil
 }
 
@@ -3033,6 +3897,56 @@ func Convert_api_ExecNewPodHook_To_v1_ExecNewPodHook(in *deployapi.ExecNewPodHoo
 	return autoConvert_api_ExecNewPodHook_To_v1_ExecNewPodHook(in, out, s)
 }
 
+func autoConvert_api_HTTPGetHook_To_v1_HTTPGetHook(in *deployapi.HTTPGetHook, out *deployapiv1.HTTPGetHook, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.HTTPGetHook))(in)
+	}
+	out.URL = in.URL
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	return nil
+}
+
+func Convert_api_HTTPGetHook_To_v1_HTTPGetHook(in *deployapi.HTTPGetHook, out *deployapiv1.HTTPGetHook, s conversion.Scope) error {
+	return autoConvert_api_HTTPGetHook_To_v1_HTTPGetHook(in, out, s)
+}
+
+func autoConvert_api_HookVolume_To_v1_HookVolume(in *deployapi.HookVolume, out *deployapiv1.HookVolume, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.HookVolume))(in)
+	}
+	out.Name = in.Name
+	out.MountPath = in.MountPath
+	out.ReadOnly = in.ReadOnly
+	// unable to generate simple pointer conversion for api.SecretVolumeSource -> v1.SecretVolumeSource
+	if in.Secret != nil {
+		out.Secret = new(apiv1.SecretVolumeSource)
+		if err := Convert_api_SecretVolumeSource_To_v1_SecretVolumeSource(in.Secret, out.Secret, s); err != nil {
+			return err
+		}
+	} else {
+		out.Secret = nil
+	}
+	// unable to generate simple pointer conversion for api.EmptyDirVolumeSource -> v1.EmptyDirVolumeSource
+	if in.EmptyDir != nil {
+		out.EmptyDir = new(apiv1.EmptyDirVolumeSource)
+		if err := Convert_api_EmptyDirVolumeSource_To_v1_EmptyDirVolumeSource(in.EmptyDir, out.EmptyDir, s); err != nil {
+			return err
+		}
+	} else {
+		out.EmptyDir = nil
+	}
+	return nil
+}
+
+func Convert_api_HookVolume_To_v1_HookVolume(in *deployapi.HookVolume, out *deployapiv1.HookVolume, s conversion.Scope) error {
+	return autoConvert_api_HookVolume_To_v1_HookVolume(in, out, s)
+}
+
 func autoConvert_api_LifecycleHook_To_v1_LifecycleHook(in *deployapi.LifecycleHook, out *deployapiv1.LifecycleHook, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapi.LifecycleHook))(in)
@@ -3057,6 +3971,24 @@ func autoConvert_api_LifecycleHook_To_v1_LifecycleHook(in *deployapi.LifecycleHo
 	} else {
 		out.TagImages = nil
 	}
+	// unable to generate simple pointer conversion for api.HTTPGetHook -> v1.HTTPGetHook
+	if in.HTTPGet != nil {
+		out.HTTPGet = new(deployapiv1.HTTPGetHook)
+		if err := Convert_api_HTTPGetHook_To_v1_HTTPGetHook(in.HTTPGet, out.HTTPGet, s); err != nil {
+			return err
+		}
+	} else {
+		out.HTTPGet = nil
+	}
+	// unable to generate simple pointer conversion for api.WaitForImageHook -> v1.WaitForImageHook
+	if in.WaitForImage != nil {
+		out.WaitForImage = new(deployapiv1.WaitForImageHook)
+		if err := Convert_api_WaitForImageHook_To_v1_WaitForImageHook(in.WaitForImage, out.WaitForImage, s); err != nil {
+			return err
+		}
+	} else {
+		out.WaitForImage = nil
+	}
 	return nil
 }
 
@@ -3136,25 +4068,105 @@ func autoConvert_api_RollingDeploymentStrategyParams_To_v1_RollingDeploymentStra
 	if err := s.Convert(&in.MaxSurge, &out.MaxSurge, 0); err != nil {
 		return err
 	}
-	if in.UpdatePercent != nil {
-		out.UpdatePercent = new(int)
-		*out.UpdatePercent = *in.UpdatePercent
+	if in.UpdatePercent != nil {
+		out.UpdatePercent = new(int)
+		*out.UpdatePercent = *in.UpdatePercent
+	} else {
+		out.UpdatePercent = nil
+	}
+	// unable to generate simple pointer conversion for api.LifecycleHook -> v1.LifecycleHook
+	if in.Pre != nil {
+		out.Pre = new(deployapiv1.LifecycleHook)
+		if err := Convert_api_LifecycleHook_To_v1_LifecycleHook(in.Pre, out.Pre, s); err != nil {
+			return err
+		}
+	} else {
+		out.Pre = nil
+	}
+	// unable to generate simple pointer conversion for api.LifecycleHook -> v1.LifecycleHook
+	if in.Post != nil {
+		out.Post = new(deployapiv1.LifecycleHook)
+		if err := Convert_api_LifecycleHook_To_v1_LifecycleHook(in.Post, out.Post, s); err != nil {
+			return err
+		}
+	} else {
+		out.Post = nil
+	}
+	return nil
+}
+
+func autoConvert_api_TagImageHook_To_v1_TagImageHook(in *deployapi.TagImageHook, out *deployapiv1.TagImageHook, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.TagImageHook))(in)
+	}
+	out.ContainerName = in.ContainerName
+	if err := Convert_api_ObjectReference_To_v1_ObjectReference(&in.To, &out.To, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func Convert_api_TagImageHook_To_v1_TagImageHook(in *deployapi.TagImageHook, out *deployapiv1.TagImageHook, s conversion.Scope) error {
+	return autoConvert_api_TagImageHook_To_v1_TagImageHook(in, out, s)
+}
+
+func autoConvert_api_WaitForImageHook_To_v1_WaitForImageHook(in *deployapi.WaitForImageHook, out *deployapiv1.WaitForImageHook, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.WaitForImageHook))(in)
+	}
+	if err := Convert_api_ObjectReference_To_v1_ObjectReference(&in.From, &out.From, s); err != nil {
+		return err
+	}
+	out.Digest = in.Digest
+	out.ContainerName = in.ContainerName
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	return nil
+}
+
+func Convert_api_WaitForImageHook_To_v1_WaitForImageHook(in *deployapi.WaitForImageHook, out *deployapiv1.WaitForImageHook, s conversion.Scope) error {
+	return autoConvert_api_WaitForImageHook_To_v1_WaitForImageHook(in, out, s)
+}
+
+func autoConvert_v1_CanaryDeploymentStrategyParams_To_api_CanaryDeploymentStrategyParams(in *deployapiv1.CanaryDeploymentStrategyParams, out *deployapi.CanaryDeploymentStrategyParams, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1.CanaryDeploymentStrategyParams))(in)
+	}
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	out.Traffic = deployapi.CanaryTrafficType(in.Traffic)
+	out.RouteName = in.RouteName
+	if in.Steps != nil {
+		out.Steps = make([]deployapi.CanaryStep, len(in.Steps))
+		for i := range in.Steps {
+			if err := Convert_v1_CanaryStep_To_api_CanaryStep(&in.Steps[i], &out.Steps[i], s); err != nil {
+				return err
+			}
+		}
 	} else {
-		out.UpdatePercent = nil
+		out.Steps = nil
 	}
-	// unable to generate simple pointer conversion for api.LifecycleHook -> v1.LifecycleHook
+	// unable to generate simple pointer conversion for v1.LifecycleHook -> api.LifecycleHook
 	if in.Pre != nil {
-		out.Pre = new(deployapiv1.LifecycleHook)
-		if err := Convert_api_LifecycleHook_To_v1_LifecycleHook(in.Pre, out.Pre, s); err != nil {
+		out.Pre = new(deployapi.LifecycleHook)
+		if err := Convert_v1_LifecycleHook_To_api_LifecycleHook(in.Pre, out.Pre, s); err != nil {
 			return err
 		}
 	} else {
 		out.Pre = nil
 	}
-	// unable to generate simple pointer conversion for api.LifecycleHook -> v1.LifecycleHook
+	// unable to generate simple pointer conversion for v1.LifecycleHook -> api.LifecycleHook
 	if in.Post != nil {
-		out.Post = new(deployapiv1.LifecycleHook)
-		if err := Convert_api_LifecycleHook_To_v1_LifecycleHook(in.Post, out.Post, s); err != nil {
+		out.Post = new(deployapi.LifecycleHook)
+		if err := Convert_v1_LifecycleHook_To_api_LifecycleHook(in.Post, out.Post, s); err != nil {
 			return err
 		}
 	} else {
@@ -3163,19 +4175,30 @@ func autoConvert_api_RollingDeploymentStrategyParams_To_v1_RollingDeploymentStra
 	return nil
 }
 
-func autoConvert_api_TagImageHook_To_v1_TagImageHook(in *deployapi.TagImageHook, out *deployapiv1.TagImageHook, s conversion.Scope) error {
+func Convert_v1_CanaryDeploymentStrategyParams_To_api_CanaryDeploymentStrategyParams(in *deployapiv1.CanaryDeploymentStrategyParams, out *deployapi.CanaryDeploymentStrategyParams, s conversion.Scope) error {
+	return autoConvert_v1_CanaryDeploymentStrategyParams_To_api_CanaryDeploymentStrategyParams(in, out, s)
+}
+
+func autoConvert_v1_CanaryStep_To_api_CanaryStep(in *deployapiv1.CanaryStep, out *deployapi.CanaryStep, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*deployapi.TagImageHook))(in)
+		defaulting.(func(*deployapiv1.CanaryStep))(in)
 	}
-	out.ContainerName = in.ContainerName
-	if err := Convert_api_ObjectReference_To_v1_ObjectReference(&in.To, &out.To, s); err != nil {
-		return err
+	out.Weight = in.Weight
+	// unable to generate simple pointer conversion for v1.LifecycleHook -> api.LifecycleHook
+	if in.Verify != nil {
+		out.Verify = new(deployapi.LifecycleHook)
+		if err := Convert_v1_LifecycleHook_To_api_LifecycleHook(in.Verify, out.Verify, s); err != nil {
+			return err
+		}
+	} else {
+		out.Verify = nil
 	}
+	out.Approve = in.Approve
 	return nil
 }
 
-func Convert_api_TagImageHook_To_v1_TagImageHook(in *deployapi.TagImageHook, out *deployapiv1.TagImageHook, s conversion.Scope) error {
-	return autoConvert_api_TagImageHook_To_v1_TagImageHook(in, out, s)
+func Convert_v1_CanaryStep_To_api_CanaryStep(in *deployapiv1.CanaryStep, out *deployapi.CanaryStep, s conversion.Scope) error {
+	return autoConvert_v1_CanaryStep_To_api_CanaryStep(in, out, s)
 }
 
 func autoConvert_v1_CustomDeploymentStrategyParams_To_api_CustomDeploymentStrategyParams(in *deployapiv1.CustomDeploymentStrategyParams, out *deployapi.CustomDeploymentStrategyParams, s conversion.Scope) error {
@@ -3222,6 +4245,15 @@ func autoConvert_v1_DeploymentCause_To_api_DeploymentCause(in *deployapiv1.Deplo
 	} else {
 		out.ImageTrigger = nil
 	}
+	// unable to generate simple pointer conversion for v1.DeploymentCauseObjectTrigger -> api.DeploymentCauseObjectTrigger
+	if in.ObjectTrigger != nil {
+		out.ObjectTrigger = new(deployapi.DeploymentCauseObjectTrigger)
+		if err := Convert_v1_DeploymentCauseObjectTrigger_To_api_DeploymentCauseObjectTrigger(in.ObjectTrigger, out.ObjectTrigger, s); err != nil {
+			return err
+		}
+	} else {
+		out.ObjectTrigger = nil
+	}
 	return nil
 }
 
@@ -3243,6 +4275,38 @@ func Convert_v1_DeploymentCauseImageTrigger_To_api_DeploymentCauseImageTrigger(i
 	return autoConvert_v1_DeploymentCauseImageTrigger_To_api_DeploymentCauseImageTrigger(in, out, s)
 }
 
+func autoConvert_v1_DeploymentCauseObjectTrigger_To_api_DeploymentCauseObjectTrigger(in *deployapiv1.DeploymentCauseObjectTrigger, out *deployapi.DeploymentCauseObjectTrigger, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1.DeploymentCauseObjectTrigger))(in)
+	}
+	if err := Convert_v1_ObjectReference_To_api_ObjectReference(&in.From, &out.From, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func Convert_v1_DeploymentCauseObjectTrigger_To_api_DeploymentCauseObjectTrigger(in *deployapiv1.DeploymentCauseObjectTrigger, out *deployapi.DeploymentCauseObjectTrigger, s conversion.Scope) error {
+	return autoConvert_v1_DeploymentCauseObjectTrigger_To_api_DeploymentCauseObjectTrigger(in, out, s)
+}
+
+func autoConvert_v1_DeploymentCondition_To_api_DeploymentCondition(in *deployapiv1.DeploymentCondition, out *deployapi.DeploymentCondition, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1.DeploymentCondition))(in)
+	}
+	out.Type = deployapi.DeploymentConditionType(in.Type)
+	out.Status = api.ConditionStatus(in.Status)
+	if err := api.Convert_unversioned_Time_To_unversioned_Time(&in.LastTransitionTime, &out.LastTransitionTime, s); err != nil {
+		return err
+	}
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+func Convert_v1_DeploymentCondition_To_api_DeploymentCondition(in *deployapiv1.DeploymentCondition, out *deployapi.DeploymentCondition, s conversion.Scope) error {
+	return autoConvert_v1_DeploymentCondition_To_api_DeploymentCondition(in, out, s)
+}
+
 func autoConvert_v1_DeploymentConfig_To_api_DeploymentConfig(in *deployapiv1.DeploymentConfig, out *deployapi.DeploymentConfig, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapiv1.DeploymentConfig))(in)
@@ -3263,6 +4327,21 @@ func Convert_v1_DeploymentConfig_To_api_DeploymentConfig(in *deployapiv1.Deploym
 	return autoConvert_v1_DeploymentConfig_To_api_DeploymentConfig(in, out, s)
 }
 
+func autoConvert_v1_DeploymentConfigChange_To_api_DeploymentConfigChange(in *deployapiv1.DeploymentConfigChange, out *deployapi.DeploymentConfigChange, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1.DeploymentConfigChange))(in)
+	}
+	out.Type = deployapi.DeploymentConfigChangeType(in.Type)
+	out.Field = in.Field
+	out.Current = in.Current
+	out.RolledBack = in.RolledBack
+	return nil
+}
+
+func Convert_v1_DeploymentConfigChange_To_api_DeploymentConfigChange(in *deployapiv1.DeploymentConfigChange, out *deployapi.DeploymentConfigChange, s conversion.Scope) error {
+	return autoConvert_v1_DeploymentConfigChange_To_api_DeploymentConfigChange(in, out, s)
+}
+
 func autoConvert_v1_DeploymentConfigList_To_api_DeploymentConfigList(in *deployapiv1.DeploymentConfigList, out *deployapi.DeploymentConfigList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapiv1.DeploymentConfigList))(in)
@@ -3301,6 +4380,31 @@ func Convert_v1_DeploymentConfigRollback_To_api_DeploymentConfigRollback(in *dep
 	return autoConvert_v1_DeploymentConfigRollback_To_api_DeploymentConfigRollback(in, out, s)
 }
 
+func autoConvert_v1_DeploymentConfigRollbackDiff_To_api_DeploymentConfigRollbackDiff(in *deployapiv1.DeploymentConfigRollbackDiff, out *deployapi.DeploymentConfigRollbackDiff, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1.DeploymentConfigRollbackDiff))(in)
+	}
+	out.Name = in.Name
+	if err := Convert_v1_ObjectReference_To_api_ObjectReference(&in.From, &out.From, s); err != nil {
+		return err
+	}
+	if in.Changes != nil {
+		out.Changes = make([]deployapi.DeploymentConfigChange, len(in.Changes))
+		for i := range in.Changes {
+			if err := Convert_v1_DeploymentConfigChange_To_api_DeploymentConfigChange(&in.Changes[i], &out.Changes[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Changes = nil
+	}
+	return nil
+}
+
+func Convert_v1_DeploymentConfigRollbackDiff_To_api_DeploymentConfigRollbackDiff(in *deployapiv1.DeploymentConfigRollbackDiff, out *deployapi.DeploymentConfigRollbackDiff, s conversion.Scope) error {
+	return autoConvert_v1_DeploymentConfigRollbackDiff_To_api_DeploymentConfigRollbackDiff(in, out, s)
+}
+
 func autoConvert_v1_DeploymentConfigRollbackSpec_To_api_DeploymentConfigRollbackSpec(in *deployapiv1.DeploymentConfigRollbackSpec, out *deployapi.DeploymentConfigRollbackSpec, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapiv1.DeploymentConfigRollbackSpec))(in)
@@ -3312,6 +4416,7 @@ func autoConvert_v1_DeploymentConfigRollbackSpec_To_api_DeploymentConfigRollback
 	out.IncludeTemplate = in.IncludeTemplate
 	out.IncludeReplicationMeta = in.IncludeReplicationMeta
 	out.IncludeStrategy = in.IncludeStrategy
+	out.DiffOnly = in.DiffOnly
 	return nil
 }
 
@@ -3338,6 +4443,20 @@ func autoConvert_v1_DeploymentConfigSpec_To_api_DeploymentConfigSpec(in *deploya
 	}
 	out.Replicas = in.Replicas
 	out.Test = in.Test
+	out.Paused = in.Paused
+	if in.ProgressDeadlineSeconds != nil {
+		out.ProgressDeadlineSeconds = new(int64)
+		*out.ProgressDeadlineSeconds = *in.ProgressDeadlineSeconds
+	} else {
+		out.ProgressDeadlineSeconds = nil
+	}
+	out.AutoRollback = in.AutoRollback
+	if in.RevisionHistoryLimit != nil {
+		out.RevisionHistoryLimit = new(int)
+		*out.RevisionHistoryLimit = *in.RevisionHistoryLimit
+	} else {
+		out.RevisionHistoryLimit = nil
+	}
 	if in.Selector != nil {
 		out.Selector = make(map[string]string)
 		for key, val := range in.Selector {
@@ -3376,6 +4495,30 @@ func autoConvert_v1_DeploymentConfigStatus_To_api_DeploymentConfigStatus(in *dep
 	} else {
 		out.Details = nil
 	}
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Replicas = in.Replicas
+	out.UpdatedReplicas = in.UpdatedReplicas
+	out.AvailableReplicas = in.AvailableReplicas
+	if in.Conditions != nil {
+		out.Conditions = make([]deployapi.DeploymentCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := Convert_v1_DeploymentCondition_To_api_DeploymentCondition(&in.Conditions[i], &out.Conditions[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
+	if in.TriggeredObjects != nil {
+		out.TriggeredObjects = make([]deployapi.DeploymentTriggeredObject, len(in.TriggeredObjects))
+		for i := range in.TriggeredObjects {
+			if err := Convert_v1_DeploymentTriggeredObject_To_api_DeploymentTriggeredObject(&in.TriggeredObjects[i], &out.TriggeredObjects[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.TriggeredObjects = nil
+	}
 	return nil
 }
 
@@ -3496,6 +4639,15 @@ func autoConvert_v1_DeploymentStrategy_To_api_DeploymentStrategy(in *deployapiv1
 	} else {
 		out.RollingParams = nil
 	}
+	// unable to generate simple pointer conversion for v1.CanaryDeploymentStrategyParams -> api.CanaryDeploymentStrategyParams
+	if in.CanaryParams != nil {
+		out.CanaryParams = new(deployapi.CanaryDeploymentStrategyParams)
+		if err := Convert_v1_CanaryDeploymentStrategyParams_To_api_CanaryDeploymentStrategyParams(in.CanaryParams, out.CanaryParams, s); err != nil {
+			return err
+		}
+	} else {
+		out.CanaryParams = nil
+	}
 	if err := Convert_v1_ResourceRequirements_To_api_ResourceRequirements(&in.Resources, &out.Resources, s); err != nil {
 		return err
 	}
@@ -3542,6 +4694,18 @@ func autoConvert_v1_DeploymentTriggerImageChangeParams_To_api_DeploymentTriggerI
 	return nil
 }
 
+func autoConvert_v1_DeploymentTriggerObjectChangeParams_To_api_DeploymentTriggerObjectChangeParams(in *deployapiv1.DeploymentTriggerObjectChangeParams, out *deployapi.DeploymentTriggerObjectChangeParams, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1.DeploymentTriggerObjectChangeParams))(in)
+	}
+	out.Name = in.Name
+	return nil
+}
+
+func Convert_v1_DeploymentTriggerObjectChangeParams_To_api_DeploymentTriggerObjectChangeParams(in *deployapiv1.DeploymentTriggerObjectChangeParams, out *deployapi.DeploymentTriggerObjectChangeParams, s conversion.Scope) error {
+	return autoConvert_v1_DeploymentTriggerObjectChangeParams_To_api_DeploymentTriggerObjectChangeParams(in, out, s)
+}
+
 func autoConvert_v1_DeploymentTriggerPolicy_To_api_DeploymentTriggerPolicy(in *deployapiv1.DeploymentTriggerPolicy, out *deployapi.DeploymentTriggerPolicy, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapiv1.DeploymentTriggerPolicy))(in)
@@ -3555,6 +4719,24 @@ func autoConvert_v1_DeploymentTriggerPolicy_To_api_DeploymentTriggerPolicy(in *d
 	} else {
 		out.ImageChangeParams = nil
 	}
+	// unable to generate simple pointer conversion for v1.DeploymentTriggerObjectChangeParams -> api.DeploymentTriggerObjectChangeParams
+	if in.SecretChangeParams != nil {
+		out.SecretChangeParams = new(deployapi.DeploymentTriggerObjectChangeParams)
+		if err := Convert_v1_DeploymentTriggerObjectChangeParams_To_api_DeploymentTriggerObjectChangeParams(in.SecretChangeParams, out.SecretChangeParams, s); err != nil {
+			return err
+		}
+	} else {
+		out.SecretChangeParams = nil
+	}
+	// unable to generate simple pointer conversion for v1.DeploymentTriggerObjectChangeParams -> api.DeploymentTriggerObjectChangeParams
+	if in.ConfigMapChangeParams != nil {
+		out.ConfigMapChangeParams = new(deployapi.DeploymentTriggerObjectChangeParams)
+		if err := Convert_v1_DeploymentTriggerObjectChangeParams_To_api_DeploymentTriggerObjectChangeParams(in.ConfigMapChangeParams, out.ConfigMapChangeParams, s); err != nil {
+			return err
+		}
+	} else {
+		out.ConfigMapChangeParams = nil
+	}
 	return nil
 }
 
@@ -3562,6 +4744,20 @@ func Convert_v1_DeploymentTriggerPolicy_To_api_DeploymentTriggerPolicy(in *deplo
 	return autoConvert_v1_DeploymentTriggerPolicy_To_api_DeploymentTriggerPolicy(in, out, s)
 }
 
+func autoConvert_v1_DeploymentTriggeredObject_To_api_DeploymentTriggeredObject(in *deployapiv1.DeploymentTriggeredObject, out *deployapi.DeploymentTriggeredObject, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1.DeploymentTriggeredObject))(in)
+	}
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.DataHash = in.DataHash
+	return nil
+}
+
+func Convert_v1_DeploymentTriggeredObject_To_api_DeploymentTriggeredObject(in *deployapiv1.DeploymentTriggeredObject, out *deployapi.DeploymentTriggeredObject, s conversion.Scope) error {
+	return autoConvert_v1_DeploymentTriggeredObject_To_api_DeploymentTriggeredObject(in, out, s)
+}
+
 func autoConvert_v1_ExecNewPodHook_To_api_ExecNewPodHook(in *deployapiv1.ExecNewPodHook, out *deployapi.ExecNewPodHook, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapiv1.ExecNewPodHook))(in)
@@ -3593,6 +4789,34 @@ func autoConvert_v1_ExecNewPodHook_To_api_ExecNewPodHook(in *deployapiv1.ExecNew
 	} else {
 		out.Volumes = nil
 	}
+	if in.ExtraVolumes != nil {
+		out.ExtraVolumes = make([]deployapi.HookVolume, len(in.ExtraVolumes))
+		for i := range in.ExtraVolumes {
+			if err := Convert_v1_HookVolume_To_api_HookVolume(&in.ExtraVolumes[i], &out.ExtraVolumes[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ExtraVolumes = nil
+	}
+	// unable to generate simple pointer conversion for v1.ResourceRequirements -> api.ResourceRequirements
+	if in.Resources != nil {
+		out.Resources = new(api.ResourceRequirements)
+		if err := Convert_v1_ResourceRequirements_To_api_ResourceRequirements(in.Resources, out.Resources, s); err != nil {
+			return err
+		}
+	} else {
+		out.Resources = nil
+	}
+	out.ServiceAccountName = in.ServiceAccountName
+	if in.NodeSelector != nil {
+		out.NodeSelector = make(map[string]string)
+		for key, val := range in.NodeSelector {
+			out.NodeSelector[key] = val
+		}
+	} else {
+		out.NodeSelector = nil
+	}
 	return nil
 }
 
@@ -3600,6 +4824,56 @@ func Convert_v1_ExecNewPodHook_To_api_ExecNewPodHook(in *deployapiv1.ExecNewPodH
 	return autoConvert_v1_ExecNewPodHook_To_api_ExecNewPodHook(in, out, s)
 }
 
+func autoConvert_v1_HTTPGetHook_To_api_HTTPGetHook(in *deployapiv1.HTTPGetHook, out *deployapi.HTTPGetHook, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1.HTTPGetHook))(in)
+	}
+	out.URL = in.URL
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	return nil
+}
+
+func Convert_v1_HTTPGetHook_To_api_HTTPGetHook(in *deployapiv1.HTTPGetHook, out *deployapi.HTTPGetHook, s conversion.Scope) error {
+	return autoConvert_v1_HTTPGetHook_To_api_HTTPGetHook(in, out, s)
+}
+
+func autoConvert_v1_HookVolume_To_api_HookVolume(in *deployapiv1.HookVolume, out *deployapi.HookVolume, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1.HookVolume))(in)
+	}
+	out.Name = in.Name
+	out.MountPath = in.MountPath
+	out.ReadOnly = in.ReadOnly
+	// unable to generate simple pointer conversion for v1.SecretVolumeSource -> api.SecretVolumeSource
+	if in.Secret != nil {
+		out.Secret = new(api.SecretVolumeSource)
+		if err := Convert_v1_SecretVolumeSource_To_api_SecretVolumeSource(in.Secret, out.Secret, s); err != nil {
+			return err
+		}
+	} else {
+		out.Secret = nil
+	}
+	// unable to generate simple pointer conversion for v1.EmptyDirVolumeSource -> api.EmptyDirVolumeSource
+	if in.EmptyDir != nil {
+		out.EmptyDir = new(api.EmptyDirVolumeSource)
+		if err := Convert_v1_EmptyDirVolumeSource_To_api_EmptyDirVolumeSource(in.EmptyDir, out.EmptyDir, s); err != nil {
+			return err
+		}
+	} else {
+		out.EmptyDir = nil
+	}
+	return nil
+}
+
+func Convert_v1_HookVolume_To_api_HookVolume(in *deployapiv1.HookVolume, out *deployapi.HookVolume, s conversion.Scope) error {
+	return autoConvert_v1_HookVolume_To_api_HookVolume(in, out, s)
+}
+
 func autoConvert_v1_LifecycleHook_To_api_LifecycleHook(in *deployapiv1.LifecycleHook, out *deployapi.LifecycleHook, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapiv1.LifecycleHook))(in)
@@ -3624,6 +4898,24 @@ func autoConvert_v1_LifecycleHook_To_api_LifecycleHook(in *deployapiv1.Lifecycle
 	} else {
 		out.TagImages = nil
 	}
+	// unable to generate simple pointer conversion for v1.HTTPGetHook -> api.HTTPGetHook
+	if in.HTTPGet != nil {
+		out.HTTPGet = new(deployapi.HTTPGetHook)
+		if err := Convert_v1_HTTPGetHook_To_api_HTTPGetHook(in.HTTPGet, out.HTTPGet, s); err != nil {
+			return err
+		}
+	} else {
+		out.HTTPGet = nil
+	}
+	// unable to generate simple pointer conversion for v1.WaitForImageHook -> api.WaitForImageHook
+	if in.WaitForImage != nil {
+		out.WaitForImage = new(deployapi.WaitForImageHook)
+		if err := Convert_v1_WaitForImageHook_To_api_WaitForImageHook(in.WaitForImage, out.WaitForImage, s); err != nil {
+			return err
+		}
+	} else {
+		out.WaitForImage = nil
+	}
 	return nil
 }
 
@@ -3741,6 +5033,28 @@ func Convert_v1_TagImageHook_To_api_TagImageHook(in *deployapiv1.TagImageHook, o
 	return autoConvert_v1_TagImageHook_To_api_TagImageHook(in, out, s)
 }
 
+func autoConvert_v1_WaitForImageHook_To_api_WaitForImageHook(in *deployapiv1.WaitForImageHook, out *deployapi.WaitForImageHook, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1.WaitForImageHook))(in)
+	}
+	if err := Convert_v1_ObjectReference_To_api_ObjectReference(&in.From, &out.From, s); err != nil {
+		return err
+	}
+	out.Digest = in.Digest
+	out.ContainerName = in.ContainerName
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	return nil
+}
+
+func Convert_v1_WaitForImageHook_To_api_WaitForImageHook(in *deployapiv1.WaitForImageHook, out *deployapi.WaitForImageHook, s conversion.Scope) error {
+	return autoConvert_v1_WaitForImageHook_To_api_WaitForImageHook(in, out, s)
+}
+
 func autoConvert_api_Image_To_v1_Image(in *imageapi.Image, out *imageapiv1.Image, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*imageapi.Image))(in)
@@ -5380,6 +6694,22 @@ func autoConvert_api_RouteSpec_To_v1_RouteSpec(in *routeapi.RouteSpec, out *rout
 	if err := Convert_api_ObjectReference_To_v1_ObjectReference(&in.To, &out.To, s); err != nil {
 		return err
 	}
+	if in.Weight != nil {
+		out.Weight = new(int32)
+		*out.Weight = *in.Weight
+	} else {
+		out.Weight = nil
+	}
+	if in.AlternateBackends != nil {
+		out.AlternateBackends = make([]routeapiv1.RouteTargetReference, len(in.AlternateBackends))
+		for i := range in.AlternateBackends {
+			if err := Convert_api_RouteTargetReference_To_v1_RouteTargetReference(&in.AlternateBackends[i], &out.AlternateBackends[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.AlternateBackends = nil
+	}
 	// unable to generate simple pointer conversion for api.RoutePort -> v1.RoutePort
 	if in.Port != nil {
 		out.Port = new(routeapiv1.RoutePort)
@@ -5398,6 +6728,7 @@ func autoConvert_api_RouteSpec_To_v1_RouteSpec(in *routeapi.RouteSpec, out *rout
 	} else {
 		out.TLS = nil
 	}
+	out.WildcardPolicy = routeapiv1.WildcardPolicyType(in.WildcardPolicy)
 	return nil
 }
 
@@ -5426,6 +6757,25 @@ func Convert_api_RouteStatus_To_v1_RouteStatus(in *routeapi.RouteStatus, out *ro
 	return autoConvert_api_RouteStatus_To_v1_RouteStatus(in, out, s)
 }
 
+func autoConvert_api_RouteTargetReference_To_v1_RouteTargetReference(in *routeapi.RouteTargetReference, out *routeapiv1.RouteTargetReference, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*routeapi.RouteTargetReference))(in)
+	}
+	out.Kind = in.Kind
+	out.Name = in.Name
+	if in.Weight != nil {
+		out.Weight = new(int32)
+		*out.Weight = *in.Weight
+	} else {
+		out.Weight = nil
+	}
+	return nil
+}
+
+func Convert_api_RouteTargetReference_To_v1_RouteTargetReference(in *routeapi.RouteTargetReference, out *routeapiv1.RouteTargetReference, s conversion.Scope) error {
+	return autoConvert_api_RouteTargetReference_To_v1_RouteTargetReference(in, out, s)
+}
+
 func autoConvert_api_TLSConfig_To_v1_TLSConfig(in *routeapi.TLSConfig, out *routeapiv1.TLSConfig, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*routeapi.TLSConfig))(in)
@@ -5557,6 +6907,22 @@ func autoConvert_v1_RouteSpec_To_api_RouteSpec(in *routeapiv1.RouteSpec, out *ro
 	if err := Convert_v1_ObjectReference_To_api_ObjectReference(&in.To, &out.To, s); err != nil {
 		return err
 	}
+	if in.Weight != nil {
+		out.Weight = new(int32)
+		*out.Weight = *in.Weight
+	} else {
+		out.Weight = nil
+	}
+	if in.AlternateBackends != nil {
+		out.AlternateBackends = make([]routeapi.RouteTargetReference, len(in.AlternateBackends))
+		for i := range in.AlternateBackends {
+			if err := Convert_v1_RouteTargetReference_To_api_RouteTargetReference(&in.AlternateBackends[i], &out.AlternateBackends[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.AlternateBackends = nil
+	}
 	// unable to generate simple pointer conversion for v1.RoutePort -> api.RoutePort
 	if in.Port != nil {
 		out.Port = new(routeapi.RoutePort)
@@ -5575,6 +6941,7 @@ func autoConvert_v1_RouteSpec_To_api_RouteSpec(in *routeapiv1.RouteSpec, out *ro
 	} else {
 		out.TLS = nil
 	}
+	out.WildcardPolicy = routeapi.WildcardPolicyType(in.WildcardPolicy)
 	return nil
 }
 
@@ -5603,6 +6970,25 @@ func Convert_v1_RouteStatus_To_api_RouteStatus(in *routeapiv1.RouteStatus, out *
 	return autoConvert_v1_RouteStatus_To_api_RouteStatus(in, out, s)
 }
 
+func autoConvert_v1_RouteTargetReference_To_api_RouteTargetReference(in *routeapiv1.RouteTargetReference, out *routeapi.RouteTargetReference, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*routeapiv1.RouteTargetReference))(in)
+	}
+	out.Kind = in.Kind
+	out.Name = in.Name
+	if in.Weight != nil {
+		out.Weight = new(int32)
+		*out.Weight = *in.Weight
+	} else {
+		out.Weight = nil
+	}
+	return nil
+}
+
+func Convert_v1_RouteTargetReference_To_api_RouteTargetReference(in *routeapiv1.RouteTargetReference, out *routeapi.RouteTargetReference, s conversion.Scope) error {
+	return autoConvert_v1_RouteTargetReference_To_api_RouteTargetReference(in, out, s)
+}
+
 func autoConvert_v1_TLSConfig_To_api_TLSConfig(in *routeapiv1.TLSConfig, out *routeapi.TLSConfig, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*routeapiv1.TLSConfig))(in)
@@ -8879,6 +10265,7 @@ func init() {
 		autoConvert_api_AzureFileVolumeSource_To_v1_AzureFileVolumeSource,
 		autoConvert_api_BinaryBuildRequestOptions_To_v1_BinaryBuildRequestOptions,
 		autoConvert_api_BinaryBuildSource_To_v1_BinaryBuildSource,
+		autoConvert_api_BuildCache_To_v1_BuildCache,
 		autoConvert_api_BuildConfigList_To_v1_BuildConfigList,
 		autoConvert_api_BuildConfigSpec_To_v1_BuildConfigSpec,
 		autoConvert_api_BuildConfigStatus_To_v1_BuildConfigStatus,
@@ -8886,15 +10273,19 @@ func init() {
 		autoConvert_api_BuildList_To_v1_BuildList,
 		autoConvert_api_BuildLogOptions_To_v1_BuildLogOptions,
 		autoConvert_api_BuildLog_To_v1_BuildLog,
+		autoConvert_api_BuildOutputDestination_To_v1_BuildOutputDestination,
 		autoConvert_api_BuildOutput_To_v1_BuildOutput,
 		autoConvert_api_BuildPostCommitSpec_To_v1_BuildPostCommitSpec,
 		autoConvert_api_BuildRequest_To_v1_BuildRequest,
 		autoConvert_api_BuildSource_To_v1_BuildSource,
 		autoConvert_api_BuildSpec_To_v1_BuildSpec,
+		autoConvert_api_BuildStatusOutput_To_v1_BuildStatusOutput,
 		autoConvert_api_BuildStatus_To_v1_BuildStatus,
 		autoConvert_api_BuildStrategy_To_v1_BuildStrategy,
 		autoConvert_api_BuildTriggerPolicy_To_v1_BuildTriggerPolicy,
 		autoConvert_api_Build_To_v1_Build,
+		autoConvert_api_CanaryDeploymentStrategyParams_To_v1_CanaryDeploymentStrategyParams,
+		autoConvert_api_CanaryStep_To_v1_CanaryStep,
 		autoConvert_api_Capabilities_To_v1_Capabilities,
 		autoConvert_api_CephFSVolumeSource_To_v1_CephFSVolumeSource,
 		autoConvert_api_CinderVolumeSource_To_v1_CinderVolumeSource,
@@ -8908,6 +10299,7 @@ func init() {
 		autoConvert_api_ClusterRoleBinding_To_v1_ClusterRoleBinding,
 		autoConvert_api_ClusterRoleList_To_v1_ClusterRoleList,
 		autoConvert_api_ClusterRole_To_v1_ClusterRole,
+		autoConvert_api_CommitStatusReporting_To_v1_CommitStatusReporting,
 		autoConvert_api_ConfigMapKeySelector_To_v1_ConfigMapKeySelector,
 		autoConvert_api_ConfigMapVolumeSource_To_v1_ConfigMapVolumeSource,
 		autoConvert_api_ContainerPort_To_v1_ContainerPort,
@@ -8915,8 +10307,12 @@ func init() {
 		autoConvert_api_CustomBuildStrategy_To_v1_CustomBuildStrategy,
 		autoConvert_api_CustomDeploymentStrategyParams_To_v1_CustomDeploymentStrategyParams,
 		autoConvert_api_DeploymentCauseImageTrigger_To_v1_DeploymentCauseImageTrigger,
+		autoConvert_api_DeploymentCauseObjectTrigger_To_v1_DeploymentCauseObjectTrigger,
 		autoConvert_api_DeploymentCause_To_v1_DeploymentCause,
+		autoConvert_api_DeploymentCondition_To_v1_DeploymentCondition,
+		autoConvert_api_DeploymentConfigChange_To_v1_DeploymentConfigChange,
 		autoConvert_api_DeploymentConfigList_To_v1_DeploymentConfigList,
+		autoConvert_api_DeploymentConfigRollbackDiff_To_v1_DeploymentConfigRollbackDiff,
 		autoConvert_api_DeploymentConfigRollbackSpec_To_v1_DeploymentConfigRollbackSpec,
 		autoConvert_api_DeploymentConfigRollback_To_v1_DeploymentConfigRollback,
 		autoConvert_api_DeploymentConfigSpec_To_v1_DeploymentConfigSpec,
@@ -8927,7 +10323,9 @@ func init() {
 		autoConvert_api_DeploymentLog_To_v1_DeploymentLog,
 		autoConvert_api_DeploymentStrategy_To_v1_DeploymentStrategy,
 		autoConvert_api_DeploymentTriggerImageChangeParams_To_v1_DeploymentTriggerImageChangeParams,
+		autoConvert_api_DeploymentTriggerObjectChangeParams_To_v1_DeploymentTriggerObjectChangeParams,
 		autoConvert_api_DeploymentTriggerPolicy_To_v1_DeploymentTriggerPolicy,
+		autoConvert_api_DeploymentTriggeredObject_To_v1_DeploymentTriggeredObject,
 		autoConvert_api_DockerBuildStrategy_To_v1_DockerBuildStrategy,
 		autoConvert_api_DownwardAPIVolumeFile_To_v1_DownwardAPIVolumeFile,
 		autoConvert_api_DownwardAPIVolumeSource_To_v1_DownwardAPIVolumeSource,
@@ -8947,8 +10345,10 @@ func init() {
 		autoConvert_api_GroupList_To_v1_GroupList,
 		autoConvert_api_Group_To_v1_Group,
 		autoConvert_api_HTTPGetAction_To_v1_HTTPGetAction,
+		autoConvert_api_HTTPGetHook_To_v1_HTTPGetHook,
 		autoConvert_api_HTTPHeader_To_v1_HTTPHeader,
 		autoConvert_api_Handler_To_v1_Handler,
+		autoConvert_api_HookVolume_To_v1_HookVolume,
 		autoConvert_api_HostPathVolumeSource_To_v1_HostPathVolumeSource,
 		autoConvert_api_HostSubnetList_To_v1_HostSubnetList,
 		autoConvert_api_HostSubnet_To_v1_HostSubnet,
@@ -8974,6 +10374,7 @@ func init() {
 		autoConvert_api_ImageStream_To_v1_ImageStream,
 		autoConvert_api_Image_To_v1_Image,
 		autoConvert_api_IsPersonalSubjectAccessReview_To_v1_IsPersonalSubjectAccessReview,
+		autoConvert_api_JenkinsPipelineBuildStrategy_To_v1_JenkinsPipelineBuildStrategy,
 		autoConvert_api_KeyToPath_To_v1_KeyToPath,
 		autoConvert_api_LifecycleHook_To_v1_LifecycleHook,
 		autoConvert_api_Lifecycle_To_v1_Lifecycle,
@@ -9009,6 +10410,8 @@ func init() {
 		autoConvert_api_ProjectSpec_To_v1_ProjectSpec,
 		autoConvert_api_ProjectStatus_To_v1_ProjectStatus,
 		autoConvert_api_Project_To_v1_Project,
+		autoConvert_api_PullRequestBuildPolicy_To_v1_PullRequestBuildPolicy,
+		autoConvert_api_PullRequestReference_To_v1_PullRequestReference,
 		autoConvert_api_RBDVolumeSource_To_v1_RBDVolumeSource,
 		autoConvert_api_RecreateDeploymentStrategyParams_To_v1_RecreateDeploymentStrategyParams,
 		autoConvert_api_RepositoryImportSpec_To_v1_RepositoryImportSpec,
@@ -9027,6 +10430,7 @@ func init() {
 		autoConvert_api_RoutePort_To_v1_RoutePort,
 		autoConvert_api_RouteSpec_To_v1_RouteSpec,
 		autoConvert_api_RouteStatus_To_v1_RouteStatus,
+		autoConvert_api_RouteTargetReference_To_v1_RouteTargetReference,
 		autoConvert_api_Route_To_v1_Route,
 		autoConvert_api_SELinuxOptions_To_v1_SELinuxOptions,
 		autoConvert_api_SecretBuildSource_To_v1_SecretBuildSource,
@@ -9037,6 +10441,7 @@ func init() {
 		autoConvert_api_SourceBuildStrategy_To_v1_SourceBuildStrategy,
 		autoConvert_api_SourceControlUser_To_v1_SourceControlUser,
 		autoConvert_api_SourceRevision_To_v1_SourceRevision,
+		autoConvert_api_StageInfo_To_v1_StageInfo,
 		autoConvert_api_SubjectAccessReviewResponse_To_v1_SubjectAccessReviewResponse,
 		autoConvert_api_SubjectAccessReview_To_v1_SubjectAccessReview,
 		autoConvert_api_TCPSocketAction_To_v1_TCPSocketAction,
@@ -9053,11 +10458,13 @@ func init() {
 		autoConvert_api_VolumeMount_To_v1_VolumeMount,
 		autoConvert_api_VolumeSource_To_v1_VolumeSource,
 		autoConvert_api_Volume_To_v1_Volume,
+		autoConvert_api_WaitForImageHook_To_v1_WaitForImageHook,
 		autoConvert_api_WebHookTrigger_To_v1_WebHookTrigger,
 		autoConvert_v1_AWSElasticBlockStoreVolumeSource_To_api_AWSElasticBlockStoreVolumeSource,
 		autoConvert_v1_AzureFileVolumeSource_To_api_AzureFileVolumeSource,
 		autoConvert_v1_BinaryBuildRequestOptions_To_api_BinaryBuildRequestOptions,
 		autoConvert_v1_BinaryBuildSource_To_api_BinaryBuildSource,
+		autoConvert_v1_BuildCache_To_api_BuildCache,
 		autoConvert_v1_BuildConfigList_To_api_BuildConfigList,
 		autoConvert_v1_BuildConfigSpec_To_api_BuildConfigSpec,
 		autoConvert_v1_BuildConfigStatus_To_api_BuildConfigStatus,
@@ -9065,15 +10472,19 @@ func init() {
 		autoConvert_v1_BuildList_To_api_BuildList,
 		autoConvert_v1_BuildLogOptions_To_api_BuildLogOptions,
 		autoConvert_v1_BuildLog_To_api_BuildLog,
+		autoConvert_v1_BuildOutputDestination_To_api_BuildOutputDestination,
 		autoConvert_v1_BuildOutput_To_api_BuildOutput,
 		autoConvert_v1_BuildPostCommitSpec_To_api_BuildPostCommitSpec,
 		autoConvert_v1_BuildRequest_To_api_BuildRequest,
 		autoConvert_v1_BuildSource_To_api_BuildSource,
 		autoConvert_v1_BuildSpec_To_api_BuildSpec,
+		autoConvert_v1_BuildStatusOutput_To_api_BuildStatusOutput,
 		autoConvert_v1_BuildStatus_To_api_BuildStatus,
 		autoConvert_v1_BuildStrategy_To_api_BuildStrategy,
 		autoConvert_v1_BuildTriggerPolicy_To_api_BuildTriggerPolicy,
 		autoConvert_v1_Build_To_api_Build,
+		autoConvert_v1_CanaryDeploymentStrategyParams_To_api_CanaryDeploymentStrategyParams,
+		autoConvert_v1_CanaryStep_To_api_CanaryStep,
 		autoConvert_v1_Capabilities_To_api_Capabilities,
 		autoConvert_v1_CephFSVolumeSource_To_api_CephFSVolumeSource,
 		autoConvert_v1_CinderVolumeSource_To_api_CinderVolumeSource,
@@ -9087,6 +10498,7 @@ func init() {
 		autoConvert_v1_ClusterRoleBinding_To_api_ClusterRoleBinding,
 		autoConvert_v1_ClusterRoleList_To_api_ClusterRoleList,
 		autoConvert_v1_ClusterRole_To_api_ClusterRole,
+		autoConvert_v1_CommitStatusReporting_To_api_CommitStatusReporting,
 		autoConvert_v1_ConfigMapKeySelector_To_api_ConfigMapKeySelector,
 		autoConvert_v1_ConfigMapVolumeSource_To_api_ConfigMapVolumeSource,
 		autoConvert_v1_ContainerPort_To_api_ContainerPort,
@@ -9094,8 +10506,12 @@ func init() {
 		autoConvert_v1_CustomBuildStrategy_To_api_CustomBuildStrategy,
 		autoConvert_v1_CustomDeploymentStrategyParams_To_api_CustomDeploymentStrategyParams,
 		autoConvert_v1_DeploymentCauseImageTrigger_To_api_DeploymentCauseImageTrigger,
+		autoConvert_v1_DeploymentCauseObjectTrigger_To_api_DeploymentCauseObjectTrigger,
 		autoConvert_v1_DeploymentCause_To_api_DeploymentCause,
+		autoConvert_v1_DeploymentCondition_To_api_DeploymentCondition,
+		autoConvert_v1_DeploymentConfigChange_To_api_DeploymentConfigChange,
 		autoConvert_v1_DeploymentConfigList_To_api_DeploymentConfigList,
+		autoConvert_v1_DeploymentConfigRollbackDiff_To_api_DeploymentConfigRollbackDiff,
 		autoConvert_v1_DeploymentConfigRollbackSpec_To_api_DeploymentConfigRollbackSpec,
 		autoConvert_v1_DeploymentConfigRollback_To_api_DeploymentConfigRollback,
 		autoConvert_v1_DeploymentConfigSpec_To_api_DeploymentConfigSpec,
@@ -9106,7 +10522,9 @@ func init() {
 		autoConvert_v1_DeploymentLog_To_api_DeploymentLog,
 		autoConvert_v1_DeploymentStrategy_To_api_DeploymentStrategy,
 		autoConvert_v1_DeploymentTriggerImageChangeParams_To_api_DeploymentTriggerImageChangeParams,
+		autoConvert_v1_DeploymentTriggerObjectChangeParams_To_api_DeploymentTriggerObjectChangeParams,
 		autoConvert_v1_DeploymentTriggerPolicy_To_api_DeploymentTriggerPolicy,
+		autoConvert_v1_DeploymentTriggeredObject_To_api_DeploymentTriggeredObject,
 		autoConvert_v1_DockerBuildStrategy_To_api_DockerBuildStrategy,
 		autoConvert_v1_DownwardAPIVolumeFile_To_api_DownwardAPIVolumeFile,
 		autoConvert_v1_DownwardAPIVolumeSource_To_api_DownwardAPIVolumeSource,
@@ -9126,8 +10544,10 @@ func init() {
 		autoConvert_v1_GroupList_To_api_GroupList,
 		autoConvert_v1_Group_To_api_Group,
 		autoConvert_v1_HTTPGetAction_To_api_HTTPGetAction,
+		autoConvert_v1_HTTPGetHook_To_api_HTTPGetHook,
 		autoConvert_v1_HTTPHeader_To_api_HTTPHeader,
 		autoConvert_v1_Handler_To_api_Handler,
+		autoConvert_v1_HookVolume_To_api_HookVolume,
 		autoConvert_v1_HostPathVolumeSource_To_api_HostPathVolumeSource,
 		autoConvert_v1_HostSubnetList_To_api_HostSubnetList,
 		autoConvert_v1_HostSubnet_To_api_HostSubnet,
@@ -9153,6 +10573,7 @@ func init() {
 		autoConvert_v1_ImageStream_To_api_ImageStream,
 		autoConvert_v1_Image_To_api_Image,
 		autoConvert_v1_IsPersonalSubjectAccessReview_To_api_IsPersonalSubjectAccessReview,
+		autoConvert_v1_JenkinsPipelineBuildStrategy_To_api_JenkinsPipelineBuildStrategy,
 		autoConvert_v1_KeyToPath_To_api_KeyToPath,
 		autoConvert_v1_LifecycleHook_To_api_LifecycleHook,
 		autoConvert_v1_Lifecycle_To_api_Lifecycle,
@@ -9188,6 +10609,8 @@ func init() {
 		autoConvert_v1_ProjectSpec_To_api_ProjectSpec,
 		autoConvert_v1_ProjectStatus_To_api_ProjectStatus,
 		autoConvert_v1_Project_To_api_Project,
+		autoConvert_v1_PullRequestBuildPolicy_To_api_PullRequestBuildPolicy,
+		autoConvert_v1_PullRequestReference_To_api_PullRequestReference,
 		autoConvert_v1_RBDVolumeSource_To_api_RBDVolumeSource,
 		autoConvert_v1_RecreateDeploymentStrategyParams_To_api_RecreateDeploymentStrategyParams,
 		autoConvert_v1_RepositoryImportSpec_To_api_RepositoryImportSpec,
@@ -9206,6 +10629,7 @@ func init() {
 		autoConvert_v1_RoutePort_To_api_RoutePort,
 		autoConvert_v1_RouteSpec_To_api_RouteSpec,
 		autoConvert_v1_RouteStatus_To_api_RouteStatus,
+		autoConvert_v1_RouteTargetReference_To_api_RouteTargetReference,
 		autoConvert_v1_Route_To_api_Route,
 		autoConvert_v1_SELinuxOptions_To_api_SELinuxOptions,
 		autoConvert_v1_SecretBuildSource_To_api_SecretBuildSource,
@@ -9216,6 +10640,7 @@ func init() {
 		autoConvert_v1_SourceBuildStrategy_To_api_SourceBuildStrategy,
 		autoConvert_v1_SourceControlUser_To_api_SourceControlUser,
 		autoConvert_v1_SourceRevision_To_api_SourceRevision,
+		autoConvert_v1_StageInfo_To_api_StageInfo,
 		autoConvert_v1_SubjectAccessReviewResponse_To_api_SubjectAccessReviewResponse,
 		autoConvert_v1_SubjectAccessReview_To_api_SubjectAccessReview,
 		autoConvert_v1_TCPSocketAction_To_api_TCPSocketAction,
@@ -9232,6 +10657,7 @@ func init() {
 		autoConvert_v1_VolumeMount_To_api_VolumeMount,
 		autoConvert_v1_VolumeSource_To_api_VolumeSource,
 		autoConvert_v1_Volume_To_api_Volume,
+		autoConvert_v1_WaitForImageHook_To_api_WaitForImageHook,
 		autoConvert_v1_WebHookTrigger_To_api_WebHookTrigger,
 	)
 	if err != nil {
@@ -735,6 +735,46 @@ func deepCopy_v1_Build(in apiv1.Build, out *apiv1.Build, c *conversion.Cloner) e
 	return nil
 }
 
+func deepCopy_v1_BuildCache(in apiv1.BuildCache, out *apiv1.BuildCache, c *conversion.Cloner) error {
+	if in.Paths != nil {
+		out.Paths = make([]apiv1.ImageSourcePath, len(in.Paths))
+		for i := range in.Paths {
+			if err := deepCopy_v1_ImageSourcePath(in.Paths[i], &out.Paths[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Paths = nil
+	}
+	if in.KeyFiles != nil {
+		out.KeyFiles = make([]string, len(in.KeyFiles))
+		for i := range in.KeyFiles {
+			out.KeyFiles[i] = in.KeyFiles[i]
+		}
+	} else {
+		out.KeyFiles = nil
+	}
+	if in.PersistentVolumeClaim != nil {
+		if newVal, err := c.DeepCopy(in.PersistentVolumeClaim); err != nil {
+			return err
+		} else {
+			out.PersistentVolumeClaim = newVal.(*pkgapiv1.LocalObjectReference)
+		}
+	} else {
+		out.PersistentVolumeClaim = nil
+	}
+	if in.Image != nil {
+		if newVal, err := c.DeepCopy(in.Image); err != nil {
+			return err
+		} else {
+			out.Image = newVal.(*pkgapiv1.ObjectReference)
+		}
+	} else {
+		out.Image = nil
+	}
+	return nil
+}
+
 func deepCopy_v1_BuildConfig(in apiv1.BuildConfig, out *apiv1.BuildConfig, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -790,6 +830,19 @@ func deepCopy_v1_BuildConfigSpec(in apiv1.BuildConfigSpec, out *apiv1.BuildConfi
 	} else {
 		out.Triggers = nil
 	}
+	out.RunPolicy = in.RunPolicy
+	if in.SuccessfulBuildsHistoryLimit != nil {
+		out.SuccessfulBuildsHistoryLimit = new(int)
+		*out.SuccessfulBuildsHistoryLimit = *in.SuccessfulBuildsHistoryLimit
+	} else {
+		out.SuccessfulBuildsHistoryLimit = nil
+	}
+	if in.FailedBuildsHistoryLimit != nil {
+		out.FailedBuildsHistoryLimit = new(int)
+		*out.FailedBuildsHistoryLimit = *in.FailedBuildsHistoryLimit
+	} else {
+		out.FailedBuildsHistoryLimit = nil
+	}
 	if err := deepCopy_v1_BuildSpec(in.BuildSpec, &out.BuildSpec, c); err != nil {
 		return err
 	}
@@ -900,6 +953,34 @@ func deepCopy_v1_BuildOutput(in apiv1.BuildOutput, out *apiv1.BuildOutput, c *co
 	} else {
 		out.PushSecret = nil
 	}
+	if in.Destinations != nil {
+		out.Destinations = make([]apiv1.BuildOutputDestination, len(in.Destinations))
+		for i := range in.Destinations {
+			if err := deepCopy_v1_BuildOutputDestination(in.Destinations[i], &out.Destinations[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Destinations = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_BuildOutputDestination(in apiv1.BuildOutputDestination, out *apiv1.BuildOutputDestination, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.To); err != nil {
+		return err
+	} else {
+		out.To = newVal.(pkgapiv1.ObjectReference)
+	}
+	if in.PushSecret != nil {
+		if newVal, err := c.DeepCopy(in.PushSecret); err != nil {
+			return err
+		} else {
+			out.PushSecret = newVal.(*pkgapiv1.LocalObjectReference)
+		}
+	} else {
+		out.PushSecret = nil
+	}
 	return nil
 }
 
@@ -987,6 +1068,14 @@ func deepCopy_v1_BuildRequest(in apiv1.BuildRequest, out *apiv1.BuildRequest, c
 	} else {
 		out.Env = nil
 	}
+	if in.PullRequest != nil {
+		out.PullRequest = new(apiv1.PullRequestReference)
+		if err := deepCopy_v1_PullRequestReference(*in.PullRequest, out.PullRequest, c); err != nil {
+			return err
+		}
+	} else {
+		out.PullRequest = nil
+	}
 	return nil
 }
 
@@ -1080,6 +1169,22 @@ func deepCopy_v1_BuildSpec(in apiv1.BuildSpec, out *apiv1.BuildSpec, c *conversi
 	} else {
 		out.CompletionDeadlineSeconds = nil
 	}
+	if in.Cache != nil {
+		out.Cache = new(apiv1.BuildCache)
+		if err := deepCopy_v1_BuildCache(*in.Cache, out.Cache, c); err != nil {
+			return err
+		}
+	} else {
+		out.Cache = nil
+	}
+	if in.CommitStatus != nil {
+		out.CommitStatus = new(apiv1.CommitStatusReporting)
+		if err := deepCopy_v1_CommitStatusReporting(*in.CommitStatus, out.CommitStatus, c); err != nil {
+			return err
+		}
+	} else {
+		out.CommitStatus = nil
+	}
 	return nil
 }
 
@@ -1117,6 +1222,32 @@ func deepCopy_v1_BuildStatus(in apiv1.BuildStatus, out *apiv1.BuildStatus, c *co
 	} else {
 		out.Config = nil
 	}
+	if in.Stages != nil {
+		out.Stages = make([]apiv1.StageInfo, len(in.Stages))
+		for i := range in.Stages {
+			if err := deepCopy_v1_StageInfo(in.Stages[i], &out.Stages[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Stages = nil
+	}
+	if in.Outputs != nil {
+		out.Outputs = make([]apiv1.BuildStatusOutput, len(in.Outputs))
+		for i := range in.Outputs {
+			if err := deepCopy_v1_BuildStatusOutput(in.Outputs[i], &out.Outputs[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Outputs = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_BuildStatusOutput(in apiv1.BuildStatusOutput, out *apiv1.BuildStatusOutput, c *conversion.Cloner) error {
+	out.DockerImageReference = in.DockerImageReference
+	out.ImageDigest = in.ImageDigest
 	return nil
 }
 
@@ -1146,6 +1277,14 @@ func deepCopy_v1_BuildStrategy(in apiv1.BuildStrategy, out *apiv1.BuildStrategy,
 	} else {
 		out.CustomStrategy = nil
 	}
+	if in.JenkinsPipelineStrategy != nil {
+		out.JenkinsPipelineStrategy = new(apiv1.JenkinsPipelineBuildStrategy)
+		if err := deepCopy_v1_JenkinsPipelineBuildStrategy(*in.JenkinsPipelineStrategy, out.JenkinsPipelineStrategy, c); err != nil {
+			return err
+		}
+	} else {
+		out.JenkinsPipelineStrategy = nil
+	}
 	return nil
 }
 
@@ -1167,6 +1306,22 @@ func deepCopy_v1_BuildTriggerPolicy(in apiv1.BuildTriggerPolicy, out *apiv1.Buil
 	} else {
 		out.GenericWebHook = nil
 	}
+	if in.GitLabWebHook != nil {
+		out.GitLabWebHook = new(apiv1.WebHookTrigger)
+		if err := deepCopy_v1_WebHookTrigger(*in.GitLabWebHook, out.GitLabWebHook, c); err != nil {
+			return err
+		}
+	} else {
+		out.GitLabWebHook = nil
+	}
+	if in.BitbucketWebHook != nil {
+		out.BitbucketWebHook = new(apiv1.WebHookTrigger)
+		if err := deepCopy_v1_WebHookTrigger(*in.BitbucketWebHook, out.BitbucketWebHook, c); err != nil {
+			return err
+		}
+	} else {
+		out.BitbucketWebHook = nil
+	}
 	if in.ImageChange != nil {
 		out.ImageChange = new(apiv1.ImageChangeTrigger)
 		if err := deepCopy_v1_ImageChangeTrigger(*in.ImageChange, out.ImageChange, c); err != nil {
@@ -1178,6 +1333,18 @@ func deepCopy_v1_BuildTriggerPolicy(in apiv1.BuildTriggerPolicy, out *apiv1.Buil
 	return nil
 }
 
+func deepCopy_v1_CommitStatusReporting(in apiv1.CommitStatusReporting, out *apiv1.CommitStatusReporting, c *conversion.Cloner) error {
+	out.Provider = in.Provider
+	out.APIURL = in.APIURL
+	if newVal, err := c.DeepCopy(in.Secret); err != nil {
+		return err
+	} else {
+		out.Secret = newVal.(pkgapiv1.LocalObjectReference)
+	}
+	out.Context = in.Context
+	return nil
+}
+
 func deepCopy_v1_CustomBuildStrategy(in apiv1.CustomBuildStrategy, out *apiv1.CustomBuildStrategy, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.From); err != nil {
 		return err
@@ -1255,6 +1422,18 @@ func deepCopy_v1_DockerBuildStrategy(in apiv1.DockerBuildStrategy, out *apiv1.Do
 	}
 	out.ForcePull = in.ForcePull
 	out.DockerfilePath = in.DockerfilePath
+	if in.BuildArgs != nil {
+		out.BuildArgs = make([]pkgapiv1.EnvVar, len(in.BuildArgs))
+		for i := range in.BuildArgs {
+			if newVal, err := c.DeepCopy(in.BuildArgs[i]); err != nil {
+				return err
+			} else {
+				out.BuildArgs[i] = newVal.(pkgapiv1.EnvVar)
+			}
+		}
+	} else {
+		out.BuildArgs = nil
+	}
 	return nil
 }
 
@@ -1336,6 +1515,26 @@ func deepCopy_v1_ImageSourcePath(in apiv1.ImageSourcePath, out *apiv1.ImageSourc
 	return nil
 }
 
+func deepCopy_v1_JenkinsPipelineBuildStrategy(in apiv1.JenkinsPipelineBuildStrategy, out *apiv1.JenkinsPipelineBuildStrategy, c *conversion.Cloner) error {
+	out.JenkinsfilePath = in.JenkinsfilePath
+	out.Jenkinsfile = in.Jenkinsfile
+	return nil
+}
+
+func deepCopy_v1_PullRequestBuildPolicy(in apiv1.PullRequestBuildPolicy, out *apiv1.PullRequestBuildPolicy, c *conversion.Cloner) error {
+	out.OutputTagPrefix = in.OutputTagPrefix
+	out.AllowForkSecrets = in.AllowForkSecrets
+	return nil
+}
+
+func deepCopy_v1_PullRequestReference(in apiv1.PullRequestReference, out *apiv1.PullRequestReference, c *conversion.Cloner) error {
+	out.Number = in.Number
+	out.Ref = in.Ref
+	out.Fork = in.Fork
+	out.OutputTag = in.OutputTag
+	return nil
+}
+
 func deepCopy_v1_SecretBuildSource(in apiv1.SecretBuildSource, out *apiv1.SecretBuildSource, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.Secret); err != nil {
 		return err
@@ -1408,8 +1607,101 @@ func deepCopy_v1_SourceRevision(in apiv1.SourceRevision, out *apiv1.SourceRevisi
 	return nil
 }
 
+func deepCopy_v1_StageInfo(in apiv1.StageInfo, out *apiv1.StageInfo, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.Phase = in.Phase
+	if in.StartTimestamp != nil {
+		if newVal, err := c.DeepCopy(in.StartTimestamp); err != nil {
+			return err
+		} else {
+			out.StartTimestamp = newVal.(*unversioned.Time)
+		}
+	} else {
+		out.StartTimestamp = nil
+	}
+	if in.CompletionTimestamp != nil {
+		if newVal, err := c.DeepCopy(in.CompletionTimestamp); err != nil {
+			return err
+		} else {
+			out.CompletionTimestamp = newVal.(*unversioned.Time)
+		}
+	} else {
+		out.CompletionTimestamp = nil
+	}
+	return nil
+}
+
 func deepCopy_v1_WebHookTrigger(in apiv1.WebHookTrigger, out *apiv1.WebHookTrigger, c *conversion.Cloner) error {
 	out.Secret = in.Secret
+	if in.SecretReference != nil {
+		if newVal, err := c.DeepCopy(in.SecretReference); err != nil {
+			return err
+		} else {
+			out.SecretReference = newVal.(*pkgapiv1.LocalObjectReference)
+		}
+	} else {
+		out.SecretReference = nil
+	}
+	if in.PullRequests != nil {
+		out.PullRequests = new(apiv1.PullRequestBuildPolicy)
+		if err := deepCopy_v1_PullRequestBuildPolicy(*in.PullRequests, out.PullRequests, c); err != nil {
+			return err
+		}
+	} else {
+		out.PullRequests = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_CanaryDeploymentStrategyParams(in deployapiv1.CanaryDeploymentStrategyParams, out *deployapiv1.CanaryDeploymentStrategyParams, c *conversion.Cloner) error {
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	out.Traffic = in.Traffic
+	out.RouteName = in.RouteName
+	if in.Steps != nil {
+		out.Steps = make([]deployapiv1.CanaryStep, len(in.Steps))
+		for i := range in.Steps {
+			if err := deepCopy_v1_CanaryStep(in.Steps[i], &out.Steps[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Steps = nil
+	}
+	if in.Pre != nil {
+		out.Pre = new(deployapiv1.LifecycleHook)
+		if err := deepCopy_v1_LifecycleHook(*in.Pre, out.Pre, c); err != nil {
+			return err
+		}
+	} else {
+		out.Pre = nil
+	}
+	if in.Post != nil {
+		out.Post = new(deployapiv1.LifecycleHook)
+		if err := deepCopy_v1_LifecycleHook(*in.Post, out.Post, c); err != nil {
+			return err
+		}
+	} else {
+		out.Post = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_CanaryStep(in deployapiv1.CanaryStep, out *deployapiv1.CanaryStep, c *conversion.Cloner) error {
+	out.Weight = in.Weight
+	if in.Verify != nil {
+		out.Verify = new(deployapiv1.LifecycleHook)
+		if err := deepCopy_v1_LifecycleHook(*in.Verify, out.Verify, c); err != nil {
+			return err
+		}
+	} else {
+		out.Verify = nil
+	}
+	out.Approve = in.Approve
 	return nil
 }
 
@@ -1448,6 +1740,14 @@ func deepCopy_v1_DeploymentCause(in deployapiv1.DeploymentCause, out *deployapiv
 	} else {
 		out.ImageTrigger = nil
 	}
+	if in.ObjectTrigger != nil {
+		out.ObjectTrigger = new(deployapiv1.DeploymentCauseObjectTrigger)
+		if err := deepCopy_v1_DeploymentCauseObjectTrigger(*in.ObjectTrigger, out.ObjectTrigger, c); err != nil {
+			return err
+		}
+	} else {
+		out.ObjectTrigger = nil
+	}
 	return nil
 }
 
@@ -1460,6 +1760,28 @@ func deepCopy_v1_DeploymentCauseImageTrigger(in deployapiv1.DeploymentCauseImage
 	return nil
 }
 
+func deepCopy_v1_DeploymentCauseObjectTrigger(in deployapiv1.DeploymentCauseObjectTrigger, out *deployapiv1.DeploymentCauseObjectTrigger, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.From); err != nil {
+		return err
+	} else {
+		out.From = newVal.(pkgapiv1.ObjectReference)
+	}
+	return nil
+}
+
+func deepCopy_v1_DeploymentCondition(in deployapiv1.DeploymentCondition, out *deployapiv1.DeploymentCondition, c *conversion.Cloner) error {
+	out.Type = in.Type
+	out.Status = in.Status
+	if newVal, err := c.DeepCopy(in.LastTransitionTime); err != nil {
+		return err
+	} else {
+		out.LastTransitionTime = newVal.(unversioned.Time)
+	}
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
 func deepCopy_v1_DeploymentConfig(in deployapiv1.DeploymentConfig, out *deployapiv1.DeploymentConfig, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -1480,6 +1802,14 @@ func deepCopy_v1_DeploymentConfig(in deployapiv1.DeploymentConfig, out *deployap
 	return nil
 }
 
+func deepCopy_v1_DeploymentConfigChange(in deployapiv1.DeploymentConfigChange, out *deployapiv1.DeploymentConfigChange, c *conversion.Cloner) error {
+	out.Type = in.Type
+	out.Field = in.Field
+	out.Current = in.Current
+	out.RolledBack = in.RolledBack
+	return nil
+}
+
 func deepCopy_v1_DeploymentConfigList(in deployapiv1.DeploymentConfigList, out *deployapiv1.DeploymentConfigList, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -1516,6 +1846,31 @@ func deepCopy_v1_DeploymentConfigRollback(in deployapiv1.DeploymentConfigRollbac
 	return nil
 }
 
+func deepCopy_v1_DeploymentConfigRollbackDiff(in deployapiv1.DeploymentConfigRollbackDiff, out *deployapiv1.DeploymentConfigRollbackDiff, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	out.Name = in.Name
+	if newVal, err := c.DeepCopy(in.From); err != nil {
+		return err
+	} else {
+		out.From = newVal.(pkgapiv1.ObjectReference)
+	}
+	if in.Changes != nil {
+		out.Changes = make([]deployapiv1.DeploymentConfigChange, len(in.Changes))
+		for i := range in.Changes {
+			if err := deepCopy_v1_DeploymentConfigChange(in.Changes[i], &out.Changes[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Changes = nil
+	}
+	return nil
+}
+
 func deepCopy_v1_DeploymentConfigRollbackSpec(in deployapiv1.DeploymentConfigRollbackSpec, out *deployapiv1.DeploymentConfigRollbackSpec, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.From); err != nil {
 		return err
@@ -1526,6 +1881,7 @@ func deepCopy_v1_DeploymentConfigRollbackSpec(in deployapiv1.DeploymentConfigRol
 	out.IncludeTemplate = in.IncludeTemplate
 	out.IncludeReplicationMeta = in.IncludeReplicationMeta
 	out.IncludeStrategy = in.IncludeStrategy
+	out.DiffOnly = in.DiffOnly
 	return nil
 }
 
@@ -1545,6 +1901,20 @@ func deepCopy_v1_DeploymentConfigSpec(in deployapiv1.DeploymentConfigSpec, out *
 	}
 	out.Replicas = in.Replicas
 	out.Test = in.Test
+	out.Paused = in.Paused
+	if in.ProgressDeadlineSeconds != nil {
+		out.ProgressDeadlineSeconds = new(int64)
+		*out.ProgressDeadlineSeconds = *in.ProgressDeadlineSeconds
+	} else {
+		out.ProgressDeadlineSeconds = nil
+	}
+	out.AutoRollback = in.AutoRollback
+	if in.RevisionHistoryLimit != nil {
+		out.RevisionHistoryLimit = new(int)
+		*out.RevisionHistoryLimit = *in.RevisionHistoryLimit
+	} else {
+		out.RevisionHistoryLimit = nil
+	}
 	if in.Selector != nil {
 		out.Selector = make(map[string]string)
 		for key, val := range in.Selector {
@@ -1575,6 +1945,30 @@ func deepCopy_v1_DeploymentConfigStatus(in deployapiv1.DeploymentConfigStatus, o
 	} else {
 		out.Details = nil
 	}
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Replicas = in.Replicas
+	out.UpdatedReplicas = in.UpdatedReplicas
+	out.AvailableReplicas = in.AvailableReplicas
+	if in.Conditions != nil {
+		out.Conditions = make([]deployapiv1.DeploymentCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := deepCopy_v1_DeploymentCondition(in.Conditions[i], &out.Conditions[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
+	if in.TriggeredObjects != nil {
+		out.TriggeredObjects = make([]deployapiv1.DeploymentTriggeredObject, len(in.TriggeredObjects))
+		for i := range in.TriggeredObjects {
+			if err := deepCopy_v1_DeploymentTriggeredObject(in.TriggeredObjects[i], &out.TriggeredObjects[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.TriggeredObjects = nil
+	}
 	return nil
 }
 
@@ -1679,6 +2073,14 @@ func deepCopy_v1_DeploymentStrategy(in deployapiv1.DeploymentStrategy, out *depl
 	} else {
 		out.RollingParams = nil
 	}
+	if in.CanaryParams != nil {
+		out.CanaryParams = new(deployapiv1.CanaryDeploymentStrategyParams)
+		if err := deepCopy_v1_CanaryDeploymentStrategyParams(*in.CanaryParams, out.CanaryParams, c); err != nil {
+			return err
+		}
+	} else {
+		out.CanaryParams = nil
+	}
 	if newVal, err := c.DeepCopy(in.Resources); err != nil {
 		return err
 	} else {
@@ -1722,6 +2124,11 @@ func deepCopy_v1_DeploymentTriggerImageChangeParams(in deployapiv1.DeploymentTri
 	return nil
 }
 
+func deepCopy_v1_DeploymentTriggerObjectChangeParams(in deployapiv1.DeploymentTriggerObjectChangeParams, out *deployapiv1.DeploymentTriggerObjectChangeParams, c *conversion.Cloner) error {
+	out.Name = in.Name
+	return nil
+}
+
 func deepCopy_v1_DeploymentTriggerPolicy(in deployapiv1.DeploymentTriggerPolicy, out *deployapiv1.DeploymentTriggerPolicy, c *conversion.Cloner) error {
 	out.Type = in.Type
 	if in.ImageChangeParams != nil {
@@ -1732,6 +2139,29 @@ func deepCopy_v1_DeploymentTriggerPolicy(in deployapiv1.DeploymentTriggerPolicy,
 	} else {
 		out.ImageChangeParams = nil
 	}
+	if in.SecretChangeParams != nil {
+		out.SecretChangeParams = new(deployapiv1.DeploymentTriggerObjectChangeParams)
+		if err := deepCopy_v1_DeploymentTriggerObjectChangeParams(*in.SecretChangeParams, out.SecretChangeParams, c); err != nil {
+			return err
+		}
+	} else {
+		out.SecretChangeParams = nil
+	}
+	if in.ConfigMapChangeParams != nil {
+		out.ConfigMapChangeParams = new(deployapiv1.DeploymentTriggerObjectChangeParams)
+		if err := deepCopy_v1_DeploymentTriggerObjectChangeParams(*in.ConfigMapChangeParams, out.ConfigMapChangeParams, c); err != nil {
+			return err
+		}
+	} else {
+		out.ConfigMapChangeParams = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_DeploymentTriggeredObject(in deployapiv1.DeploymentTriggeredObject, out *deployapiv1.DeploymentTriggeredObject, c *conversion.Cloner) error {
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.DataHash = in.DataHash
 	return nil
 }
 
@@ -1765,6 +2195,70 @@ func deepCopy_v1_ExecNewPodHook(in deployapiv1.ExecNewPodHook, out *deployapiv1.
 	} else {
 		out.Volumes = nil
 	}
+	if in.ExtraVolumes != nil {
+		out.ExtraVolumes = make([]deployapiv1.HookVolume, len(in.ExtraVolumes))
+		for i := range in.ExtraVolumes {
+			if err := deepCopy_v1_HookVolume(in.ExtraVolumes[i], &out.ExtraVolumes[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ExtraVolumes = nil
+	}
+	if in.Resources != nil {
+		if newVal, err := c.DeepCopy(in.Resources); err != nil {
+			return err
+		} else {
+			out.Resources = newVal.(*pkgapiv1.ResourceRequirements)
+		}
+	} else {
+		out.Resources = nil
+	}
+	out.ServiceAccountName = in.ServiceAccountName
+	if in.NodeSelector != nil {
+		out.NodeSelector = make(map[string]string)
+		for key, val := range in.NodeSelector {
+			out.NodeSelector[key] = val
+		}
+	} else {
+		out.NodeSelector = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_HTTPGetHook(in deployapiv1.HTTPGetHook, out *deployapiv1.HTTPGetHook, c *conversion.Cloner) error {
+	out.URL = in.URL
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_HookVolume(in deployapiv1.HookVolume, out *deployapiv1.HookVolume, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.MountPath = in.MountPath
+	out.ReadOnly = in.ReadOnly
+	if in.Secret != nil {
+		if newVal, err := c.DeepCopy(in.Secret); err != nil {
+			return err
+		} else {
+			out.Secret = newVal.(*pkgapiv1.SecretVolumeSource)
+		}
+	} else {
+		out.Secret = nil
+	}
+	if in.EmptyDir != nil {
+		if newVal, err := c.DeepCopy(in.EmptyDir); err != nil {
+			return err
+		} else {
+			out.EmptyDir = newVal.(*pkgapiv1.EmptyDirVolumeSource)
+		}
+	} else {
+		out.EmptyDir = nil
+	}
 	return nil
 }
 
@@ -1788,6 +2282,22 @@ func deepCopy_v1_LifecycleHook(in deployapiv1.LifecycleHook, out *deployapiv1.Li
 	} else {
 		out.TagImages = nil
 	}
+	if in.HTTPGet != nil {
+		out.HTTPGet = new(deployapiv1.HTTPGetHook)
+		if err := deepCopy_v1_HTTPGetHook(*in.HTTPGet, out.HTTPGet, c); err != nil {
+			return err
+		}
+	} else {
+		out.HTTPGet = nil
+	}
+	if in.WaitForImage != nil {
+		out.WaitForImage = new(deployapiv1.WaitForImageHook)
+		if err := deepCopy_v1_WaitForImageHook(*in.WaitForImage, out.WaitForImage, c); err != nil {
+			return err
+		}
+	} else {
+		out.WaitForImage = nil
+	}
 	return nil
 }
 
@@ -1897,6 +2407,23 @@ func deepCopy_v1_TagImageHook(in deployapiv1.TagImageHook, out *deployapiv1.TagI
 	return nil
 }
 
+func deepCopy_v1_WaitForImageHook(in deployapiv1.WaitForImageHook, out *deployapiv1.WaitForImageHook, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.From); err != nil {
+		return err
+	} else {
+		out.From = newVal.(pkgapiv1.ObjectReference)
+	}
+	out.Digest = in.Digest
+	out.ContainerName = in.ContainerName
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	return nil
+}
+
 func deepCopy_v1_Image(in imageapiv1.Image, out *imageapiv1.Image, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -2743,6 +3270,22 @@ func deepCopy_v1_RouteSpec(in routeapiv1.RouteSpec, out *routeapiv1.RouteSpec, c
 	} else {
 		out.To = newVal.(pkgapiv1.ObjectReference)
 	}
+	if in.Weight != nil {
+		out.Weight = new(int32)
+		*out.Weight = *in.Weight
+	} else {
+		out.Weight = nil
+	}
+	if in.AlternateBackends != nil {
+		out.AlternateBackends = make([]routeapiv1.RouteTargetReference, len(in.AlternateBackends))
+		for i := range in.AlternateBackends {
+			if err := deepCopy_v1_RouteTargetReference(in.AlternateBackends[i], &out.AlternateBackends[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.AlternateBackends = nil
+	}
 	if in.Port != nil {
 		out.Port = new(routeapiv1.RoutePort)
 		if err := deepCopy_v1_RoutePort(*in.Port, out.Port, c); err != nil {
@@ -2759,6 +3302,7 @@ func deepCopy_v1_RouteSpec(in routeapiv1.RouteSpec, out *routeapiv1.RouteSpec, c
 	} else {
 		out.TLS = nil
 	}
+	out.WildcardPolicy = in.WildcardPolicy
 	return nil
 }
 
@@ -2776,6 +3320,18 @@ func deepCopy_v1_RouteStatus(in routeapiv1.RouteStatus, out *routeapiv1.RouteSta
 	return nil
 }
 
+func deepCopy_v1_RouteTargetReference(in routeapiv1.RouteTargetReference, out *routeapiv1.RouteTargetReference, c *conversion.Cloner) error {
+	out.Kind = in.Kind
+	out.Name = in.Name
+	if in.Weight != nil {
+		out.Weight = new(int32)
+		*out.Weight = *in.Weight
+	} else {
+		out.Weight = nil
+	}
+	return nil
+}
+
 func deepCopy_v1_TLSConfig(in routeapiv1.TLSConfig, out *routeapiv1.TLSConfig, c *conversion.Cloner) error {
 	out.Termination = in.Termination
 	out.Certificate = in.Certificate
@@ -3199,6 +3755,7 @@ func init() {
 		deepCopy_v1_BinaryBuildRequestOptions,
 		deepCopy_v1_BinaryBuildSource,
 		deepCopy_v1_Build,
+		deepCopy_v1_BuildCache,
 		deepCopy_v1_BuildConfig,
 		deepCopy_v1_BuildConfigList,
 		deepCopy_v1_BuildConfigSpec,
@@ -3207,13 +3764,16 @@ func init() {
 		deepCopy_v1_BuildLog,
 		deepCopy_v1_BuildLogOptions,
 		deepCopy_v1_BuildOutput,
+		deepCopy_v1_BuildOutputDestination,
 		deepCopy_v1_BuildPostCommitSpec,
 		deepCopy_v1_BuildRequest,
 		deepCopy_v1_BuildSource,
 		deepCopy_v1_BuildSpec,
 		deepCopy_v1_BuildStatus,
+		deepCopy_v1_BuildStatusOutput,
 		deepCopy_v1_BuildStrategy,
 		deepCopy_v1_BuildTriggerPolicy,
+		deepCopy_v1_CommitStatusReporting,
 		deepCopy_v1_CustomBuildStrategy,
 		deepCopy_v1_DockerBuildStrategy,
 		deepCopy_v1_GitBuildSource,
@@ -3221,18 +3781,28 @@ func init() {
 		deepCopy_v1_ImageChangeTrigger,
 		deepCopy_v1_ImageSource,
 		deepCopy_v1_ImageSourcePath,
+		deepCopy_v1_JenkinsPipelineBuildStrategy,
+		deepCopy_v1_PullRequestBuildPolicy,
+		deepCopy_v1_PullRequestReference,
 		deepCopy_v1_SecretBuildSource,
 		deepCopy_v1_SecretSpec,
 		deepCopy_v1_SourceBuildStrategy,
 		deepCopy_v1_SourceControlUser,
 		deepCopy_v1_SourceRevision,
+		deepCopy_v1_StageInfo,
 		deepCopy_v1_WebHookTrigger,
+		deepCopy_v1_CanaryDeploymentStrategyParams,
+		deepCopy_v1_CanaryStep,
 		deepCopy_v1_CustomDeploymentStrategyParams,
 		deepCopy_v1_DeploymentCause,
 		deepCopy_v1_DeploymentCauseImageTrigger,
+		deepCopy_v1_DeploymentCauseObjectTrigger,
+		deepCopy_v1_DeploymentCondition,
 		deepCopy_v1_DeploymentConfig,
+		deepCopy_v1_DeploymentConfigChange,
 		deepCopy_v1_DeploymentConfigList,
 		deepCopy_v1_DeploymentConfigRollback,
+		deepCopy_v1_DeploymentConfigRollbackDiff,
 		deepCopy_v1_DeploymentConfigRollbackSpec,
 		deepCopy_v1_DeploymentConfigSpec,
 		deepCopy_v1_DeploymentConfigStatus,
@@ -3241,12 +3811,17 @@ func init() {
 		deepCopy_v1_DeploymentLogOptions,
 		deepCopy_v1_DeploymentStrategy,
 		deepCopy_v1_DeploymentTriggerImageChangeParams,
+		deepCopy_v1_DeploymentTriggerObjectChangeParams,
 		deepCopy_v1_DeploymentTriggerPolicy,
+		deepCopy_v1_DeploymentTriggeredObject,
 		deepCopy_v1_ExecNewPodHook,
+		deepCopy_v1_HTTPGetHook,
+		deepCopy_v1_HookVolume,
 		deepCopy_v1_LifecycleHook,
 		deepCopy_v1_RecreateDeploymentStrategyParams,
 		deepCopy_v1_RollingDeploymentStrategyParams,
 		deepCopy_v1_TagImageHook,
+		deepCopy_v1_WaitForImageHook,
 		deepCopy_v1_Image,
 		deepCopy_v1_ImageImportSpec,
 		deepCopy_v1_ImageImportStatus,
@@ -3290,6 +3865,7 @@ func init() {
 		deepCopy_v1_RoutePort,
 		deepCopy_v1_RouteSpec,
 		deepCopy_v1_RouteStatus,
+		deepCopy_v1_RouteTargetReference,
 		deepCopy_v1_TLSConfig,
 		deepCopy_v1_ClusterNetwork,
 		deepCopy_v1_ClusterNetworkList,
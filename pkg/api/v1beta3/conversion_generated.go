@@ -995,6 +995,53 @@ func Convert_api_Build_To_v1beta3_Build(in *buildapi.Build, out *v1beta3.Build,
 	return autoConvert_api_Build_To_v1beta3_Build(in, out, s)
 }
 
+func autoConvert_api_BuildCache_To_v1beta3_BuildCache(in *buildapi.BuildCache, out *v1beta3.BuildCache, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.BuildCache))(in)
+	}
+	if in.Paths != nil {
+		out.Paths = make([]v1beta3.ImageSourcePath, len(in.Paths))
+		for i := range in.Paths {
+			if err := Convert_api_ImageSourcePath_To_v1beta3_ImageSourcePath(&in.Paths[i], &out.Paths[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Paths = nil
+	}
+	if in.KeyFiles != nil {
+		out.KeyFiles = make([]string, len(in.KeyFiles))
+		for i := range in.KeyFiles {
+			out.KeyFiles[i] = in.KeyFiles[i]
+		}
+	} else {
+		out.KeyFiles = nil
+	}
+	// unable to generate simple pointer conversion for api.LocalObjectReference -> v1beta3.LocalObjectReference
+	if in.PersistentVolumeClaim != nil {
+		out.PersistentVolumeClaim = new(apiv1beta3.LocalObjectReference)
+		if err := Convert_api_LocalObjectReference_To_v1beta3_LocalObjectReference(in.PersistentVolumeClaim, out.PersistentVolumeClaim, s); err != nil {
+			return err
+		}
+	} else {
+		out.PersistentVolumeClaim = nil
+	}
+	// unable to generate simple pointer conversion for api.ObjectReference -> v1beta3.ObjectReference
+	if in.Image != nil {
+		out.Image = new(apiv1beta3.ObjectReference)
+		if err := Convert_api_ObjectReference_To_v1beta3_ObjectReference(in.Image, out.Image, s); err != nil {
+			return err
+		}
+	} else {
+		out.Image = nil
+	}
+	return nil
+}
+
+func Convert_api_BuildCache_To_v1beta3_BuildCache(in *buildapi.BuildCache, out *v1beta3.BuildCache, s conversion.Scope) error {
+	return autoConvert_api_BuildCache_To_v1beta3_BuildCache(in, out, s)
+}
+
 func autoConvert_api_BuildConfig_To_v1beta3_BuildConfig(in *buildapi.BuildConfig, out *v1beta3.BuildConfig, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.BuildConfig))(in)
@@ -1049,6 +1096,19 @@ func autoConvert_api_BuildConfigSpec_To_v1beta3_BuildConfigSpec(in *buildapi.Bui
 	} else {
 		out.Triggers = nil
 	}
+	out.RunPolicy = v1beta3.BuildRunPolicy(in.RunPolicy)
+	if in.SuccessfulBuildsHistoryLimit != nil {
+		out.SuccessfulBuildsHistoryLimit = new(int)
+		*out.SuccessfulBuildsHistoryLimit = *in.SuccessfulBuildsHistoryLimit
+	} else {
+		out.SuccessfulBuildsHistoryLimit = nil
+	}
+	if in.FailedBuildsHistoryLimit != nil {
+		out.FailedBuildsHistoryLimit = new(int)
+		*out.FailedBuildsHistoryLimit = *in.FailedBuildsHistoryLimit
+	} else {
+		out.FailedBuildsHistoryLimit = nil
+	}
 	if err := Convert_api_BuildSpec_To_v1beta3_BuildSpec(&in.BuildSpec, &out.BuildSpec, s); err != nil {
 		return err
 	}
@@ -1177,9 +1237,42 @@ func autoConvert_api_BuildOutput_To_v1beta3_BuildOutput(in *buildapi.BuildOutput
 	} else {
 		out.PushSecret = nil
 	}
+	if in.Destinations != nil {
+		out.Destinations = make([]v1beta3.BuildOutputDestination, len(in.Destinations))
+		for i := range in.Destinations {
+			if err := Convert_api_BuildOutputDestination_To_v1beta3_BuildOutputDestination(&in.Destinations[i], &out.Destinations[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Destinations = nil
+	}
 	return nil
 }
 
+func autoConvert_api_BuildOutputDestination_To_v1beta3_BuildOutputDestination(in *buildapi.BuildOutputDestination, out *v1beta3.BuildOutputDestination, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.BuildOutputDestination))(in)
+	}
+	if err := Convert_api_ObjectReference_To_v1beta3_ObjectReference(&in.To, &out.To, s); err != nil {
+		return err
+	}
+	// unable to generate simple pointer conversion for api.LocalObjectReference -> v1beta3.LocalObjectReference
+	if in.PushSecret != nil {
+		out.PushSecret = new(apiv1beta3.LocalObjectReference)
+		if err := Convert_api_LocalObjectReference_To_v1beta3_LocalObjectReference(in.PushSecret, out.PushSecret, s); err != nil {
+			return err
+		}
+	} else {
+		out.PushSecret = nil
+	}
+	return nil
+}
+
+func Convert_api_BuildOutputDestination_To_v1beta3_BuildOutputDestination(in *buildapi.BuildOutputDestination, out *v1beta3.BuildOutputDestination, s conversion.Scope) error {
+	return autoConvert_api_BuildOutputDestination_To_v1beta3_BuildOutputDestination(in, out, s)
+}
+
 func autoConvert_api_BuildPostCommitSpec_To_v1beta3_BuildPostCommitSpec(in *buildapi.BuildPostCommitSpec, out *v1beta3.BuildPostCommitSpec, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.BuildPostCommitSpec))(in)
@@ -1303,6 +1396,24 @@ func autoConvert_api_BuildSpec_To_v1beta3_BuildSpec(in *buildapi.BuildSpec, out
 	} else {
 		out.CompletionDeadlineSeconds = nil
 	}
+	// unable to generate simple pointer conversion for api.BuildCache -> v1beta3.BuildCache
+	if in.Cache != nil {
+		out.Cache = new(v1beta3.BuildCache)
+		if err := Convert_api_BuildCache_To_v1beta3_BuildCache(in.Cache, out.Cache, s); err != nil {
+			return err
+		}
+	} else {
+		out.Cache = nil
+	}
+	// unable to generate simple pointer conversion for api.CommitStatusReporting -> v1beta3.CommitStatusReporting
+	if in.CommitStatus != nil {
+		out.CommitStatus = new(v1beta3.CommitStatusReporting)
+		if err := Convert_api_CommitStatusReporting_To_v1beta3_CommitStatusReporting(in.CommitStatus, out.CommitStatus, s); err != nil {
+			return err
+		}
+	} else {
+		out.CommitStatus = nil
+	}
 	return nil
 }
 
@@ -1347,6 +1458,26 @@ func autoConvert_api_BuildStatus_To_v1beta3_BuildStatus(in *buildapi.BuildStatus
 	} else {
 		out.Config = nil
 	}
+	if in.Stages != nil {
+		out.Stages = make([]v1beta3.StageInfo, len(in.Stages))
+		for i := range in.Stages {
+			if err := Convert_api_StageInfo_To_v1beta3_StageInfo(&in.Stages[i], &out.Stages[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Stages = nil
+	}
+	if in.Outputs != nil {
+		out.Outputs = make([]v1beta3.BuildStatusOutput, len(in.Outputs))
+		for i := range in.Outputs {
+			if err := Convert_api_BuildStatusOutput_To_v1beta3_BuildStatusOutput(&in.Outputs[i], &out.Outputs[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Outputs = nil
+	}
 	return nil
 }
 
@@ -1354,6 +1485,19 @@ func Convert_api_BuildStatus_To_v1beta3_BuildStatus(in *buildapi.BuildStatus, ou
 	return autoConvert_api_BuildStatus_To_v1beta3_BuildStatus(in, out, s)
 }
 
+func autoConvert_api_BuildStatusOutput_To_v1beta3_BuildStatusOutput(in *buildapi.BuildStatusOutput, out *v1beta3.BuildStatusOutput, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.BuildStatusOutput))(in)
+	}
+	out.DockerImageReference = in.DockerImageReference
+	out.ImageDigest = in.ImageDigest
+	return nil
+}
+
+func Convert_api_BuildStatusOutput_To_v1beta3_BuildStatusOutput(in *buildapi.BuildStatusOutput, out *v1beta3.BuildStatusOutput, s conversion.Scope) error {
+	return autoConvert_api_BuildStatusOutput_To_v1beta3_BuildStatusOutput(in, out, s)
+}
+
 func autoConvert_api_BuildStrategy_To_v1beta3_BuildStrategy(in *buildapi.BuildStrategy, out *v1beta3.BuildStrategy, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.BuildStrategy))(in)
@@ -1382,6 +1526,15 @@ func autoConvert_api_BuildStrategy_To_v1beta3_BuildStrategy(in *buildapi.BuildSt
 	} else {
 		out.CustomStrategy = nil
 	}
+	// unable to generate simple pointer conversion for api.JenkinsPipelineBuildStrategy -> v1beta3.JenkinsPipelineBuildStrategy
+	if in.JenkinsPipelineStrategy != nil {
+		out.JenkinsPipelineStrategy = new(v1beta3.JenkinsPipelineBuildStrategy)
+		if err := Convert_api_JenkinsPipelineBuildStrategy_To_v1beta3_JenkinsPipelineBuildStrategy(in.JenkinsPipelineStrategy, out.JenkinsPipelineStrategy, s); err != nil {
+			return err
+		}
+	} else {
+		out.JenkinsPipelineStrategy = nil
+	}
 	return nil
 }
 
@@ -1408,6 +1561,24 @@ func autoConvert_api_BuildTriggerPolicy_To_v1beta3_BuildTriggerPolicy(in *builda
 	} else {
 		out.GenericWebHook = nil
 	}
+	// unable to generate simple pointer conversion for api.WebHookTrigger -> v1beta3.WebHookTrigger
+	if in.GitLabWebHook != nil {
+		out.GitLabWebHook = new(v1beta3.WebHookTrigger)
+		if err := Convert_api_WebHookTrigger_To_v1beta3_WebHookTrigger(in.GitLabWebHook, out.GitLabWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.GitLabWebHook = nil
+	}
+	// unable to generate simple pointer conversion for api.WebHookTrigger -> v1beta3.WebHookTrigger
+	if in.BitbucketWebHook != nil {
+		out.BitbucketWebHook = new(v1beta3.WebHookTrigger)
+		if err := Convert_api_WebHookTrigger_To_v1beta3_WebHookTrigger(in.BitbucketWebHook, out.BitbucketWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.BitbucketWebHook = nil
+	}
 	// unable to generate simple pointer conversion for api.ImageChangeTrigger -> v1beta3.ImageChangeTrigger
 	if in.ImageChange != nil {
 		out.ImageChange = new(v1beta3.ImageChangeTrigger)
@@ -1420,6 +1591,23 @@ func autoConvert_api_BuildTriggerPolicy_To_v1beta3_BuildTriggerPolicy(in *builda
 	return nil
 }
 
+func autoConvert_api_CommitStatusReporting_To_v1beta3_CommitStatusReporting(in *buildapi.CommitStatusReporting, out *v1beta3.CommitStatusReporting, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.CommitStatusReporting))(in)
+	}
+	out.Provider = v1beta3.CommitStatusProvider(in.Provider)
+	out.APIURL = in.APIURL
+	if err := Convert_api_LocalObjectReference_To_v1beta3_LocalObjectReference(&in.Secret, &out.Secret, s); err != nil {
+		return err
+	}
+	out.Context = in.Context
+	return nil
+}
+
+func Convert_api_CommitStatusReporting_To_v1beta3_CommitStatusReporting(in *buildapi.CommitStatusReporting, out *v1beta3.CommitStatusReporting, s conversion.Scope) error {
+	return autoConvert_api_CommitStatusReporting_To_v1beta3_CommitStatusReporting(in, out, s)
+}
+
 func autoConvert_api_CustomBuildStrategy_To_v1beta3_CustomBuildStrategy(in *buildapi.CustomBuildStrategy, out *v1beta3.CustomBuildStrategy, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.CustomBuildStrategy))(in)
@@ -1497,6 +1685,16 @@ func autoConvert_api_DockerBuildStrategy_To_v1beta3_DockerBuildStrategy(in *buil
 	}
 	out.ForcePull = in.ForcePull
 	out.DockerfilePath = in.DockerfilePath
+	if in.BuildArgs != nil {
+		out.BuildArgs = make([]apiv1beta3.EnvVar, len(in.BuildArgs))
+		for i := range in.BuildArgs {
+			if err := s.Convert(&in.BuildArgs[i], &out.BuildArgs[i], 0); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.BuildArgs = nil
+	}
 	return nil
 }
 
@@ -1611,6 +1809,47 @@ func Convert_api_ImageSourcePath_To_v1beta3_ImageSourcePath(in *buildapi.ImageSo
 	return autoConvert_api_ImageSourcePath_To_v1beta3_ImageSourcePath(in, out, s)
 }
 
+func autoConvert_api_JenkinsPipelineBuildStrategy_To_v1beta3_JenkinsPipelineBuildStrategy(in *buildapi.JenkinsPipelineBuildStrategy, out *v1beta3.JenkinsPipelineBuildStrategy, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.JenkinsPipelineBuildStrategy))(in)
+	}
+	out.JenkinsfilePath = in.JenkinsfilePath
+	out.Jenkinsfile = in.Jenkinsfile
+	return nil
+}
+
+func Convert_api_JenkinsPipelineBuildStrategy_To_v1beta3_JenkinsPipelineBuildStrategy(in *buildapi.JenkinsPipelineBuildStrategy, out *v1beta3.JenkinsPipelineBuildStrategy, s conversion.Scope) error {
+	return autoConvert_api_JenkinsPipelineBuildStrategy_To_v1beta3_JenkinsPipelineBuildStrategy(in, out, s)
+}
+
+func autoConvert_api_PullRequestBuildPolicy_To_v1beta3_PullRequestBuildPolicy(in *buildapi.PullRequestBuildPolicy, out *v1beta3.PullRequestBuildPolicy, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.PullRequestBuildPolicy))(in)
+	}
+	out.OutputTagPrefix = in.OutputTagPrefix
+	out.AllowForkSecrets = in.AllowForkSecrets
+	return nil
+}
+
+func Convert_api_PullRequestBuildPolicy_To_v1beta3_PullRequestBuildPolicy(in *buildapi.PullRequestBuildPolicy, out *v1beta3.PullRequestBuildPolicy, s conversion.Scope) error {
+	return autoConvert_api_PullRequestBuildPolicy_To_v1beta3_PullRequestBuildPolicy(in, out, s)
+}
+
+func autoConvert_api_PullRequestReference_To_v1beta3_PullRequestReference(in *buildapi.PullRequestReference, out *v1beta3.PullRequestReference, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.PullRequestReference))(in)
+	}
+	out.Number = in.Number
+	out.Ref = in.Ref
+	out.Fork = in.Fork
+	out.OutputTag = in.OutputTag
+	return nil
+}
+
+func Convert_api_PullRequestReference_To_v1beta3_PullRequestReference(in *buildapi.PullRequestReference, out *v1beta3.PullRequestReference, s conversion.Scope) error {
+	return autoConvert_api_PullRequestReference_To_v1beta3_PullRequestReference(in, out, s)
+}
+
 func autoConvert_api_SecretBuildSource_To_v1beta3_SecretBuildSource(in *buildapi.SecretBuildSource, out *v1beta3.SecretBuildSource, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.SecretBuildSource))(in)
@@ -1702,11 +1941,60 @@ func autoConvert_api_SourceRevision_To_v1beta3_SourceRevision(in *buildapi.Sourc
 	return nil
 }
 
+func autoConvert_api_StageInfo_To_v1beta3_StageInfo(in *buildapi.StageInfo, out *v1beta3.StageInfo, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.StageInfo))(in)
+	}
+	out.Name = in.Name
+	out.Phase = v1beta3.BuildPhase(in.Phase)
+	// unable to generate simple pointer conversion for unversioned.Time -> unversioned.Time
+	if in.StartTimestamp != nil {
+		out.StartTimestamp = new(unversioned.Time)
+		if err := api.Convert_unversioned_Time_To_unversioned_Time(in.StartTimestamp, out.StartTimestamp, s); err != nil {
+			return err
+		}
+	} else {
+		out.StartTimestamp = nil
+	}
+	// unable to generate simple pointer conversion for unversioned.Time -> unversioned.Time
+	if in.CompletionTimestamp != nil {
+		out.CompletionTimestamp = new(unversioned.Time)
+		if err := api.Convert_unversioned_Time_To_unversioned_Time(in.CompletionTimestamp, out.CompletionTimestamp, s); err != nil {
+			return err
+		}
+	} else {
+		out.CompletionTimestamp = nil
+	}
+	return nil
+}
+
+func Convert_api_StageInfo_To_v1beta3_StageInfo(in *buildapi.StageInfo, out *v1beta3.StageInfo, s conversion.Scope) error {
+	return autoConvert_api_StageInfo_To_v1beta3_StageInfo(in, out, s)
+}
+
 func autoConvert_api_WebHookTrigger_To_v1beta3_WebHookTrigger(in *buildapi.WebHookTrigger, out *v1beta3.WebHookTrigger, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.WebHookTrigger))(in)
 	}
 	out.Secret = in.Secret
+	// unable to generate simple pointer conversion for api.LocalObjectReference -> v1beta3.LocalObjectReference
+	if in.SecretReference != nil {
+		out.SecretReference = new(apiv1beta3.LocalObjectReference)
+		if err := Convert_api_LocalObjectReference_To_v1beta3_LocalObjectReference(in.SecretReference, out.SecretReference, s); err != nil {
+			return err
+		}
+	} else {
+		out.SecretReference = nil
+	}
+	// unable to generate simple pointer conversion for api.PullRequestBuildPolicy -> v1beta3.PullRequestBuildPolicy
+	if in.PullRequests != nil {
+		out.PullRequests = new(v1beta3.PullRequestBuildPolicy)
+		if err := Convert_api_PullRequestBuildPolicy_To_v1beta3_PullRequestBuildPolicy(in.PullRequests, out.PullRequests, s); err != nil {
+			return err
+		}
+	} else {
+		out.PullRequests = nil
+	}
 	return nil
 }
 
@@ -1767,6 +2055,53 @@ func Convert_v1beta3_Build_To_api_Build(in *v1beta3.Build, out *buildapi.Build,
 	return autoConvert_v1beta3_Build_To_api_Build(in, out, s)
 }
 
+func autoConvert_v1beta3_BuildCache_To_api_BuildCache(in *v1beta3.BuildCache, out *buildapi.BuildCache, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1beta3.BuildCache))(in)
+	}
+	if in.Paths != nil {
+		out.Paths = make([]buildapi.ImageSourcePath, len(in.Paths))
+		for i := range in.Paths {
+			if err := Convert_v1beta3_ImageSourcePath_To_api_ImageSourcePath(&in.Paths[i], &out.Paths[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Paths = nil
+	}
+	if in.KeyFiles != nil {
+		out.KeyFiles = make([]string, len(in.KeyFiles))
+		for i := range in.KeyFiles {
+			out.KeyFiles[i] = in.KeyFiles[i]
+		}
+	} else {
+		out.KeyFiles = nil
+	}
+	// unable to generate simple pointer conversion for v1beta3.LocalObjectReference -> api.LocalObjectReference
+	if in.PersistentVolumeClaim != nil {
+		out.PersistentVolumeClaim = new(api.LocalObjectReference)
+		if err := Convert_v1beta3_LocalObjectReference_To_api_LocalObjectReference(in.PersistentVolumeClaim, out.PersistentVolumeClaim, s); err != nil {
+			return err
+		}
+	} else {
+		out.PersistentVolumeClaim = nil
+	}
+	// unable to generate simple pointer conversion for v1beta3.ObjectReference -> api.ObjectReference
+	if in.Image != nil {
+		out.Image = new(api.ObjectReference)
+		if err := Convert_v1beta3_ObjectReference_To_api_ObjectReference(in.Image, out.Image, s); err != nil {
+			return err
+		}
+	} else {
+		out.Image = nil
+	}
+	return nil
+}
+
+func Convert_v1beta3_BuildCache_To_api_BuildCache(in *v1beta3.BuildCache, out *buildapi.BuildCache, s conversion.Scope) error {
+	return autoConvert_v1beta3_BuildCache_To_api_BuildCache(in, out, s)
+}
+
 func autoConvert_v1beta3_BuildConfig_To_api_BuildConfig(in *v1beta3.BuildConfig, out *buildapi.BuildConfig, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*v1beta3.BuildConfig))(in)
@@ -1821,6 +2156,19 @@ func autoConvert_v1beta3_BuildConfigSpec_To_api_BuildConfigSpec(in *v1beta3.Buil
 	} else {
 		out.Triggers = nil
 	}
+	out.RunPolicy = buildapi.BuildRunPolicy(in.RunPolicy)
+	if in.SuccessfulBuildsHistoryLimit != nil {
+		out.SuccessfulBuildsHistoryLimit = new(int)
+		*out.SuccessfulBuildsHistoryLimit = *in.SuccessfulBuildsHistoryLimit
+	} else {
+		out.SuccessfulBuildsHistoryLimit = nil
+	}
+	if in.FailedBuildsHistoryLimit != nil {
+		out.FailedBuildsHistoryLimit = new(int)
+		*out.FailedBuildsHistoryLimit = *in.FailedBuildsHistoryLimit
+	} else {
+		out.FailedBuildsHistoryLimit = nil
+	}
 	if err := Convert_v1beta3_BuildSpec_To_api_BuildSpec(&in.BuildSpec, &out.BuildSpec, s); err != nil {
 		return err
 	}
@@ -1949,9 +2297,42 @@ func autoConvert_v1beta3_BuildOutput_To_api_BuildOutput(in *v1beta3.BuildOutput,
 	} else {
 		out.PushSecret = nil
 	}
+	if in.Destinations != nil {
+		out.Destinations = make([]buildapi.BuildOutputDestination, len(in.Destinations))
+		for i := range in.Destinations {
+			if err := Convert_v1beta3_BuildOutputDestination_To_api_BuildOutputDestination(&in.Destinations[i], &out.Destinations[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Destinations = nil
+	}
 	return nil
 }
 
+func autoConvert_v1beta3_BuildOutputDestination_To_api_BuildOutputDestination(in *v1beta3.BuildOutputDestination, out *buildapi.BuildOutputDestination, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1beta3.BuildOutputDestination))(in)
+	}
+	if err := Convert_v1beta3_ObjectReference_To_api_ObjectReference(&in.To, &out.To, s); err != nil {
+		return err
+	}
+	// unable to generate simple pointer conversion for v1beta3.LocalObjectReference -> api.LocalObjectReference
+	if in.PushSecret != nil {
+		out.PushSecret = new(api.LocalObjectReference)
+		if err := Convert_v1beta3_LocalObjectReference_To_api_LocalObjectReference(in.PushSecret, out.PushSecret, s); err != nil {
+			return err
+		}
+	} else {
+		out.PushSecret = nil
+	}
+	return nil
+}
+
+func Convert_v1beta3_BuildOutputDestination_To_api_BuildOutputDestination(in *v1beta3.BuildOutputDestination, out *buildapi.BuildOutputDestination, s conversion.Scope) error {
+	return autoConvert_v1beta3_BuildOutputDestination_To_api_BuildOutputDestination(in, out, s)
+}
+
 func autoConvert_v1beta3_BuildPostCommitSpec_To_api_BuildPostCommitSpec(in *v1beta3.BuildPostCommitSpec, out *buildapi.BuildPostCommitSpec, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*v1beta3.BuildPostCommitSpec))(in)
@@ -2076,6 +2457,24 @@ func autoConvert_v1beta3_BuildSpec_To_api_BuildSpec(in *v1beta3.BuildSpec, out *
 	} else {
 		out.CompletionDeadlineSeconds = nil
 	}
+	// unable to generate simple pointer conversion for v1beta3.BuildCache -> api.BuildCache
+	if in.Cache != nil {
+		out.Cache = new(buildapi.BuildCache)
+		if err := Convert_v1beta3_BuildCache_To_api_BuildCache(in.Cache, out.Cache, s); err != nil {
+			return err
+		}
+	} else {
+		out.Cache = nil
+	}
+	// unable to generate simple pointer conversion for v1beta3.CommitStatusReporting -> api.CommitStatusReporting
+	if in.CommitStatus != nil {
+		out.CommitStatus = new(buildapi.CommitStatusReporting)
+		if err := Convert_v1beta3_CommitStatusReporting_To_api_CommitStatusReporting(in.CommitStatus, out.CommitStatus, s); err != nil {
+			return err
+		}
+	} else {
+		out.CommitStatus = nil
+	}
 	return nil
 }
 
@@ -2120,6 +2519,26 @@ func autoConvert_v1beta3_BuildStatus_To_api_BuildStatus(in *v1beta3.BuildStatus,
 	} else {
 		out.Config = nil
 	}
+	if in.Stages != nil {
+		out.Stages = make([]buildapi.StageInfo, len(in.Stages))
+		for i := range in.Stages {
+			if err := Convert_v1beta3_StageInfo_To_api_StageInfo(&in.Stages[i], &out.Stages[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Stages = nil
+	}
+	if in.Outputs != nil {
+		out.Outputs = make([]buildapi.BuildStatusOutput, len(in.Outputs))
+		for i := range in.Outputs {
+			if err := Convert_v1beta3_BuildStatusOutput_To_api_BuildStatusOutput(&in.Outputs[i], &out.Outputs[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Outputs = nil
+	}
 	return nil
 }
 
@@ -2127,6 +2546,19 @@ func Convert_v1beta3_BuildStatus_To_api_BuildStatus(in *v1beta3.BuildStatus, out
 	return autoConvert_v1beta3_BuildStatus_To_api_BuildStatus(in, out, s)
 }
 
+func autoConvert_v1beta3_BuildStatusOutput_To_api_BuildStatusOutput(in *v1beta3.BuildStatusOutput, out *buildapi.BuildStatusOutput, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1beta3.BuildStatusOutput))(in)
+	}
+	out.DockerImageReference = in.DockerImageReference
+	out.ImageDigest = in.ImageDigest
+	return nil
+}
+
+func Convert_v1beta3_BuildStatusOutput_To_api_BuildStatusOutput(in *v1beta3.BuildStatusOutput, out *buildapi.BuildStatusOutput, s conversion.Scope) error {
+	return autoConvert_v1beta3_BuildStatusOutput_To_api_BuildStatusOutput(in, out, s)
+}
+
 func autoConvert_v1beta3_BuildStrategy_To_api_BuildStrategy(in *v1beta3.BuildStrategy, out *buildapi.BuildStrategy, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*v1beta3.BuildStrategy))(in)
@@ -2156,6 +2588,15 @@ func autoConvert_v1beta3_BuildStrategy_To_api_BuildStrategy(in *v1beta3.BuildStr
 	} else {
 		out.CustomStrategy = nil
 	}
+	// unable to generate simple pointer conversion for v1beta3.JenkinsPipelineBuildStrategy -> api.JenkinsPipelineBuildStrategy
+	if in.JenkinsPipelineStrategy != nil {
+		out.JenkinsPipelineStrategy = new(buildapi.JenkinsPipelineBuildStrategy)
+		if err := Convert_v1beta3_JenkinsPipelineBuildStrategy_To_api_JenkinsPipelineBuildStrategy(in.JenkinsPipelineStrategy, out.JenkinsPipelineStrategy, s); err != nil {
+			return err
+		}
+	} else {
+		out.JenkinsPipelineStrategy = nil
+	}
 	return nil
 }
 
@@ -2182,6 +2623,24 @@ func autoConvert_v1beta3_BuildTriggerPolicy_To_api_BuildTriggerPolicy(in *v1beta
 	} else {
 		out.GenericWebHook = nil
 	}
+	// unable to generate simple pointer conversion for v1beta3.WebHookTrigger -> api.WebHookTrigger
+	if in.GitLabWebHook != nil {
+		out.GitLabWebHook = new(buildapi.WebHookTrigger)
+		if err := Convert_v1beta3_WebHookTrigger_To_api_WebHookTrigger(in.GitLabWebHook, out.GitLabWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.GitLabWebHook = nil
+	}
+	// unable to generate simple pointer conversion for v1beta3.WebHookTrigger -> api.WebHookTrigger
+	if in.BitbucketWebHook != nil {
+		out.BitbucketWebHook = new(buildapi.WebHookTrigger)
+		if err := Convert_v1beta3_WebHookTrigger_To_api_WebHookTrigger(in.BitbucketWebHook, out.BitbucketWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.BitbucketWebHook = nil
+	}
 	// unable to generate simple pointer conversion for v1beta3.ImageChangeTrigger -> api.ImageChangeTrigger
 	if in.ImageChange != nil {
 		out.ImageChange = new(buildapi.ImageChangeTrigger)
@@ -2194,6 +2653,23 @@ func autoConvert_v1beta3_BuildTriggerPolicy_To_api_BuildTriggerPolicy(in *v1beta
 	return nil
 }
 
+func autoConvert_v1beta3_CommitStatusReporting_To_api_CommitStatusReporting(in *v1beta3.CommitStatusReporting, out *buildapi.CommitStatusReporting, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1beta3.CommitStatusReporting))(in)
+	}
+	out.Provider = buildapi.CommitStatusProvider(in.Provider)
+	out.APIURL = in.APIURL
+	if err := Convert_v1beta3_LocalObjectReference_To_api_LocalObjectReference(&in.Secret, &out.Secret, s); err != nil {
+		return err
+	}
+	out.Context = in.Context
+	return nil
+}
+
+func Convert_v1beta3_CommitStatusReporting_To_api_CommitStatusReporting(in *v1beta3.CommitStatusReporting, out *buildapi.CommitStatusReporting, s conversion.Scope) error {
+	return autoConvert_v1beta3_CommitStatusReporting_To_api_CommitStatusReporting(in, out, s)
+}
+
 func autoConvert_v1beta3_CustomBuildStrategy_To_api_CustomBuildStrategy(in *v1beta3.CustomBuildStrategy, out *buildapi.CustomBuildStrategy, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*v1beta3.CustomBuildStrategy))(in)
@@ -2271,6 +2747,16 @@ func autoConvert_v1beta3_DockerBuildStrategy_To_api_DockerBuildStrategy(in *v1be
 	}
 	out.ForcePull = in.ForcePull
 	out.DockerfilePath = in.DockerfilePath
+	if in.BuildArgs != nil {
+		out.BuildArgs = make([]api.EnvVar, len(in.BuildArgs))
+		for i := range in.BuildArgs {
+			if err := s.Convert(&in.BuildArgs[i], &out.BuildArgs[i], 0); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.BuildArgs = nil
+	}
 	return nil
 }
 
@@ -2385,6 +2871,47 @@ func Convert_v1beta3_ImageSourcePath_To_api_ImageSourcePath(in *v1beta3.ImageSou
 	return autoConvert_v1beta3_ImageSourcePath_To_api_ImageSourcePath(in, out, s)
 }
 
+func autoConvert_v1beta3_JenkinsPipelineBuildStrategy_To_api_JenkinsPipelineBuildStrategy(in *v1beta3.JenkinsPipelineBuildStrategy, out *buildapi.JenkinsPipelineBuildStrategy, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1beta3.JenkinsPipelineBuildStrategy))(in)
+	}
+	out.JenkinsfilePath = in.JenkinsfilePath
+	out.Jenkinsfile = in.Jenkinsfile
+	return nil
+}
+
+func Convert_v1beta3_JenkinsPipelineBuildStrategy_To_api_JenkinsPipelineBuildStrategy(in *v1beta3.JenkinsPipelineBuildStrategy, out *buildapi.JenkinsPipelineBuildStrategy, s conversion.Scope) error {
+	return autoConvert_v1beta3_JenkinsPipelineBuildStrategy_To_api_JenkinsPipelineBuildStrategy(in, out, s)
+}
+
+func autoConvert_v1beta3_PullRequestBuildPolicy_To_api_PullRequestBuildPolicy(in *v1beta3.PullRequestBuildPolicy, out *buildapi.PullRequestBuildPolicy, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1beta3.PullRequestBuildPolicy))(in)
+	}
+	out.OutputTagPrefix = in.OutputTagPrefix
+	out.AllowForkSecrets = in.AllowForkSecrets
+	return nil
+}
+
+func Convert_v1beta3_PullRequestBuildPolicy_To_api_PullRequestBuildPolicy(in *v1beta3.PullRequestBuildPolicy, out *buildapi.PullRequestBuildPolicy, s conversion.Scope) error {
+	return autoConvert_v1beta3_PullRequestBuildPolicy_To_api_PullRequestBuildPolicy(in, out, s)
+}
+
+func autoConvert_v1beta3_PullRequestReference_To_api_PullRequestReference(in *v1beta3.PullRequestReference, out *buildapi.PullRequestReference, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1beta3.PullRequestReference))(in)
+	}
+	out.Number = in.Number
+	out.Ref = in.Ref
+	out.Fork = in.Fork
+	out.OutputTag = in.OutputTag
+	return nil
+}
+
+func Convert_v1beta3_PullRequestReference_To_api_PullRequestReference(in *v1beta3.PullRequestReference, out *buildapi.PullRequestReference, s conversion.Scope) error {
+	return autoConvert_v1beta3_PullRequestReference_To_api_PullRequestReference(in, out, s)
+}
+
 func autoConvert_v1beta3_SecretBuildSource_To_api_SecretBuildSource(in *v1beta3.SecretBuildSource, out *buildapi.SecretBuildSource, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*v1beta3.SecretBuildSource))(in)
@@ -2477,11 +3004,60 @@ func autoConvert_v1beta3_SourceRevision_To_api_SourceRevision(in *v1beta3.Source
 	return nil
 }
 
+func autoConvert_v1beta3_StageInfo_To_api_StageInfo(in *v1beta3.StageInfo, out *buildapi.StageInfo, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*v1beta3.StageInfo))(in)
+	}
+	out.Name = in.Name
+	out.Phase = buildapi.BuildPhase(in.Phase)
+	// unable to generate simple pointer conversion for unversioned.Time -> unversioned.Time
+	if in.StartTimestamp != nil {
+		out.StartTimestamp = new(unversioned.Time)
+		if err := api.Convert_unversioned_Time_To_unversioned_Time(in.StartTimestamp, out.StartTimestamp, s); err != nil {
+			return err
+		}
+	} else {
+		out.StartTimestamp = nil
+	}
+	// unable to generate simple pointer conversion for unversioned.Time -> unversioned.Time
+	if in.CompletionTimestamp != nil {
+		out.CompletionTimestamp = new(unversioned.Time)
+		if err := api.Convert_unversioned_Time_To_unversioned_Time(in.CompletionTimestamp, out.CompletionTimestamp, s); err != nil {
+			return err
+		}
+	} else {
+		out.CompletionTimestamp = nil
+	}
+	return nil
+}
+
+func Convert_v1beta3_StageInfo_To_api_StageInfo(in *v1beta3.StageInfo, out *buildapi.StageInfo, s conversion.Scope) error {
+	return autoConvert_v1beta3_StageInfo_To_api_StageInfo(in, out, s)
+}
+
 func autoConvert_v1beta3_WebHookTrigger_To_api_WebHookTrigger(in *v1beta3.WebHookTrigger, out *buildapi.WebHookTrigger, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*v1beta3.WebHookTrigger))(in)
 	}
 	out.Secret = in.Secret
+	// unable to generate simple pointer conversion for v1beta3.LocalObjectReference -> api.LocalObjectReference
+	if in.SecretReference != nil {
+		out.SecretReference = new(api.LocalObjectReference)
+		if err := Convert_v1beta3_LocalObjectReference_To_api_LocalObjectReference(in.SecretReference, out.SecretReference, s); err != nil {
+			return err
+		}
+	} else {
+		out.SecretReference = nil
+	}
+	// unable to generate simple pointer conversion for v1beta3.PullRequestBuildPolicy -> api.PullRequestBuildPolicy
+	if in.PullRequests != nil {
+		out.PullRequests = new(buildapi.PullRequestBuildPolicy)
+		if err := Convert_v1beta3_PullRequestBuildPolicy_To_api_PullRequestBuildPolicy(in.PullRequests, out.PullRequests, s); err != nil {
+			return err
+		}
+	} else {
+		out.PullRequests = nil
+	}
 	return nil
 }
 
@@ -2503,6 +3079,15 @@ func autoConvert_api_DeploymentCause_To_v1beta3_DeploymentCause(in *deployapi.De
 	} else {
 		out.ImageTrigger = nil
 	}
+	// unable to generate simple pointer conversion for api.DeploymentCauseObjectTrigger -> v1beta3.DeploymentCauseObjectTrigger
+	if in.ObjectTrigger != nil {
+		out.ObjectTrigger = new(deployapiv1beta3.DeploymentCauseObjectTrigger)
+		if err := Convert_api_DeploymentCauseObjectTrigger_To_v1beta3_DeploymentCauseObjectTrigger(in.ObjectTrigger, out.ObjectTrigger, s); err != nil {
+			return err
+		}
+	} else {
+		out.ObjectTrigger = nil
+	}
 	return nil
 }
 
@@ -2524,6 +3109,53 @@ func Convert_api_DeploymentCauseImageTrigger_To_v1beta3_DeploymentCauseImageTrig
 	return autoConvert_api_DeploymentCauseImageTrigger_To_v1beta3_DeploymentCauseImageTrigger(in, out, s)
 }
 
+func autoConvert_api_DeploymentCauseObjectTrigger_To_v1beta3_DeploymentCauseObjectTrigger(in *deployapi.DeploymentCauseObjectTrigger, out *deployapiv1beta3.DeploymentCauseObjectTrigger, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.DeploymentCauseObjectTrigger))(in)
+	}
+	if err := Convert_api_ObjectReference_To_v1beta3_ObjectReference(&in.From, &out.From, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func Convert_api_DeploymentCauseObjectTrigger_To_v1beta3_DeploymentCauseObjectTrigger(in *deployapi.DeploymentCauseObjectTrigger, out *deployapiv1beta3.DeploymentCauseObjectTrigger, s conversion.Scope) error {
+	return autoConvert_api_DeploymentCauseObjectTrigger_To_v1beta3_DeploymentCauseObjectTrigger(in, out, s)
+}
+
+func autoConvert_api_DeploymentCondition_To_v1beta3_DeploymentCondition(in *deployapi.DeploymentCondition, out *deployapiv1beta3.DeploymentCondition, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.DeploymentCondition))(in)
+	}
+	out.Type = deployapiv1beta3.DeploymentConditionType(in.Type)
+	out.Status = apiv1beta3.ConditionStatus(in.Status)
+	if err := api.Convert_unversioned_Time_To_unversioned_Time(&in.LastTransitionTime, &out.LastTransitionTime, s); err != nil {
+		return err
+	}
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+func Convert_api_DeploymentCondition_To_v1beta3_DeploymentCondition(in *deployapi.DeploymentCondition, out *deployapiv1beta3.DeploymentCondition, s conversion.Scope) error {
+	return autoConvert_api_DeploymentCondition_To_v1beta3_DeploymentCondition(in, out, s)
+}
+
+func autoConvert_api_DeploymentConfigChange_To_v1beta3_DeploymentConfigChange(in *deployapi.DeploymentConfigChange, out *deployapiv1beta3.DeploymentConfigChange, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.DeploymentConfigChange))(in)
+	}
+	out.Type = deployapiv1beta3.DeploymentConfigChangeType(in.Type)
+	out.Field = in.Field
+	out.Current = in.Current
+	out.RolledBack = in.RolledBack
+	return nil
+}
+
+func Convert_api_DeploymentConfigChange_To_v1beta3_DeploymentConfigChange(in *deployapi.DeploymentConfigChange, out *deployapiv1beta3.DeploymentConfigChange, s conversion.Scope) error {
+	return autoConvert_api_DeploymentConfigChange_To_v1beta3_DeploymentConfigChange(in, out, s)
+}
+
 func autoConvert_api_DeploymentConfigRollback_To_v1beta3_DeploymentConfigRollback(in *deployapi.DeploymentConfigRollback, out *deployapiv1beta3.DeploymentConfigRollback, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapi.DeploymentConfigRollback))(in)
@@ -2538,6 +3170,31 @@ func Convert_api_DeploymentConfigRollback_To_v1beta3_DeploymentConfigRollback(in
 	return autoConvert_api_DeploymentConfigRollback_To_v1beta3_DeploymentConfigRollback(in, out, s)
 }
 
+func autoConvert_api_DeploymentConfigRollbackDiff_To_v1beta3_DeploymentConfigRollbackDiff(in *deployapi.DeploymentConfigRollbackDiff, out *deployapiv1beta3.DeploymentConfigRollbackDiff, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.DeploymentConfigRollbackDiff))(in)
+	}
+	out.Name = in.Name
+	if err := Convert_api_ObjectReference_To_v1beta3_ObjectReference(&in.From, &out.From, s); err != nil {
+		return err
+	}
+	if in.Changes != nil {
+		out.Changes = make([]deployapiv1beta3.DeploymentConfigChange, len(in.Changes))
+		for i := range in.Changes {
+			if err := Convert_api_DeploymentConfigChange_To_v1beta3_DeploymentConfigChange(&in.Changes[i], &out.Changes[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Changes = nil
+	}
+	return nil
+}
+
+func Convert_api_DeploymentConfigRollbackDiff_To_v1beta3_DeploymentConfigRollbackDiff(in *deployapi.DeploymentConfigRollbackDiff, out *deployapiv1beta3.DeploymentConfigRollbackDiff, s conversion.Scope) error {
+	return autoConvert_api_DeploymentConfigRollbackDiff_To_v1beta3_DeploymentConfigRollbackDiff(in, out, s)
+}
+
 func autoConvert_api_DeploymentConfigRollbackSpec_To_v1beta3_DeploymentConfigRollbackSpec(in *deployapi.DeploymentConfigRollbackSpec, out *deployapiv1beta3.DeploymentConfigRollbackSpec, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapi.DeploymentConfigRollbackSpec))(in)
@@ -2549,6 +3206,7 @@ func autoConvert_api_DeploymentConfigRollbackSpec_To_v1beta3_DeploymentConfigRol
 	out.IncludeTemplate = in.IncludeTemplate
 	out.IncludeReplicationMeta = in.IncludeReplicationMeta
 	out.IncludeStrategy = in.IncludeStrategy
+	out.DiffOnly = in.DiffOnly
 	return nil
 }
 
@@ -2634,48 +3292,142 @@ func autoConvert_api_DeploymentLogOptions_To_v1beta3_DeploymentLogOptions(in *de
 	return nil
 }
 
-func Convert_api_DeploymentLogOptions_To_v1beta3_DeploymentLogOptions(in *deployapi.DeploymentLogOptions, out *deployapiv1beta3.DeploymentLogOptions, s conversion.Scope) error {
-	return autoConvert_api_DeploymentLogOptions_To_v1beta3_DeploymentLogOptions(in, out, s)
+func Convert_api_DeploymentLogOptions_To_v1beta3_DeploymentLogOptions(in *deployapi.DeploymentLogOptions, out *deployapiv1beta3.DeploymentLogOptions, s conversion.Scope) error {
+	return autoConvert_api_DeploymentLogOptions_To_v1beta3_DeploymentLogOptions(in, out, s)
+}
+
+func autoConvert_api_DeploymentTriggerImageChangeParams_To_v1beta3_DeploymentTriggerImageChangeParams(in *deployapi.DeploymentTriggerImageChangeParams, out *deployapiv1beta3.DeploymentTriggerImageChangeParams, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.DeploymentTriggerImageChangeParams))(in)
+	}
+	out.Automatic = in.Automatic
+	if in.ContainerNames != nil {
+		out.ContainerNames = make([]string, len(in.ContainerNames))
+		for i := range in.ContainerNames {
+			out.ContainerNames[i] = in.ContainerNames[i]
+		}
+	} else {
+		out.ContainerNames = nil
+	}
+	if err := Convert_api_ObjectReference_To_v1beta3_ObjectReference(&in.From, &out.From, s); err != nil {
+		return err
+	}
+	out.LastTriggeredImage = in.LastTriggeredImage
+	return nil
+}
+
+func autoConvert_api_DeploymentTriggerObjectChangeParams_To_v1beta3_DeploymentTriggerObjectChangeParams(in *deployapi.DeploymentTriggerObjectChangeParams, out *deployapiv1beta3.DeploymentTriggerObjectChangeParams, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.DeploymentTriggerObjectChangeParams))(in)
+	}
+	out.Name = in.Name
+	return nil
+}
+
+func Convert_api_DeploymentTriggerObjectChangeParams_To_v1beta3_DeploymentTriggerObjectChangeParams(in *deployapi.DeploymentTriggerObjectChangeParams, out *deployapiv1beta3.DeploymentTriggerObjectChangeParams, s conversion.Scope) error {
+	return autoConvert_api_DeploymentTriggerObjectChangeParams_To_v1beta3_DeploymentTriggerObjectChangeParams(in, out, s)
+}
+
+func autoConvert_api_DeploymentTriggerPolicy_To_v1beta3_DeploymentTriggerPolicy(in *deployapi.DeploymentTriggerPolicy, out *deployapiv1beta3.DeploymentTriggerPolicy, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.DeploymentTriggerPolicy))(in)
+	}
+	out.Type = deployapiv1beta3.DeploymentTriggerType(in.Type)
+	// unable to generate simple pointer conversion for api.DeploymentTriggerImageChangeParams -> v1beta3.DeploymentTriggerImageChangeParams
+	if in.ImageChangeParams != nil {
+		if err := s.Convert(&in.ImageChangeParams, &out.ImageChangeParams, 0); err != nil {
+			return err
+		}
+	} else {
+		out.ImageChangeParams = nil
+	}
+	// unable to generate simple pointer conversion for api.DeploymentTriggerObjectChangeParams -> v1beta3.DeploymentTriggerObjectChangeParams
+	if in.SecretChangeParams != nil {
+		out.SecretChangeParams = new(deployapiv1beta3.DeploymentTriggerObjectChangeParams)
+		if err := Convert_api_DeploymentTriggerObjectChangeParams_To_v1beta3_DeploymentTriggerObjectChangeParams(in.SecretChangeParams, out.SecretChangeParams, s); err != nil {
+			return err
+		}
+	} else {
+		out.SecretChangeParams = nil
+	}
+	// unable to generate simple pointer conversion for api.DeploymentTriggerObjectChangeParams -> v1beta3.DeploymentTriggerObjectChangeParams
+	if in.ConfigMapChangeParams != nil {
+		out.ConfigMapChangeParams = new(deployapiv1beta3.DeploymentTriggerObjectChangeParams)
+		if err := Convert_api_DeploymentTriggerObjectChangeParams_To_v1beta3_DeploymentTriggerObjectChangeParams(in.ConfigMapChangeParams, out.ConfigMapChangeParams, s); err != nil {
+			return err
+		}
+	} else {
+		out.ConfigMapChangeParams = nil
+	}
+	return nil
+}
+
+func Convert_api_DeploymentTriggerPolicy_To_v1beta3_DeploymentTriggerPolicy(in *deployapi.DeploymentTriggerPolicy, out *deployapiv1beta3.DeploymentTriggerPolicy, s conversion.Scope) error {
+	return autoConvert_api_DeploymentTriggerPolicy_To_v1beta3_DeploymentTriggerPolicy(in, out, s)
 }
 
-func autoConvert_api_DeploymentTriggerImageChangeParams_To_v1beta3_DeploymentTriggerImageChangeParams(in *deployapi.DeploymentTriggerImageChangeParams, out *deployapiv1beta3.DeploymentTriggerImageChangeParams, s conversion.Scope) error {
+func autoConvert_api_DeploymentTriggeredObject_To_v1beta3_DeploymentTriggeredObject(in *deployapi.DeploymentTriggeredObject, out *deployapiv1beta3.DeploymentTriggeredObject, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*deployapi.DeploymentTriggerImageChangeParams))(in)
+		defaulting.(func(*deployapi.DeploymentTriggeredObject))(in)
 	}
-	out.Automatic = in.Automatic
-	if in.ContainerNames != nil {
-		out.ContainerNames = make([]string, len(in.ContainerNames))
-		for i := range in.ContainerNames {
-			out.ContainerNames[i] = in.ContainerNames[i]
-		}
-	} else {
-		out.ContainerNames = nil
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.DataHash = in.DataHash
+	return nil
+}
+
+func Convert_api_DeploymentTriggeredObject_To_v1beta3_DeploymentTriggeredObject(in *deployapi.DeploymentTriggeredObject, out *deployapiv1beta3.DeploymentTriggeredObject, s conversion.Scope) error {
+	return autoConvert_api_DeploymentTriggeredObject_To_v1beta3_DeploymentTriggeredObject(in, out, s)
+}
+
+func autoConvert_api_HTTPGetHook_To_v1beta3_HTTPGetHook(in *deployapi.HTTPGetHook, out *deployapiv1beta3.HTTPGetHook, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.HTTPGetHook))(in)
 	}
-	if err := Convert_api_ObjectReference_To_v1beta3_ObjectReference(&in.From, &out.From, s); err != nil {
-		return err
+	out.URL = in.URL
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
 	}
-	out.LastTriggeredImage = in.LastTriggeredImage
 	return nil
 }
 
-func autoConvert_api_DeploymentTriggerPolicy_To_v1beta3_DeploymentTriggerPolicy(in *deployapi.DeploymentTriggerPolicy, out *deployapiv1beta3.DeploymentTriggerPolicy, s conversion.Scope) error {
+func Convert_api_HTTPGetHook_To_v1beta3_HTTPGetHook(in *deployapi.HTTPGetHook, out *deployapiv1beta3.HTTPGetHook, s conversion.Scope) error {
+	return autoConvert_api_HTTPGetHook_To_v1beta3_HTTPGetHook(in, out, s)
+}
+
+func autoConvert_api_HookVolume_To_v1beta3_HookVolume(in *deployapi.HookVolume, out *deployapiv1beta3.HookVolume, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*deployapi.DeploymentTriggerPolicy))(in)
+		defaulting.(func(*deployapi.HookVolume))(in)
 	}
-	out.Type = deployapiv1beta3.DeploymentTriggerType(in.Type)
-	// unable to generate simple pointer conversion for api.DeploymentTriggerImageChangeParams -> v1beta3.DeploymentTriggerImageChangeParams
-	if in.ImageChangeParams != nil {
-		if err := s.Convert(&in.ImageChangeParams, &out.ImageChangeParams, 0); err != nil {
+	out.Name = in.Name
+	out.MountPath = in.MountPath
+	out.ReadOnly = in.ReadOnly
+	// unable to generate simple pointer conversion for api.SecretVolumeSource -> v1beta3.SecretVolumeSource
+	if in.Secret != nil {
+		out.Secret = new(apiv1beta3.SecretVolumeSource)
+		if err := Convert_api_SecretVolumeSource_To_v1beta3_SecretVolumeSource(in.Secret, out.Secret, s); err != nil {
 			return err
 		}
 	} else {
-		out.ImageChangeParams = nil
+		out.Secret = nil
+	}
+	// unable to generate simple pointer conversion for api.EmptyDirVolumeSource -> v1beta3.EmptyDirVolumeSource
+	if in.EmptyDir != nil {
+		out.EmptyDir = new(apiv1beta3.EmptyDirVolumeSource)
+		if err := Convert_api_EmptyDirVolumeSource_To_v1beta3_EmptyDirVolumeSource(in.EmptyDir, out.EmptyDir, s); err != nil {
+			return err
+		}
+	} else {
+		out.EmptyDir = nil
 	}
 	return nil
 }
 
-func Convert_api_DeploymentTriggerPolicy_To_v1beta3_DeploymentTriggerPolicy(in *deployapi.DeploymentTriggerPolicy, out *deployapiv1beta3.DeploymentTriggerPolicy, s conversion.Scope) error {
-	return autoConvert_api_DeploymentTriggerPolicy_To_v1beta3_DeploymentTriggerPolicy(in, out, s)
+func Convert_api_HookVolume_To_v1beta3_HookVolume(in *deployapi.HookVolume, out *deployapiv1beta3.HookVolume, s conversion.Scope) error {
+	return autoConvert_api_HookVolume_To_v1beta3_HookVolume(in, out, s)
 }
 
 func autoConvert_api_RollingDeploymentStrategyParams_To_v1beta3_RollingDeploymentStrategyParams(in *deployapi.RollingDeploymentStrategyParams, out *deployapiv1beta3.RollingDeploymentStrategyParams, s conversion.Scope) error {
@@ -2746,6 +3498,28 @@ func Convert_api_TagImageHook_To_v1beta3_TagImageHook(in *deployapi.TagImageHook
 	return autoConvert_api_TagImageHook_To_v1beta3_TagImageHook(in, out, s)
 }
 
+func autoConvert_api_WaitForImageHook_To_v1beta3_WaitForImageHook(in *deployapi.WaitForImageHook, out *deployapiv1beta3.WaitForImageHook, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.WaitForImageHook))(in)
+	}
+	if err := Convert_api_ObjectReference_To_v1beta3_ObjectReference(&in.From, &out.From, s); err != nil {
+		return err
+	}
+	out.Digest = in.Digest
+	out.ContainerName = in.ContainerName
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	return nil
+}
+
+func Convert_api_WaitForImageHook_To_v1beta3_WaitForImageHook(in *deployapi.WaitForImageHook, out *deployapiv1beta3.WaitForImageHook, s conversion.Scope) error {
+	return autoConvert_api_WaitForImageHook_To_v1beta3_WaitForImageHook(in, out, s)
+}
+
 func autoConvert_v1beta3_DeploymentCause_To_api_DeploymentCause(in *deployapiv1beta3.DeploymentCause, out *deployapi.DeploymentCause, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapiv1beta3.DeploymentCause))(in)
@@ -2760,6 +3534,15 @@ func autoConvert_v1beta3_DeploymentCause_To_api_DeploymentCause(in *deployapiv1b
 	} else {
 		out.ImageTrigger = nil
 	}
+	// unable to generate simple pointer conversion for v1beta3.DeploymentCauseObjectTrigger -> api.DeploymentCauseObjectTrigger
+	if in.ObjectTrigger != nil {
+		out.ObjectTrigger = new(deployapi.DeploymentCauseObjectTrigger)
+		if err := Convert_v1beta3_DeploymentCauseObjectTrigger_To_api_DeploymentCauseObjectTrigger(in.ObjectTrigger, out.ObjectTrigger, s); err != nil {
+			return err
+		}
+	} else {
+		out.ObjectTrigger = nil
+	}
 	return nil
 }
 
@@ -2781,6 +3564,53 @@ func Convert_v1beta3_DeploymentCauseImageTrigger_To_api_DeploymentCauseImageTrig
 	return autoConvert_v1beta3_DeploymentCauseImageTrigger_To_api_DeploymentCauseImageTrigger(in, out, s)
 }
 
+func autoConvert_v1beta3_DeploymentCauseObjectTrigger_To_api_DeploymentCauseObjectTrigger(in *deployapiv1beta3.DeploymentCauseObjectTrigger, out *deployapi.DeploymentCauseObjectTrigger, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1beta3.DeploymentCauseObjectTrigger))(in)
+	}
+	if err := Convert_v1beta3_ObjectReference_To_api_ObjectReference(&in.From, &out.From, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func Convert_v1beta3_DeploymentCauseObjectTrigger_To_api_DeploymentCauseObjectTrigger(in *deployapiv1beta3.DeploymentCauseObjectTrigger, out *deployapi.DeploymentCauseObjectTrigger, s conversion.Scope) error {
+	return autoConvert_v1beta3_DeploymentCauseObjectTrigger_To_api_DeploymentCauseObjectTrigger(in, out, s)
+}
+
+func autoConvert_v1beta3_DeploymentCondition_To_api_DeploymentCondition(in *deployapiv1beta3.DeploymentCondition, out *deployapi.DeploymentCondition, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1beta3.DeploymentCondition))(in)
+	}
+	out.Type = deployapi.DeploymentConditionType(in.Type)
+	out.Status = api.ConditionStatus(in.Status)
+	if err := api.Convert_unversioned_Time_To_unversioned_Time(&in.LastTransitionTime, &out.LastTransitionTime, s); err != nil {
+		return err
+	}
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+func Convert_v1beta3_DeploymentCondition_To_api_DeploymentCondition(in *deployapiv1beta3.DeploymentCondition, out *deployapi.DeploymentCondition, s conversion.Scope) error {
+	return autoConvert_v1beta3_DeploymentCondition_To_api_DeploymentCondition(in, out, s)
+}
+
+func autoConvert_v1beta3_DeploymentConfigChange_To_api_DeploymentConfigChange(in *deployapiv1beta3.DeploymentConfigChange, out *deployapi.DeploymentConfigChange, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1beta3.DeploymentConfigChange))(in)
+	}
+	out.Type = deployapi.DeploymentConfigChangeType(in.Type)
+	out.Field = in.Field
+	out.Current = in.Current
+	out.RolledBack = in.RolledBack
+	return nil
+}
+
+func Convert_v1beta3_DeploymentConfigChange_To_api_DeploymentConfigChange(in *deployapiv1beta3.DeploymentConfigChange, out *deployapi.DeploymentConfigChange, s conversion.Scope) error {
+	return autoConvert_v1beta3_DeploymentConfigChange_To_api_DeploymentConfigChange(in, out, s)
+}
+
 func autoConvert_v1beta3_DeploymentConfigRollback_To_api_DeploymentConfigRollback(in *deployapiv1beta3.DeploymentConfigRollback, out *deployapi.DeploymentConfigRollback, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapiv1beta3.DeploymentConfigRollback))(in)
@@ -2795,6 +3625,31 @@ func Convert_v1beta3_DeploymentConfigRollback_To_api_DeploymentConfigRollback(in
 	return autoConvert_v1beta3_DeploymentConfigRollback_To_api_DeploymentConfigRollback(in, out, s)
 }
 
+func autoConvert_v1beta3_DeploymentConfigRollbackDiff_To_api_DeploymentConfigRollbackDiff(in *deployapiv1beta3.DeploymentConfigRollbackDiff, out *deployapi.DeploymentConfigRollbackDiff, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1beta3.DeploymentConfigRollbackDiff))(in)
+	}
+	out.Name = in.Name
+	if err := Convert_v1beta3_ObjectReference_To_api_ObjectReference(&in.From, &out.From, s); err != nil {
+		return err
+	}
+	if in.Changes != nil {
+		out.Changes = make([]deployapi.DeploymentConfigChange, len(in.Changes))
+		for i := range in.Changes {
+			if err := Convert_v1beta3_DeploymentConfigChange_To_api_DeploymentConfigChange(&in.Changes[i], &out.Changes[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Changes = nil
+	}
+	return nil
+}
+
+func Convert_v1beta3_DeploymentConfigRollbackDiff_To_api_DeploymentConfigRollbackDiff(in *deployapiv1beta3.DeploymentConfigRollbackDiff, out *deployapi.DeploymentConfigRollbackDiff, s conversion.Scope) error {
+	return autoConvert_v1beta3_DeploymentConfigRollbackDiff_To_api_DeploymentConfigRollbackDiff(in, out, s)
+}
+
 func autoConvert_v1beta3_DeploymentConfigRollbackSpec_To_api_DeploymentConfigRollbackSpec(in *deployapiv1beta3.DeploymentConfigRollbackSpec, out *deployapi.DeploymentConfigRollbackSpec, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapiv1beta3.DeploymentConfigRollbackSpec))(in)
@@ -2806,6 +3661,7 @@ func autoConvert_v1beta3_DeploymentConfigRollbackSpec_To_api_DeploymentConfigRol
 	out.IncludeTemplate = in.IncludeTemplate
 	out.IncludeReplicationMeta = in.IncludeReplicationMeta
 	out.IncludeStrategy = in.IncludeStrategy
+	out.DiffOnly = in.DiffOnly
 	return nil
 }
 
@@ -2827,6 +3683,30 @@ func autoConvert_v1beta3_DeploymentConfigStatus_To_api_DeploymentConfigStatus(in
 	} else {
 		out.Details = nil
 	}
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Replicas = in.Replicas
+	out.UpdatedReplicas = in.UpdatedReplicas
+	out.AvailableReplicas = in.AvailableReplicas
+	if in.Conditions != nil {
+		out.Conditions = make([]deployapi.DeploymentCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := Convert_v1beta3_DeploymentCondition_To_api_DeploymentCondition(&in.Conditions[i], &out.Conditions[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
+	if in.TriggeredObjects != nil {
+		out.TriggeredObjects = make([]deployapi.DeploymentTriggeredObject, len(in.TriggeredObjects))
+		for i := range in.TriggeredObjects {
+			if err := Convert_v1beta3_DeploymentTriggeredObject_To_api_DeploymentTriggeredObject(&in.TriggeredObjects[i], &out.TriggeredObjects[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.TriggeredObjects = nil
+	}
 	return nil
 }
 
@@ -2936,6 +3816,18 @@ func autoConvert_v1beta3_DeploymentTriggerImageChangeParams_To_api_DeploymentTri
 	return nil
 }
 
+func autoConvert_v1beta3_DeploymentTriggerObjectChangeParams_To_api_DeploymentTriggerObjectChangeParams(in *deployapiv1beta3.DeploymentTriggerObjectChangeParams, out *deployapi.DeploymentTriggerObjectChangeParams, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1beta3.DeploymentTriggerObjectChangeParams))(in)
+	}
+	out.Name = in.Name
+	return nil
+}
+
+func Convert_v1beta3_DeploymentTriggerObjectChangeParams_To_api_DeploymentTriggerObjectChangeParams(in *deployapiv1beta3.DeploymentTriggerObjectChangeParams, out *deployapi.DeploymentTriggerObjectChangeParams, s conversion.Scope) error {
+	return autoConvert_v1beta3_DeploymentTriggerObjectChangeParams_To_api_DeploymentTriggerObjectChangeParams(in, out, s)
+}
+
 func autoConvert_v1beta3_DeploymentTriggerPolicy_To_api_DeploymentTriggerPolicy(in *deployapiv1beta3.DeploymentTriggerPolicy, out *deployapi.DeploymentTriggerPolicy, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapiv1beta3.DeploymentTriggerPolicy))(in)
@@ -2949,6 +3841,24 @@ func autoConvert_v1beta3_DeploymentTriggerPolicy_To_api_DeploymentTriggerPolicy(
 	} else {
 		out.ImageChangeParams = nil
 	}
+	// unable to generate simple pointer conversion for v1beta3.DeploymentTriggerObjectChangeParams -> api.DeploymentTriggerObjectChangeParams
+	if in.SecretChangeParams != nil {
+		out.SecretChangeParams = new(deployapi.DeploymentTriggerObjectChangeParams)
+		if err := Convert_v1beta3_DeploymentTriggerObjectChangeParams_To_api_DeploymentTriggerObjectChangeParams(in.SecretChangeParams, out.SecretChangeParams, s); err != nil {
+			return err
+		}
+	} else {
+		out.SecretChangeParams = nil
+	}
+	// unable to generate simple pointer conversion for v1beta3.DeploymentTriggerObjectChangeParams -> api.DeploymentTriggerObjectChangeParams
+	if in.ConfigMapChangeParams != nil {
+		out.ConfigMapChangeParams = new(deployapi.DeploymentTriggerObjectChangeParams)
+		if err := Convert_v1beta3_DeploymentTriggerObjectChangeParams_To_api_DeploymentTriggerObjectChangeParams(in.ConfigMapChangeParams, out.ConfigMapChangeParams, s); err != nil {
+			return err
+		}
+	} else {
+		out.ConfigMapChangeParams = nil
+	}
 	return nil
 }
 
@@ -2956,6 +3866,70 @@ func Convert_v1beta3_DeploymentTriggerPolicy_To_api_DeploymentTriggerPolicy(in *
 	return autoConvert_v1beta3_DeploymentTriggerPolicy_To_api_DeploymentTriggerPolicy(in, out, s)
 }
 
+func autoConvert_v1beta3_DeploymentTriggeredObject_To_api_DeploymentTriggeredObject(in *deployapiv1beta3.DeploymentTriggeredObject, out *deployapi.DeploymentTriggeredObject, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1beta3.DeploymentTriggeredObject))(in)
+	}
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.DataHash = in.DataHash
+	return nil
+}
+
+func Convert_v1beta3_DeploymentTriggeredObject_To_api_DeploymentTriggeredObject(in *deployapiv1beta3.DeploymentTriggeredObject, out *deployapi.DeploymentTriggeredObject, s conversion.Scope) error {
+	return autoConvert_v1beta3_DeploymentTriggeredObject_To_api_DeploymentTriggeredObject(in, out, s)
+}
+
+func autoConvert_v1beta3_HTTPGetHook_To_api_HTTPGetHook(in *deployapiv1beta3.HTTPGetHook, out *deployapi.HTTPGetHook, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1beta3.HTTPGetHook))(in)
+	}
+	out.URL = in.URL
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	return nil
+}
+
+func Convert_v1beta3_HTTPGetHook_To_api_HTTPGetHook(in *deployapiv1beta3.HTTPGetHook, out *deployapi.HTTPGetHook, s conversion.Scope) error {
+	return autoConvert_v1beta3_HTTPGetHook_To_api_HTTPGetHook(in, out, s)
+}
+
+func autoConvert_v1beta3_HookVolume_To_api_HookVolume(in *deployapiv1beta3.HookVolume, out *deployapi.HookVolume, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1beta3.HookVolume))(in)
+	}
+	out.Name = in.Name
+	out.MountPath = in.MountPath
+	out.ReadOnly = in.ReadOnly
+	// unable to generate simple pointer conversion for v1beta3.SecretVolumeSource -> api.SecretVolumeSource
+	if in.Secret != nil {
+		out.Secret = new(api.SecretVolumeSource)
+		if err := Convert_v1beta3_SecretVolumeSource_To_api_SecretVolumeSource(in.Secret, out.Secret, s); err != nil {
+			return err
+		}
+	} else {
+		out.Secret = nil
+	}
+	// unable to generate simple pointer conversion for v1beta3.EmptyDirVolumeSource -> api.EmptyDirVolumeSource
+	if in.EmptyDir != nil {
+		out.EmptyDir = new(api.EmptyDirVolumeSource)
+		if err := Convert_v1beta3_EmptyDirVolumeSource_To_api_EmptyDirVolumeSource(in.EmptyDir, out.EmptyDir, s); err != nil {
+			return err
+		}
+	} else {
+		out.EmptyDir = nil
+	}
+	return nil
+}
+
+func Convert_v1beta3_HookVolume_To_api_HookVolume(in *deployapiv1beta3.HookVolume, out *deployapi.HookVolume, s conversion.Scope) error {
+	return autoConvert_v1beta3_HookVolume_To_api_HookVolume(in, out, s)
+}
+
 func autoConvert_v1beta3_RollingDeploymentStrategyParams_To_api_RollingDeploymentStrategyParams(in *deployapiv1beta3.RollingDeploymentStrategyParams, out *deployapi.RollingDeploymentStrategyParams, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapiv1beta3.RollingDeploymentStrategyParams))(in)
@@ -3020,6 +3994,28 @@ func Convert_v1beta3_TagImageHook_To_api_TagImageHook(in *deployapiv1beta3.TagIm
 	return autoConvert_v1beta3_TagImageHook_To_api_TagImageHook(in, out, s)
 }
 
+func autoConvert_v1beta3_WaitForImageHook_To_api_WaitForImageHook(in *deployapiv1beta3.WaitForImageHook, out *deployapi.WaitForImageHook, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1beta3.WaitForImageHook))(in)
+	}
+	if err := Convert_v1beta3_ObjectReference_To_api_ObjectReference(&in.From, &out.From, s); err != nil {
+		return err
+	}
+	out.Digest = in.Digest
+	out.ContainerName = in.ContainerName
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	return nil
+}
+
+func Convert_v1beta3_WaitForImageHook_To_api_WaitForImageHook(in *deployapiv1beta3.WaitForImageHook, out *deployapi.WaitForImageHook, s conversion.Scope) error {
+	return autoConvert_v1beta3_WaitForImageHook_To_api_WaitForImageHook(in, out, s)
+}
+
 func autoConvert_api_Image_To_v1beta3_Image(in *imageapi.Image, out *imageapiv1beta3.Image, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*imageapi.Image))(in)
@@ -4072,6 +5068,22 @@ func autoConvert_api_RouteSpec_To_v1beta3_RouteSpec(in *routeapi.RouteSpec, out
 	if err := Convert_api_ObjectReference_To_v1beta3_ObjectReference(&in.To, &out.To, s); err != nil {
 		return err
 	}
+	if in.Weight != nil {
+		out.Weight = new(int32)
+		*out.Weight = *in.Weight
+	} else {
+		out.Weight = nil
+	}
+	if in.AlternateBackends != nil {
+		out.AlternateBackends = make([]routeapiv1beta3.RouteTargetReference, len(in.AlternateBackends))
+		for i := range in.AlternateBackends {
+			if err := Convert_api_RouteTargetReference_To_v1beta3_RouteTargetReference(&in.AlternateBackends[i], &out.AlternateBackends[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.AlternateBackends = nil
+	}
 	// unable to generate simple pointer conversion for api.RoutePort -> v1beta3.RoutePort
 	if in.Port != nil {
 		out.Port = new(routeapiv1beta3.RoutePort)
@@ -4090,6 +5102,7 @@ func autoConvert_api_RouteSpec_To_v1beta3_RouteSpec(in *routeapi.RouteSpec, out
 	} else {
 		out.TLS = nil
 	}
+	out.WildcardPolicy = routeapiv1beta3.WildcardPolicyType(in.WildcardPolicy)
 	return nil
 }
 
@@ -4118,6 +5131,25 @@ func Convert_api_RouteStatus_To_v1beta3_RouteStatus(in *routeapi.RouteStatus, ou
 	return autoConvert_api_RouteStatus_To_v1beta3_RouteStatus(in, out, s)
 }
 
+func autoConvert_api_RouteTargetReference_To_v1beta3_RouteTargetReference(in *routeapi.RouteTargetReference, out *routeapiv1beta3.RouteTargetReference, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*routeapi.RouteTargetReference))(in)
+	}
+	out.Kind = in.Kind
+	out.Name = in.Name
+	if in.Weight != nil {
+		out.Weight = new(int32)
+		*out.Weight = *in.Weight
+	} else {
+		out.Weight = nil
+	}
+	return nil
+}
+
+func Convert_api_RouteTargetReference_To_v1beta3_RouteTargetReference(in *routeapi.RouteTargetReference, out *routeapiv1beta3.RouteTargetReference, s conversion.Scope) error {
+	return autoConvert_api_RouteTargetReference_To_v1beta3_RouteTargetReference(in, out, s)
+}
+
 func autoConvert_api_TLSConfig_To_v1beta3_TLSConfig(in *routeapi.TLSConfig, out *routeapiv1beta3.TLSConfig, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*routeapi.TLSConfig))(in)
@@ -4249,6 +5281,22 @@ func autoConvert_v1beta3_RouteSpec_To_api_RouteSpec(in *routeapiv1beta3.RouteSpe
 	if err := Convert_v1beta3_ObjectReference_To_api_ObjectReference(&in.To, &out.To, s); err != nil {
 		return err
 	}
+	if in.Weight != nil {
+		out.Weight = new(int32)
+		*out.Weight = *in.Weight
+	} else {
+		out.Weight = nil
+	}
+	if in.AlternateBackends != nil {
+		out.AlternateBackends = make([]routeapi.RouteTargetReference, len(in.AlternateBackends))
+		for i := range in.AlternateBackends {
+			if err := Convert_v1beta3_RouteTargetReference_To_api_RouteTargetReference(&in.AlternateBackends[i], &out.AlternateBackends[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.AlternateBackends = nil
+	}
 	// unable to generate simple pointer conversion for v1beta3.RoutePort -> api.RoutePort
 	if in.Port != nil {
 		out.Port = new(routeapi.RoutePort)
@@ -4267,6 +5315,7 @@ func autoConvert_v1beta3_RouteSpec_To_api_RouteSpec(in *routeapiv1beta3.RouteSpe
 	} else {
 		out.TLS = nil
 	}
+	out.WildcardPolicy = routeapi.WildcardPolicyType(in.WildcardPolicy)
 	return nil
 }
 
@@ -4295,6 +5344,25 @@ func Convert_v1beta3_RouteStatus_To_api_RouteStatus(in *routeapiv1beta3.RouteSta
 	return autoConvert_v1beta3_RouteStatus_To_api_RouteStatus(in, out, s)
 }
 
+func autoConvert_v1beta3_RouteTargetReference_To_api_RouteTargetReference(in *routeapiv1beta3.RouteTargetReference, out *routeapi.RouteTargetReference, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*routeapiv1beta3.RouteTargetReference))(in)
+	}
+	out.Kind = in.Kind
+	out.Name = in.Name
+	if in.Weight != nil {
+		out.Weight = new(int32)
+		*out.Weight = *in.Weight
+	} else {
+		out.Weight = nil
+	}
+	return nil
+}
+
+func Convert_v1beta3_RouteTargetReference_To_api_RouteTargetReference(in *routeapiv1beta3.RouteTargetReference, out *routeapi.RouteTargetReference, s conversion.Scope) error {
+	return autoConvert_v1beta3_RouteTargetReference_To_api_RouteTargetReference(in, out, s)
+}
+
 func autoConvert_v1beta3_TLSConfig_To_api_TLSConfig(in *routeapiv1beta3.TLSConfig, out *routeapi.TLSConfig, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*routeapiv1beta3.TLSConfig))(in)
@@ -6603,6 +7671,7 @@ func init() {
 		autoConvert_api_AWSElasticBlockStoreVolumeSource_To_v1beta3_AWSElasticBlockStoreVolumeSource,
 		autoConvert_api_BinaryBuildRequestOptions_To_v1beta3_BinaryBuildRequestOptions,
 		autoConvert_api_BinaryBuildSource_To_v1beta3_BinaryBuildSource,
+		autoConvert_api_BuildCache_To_v1beta3_BuildCache,
 		autoConvert_api_BuildConfigList_To_v1beta3_BuildConfigList,
 		autoConvert_api_BuildConfigSpec_To_v1beta3_BuildConfigSpec,
 		autoConvert_api_BuildConfigStatus_To_v1beta3_BuildConfigStatus,
@@ -6610,10 +7679,12 @@ func init() {
 		autoConvert_api_BuildList_To_v1beta3_BuildList,
 		autoConvert_api_BuildLogOptions_To_v1beta3_BuildLogOptions,
 		autoConvert_api_BuildLog_To_v1beta3_BuildLog,
+		autoConvert_api_BuildOutputDestination_To_v1beta3_BuildOutputDestination,
 		autoConvert_api_BuildOutput_To_v1beta3_BuildOutput,
 		autoConvert_api_BuildPostCommitSpec_To_v1beta3_BuildPostCommitSpec,
 		autoConvert_api_BuildSource_To_v1beta3_BuildSource,
 		autoConvert_api_BuildSpec_To_v1beta3_BuildSpec,
+		autoConvert_api_BuildStatusOutput_To_v1beta3_BuildStatusOutput,
 		autoConvert_api_BuildStatus_To_v1beta3_BuildStatus,
 		autoConvert_api_BuildStrategy_To_v1beta3_BuildStrategy,
 		autoConvert_api_BuildTriggerPolicy_To_v1beta3_BuildTriggerPolicy,
@@ -6629,18 +7700,25 @@ func init() {
 		autoConvert_api_ClusterRoleBinding_To_v1beta3_ClusterRoleBinding,
 		autoConvert_api_ClusterRoleList_To_v1beta3_ClusterRoleList,
 		autoConvert_api_ClusterRole_To_v1beta3_ClusterRole,
+		autoConvert_api_CommitStatusReporting_To_v1beta3_CommitStatusReporting,
 		autoConvert_api_ContainerPort_To_v1beta3_ContainerPort,
 		autoConvert_api_Container_To_v1beta3_Container,
 		autoConvert_api_CustomBuildStrategy_To_v1beta3_CustomBuildStrategy,
 		autoConvert_api_DeploymentCauseImageTrigger_To_v1beta3_DeploymentCauseImageTrigger,
+		autoConvert_api_DeploymentCauseObjectTrigger_To_v1beta3_DeploymentCauseObjectTrigger,
 		autoConvert_api_DeploymentCause_To_v1beta3_DeploymentCause,
+		autoConvert_api_DeploymentCondition_To_v1beta3_DeploymentCondition,
+		autoConvert_api_DeploymentConfigChange_To_v1beta3_DeploymentConfigChange,
+		autoConvert_api_DeploymentConfigRollbackDiff_To_v1beta3_DeploymentConfigRollbackDiff,
 		autoConvert_api_DeploymentConfigRollbackSpec_To_v1beta3_DeploymentConfigRollbackSpec,
 		autoConvert_api_DeploymentConfigRollback_To_v1beta3_DeploymentConfigRollback,
 		autoConvert_api_DeploymentDetails_To_v1beta3_DeploymentDetails,
 		autoConvert_api_DeploymentLogOptions_To_v1beta3_DeploymentLogOptions,
 		autoConvert_api_DeploymentLog_To_v1beta3_DeploymentLog,
 		autoConvert_api_DeploymentTriggerImageChangeParams_To_v1beta3_DeploymentTriggerImageChangeParams,
+		autoConvert_api_DeploymentTriggerObjectChangeParams_To_v1beta3_DeploymentTriggerObjectChangeParams,
 		autoConvert_api_DeploymentTriggerPolicy_To_v1beta3_DeploymentTriggerPolicy,
+		autoConvert_api_DeploymentTriggeredObject_To_v1beta3_DeploymentTriggeredObject,
 		autoConvert_api_DockerBuildStrategy_To_v1beta3_DockerBuildStrategy,
 		autoConvert_api_DownwardAPIVolumeFile_To_v1beta3_DownwardAPIVolumeFile,
 		autoConvert_api_DownwardAPIVolumeSource_To_v1beta3_DownwardAPIVolumeSource,
@@ -6654,6 +7732,8 @@ func init() {
 		autoConvert_api_GlusterfsVolumeSource_To_v1beta3_GlusterfsVolumeSource,
 		autoConvert_api_GroupList_To_v1beta3_GroupList,
 		autoConvert_api_Group_To_v1beta3_Group,
+		autoConvert_api_HTTPGetHook_To_v1beta3_HTTPGetHook,
+		autoConvert_api_HookVolume_To_v1beta3_HookVolume,
 		autoConvert_api_HostPathVolumeSource_To_v1beta3_HostPathVolumeSource,
 		autoConvert_api_HostSubnetList_To_v1beta3_HostSubnetList,
 		autoConvert_api_HostSubnet_To_v1beta3_HostSubnet,
@@ -6673,6 +7753,7 @@ func init() {
 		autoConvert_api_ImageStream_To_v1beta3_ImageStream,
 		autoConvert_api_Image_To_v1beta3_Image,
 		autoConvert_api_IsPersonalSubjectAccessReview_To_v1beta3_IsPersonalSubjectAccessReview,
+		autoConvert_api_JenkinsPipelineBuildStrategy_To_v1beta3_JenkinsPipelineBuildStrategy,
 		autoConvert_api_LocalObjectReference_To_v1beta3_LocalObjectReference,
 		autoConvert_api_LocalResourceAccessReview_To_v1beta3_LocalResourceAccessReview,
 		autoConvert_api_LocalSubjectAccessReview_To_v1beta3_LocalSubjectAccessReview,
@@ -6704,6 +7785,8 @@ func init() {
 		autoConvert_api_ProjectSpec_To_v1beta3_ProjectSpec,
 		autoConvert_api_ProjectStatus_To_v1beta3_ProjectStatus,
 		autoConvert_api_Project_To_v1beta3_Project,
+		autoConvert_api_PullRequestBuildPolicy_To_v1beta3_PullRequestBuildPolicy,
+		autoConvert_api_PullRequestReference_To_v1beta3_PullRequestReference,
 		autoConvert_api_RBDVolumeSource_To_v1beta3_RBDVolumeSource,
 		autoConvert_api_ResourceAccessReviewResponse_To_v1beta3_ResourceAccessReviewResponse,
 		autoConvert_api_ResourceAccessReview_To_v1beta3_ResourceAccessReview,
@@ -6719,6 +7802,7 @@ func init() {
 		autoConvert_api_RoutePort_To_v1beta3_RoutePort,
 		autoConvert_api_RouteSpec_To_v1beta3_RouteSpec,
 		autoConvert_api_RouteStatus_To_v1beta3_RouteStatus,
+		autoConvert_api_RouteTargetReference_To_v1beta3_RouteTargetReference,
 		autoConvert_api_Route_To_v1beta3_Route,
 		autoConvert_api_SecretBuildSource_To_v1beta3_SecretBuildSource,
 		autoConvert_api_SecretSpec_To_v1beta3_SecretSpec,
@@ -6726,6 +7810,7 @@ func init() {
 		autoConvert_api_SourceBuildStrategy_To_v1beta3_SourceBuildStrategy,
 		autoConvert_api_SourceControlUser_To_v1beta3_SourceControlUser,
 		autoConvert_api_SourceRevision_To_v1beta3_SourceRevision,
+		autoConvert_api_StageInfo_To_v1beta3_StageInfo,
 		autoConvert_api_SubjectAccessReviewResponse_To_v1beta3_SubjectAccessReviewResponse,
 		autoConvert_api_SubjectAccessReview_To_v1beta3_SubjectAccessReview,
 		autoConvert_api_TCPSocketAction_To_v1beta3_TCPSocketAction,
@@ -6739,10 +7824,12 @@ func init() {
 		autoConvert_api_VolumeMount_To_v1beta3_VolumeMount,
 		autoConvert_api_VolumeSource_To_v1beta3_VolumeSource,
 		autoConvert_api_Volume_To_v1beta3_Volume,
+		autoConvert_api_WaitForImageHook_To_v1beta3_WaitForImageHook,
 		autoConvert_api_WebHookTrigger_To_v1beta3_WebHookTrigger,
 		autoConvert_v1beta3_AWSElasticBlockStoreVolumeSource_To_api_AWSElasticBlockStoreVolumeSource,
 		autoConvert_v1beta3_BinaryBuildRequestOptions_To_api_BinaryBuildRequestOptions,
 		autoConvert_v1beta3_BinaryBuildSource_To_api_BinaryBuildSource,
+		autoConvert_v1beta3_BuildCache_To_api_BuildCache,
 		autoConvert_v1beta3_BuildConfigList_To_api_BuildConfigList,
 		autoConvert_v1beta3_BuildConfigSpec_To_api_BuildConfigSpec,
 		autoConvert_v1beta3_BuildConfigStatus_To_api_BuildConfigStatus,
@@ -6750,10 +7837,12 @@ func init() {
 		autoConvert_v1beta3_BuildList_To_api_BuildList,
 		autoConvert_v1beta3_BuildLogOptions_To_api_BuildLogOptions,
 		autoConvert_v1beta3_BuildLog_To_api_BuildLog,
+		autoConvert_v1beta3_BuildOutputDestination_To_api_BuildOutputDestination,
 		autoConvert_v1beta3_BuildOutput_To_api_BuildOutput,
 		autoConvert_v1beta3_BuildPostCommitSpec_To_api_BuildPostCommitSpec,
 		autoConvert_v1beta3_BuildSource_To_api_BuildSource,
 		autoConvert_v1beta3_BuildSpec_To_api_BuildSpec,
+		autoConvert_v1beta3_BuildStatusOutput_To_api_BuildStatusOutput,
 		autoConvert_v1beta3_BuildStatus_To_api_BuildStatus,
 		autoConvert_v1beta3_BuildStrategy_To_api_BuildStrategy,
 		autoConvert_v1beta3_BuildTriggerPolicy_To_api_BuildTriggerPolicy,
@@ -6769,11 +7858,16 @@ func init() {
 		autoConvert_v1beta3_ClusterRoleBinding_To_api_ClusterRoleBinding,
 		autoConvert_v1beta3_ClusterRoleList_To_api_ClusterRoleList,
 		autoConvert_v1beta3_ClusterRole_To_api_ClusterRole,
+		autoConvert_v1beta3_CommitStatusReporting_To_api_CommitStatusReporting,
 		autoConvert_v1beta3_ContainerPort_To_api_ContainerPort,
 		autoConvert_v1beta3_Container_To_api_Container,
 		autoConvert_v1beta3_CustomBuildStrategy_To_api_CustomBuildStrategy,
 		autoConvert_v1beta3_DeploymentCauseImageTrigger_To_api_DeploymentCauseImageTrigger,
+		autoConvert_v1beta3_DeploymentCauseObjectTrigger_To_api_DeploymentCauseObjectTrigger,
 		autoConvert_v1beta3_DeploymentCause_To_api_DeploymentCause,
+		autoConvert_v1beta3_DeploymentCondition_To_api_DeploymentCondition,
+		autoConvert_v1beta3_DeploymentConfigChange_To_api_DeploymentConfigChange,
+		autoConvert_v1beta3_DeploymentConfigRollbackDiff_To_api_DeploymentConfigRollbackDiff,
 		autoConvert_v1beta3_DeploymentConfigRollbackSpec_To_api_DeploymentConfigRollbackSpec,
 		autoConvert_v1beta3_DeploymentConfigRollback_To_api_DeploymentConfigRollback,
 		autoConvert_v1beta3_DeploymentConfigStatus_To_api_DeploymentConfigStatus,
@@ -6781,7 +7875,9 @@ func init() {
 		autoConvert_v1beta3_DeploymentLogOptions_To_api_DeploymentLogOptions,
 		autoConvert_v1beta3_DeploymentLog_To_api_DeploymentLog,
 		autoConvert_v1beta3_DeploymentTriggerImageChangeParams_To_api_DeploymentTriggerImageChangeParams,
+		autoConvert_v1beta3_DeploymentTriggerObjectChangeParams_To_api_DeploymentTriggerObjectChangeParams,
 		autoConvert_v1beta3_DeploymentTriggerPolicy_To_api_DeploymentTriggerPolicy,
+		autoConvert_v1beta3_DeploymentTriggeredObject_To_api_DeploymentTriggeredObject,
 		autoConvert_v1beta3_DockerBuildStrategy_To_api_DockerBuildStrategy,
 		autoConvert_v1beta3_DownwardAPIVolumeFile_To_api_DownwardAPIVolumeFile,
 		autoConvert_v1beta3_DownwardAPIVolumeSource_To_api_DownwardAPIVolumeSource,
@@ -6795,6 +7891,8 @@ func init() {
 		autoConvert_v1beta3_GlusterfsVolumeSource_To_api_GlusterfsVolumeSource,
 		autoConvert_v1beta3_GroupList_To_api_GroupList,
 		autoConvert_v1beta3_Group_To_api_Group,
+		autoConvert_v1beta3_HTTPGetHook_To_api_HTTPGetHook,
+		autoConvert_v1beta3_HookVolume_To_api_HookVolume,
 		autoConvert_v1beta3_HostPathVolumeSource_To_api_HostPathVolumeSource,
 		autoConvert_v1beta3_HostSubnetList_To_api_HostSubnetList,
 		autoConvert_v1beta3_HostSubnet_To_api_HostSubnet,
@@ -6814,6 +7912,7 @@ func init() {
 		autoConvert_v1beta3_ImageStream_To_api_ImageStream,
 		autoConvert_v1beta3_Image_To_api_Image,
 		autoConvert_v1beta3_IsPersonalSubjectAccessReview_To_api_IsPersonalSubjectAccessReview,
+		autoConvert_v1beta3_JenkinsPipelineBuildStrategy_To_api_JenkinsPipelineBuildStrategy,
 		autoConvert_v1beta3_LocalObjectReference_To_api_LocalObjectReference,
 		autoConvert_v1beta3_LocalResourceAccessReview_To_api_LocalResourceAccessReview,
 		autoConvert_v1beta3_LocalSubjectAccessReview_To_api_LocalSubjectAccessReview,
@@ -6845,6 +7944,8 @@ func init() {
 		autoConvert_v1beta3_ProjectSpec_To_api_ProjectSpec,
 		autoConvert_v1beta3_ProjectStatus_To_api_ProjectStatus,
 		autoConvert_v1beta3_Project_To_api_Project,
+		autoConvert_v1beta3_PullRequestBuildPolicy_To_api_PullRequestBuildPolicy,
+		autoConvert_v1beta3_PullRequestReference_To_api_PullRequestReference,
 		autoConvert_v1beta3_RBDVolumeSource_To_api_RBDVolumeSource,
 		autoConvert_v1beta3_ResourceAccessReviewResponse_To_api_ResourceAccessReviewResponse,
 		autoConvert_v1beta3_ResourceAccessReview_To_api_ResourceAccessReview,
@@ -6860,6 +7961,7 @@ func init() {
 		autoConvert_v1beta3_RoutePort_To_api_RoutePort,
 		autoConvert_v1beta3_RouteSpec_To_api_RouteSpec,
 		autoConvert_v1beta3_RouteStatus_To_api_RouteStatus,
+		autoConvert_v1beta3_RouteTargetReference_To_api_RouteTargetReference,
 		autoConvert_v1beta3_Route_To_api_Route,
 		autoConvert_v1beta3_SecretBuildSource_To_api_SecretBuildSource,
 		autoConvert_v1beta3_SecretSpec_To_api_SecretSpec,
@@ -6867,6 +7969,7 @@ func init() {
 		autoConvert_v1beta3_SourceBuildStrategy_To_api_SourceBuildStrategy,
 		autoConvert_v1beta3_SourceControlUser_To_api_SourceControlUser,
 		autoConvert_v1beta3_SourceRevision_To_api_SourceRevision,
+		autoConvert_v1beta3_StageInfo_To_api_StageInfo,
 		autoConvert_v1beta3_SubjectAccessReviewResponse_To_api_SubjectAccessReviewResponse,
 		autoConvert_v1beta3_SubjectAccessReview_To_api_SubjectAccessReview,
 		autoConvert_v1beta3_TCPSocketAction_To_api_TCPSocketAction,
@@ -6880,6 +7983,7 @@ func init() {
 		autoConvert_v1beta3_VolumeMount_To_api_VolumeMount,
 		autoConvert_v1beta3_VolumeSource_To_api_VolumeSource,
 		autoConvert_v1beta3_Volume_To_api_Volume,
+		autoConvert_v1beta3_WaitForImageHook_To_api_WaitForImageHook,
 		autoConvert_v1beta3_WebHookTrigger_To_api_WebHookTrigger,
 	)
 	if err != nil {
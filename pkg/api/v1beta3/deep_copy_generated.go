@@ -743,6 +743,46 @@ func deepCopy_v1beta3_Build(in apiv1beta3.Build, out *apiv1beta3.Build, c *conve
 	return nil
 }
 
+func deepCopy_v1beta3_BuildCache(in apiv1beta3.BuildCache, out *apiv1beta3.BuildCache, c *conversion.Cloner) error {
+	if in.Paths != nil {
+		out.Paths = make([]apiv1beta3.ImageSourcePath, len(in.Paths))
+		for i := range in.Paths {
+			if err := deepCopy_v1beta3_ImageSourcePath(in.Paths[i], &out.Paths[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Paths = nil
+	}
+	if in.KeyFiles != nil {
+		out.KeyFiles = make([]string, len(in.KeyFiles))
+		for i := range in.KeyFiles {
+			out.KeyFiles[i] = in.KeyFiles[i]
+		}
+	} else {
+		out.KeyFiles = nil
+	}
+	if in.PersistentVolumeClaim != nil {
+		if newVal, err := c.DeepCopy(in.PersistentVolumeClaim); err != nil {
+			return err
+		} else {
+			out.PersistentVolumeClaim = newVal.(*pkgapiv1beta3.LocalObjectReference)
+		}
+	} else {
+		out.PersistentVolumeClaim = nil
+	}
+	if in.Image != nil {
+		if newVal, err := c.DeepCopy(in.Image); err != nil {
+			return err
+		} else {
+			out.Image = newVal.(*pkgapiv1beta3.ObjectReference)
+		}
+	} else {
+		out.Image = nil
+	}
+	return nil
+}
+
 func deepCopy_v1beta3_BuildConfig(in apiv1beta3.BuildConfig, out *apiv1beta3.BuildConfig, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -798,6 +838,19 @@ func deepCopy_v1beta3_BuildConfigSpec(in apiv1beta3.BuildConfigSpec, out *apiv1b
 	} else {
 		out.Triggers = nil
 	}
+	out.RunPolicy = in.RunPolicy
+	if in.SuccessfulBuildsHistoryLimit != nil {
+		out.SuccessfulBuildsHistoryLimit = new(int)
+		*out.SuccessfulBuildsHistoryLimit = *in.SuccessfulBuildsHistoryLimit
+	} else {
+		out.SuccessfulBuildsHistoryLimit = nil
+	}
+	if in.FailedBuildsHistoryLimit != nil {
+		out.FailedBuildsHistoryLimit = new(int)
+		*out.FailedBuildsHistoryLimit = *in.FailedBuildsHistoryLimit
+	} else {
+		out.FailedBuildsHistoryLimit = nil
+	}
 	if err := deepCopy_v1beta3_BuildSpec(in.BuildSpec, &out.BuildSpec, c); err != nil {
 		return err
 	}
@@ -908,6 +961,34 @@ func deepCopy_v1beta3_BuildOutput(in apiv1beta3.BuildOutput, out *apiv1beta3.Bui
 	} else {
 		out.PushSecret = nil
 	}
+	if in.Destinations != nil {
+		out.Destinations = make([]apiv1beta3.BuildOutputDestination, len(in.Destinations))
+		for i := range in.Destinations {
+			if err := deepCopy_v1beta3_BuildOutputDestination(in.Destinations[i], &out.Destinations[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Destinations = nil
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_BuildOutputDestination(in apiv1beta3.BuildOutputDestination, out *apiv1beta3.BuildOutputDestination, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.To); err != nil {
+		return err
+	} else {
+		out.To = newVal.(pkgapiv1beta3.ObjectReference)
+	}
+	if in.PushSecret != nil {
+		if newVal, err := c.DeepCopy(in.PushSecret); err != nil {
+			return err
+		} else {
+			out.PushSecret = newVal.(*pkgapiv1beta3.LocalObjectReference)
+		}
+	} else {
+		out.PushSecret = nil
+	}
 	return nil
 }
 
@@ -995,6 +1076,14 @@ func deepCopy_v1beta3_BuildRequest(in apiv1beta3.BuildRequest, out *apiv1beta3.B
 	} else {
 		out.Env = nil
 	}
+	if in.PullRequest != nil {
+		out.PullRequest = new(apiv1beta3.PullRequestReference)
+		if err := deepCopy_v1beta3_PullRequestReference(*in.PullRequest, out.PullRequest, c); err != nil {
+			return err
+		}
+	} else {
+		out.PullRequest = nil
+	}
 	return nil
 }
 
@@ -1088,6 +1177,22 @@ func deepCopy_v1beta3_BuildSpec(in apiv1beta3.BuildSpec, out *apiv1beta3.BuildSp
 	} else {
 		out.CompletionDeadlineSeconds = nil
 	}
+	if in.Cache != nil {
+		out.Cache = new(apiv1beta3.BuildCache)
+		if err := deepCopy_v1beta3_BuildCache(*in.Cache, out.Cache, c); err != nil {
+			return err
+		}
+	} else {
+		out.Cache = nil
+	}
+	if in.CommitStatus != nil {
+		out.CommitStatus = new(apiv1beta3.CommitStatusReporting)
+		if err := deepCopy_v1beta3_CommitStatusReporting(*in.CommitStatus, out.CommitStatus, c); err != nil {
+			return err
+		}
+	} else {
+		out.CommitStatus = nil
+	}
 	return nil
 }
 
@@ -1125,6 +1230,32 @@ func deepCopy_v1beta3_BuildStatus(in apiv1beta3.BuildStatus, out *apiv1beta3.Bui
 	} else {
 		out.Config = nil
 	}
+	if in.Stages != nil {
+		out.Stages = make([]apiv1beta3.StageInfo, len(in.Stages))
+		for i := range in.Stages {
+			if err := deepCopy_v1beta3_StageInfo(in.Stages[i], &out.Stages[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Stages = nil
+	}
+	if in.Outputs != nil {
+		out.Outputs = make([]apiv1beta3.BuildStatusOutput, len(in.Outputs))
+		for i := range in.Outputs {
+			if err := deepCopy_v1beta3_BuildStatusOutput(in.Outputs[i], &out.Outputs[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Outputs = nil
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_BuildStatusOutput(in apiv1beta3.BuildStatusOutput, out *apiv1beta3.BuildStatusOutput, c *conversion.Cloner) error {
+	out.DockerImageReference = in.DockerImageReference
+	out.ImageDigest = in.ImageDigest
 	return nil
 }
 
@@ -1154,6 +1285,14 @@ func deepCopy_v1beta3_BuildStrategy(in apiv1beta3.BuildStrategy, out *apiv1beta3
 	} else {
 		out.CustomStrategy = nil
 	}
+	if in.JenkinsPipelineStrategy != nil {
+		out.JenkinsPipelineStrategy = new(apiv1beta3.JenkinsPipelineBuildStrategy)
+		if err := deepCopy_v1beta3_JenkinsPipelineBuildStrategy(*in.JenkinsPipelineStrategy, out.JenkinsPipelineStrategy, c); err != nil {
+			return err
+		}
+	} else {
+		out.JenkinsPipelineStrategy = nil
+	}
 	return nil
 }
 
@@ -1175,6 +1314,22 @@ func deepCopy_v1beta3_BuildTriggerPolicy(in apiv1beta3.BuildTriggerPolicy, out *
 	} else {
 		out.GenericWebHook = nil
 	}
+	if in.GitLabWebHook != nil {
+		out.GitLabWebHook = new(apiv1beta3.WebHookTrigger)
+		if err := deepCopy_v1beta3_WebHookTrigger(*in.GitLabWebHook, out.GitLabWebHook, c); err != nil {
+			return err
+		}
+	} else {
+		out.GitLabWebHook = nil
+	}
+	if in.BitbucketWebHook != nil {
+		out.BitbucketWebHook = new(apiv1beta3.WebHookTrigger)
+		if err := deepCopy_v1beta3_WebHookTrigger(*in.BitbucketWebHook, out.BitbucketWebHook, c); err != nil {
+			return err
+		}
+	} else {
+		out.BitbucketWebHook = nil
+	}
 	if in.ImageChange != nil {
 		out.ImageChange = new(apiv1beta3.ImageChangeTrigger)
 		if err := deepCopy_v1beta3_ImageChangeTrigger(*in.ImageChange, out.ImageChange, c); err != nil {
@@ -1186,6 +1341,18 @@ func deepCopy_v1beta3_BuildTriggerPolicy(in apiv1beta3.BuildTriggerPolicy, out *
 	return nil
 }
 
+func deepCopy_v1beta3_CommitStatusReporting(in apiv1beta3.CommitStatusReporting, out *apiv1beta3.CommitStatusReporting, c *conversion.Cloner) error {
+	out.Provider = in.Provider
+	out.APIURL = in.APIURL
+	if newVal, err := c.DeepCopy(in.Secret); err != nil {
+		return err
+	} else {
+		out.Secret = newVal.(pkgapiv1beta3.LocalObjectReference)
+	}
+	out.Context = in.Context
+	return nil
+}
+
 func deepCopy_v1beta3_CustomBuildStrategy(in apiv1beta3.CustomBuildStrategy, out *apiv1beta3.CustomBuildStrategy, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.From); err != nil {
 		return err
@@ -1263,6 +1430,18 @@ func deepCopy_v1beta3_DockerBuildStrategy(in apiv1beta3.DockerBuildStrategy, out
 	}
 	out.ForcePull = in.ForcePull
 	out.DockerfilePath = in.DockerfilePath
+	if in.BuildArgs != nil {
+		out.BuildArgs = make([]pkgapiv1beta3.EnvVar, len(in.BuildArgs))
+		for i := range in.BuildArgs {
+			if newVal, err := c.DeepCopy(in.BuildArgs[i]); err != nil {
+				return err
+			} else {
+				out.BuildArgs[i] = newVal.(pkgapiv1beta3.EnvVar)
+			}
+		}
+	} else {
+		out.BuildArgs = nil
+	}
 	return nil
 }
 
@@ -1344,6 +1523,26 @@ func deepCopy_v1beta3_ImageSourcePath(in apiv1beta3.ImageSourcePath, out *apiv1b
 	return nil
 }
 
+func deepCopy_v1beta3_JenkinsPipelineBuildStrategy(in apiv1beta3.JenkinsPipelineBuildStrategy, out *apiv1beta3.JenkinsPipelineBuildStrategy, c *conversion.Cloner) error {
+	out.JenkinsfilePath = in.JenkinsfilePath
+	out.Jenkinsfile = in.Jenkinsfile
+	return nil
+}
+
+func deepCopy_v1beta3_PullRequestBuildPolicy(in apiv1beta3.PullRequestBuildPolicy, out *apiv1beta3.PullRequestBuildPolicy, c *conversion.Cloner) error {
+	out.OutputTagPrefix = in.OutputTagPrefix
+	out.AllowForkSecrets = in.AllowForkSecrets
+	return nil
+}
+
+func deepCopy_v1beta3_PullRequestReference(in apiv1beta3.PullRequestReference, out *apiv1beta3.PullRequestReference, c *conversion.Cloner) error {
+	out.Number = in.Number
+	out.Ref = in.Ref
+	out.Fork = in.Fork
+	out.OutputTag = in.OutputTag
+	return nil
+}
+
 func deepCopy_v1beta3_SecretBuildSource(in apiv1beta3.SecretBuildSource, out *apiv1beta3.SecretBuildSource, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.Secret); err != nil {
 		return err
@@ -1416,8 +1615,101 @@ func deepCopy_v1beta3_SourceRevision(in apiv1beta3.SourceRevision, out *apiv1bet
 	return nil
 }
 
+func deepCopy_v1beta3_StageInfo(in apiv1beta3.StageInfo, out *apiv1beta3.StageInfo, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.Phase = in.Phase
+	if in.StartTimestamp != nil {
+		if newVal, err := c.DeepCopy(in.StartTimestamp); err != nil {
+			return err
+		} else {
+			out.StartTimestamp = newVal.(*unversioned.Time)
+		}
+	} else {
+		out.StartTimestamp = nil
+	}
+	if in.CompletionTimestamp != nil {
+		if newVal, err := c.DeepCopy(in.CompletionTimestamp); err != nil {
+			return err
+		} else {
+			out.CompletionTimestamp = newVal.(*unversioned.Time)
+		}
+	} else {
+		out.CompletionTimestamp = nil
+	}
+	return nil
+}
+
 func deepCopy_v1beta3_WebHookTrigger(in apiv1beta3.WebHookTrigger, out *apiv1beta3.WebHookTrigger, c *conversion.Cloner) error {
 	out.Secret = in.Secret
+	if in.SecretReference != nil {
+		if newVal, err := c.DeepCopy(in.SecretReference); err != nil {
+			return err
+		} else {
+			out.SecretReference = newVal.(*pkgapiv1beta3.LocalObjectReference)
+		}
+	} else {
+		out.SecretReference = nil
+	}
+	if in.PullRequests != nil {
+		out.PullRequests = new(apiv1beta3.PullRequestBuildPolicy)
+		if err := deepCopy_v1beta3_PullRequestBuildPolicy(*in.PullRequests, out.PullRequests, c); err != nil {
+			return err
+		}
+	} else {
+		out.PullRequests = nil
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_CanaryDeploymentStrategyParams(in deployapiv1beta3.CanaryDeploymentStrategyParams, out *deployapiv1beta3.CanaryDeploymentStrategyParams, c *conversion.Cloner) error {
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	out.Traffic = in.Traffic
+	out.RouteName = in.RouteName
+	if in.Steps != nil {
+		out.Steps = make([]deployapiv1beta3.CanaryStep, len(in.Steps))
+		for i := range in.Steps {
+			if err := deepCopy_v1beta3_CanaryStep(in.Steps[i], &out.Steps[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Steps = nil
+	}
+	if in.Pre != nil {
+		out.Pre = new(deployapiv1beta3.LifecycleHook)
+		if err := deepCopy_v1beta3_LifecycleHook(*in.Pre, out.Pre, c); err != nil {
+			return err
+		}
+	} else {
+		out.Pre = nil
+	}
+	if in.Post != nil {
+		out.Post = new(deployapiv1beta3.LifecycleHook)
+		if err := deepCopy_v1beta3_LifecycleHook(*in.Post, out.Post, c); err != nil {
+			return err
+		}
+	} else {
+		out.Post = nil
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_CanaryStep(in deployapiv1beta3.CanaryStep, out *deployapiv1beta3.CanaryStep, c *conversion.Cloner) error {
+	out.Weight = in.Weight
+	if in.Verify != nil {
+		out.Verify = new(deployapiv1beta3.LifecycleHook)
+		if err := deepCopy_v1beta3_LifecycleHook(*in.Verify, out.Verify, c); err != nil {
+			return err
+		}
+	} else {
+		out.Verify = nil
+	}
+	out.Approve = in.Approve
 	return nil
 }
 
@@ -1456,6 +1748,14 @@ func deepCopy_v1beta3_DeploymentCause(in deployapiv1beta3.DeploymentCause, out *
 	} else {
 		out.ImageTrigger = nil
 	}
+	if in.ObjectTrigger != nil {
+		out.ObjectTrigger = new(deployapiv1beta3.DeploymentCauseObjectTrigger)
+		if err := deepCopy_v1beta3_DeploymentCauseObjectTrigger(*in.ObjectTrigger, out.ObjectTrigger, c); err != nil {
+			return err
+		}
+	} else {
+		out.ObjectTrigger = nil
+	}
 	return nil
 }
 
@@ -1468,6 +1768,28 @@ func deepCopy_v1beta3_DeploymentCauseImageTrigger(in deployapiv1beta3.Deployment
 	return nil
 }
 
+func deepCopy_v1beta3_DeploymentCauseObjectTrigger(in deployapiv1beta3.DeploymentCauseObjectTrigger, out *deployapiv1beta3.DeploymentCauseObjectTrigger, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.From); err != nil {
+		return err
+	} else {
+		out.From = newVal.(pkgapiv1beta3.ObjectReference)
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_DeploymentCondition(in deployapiv1beta3.DeploymentCondition, out *deployapiv1beta3.DeploymentCondition, c *conversion.Cloner) error {
+	out.Type = in.Type
+	out.Status = in.Status
+	if newVal, err := c.DeepCopy(in.LastTransitionTime); err != nil {
+		return err
+	} else {
+		out.LastTransitionTime = newVal.(unversioned.Time)
+	}
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
 func deepCopy_v1beta3_DeploymentConfig(in deployapiv1beta3.DeploymentConfig, out *deployapiv1beta3.DeploymentConfig, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -1488,6 +1810,14 @@ func deepCopy_v1beta3_DeploymentConfig(in deployapiv1beta3.DeploymentConfig, out
 	return nil
 }
 
+func deepCopy_v1beta3_DeploymentConfigChange(in deployapiv1beta3.DeploymentConfigChange, out *deployapiv1beta3.DeploymentConfigChange, c *conversion.Cloner) error {
+	out.Type = in.Type
+	out.Field = in.Field
+	out.Current = in.Current
+	out.RolledBack = in.RolledBack
+	return nil
+}
+
 func deepCopy_v1beta3_DeploymentConfigList(in deployapiv1beta3.DeploymentConfigList, out *deployapiv1beta3.DeploymentConfigList, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -1524,6 +1854,31 @@ func deepCopy_v1beta3_DeploymentConfigRollback(in deployapiv1beta3.DeploymentCon
 	return nil
 }
 
+func deepCopy_v1beta3_DeploymentConfigRollbackDiff(in deployapiv1beta3.DeploymentConfigRollbackDiff, out *deployapiv1beta3.DeploymentConfigRollbackDiff, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	out.Name = in.Name
+	if newVal, err := c.DeepCopy(in.From); err != nil {
+		return err
+	} else {
+		out.From = newVal.(pkgapiv1beta3.ObjectReference)
+	}
+	if in.Changes != nil {
+		out.Changes = make([]deployapiv1beta3.DeploymentConfigChange, len(in.Changes))
+		for i := range in.Changes {
+			if err := deepCopy_v1beta3_DeploymentConfigChange(in.Changes[i], &out.Changes[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Changes = nil
+	}
+	return nil
+}
+
 func deepCopy_v1beta3_DeploymentConfigRollbackSpec(in deployapiv1beta3.DeploymentConfigRollbackSpec, out *deployapiv1beta3.DeploymentConfigRollbackSpec, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.From); err != nil {
 		return err
@@ -1534,6 +1889,7 @@ func deepCopy_v1beta3_DeploymentConfigRollbackSpec(in deployapiv1beta3.Deploymen
 	out.IncludeTemplate = in.IncludeTemplate
 	out.IncludeReplicationMeta = in.IncludeReplicationMeta
 	out.IncludeStrategy = in.IncludeStrategy
+	out.DiffOnly = in.DiffOnly
 	return nil
 }
 
@@ -1553,6 +1909,20 @@ func deepCopy_v1beta3_DeploymentConfigSpec(in deployapiv1beta3.DeploymentConfigS
 	}
 	out.Replicas = in.Replicas
 	out.Test = in.Test
+	out.Paused = in.Paused
+	if in.ProgressDeadlineSeconds != nil {
+		out.ProgressDeadlineSeconds = new(int64)
+		*out.ProgressDeadlineSeconds = *in.ProgressDeadlineSeconds
+	} else {
+		out.ProgressDeadlineSeconds = nil
+	}
+	out.AutoRollback = in.AutoRollback
+	if in.RevisionHistoryLimit != nil {
+		out.RevisionHistoryLimit = new(int)
+		*out.RevisionHistoryLimit = *in.RevisionHistoryLimit
+	} else {
+		out.RevisionHistoryLimit = nil
+	}
 	if in.Selector != nil {
 		out.Selector = make(map[string]string)
 		for key, val := range in.Selector {
@@ -1583,6 +1953,30 @@ func deepCopy_v1beta3_DeploymentConfigStatus(in deployapiv1beta3.DeploymentConfi
 	} else {
 		out.Details = nil
 	}
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Replicas = in.Replicas
+	out.UpdatedReplicas = in.UpdatedReplicas
+	out.AvailableReplicas = in.AvailableReplicas
+	if in.Conditions != nil {
+		out.Conditions = make([]deployapiv1beta3.DeploymentCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := deepCopy_v1beta3_DeploymentCondition(in.Conditions[i], &out.Conditions[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
+	if in.TriggeredObjects != nil {
+		out.TriggeredObjects = make([]deployapiv1beta3.DeploymentTriggeredObject, len(in.TriggeredObjects))
+		for i := range in.TriggeredObjects {
+			if err := deepCopy_v1beta3_DeploymentTriggeredObject(in.TriggeredObjects[i], &out.TriggeredObjects[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.TriggeredObjects = nil
+	}
 	return nil
 }
 
@@ -1687,6 +2081,14 @@ func deepCopy_v1beta3_DeploymentStrategy(in deployapiv1beta3.DeploymentStrategy,
 	} else {
 		out.RollingParams = nil
 	}
+	if in.CanaryParams != nil {
+		out.CanaryParams = new(deployapiv1beta3.CanaryDeploymentStrategyParams)
+		if err := deepCopy_v1beta3_CanaryDeploymentStrategyParams(*in.CanaryParams, out.CanaryParams, c); err != nil {
+			return err
+		}
+	} else {
+		out.CanaryParams = nil
+	}
 	if newVal, err := c.DeepCopy(in.Resources); err != nil {
 		return err
 	} else {
@@ -1730,6 +2132,11 @@ func deepCopy_v1beta3_DeploymentTriggerImageChangeParams(in deployapiv1beta3.Dep
 	return nil
 }
 
+func deepCopy_v1beta3_DeploymentTriggerObjectChangeParams(in deployapiv1beta3.DeploymentTriggerObjectChangeParams, out *deployapiv1beta3.DeploymentTriggerObjectChangeParams, c *conversion.Cloner) error {
+	out.Name = in.Name
+	return nil
+}
+
 func deepCopy_v1beta3_DeploymentTriggerPolicy(in deployapiv1beta3.DeploymentTriggerPolicy, out *deployapiv1beta3.DeploymentTriggerPolicy, c *conversion.Cloner) error {
 	out.Type = in.Type
 	if in.ImageChangeParams != nil {
@@ -1740,6 +2147,29 @@ func deepCopy_v1beta3_DeploymentTriggerPolicy(in deployapiv1beta3.DeploymentTrig
 	} else {
 		out.ImageChangeParams = nil
 	}
+	if in.SecretChangeParams != nil {
+		out.SecretChangeParams = new(deployapiv1beta3.DeploymentTriggerObjectChangeParams)
+		if err := deepCopy_v1beta3_DeploymentTriggerObjectChangeParams(*in.SecretChangeParams, out.SecretChangeParams, c); err != nil {
+			return err
+		}
+	} else {
+		out.SecretChangeParams = nil
+	}
+	if in.ConfigMapChangeParams != nil {
+		out.ConfigMapChangeParams = new(deployapiv1beta3.DeploymentTriggerObjectChangeParams)
+		if err := deepCopy_v1beta3_DeploymentTriggerObjectChangeParams(*in.ConfigMapChangeParams, out.ConfigMapChangeParams, c); err != nil {
+			return err
+		}
+	} else {
+		out.ConfigMapChangeParams = nil
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_DeploymentTriggeredObject(in deployapiv1beta3.DeploymentTriggeredObject, out *deployapiv1beta3.DeploymentTriggeredObject, c *conversion.Cloner) error {
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.DataHash = in.DataHash
 	return nil
 }
 
@@ -1773,6 +2203,70 @@ func deepCopy_v1beta3_ExecNewPodHook(in deployapiv1beta3.ExecNewPodHook, out *de
 	} else {
 		out.Volumes = nil
 	}
+	if in.ExtraVolumes != nil {
+		out.ExtraVolumes = make([]deployapiv1beta3.HookVolume, len(in.ExtraVolumes))
+		for i := range in.ExtraVolumes {
+			if err := deepCopy_v1beta3_HookVolume(in.ExtraVolumes[i], &out.ExtraVolumes[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ExtraVolumes = nil
+	}
+	if in.Resources != nil {
+		if newVal, err := c.DeepCopy(in.Resources); err != nil {
+			return err
+		} else {
+			out.Resources = newVal.(*pkgapiv1beta3.ResourceRequirements)
+		}
+	} else {
+		out.Resources = nil
+	}
+	out.ServiceAccountName = in.ServiceAccountName
+	if in.NodeSelector != nil {
+		out.NodeSelector = make(map[string]string)
+		for key, val := range in.NodeSelector {
+			out.NodeSelector[key] = val
+		}
+	} else {
+		out.NodeSelector = nil
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_HTTPGetHook(in deployapiv1beta3.HTTPGetHook, out *deployapiv1beta3.HTTPGetHook, c *conversion.Cloner) error {
+	out.URL = in.URL
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_HookVolume(in deployapiv1beta3.HookVolume, out *deployapiv1beta3.HookVolume, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.MountPath = in.MountPath
+	out.ReadOnly = in.ReadOnly
+	if in.Secret != nil {
+		if newVal, err := c.DeepCopy(in.Secret); err != nil {
+			return err
+		} else {
+			out.Secret = newVal.(*pkgapiv1beta3.SecretVolumeSource)
+		}
+	} else {
+		out.Secret = nil
+	}
+	if in.EmptyDir != nil {
+		if newVal, err := c.DeepCopy(in.EmptyDir); err != nil {
+			return err
+		} else {
+			out.EmptyDir = newVal.(*pkgapiv1beta3.EmptyDirVolumeSource)
+		}
+	} else {
+		out.EmptyDir = nil
+	}
 	return nil
 }
 
@@ -1796,6 +2290,22 @@ func deepCopy_v1beta3_LifecycleHook(in deployapiv1beta3.LifecycleHook, out *depl
 	} else {
 		out.TagImages = nil
 	}
+	if in.HTTPGet != nil {
+		out.HTTPGet = new(deployapiv1beta3.HTTPGetHook)
+		if err := deepCopy_v1beta3_HTTPGetHook(*in.HTTPGet, out.HTTPGet, c); err != nil {
+			return err
+		}
+	} else {
+		out.HTTPGet = nil
+	}
+	if in.WaitForImage != nil {
+		out.WaitForImage = new(deployapiv1beta3.WaitForImageHook)
+		if err := deepCopy_v1beta3_WaitForImageHook(*in.WaitForImage, out.WaitForImage, c); err != nil {
+			return err
+		}
+	} else {
+		out.WaitForImage = nil
+	}
 	return nil
 }
 
@@ -1905,6 +2415,23 @@ func deepCopy_v1beta3_TagImageHook(in deployapiv1beta3.TagImageHook, out *deploy
 	return nil
 }
 
+func deepCopy_v1beta3_WaitForImageHook(in deployapiv1beta3.WaitForImageHook, out *deployapiv1beta3.WaitForImageHook, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.From); err != nil {
+		return err
+	} else {
+		out.From = newVal.(pkgapiv1beta3.ObjectReference)
+	}
+	out.Digest = in.Digest
+	out.ContainerName = in.ContainerName
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	return nil
+}
+
 func deepCopy_v1beta3_Image(in imageapiv1beta3.Image, out *imageapiv1beta3.Image, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -2561,6 +3088,22 @@ func deepCopy_v1beta3_RouteSpec(in routeapiv1beta3.RouteSpec, out *routeapiv1bet
 	} else {
 		out.To = newVal.(pkgapiv1beta3.ObjectReference)
 	}
+	if in.Weight != nil {
+		out.Weight = new(int32)
+		*out.Weight = *in.Weight
+	} else {
+		out.Weight = nil
+	}
+	if in.AlternateBackends != nil {
+		out.AlternateBackends = make([]routeapiv1beta3.RouteTargetReference, len(in.AlternateBackends))
+		for i := range in.AlternateBackends {
+			if err := deepCopy_v1beta3_RouteTargetReference(in.AlternateBackends[i], &out.AlternateBackends[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.AlternateBackends = nil
+	}
 	if in.Port != nil {
 		out.Port = new(routeapiv1beta3.RoutePort)
 		if err := deepCopy_v1beta3_RoutePort(*in.Port, out.Port, c); err != nil {
@@ -2577,6 +3120,7 @@ func deepCopy_v1beta3_RouteSpec(in routeapiv1beta3.RouteSpec, out *routeapiv1bet
 	} else {
 		out.TLS = nil
 	}
+	out.WildcardPolicy = in.WildcardPolicy
 	return nil
 }
 
@@ -2594,6 +3138,18 @@ func deepCopy_v1beta3_RouteStatus(in routeapiv1beta3.RouteStatus, out *routeapiv
 	return nil
 }
 
+func deepCopy_v1beta3_RouteTargetReference(in routeapiv1beta3.RouteTargetReference, out *routeapiv1beta3.RouteTargetReference, c *conversion.Cloner) error {
+	out.Kind = in.Kind
+	out.Name = in.Name
+	if in.Weight != nil {
+		out.Weight = new(int32)
+		*out.Weight = *in.Weight
+	} else {
+		out.Weight = nil
+	}
+	return nil
+}
+
 func deepCopy_v1beta3_TLSConfig(in routeapiv1beta3.TLSConfig, out *routeapiv1beta3.TLSConfig, c *conversion.Cloner) error {
 	out.Termination = in.Termination
 	out.Certificate = in.Certificate
@@ -3017,6 +3573,7 @@ func init() {
 		deepCopy_v1beta3_BinaryBuildRequestOptions,
 		deepCopy_v1beta3_BinaryBuildSource,
 		deepCopy_v1beta3_Build,
+		deepCopy_v1beta3_BuildCache,
 		deepCopy_v1beta3_BuildConfig,
 		deepCopy_v1beta3_BuildConfigList,
 		deepCopy_v1beta3_BuildConfigSpec,
@@ -3025,13 +3582,16 @@ func init() {
 		deepCopy_v1beta3_BuildLog,
 		deepCopy_v1beta3_BuildLogOptions,
 		deepCopy_v1beta3_BuildOutput,
+		deepCopy_v1beta3_BuildOutputDestination,
 		deepCopy_v1beta3_BuildPostCommitSpec,
 		deepCopy_v1beta3_BuildRequest,
 		deepCopy_v1beta3_BuildSource,
 		deepCopy_v1beta3_BuildSpec,
 		deepCopy_v1beta3_BuildStatus,
+		deepCopy_v1beta3_BuildStatusOutput,
 		deepCopy_v1beta3_BuildStrategy,
 		deepCopy_v1beta3_BuildTriggerPolicy,
+		deepCopy_v1beta3_CommitStatusReporting,
 		deepCopy_v1beta3_CustomBuildStrategy,
 		deepCopy_v1beta3_DockerBuildStrategy,
 		deepCopy_v1beta3_GitBuildSource,
@@ -3039,18 +3599,28 @@ func init() {
 		deepCopy_v1beta3_ImageChangeTrigger,
 		deepCopy_v1beta3_ImageSource,
 		deepCopy_v1beta3_ImageSourcePath,
+		deepCopy_v1beta3_JenkinsPipelineBuildStrategy,
+		deepCopy_v1beta3_PullRequestBuildPolicy,
+		deepCopy_v1beta3_PullRequestReference,
 		deepCopy_v1beta3_SecretBuildSource,
 		deepCopy_v1beta3_SecretSpec,
 		deepCopy_v1beta3_SourceBuildStrategy,
 		deepCopy_v1beta3_SourceControlUser,
 		deepCopy_v1beta3_SourceRevision,
+		deepCopy_v1beta3_StageInfo,
 		deepCopy_v1beta3_WebHookTrigger,
+		deepCopy_v1beta3_CanaryDeploymentStrategyParams,
+		deepCopy_v1beta3_CanaryStep,
 		deepCopy_v1beta3_CustomDeploymentStrategyParams,
 		deepCopy_v1beta3_DeploymentCause,
 		deepCopy_v1beta3_DeploymentCauseImageTrigger,
+		deepCopy_v1beta3_DeploymentCauseObjectTrigger,
+		deepCopy_v1beta3_DeploymentCondition,
 		deepCopy_v1beta3_DeploymentConfig,
+		deepCopy_v1beta3_DeploymentConfigChange,
 		deepCopy_v1beta3_DeploymentConfigList,
 		deepCopy_v1beta3_DeploymentConfigRollback,
+		deepCopy_v1beta3_DeploymentConfigRollbackDiff,
 		deepCopy_v1beta3_DeploymentConfigRollbackSpec,
 		deepCopy_v1beta3_DeploymentConfigSpec,
 		deepCopy_v1beta3_DeploymentConfigStatus,
@@ -3059,12 +3629,17 @@ func init() {
 		deepCopy_v1beta3_DeploymentLogOptions,
 		deepCopy_v1beta3_DeploymentStrategy,
 		deepCopy_v1beta3_DeploymentTriggerImageChangeParams,
+		deepCopy_v1beta3_DeploymentTriggerObjectChangeParams,
 		deepCopy_v1beta3_DeploymentTriggerPolicy,
+		deepCopy_v1beta3_DeploymentTriggeredObject,
 		deepCopy_v1beta3_ExecNewPodHook,
+		deepCopy_v1beta3_HTTPGetHook,
+		deepCopy_v1beta3_HookVolume,
 		deepCopy_v1beta3_LifecycleHook,
 		deepCopy_v1beta3_RecreateDeploymentStrategyParams,
 		deepCopy_v1beta3_RollingDeploymentStrategyParams,
 		deepCopy_v1beta3_TagImageHook,
+		deepCopy_v1beta3_WaitForImageHook,
 		deepCopy_v1beta3_Image,
 		deepCopy_v1beta3_ImageLayer,
 		deepCopy_v1beta3_ImageList,
@@ -3101,6 +3676,7 @@ func init() {
 		deepCopy_v1beta3_RoutePort,
 		deepCopy_v1beta3_RouteSpec,
 		deepCopy_v1beta3_RouteStatus,
+		deepCopy_v1beta3_RouteTargetReference,
 		deepCopy_v1beta3_TLSConfig,
 		deepCopy_v1beta3_ClusterNetwork,
 		deepCopy_v1beta3_ClusterNetworkList,
@@ -20,6 +20,7 @@ import (
 var KnownValidationExceptions = []reflect.Type{
 	reflect.TypeOf(&buildapi.BuildLog{}),                              // masks calls to a build subresource
 	reflect.TypeOf(&deployapi.DeploymentLog{}),                        // masks calls to a deploymentConfig subresource
+	reflect.TypeOf(&deployapi.DeploymentConfigRollbackDiff{}),         // this object is only returned, never accepted
 	reflect.TypeOf(&imageapi.ImageStreamImage{}),                      // this object is only returned, never accepted
 	reflect.TypeOf(&imageapi.ImageStreamTag{}),                        // this object is only returned, never accepted
 	reflect.TypeOf(&authorizationapi.IsPersonalSubjectAccessReview{}), // only an api type for runtime.EmbeddedObject, never accepted
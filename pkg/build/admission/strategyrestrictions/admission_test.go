@@ -81,6 +81,15 @@ func TestBuildAdmission(t *testing.T) {
 			expectedResource: authorizationapi.CustomBuildResource,
 			expectAccept:     true,
 		},
+		{
+			name:             "denied jenkins pipeline build",
+			object:           testBuild(buildapi.BuildStrategy{JenkinsPipelineStrategy: &buildapi.JenkinsPipelineBuildStrategy{}}),
+			kind:             buildapi.Kind("Build"),
+			resource:         buildsResource,
+			reviewResponse:   reviewResponse(false, "cannot create build of type jenkins pipeline build"),
+			expectAccept:     false,
+			expectedResource: authorizationapi.JenkinsPipelineBuildResource,
+		},
 		{
 			name:             "allowed build config",
 			object:           testBuildConfig(buildapi.BuildStrategy{DockerStrategy: &buildapi.DockerBuildStrategy{}}),
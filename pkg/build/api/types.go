@@ -17,8 +17,17 @@ const (
 	BuildCloneAnnotation = "openshift.io/build.clone-of"
 	// BuildPodNameAnnotation is an annotation whose value is the name of the pod running this build
 	BuildPodNameAnnotation = "openshift.io/build.pod-name"
+	// BuildAcceptedAnnotation is an annotation used to signal the build controller that a
+	// Build waiting on the run policy of its BuildConfig may now be able to run.
+	BuildAcceptedAnnotation = "openshift.io/build.accepted"
+	// BuildCompletionHandledAnnotation is an annotation set by the build controller on a
+	// completed Build once it started the next build of its BuildConfig and pruned its history.
+	BuildCompletionHandledAnnotation = "openshift.io/build.completion-handled"
 	// BuildLabel is the key of a Pod label whose value is the Name of a Build which is run.
 	BuildLabel = "openshift.io/build.name"
+	// BuildRunPolicyLabel is the key of a Build label whose value is the run policy of the
+	// BuildConfig the Build was created from.
+	BuildRunPolicyLabel = "openshift.io/build.start-policy"
 	// DefaultDockerLabelNamespace is the key of a Build label, whose values are build metadata.
 	DefaultDockerLabelNamespace = "io.openshift."
 	// OriginVersion is an environment variable key that indicates the version of origin that
@@ -30,6 +39,12 @@ const (
 	// DropCapabilities is an environment variable that contains a list of capabilities to drop when
 	// executing a Source build
 	DropCapabilities = "DROP_CAPS"
+	// WebHookSecretKey is the key of the value used to verify webhook payload
+	// signatures in the Secret referenced by a WebHookTrigger.
+	WebHookSecretKey = "WebHookSecretKey"
+	// CommitStatusTokenKey is the key of the API token used to report build
+	// statuses in the Secret referenced by a CommitStatusReporting.
+	CommitStatusTokenKey = "CommitStatusTokenKey"
 )
 
 // Build encapsulates the inputs needed to produce a new deployable image, as well as
@@ -76,6 +91,14 @@ type BuildSpec struct {
 	// scheduled in the system, that the build may be active on a node before the
 	// system actively tries to terminate the build; value must be positive integer
 	CompletionDeadlineSeconds *int64
+
+	// Cache describes a cache of build dependencies that is restored before
+	// the build runs and saved once it completes.
+	Cache *BuildCache
+
+	// CommitStatus, if set, reports the status of the build on the commit it
+	// builds to the Git provider hosting the source.
+	CommitStatus *CommitStatusReporting
 }
 
 // BuildStatus contains the status of a build
@@ -114,6 +137,41 @@ type BuildStatus struct {
 
 	// Config is an ObjectReference to the BuildConfig this Build is based on.
 	Config *kapi.ObjectReference
+
+	// Stages contains details about each stage of a JenkinsPipeline build, as
+	// reported by the pipeline executor.
+	Stages []StageInfo
+
+	// Outputs lists the images pushed by this build, one for Build.Spec.Output.To
+	// followed by one for each of Build.Spec.Output.Destinations, together with
+	// the digest each push resulted in.
+	Outputs []BuildStatusOutput
+}
+
+// BuildStatusOutput describes an image pushed by a build.
+type BuildStatusOutput struct {
+	// DockerImageReference is the reference the image was pushed to.
+	DockerImageReference string
+
+	// ImageDigest is the digest of the pushed image.
+	ImageDigest string
+}
+
+// StageInfo contains details about a single stage of a pipeline build.
+type StageInfo struct {
+	// Name is the name of the stage, as defined in the Jenkinsfile.
+	Name string
+
+	// Phase is the point in the lifecycle of the stage.
+	Phase BuildPhase
+
+	// StartTimestamp is a timestamp representing the server time when this stage
+	// started running.
+	StartTimestamp *unversioned.Time
+
+	// CompletionTimestamp is a timestamp representing the server time when this
+	// stage finished, whether it failed or succeeded.
+	CompletionTimestamp *unversioned.Time
 }
 
 // BuildPhase represents the status of a build at a point in time.
@@ -177,6 +235,15 @@ const (
 	// StatusReasonExceededRetryTimeout is an error condition when the build has
 	// not completed and retrying the build times out.
 	StatusReasonExceededRetryTimeout = "ExceededRetryTimeout"
+
+	// StatusReasonWaitingForPreviousBuild indicates that the build is waiting
+	// for earlier builds of its BuildConfig to complete, as required by the run
+	// policy of the BuildConfig.
+	StatusReasonWaitingForPreviousBuild = "WaitingForPreviousBuild"
+
+	// StatusReasonCannotStartPipeline is an error condition when the pipeline
+	// executor refuses to start a JenkinsPipeline build.
+	StatusReasonCannotStartPipeline = "CannotStartPipeline"
 )
 
 // BuildSource is the input used for the build.
@@ -248,6 +315,66 @@ type ImageSourcePath struct {
 	DestinationDir string
 }
 
+// BuildCache describes a cache of build dependencies, such as a Maven or npm
+// repository, that is restored into the build directory before a build and
+// saved from the output image after it. Exactly one of PersistentVolumeClaim
+// or Image must be set.
+type BuildCache struct {
+	// Paths lists the directories of the output image to cache and where they
+	// are restored to within the build directory.
+	Paths []ImageSourcePath
+
+	// KeyFiles lists files, relative to the build directory, whose content
+	// identifies the cache, for example pom.xml or package.json. A cache saved
+	// while these files had a different content is not restored.
+	KeyFiles []string
+
+	// PersistentVolumeClaim is the claim the cache is stored on.
+	PersistentVolumeClaim *kapi.LocalObjectReference
+
+	// Image is the ImageStreamTag or DockerImage the cache is stored in. It is
+	// pulled and pushed using the push secret of the build output.
+	Image *kapi.ObjectReference
+}
+
+// CommitStatusProvider is the type of Git provider build statuses are
+// reported to.
+type CommitStatusProvider string
+
+const (
+	// GitHubCommitStatusProvider reports build statuses with the GitHub
+	// statuses API.
+	GitHubCommitStatusProvider CommitStatusProvider = "GitHub"
+
+	// GitLabCommitStatusProvider reports build statuses with the GitLab
+	// commit status API.
+	GitLabCommitStatusProvider CommitStatusProvider = "GitLab"
+)
+
+// CommitStatusReporting describes how the status of a build is reported to
+// the Git provider hosting its source. A pending status is reported once the
+// build pod is running and the final status once the build completes, for
+// builds whose source revision is known. The statuses of pipeline builds are
+// not reported.
+type CommitStatusReporting struct {
+	// Provider is the type of Git provider the statuses are reported to.
+	Provider CommitStatusProvider
+
+	// APIURL is the base URL of the API of the provider. It must be on the
+	// host of the source repository or on its api subdomain. It defaults to
+	// https://api.github.com for GitHub and to the host of the source
+	// repository for GitLab.
+	APIURL string
+
+	// Secret is the name of a Secret holding the API token used to report
+	// the statuses under CommitStatusTokenKey.
+	Secret kapi.LocalObjectReference
+
+	// Context identifies the statuses reported by the builds. It defaults to
+	// openshift/ followed by the name of the BuildConfig.
+	Context string
+}
+
 // SecretBuildSource describes a secret and its destination directory that will be
 // used only at the build time. The content of the secret referenced here will
 // be copied into the destination directory instead of mounting.
@@ -333,6 +460,9 @@ type BuildStrategy struct {
 
 	// CustomStrategy holds the parameters to the Custom build strategy
 	CustomStrategy *CustomBuildStrategy
+
+	// JenkinsPipelineStrategy holds the parameters to the Jenkins Pipeline build strategy.
+	JenkinsPipelineStrategy *JenkinsPipelineBuildStrategy
 }
 
 // BuildStrategyType describes a particular way of performing a build.
@@ -399,6 +529,12 @@ type DockerBuildStrategy struct {
 	// DockerfilePath is the path of the Dockerfile that will be used to build the Docker image,
 	// relative to the root of the context (contextDir).
 	DockerfilePath string
+
+	// BuildArgs contains build arguments passed to the Docker build with
+	// --build-arg. Their values can be taken from secrets or config maps with
+	// valueFrom. The values taken from secrets are hidden from the build log
+	// and replaced in the history and the configuration of the image.
+	BuildArgs []kapi.EnvVar
 }
 
 // SourceBuildStrategy defines input parameters specific to an Source build.
@@ -425,6 +561,19 @@ type SourceBuildStrategy struct {
 	ForcePull bool
 }
 
+// JenkinsPipelineBuildStrategy holds parameters specific to a Jenkins Pipeline build.
+// Builds using this strategy are not run in a build pod; they are handed off to a
+// pipeline executor which reports the progress of each stage back on the Build.
+type JenkinsPipelineBuildStrategy struct {
+	// JenkinsfilePath is the optional path of the Jenkinsfile that will be used to configure the pipeline
+	// relative to the root of the context (contextDir). If both JenkinsfilePath & Jenkinsfile are
+	// both not specified, this defaults to Jenkinsfile in the root of the specified contextDir.
+	JenkinsfilePath string
+
+	// Jenkinsfile defines the optional raw contents of a Jenkinsfile which defines a Jenkins pipeline build.
+	Jenkinsfile string
+}
+
 // A BuildPostCommitSpec holds a build post commit hook specification. The hook
 // executes a command in a temporary container running the build output image,
 // immediately after the last layer of the image is committed and before the
@@ -527,6 +676,23 @@ type BuildOutput struct {
 	// up the authentication for executing the Docker push to authentication
 	// enabled Docker Registry (or Docker Hub).
 	PushSecret *kapi.LocalObjectReference
+
+	// Destinations lists additional locations the output image is pushed to
+	// once it has been pushed to To, such as further tags or external
+	// registries. To must be set when destinations are specified.
+	Destinations []BuildOutputDestination
+}
+
+// BuildOutputDestination describes an additional location the output image of
+// a build is pushed to.
+type BuildOutputDestination struct {
+	// To is the location to push the output image to. Kind must be one of
+	// 'ImageStreamTag' or 'DockerImage'.
+	To kapi.ObjectReference
+
+	// PushSecret is the name of a Secret used to authenticate the push to
+	// this destination. If not set, PushSecret of the build output is used.
+	PushSecret *kapi.LocalObjectReference
 }
 
 const (
@@ -539,6 +705,9 @@ const (
 	// BuildConfigPausedAnnotation is an annotation that marks a BuildConfig as paused.
 	// New Builds cannot be instantiated from a paused BuildConfig.
 	BuildConfigPausedAnnotation = "openshift.io/build-config.paused"
+	// BuildPullRequestLabel is the key of a Build label whose value is the
+	// number of the pull request the Build was created for.
+	BuildPullRequestLabel = "openshift.io/build.pull-request"
 )
 
 // BuildConfig is a template which can be used to create new builds.
@@ -559,10 +728,40 @@ type BuildConfigSpec struct {
 	// are defined, a new build can only occur as a result of an explicit client build creation.
 	Triggers []BuildTriggerPolicy
 
+	// RunPolicy describes how the new builds created from this BuildConfig will be
+	// scheduled for execution.
+	RunPolicy BuildRunPolicy
+
+	// SuccessfulBuildsHistoryLimit is the number of old successful builds to retain.
+	// If not specified, all successful builds are retained.
+	SuccessfulBuildsHistoryLimit *int
+
+	// FailedBuildsHistoryLimit is the number of old failed, errored and cancelled
+	// builds to retain. If not specified, all failed builds are retained.
+	FailedBuildsHistoryLimit *int
+
 	// BuildSpec is the desired build specification
 	BuildSpec
 }
 
+// BuildRunPolicy defines the behaviour of how the new builds are executed
+// from the existing BuildConfig.
+type BuildRunPolicy string
+
+const (
+	// BuildRunPolicyParallel schedules new builds immediately after they are
+	// created. Builds will be executed in parallel.
+	BuildRunPolicyParallel BuildRunPolicy = "Parallel"
+
+	// BuildRunPolicySerial schedules new builds to execute in a sequence as
+	// they are created. Every build waits for the previous build to complete.
+	BuildRunPolicySerial BuildRunPolicy = "Serial"
+
+	// BuildRunPolicySerialLatestOnly schedules only the latest build to execute,
+	// cancelling all the previously queued builds.
+	BuildRunPolicySerialLatestOnly BuildRunPolicy = "SerialLatestOnly"
+)
+
 // BuildConfigStatus contains current state of the build config object.
 type BuildConfigStatus struct {
 	// LastVersion is used to inform about number of last triggered build.
@@ -573,6 +772,33 @@ type BuildConfigStatus struct {
 type WebHookTrigger struct {
 	// Secret used to validate requests.
 	Secret string
+
+	// SecretReference is the name of a Secret holding the key used to verify
+	// the signature of the request payload. The Secret must contain the key
+	// under WebHookSecretKey. Only GitHub webhooks support payload signatures,
+	// when set the Secret is optional.
+	SecretReference *kapi.LocalObjectReference
+
+	// PullRequests, if set, enables builds of the pull requests opened against
+	// the branch built by the BuildConfig. Only GitHub webhooks support pull
+	// request events.
+	PullRequests *PullRequestBuildPolicy
+}
+
+// PullRequestBuildPolicy defines how the builds of pull requests are created.
+type PullRequestBuildPolicy struct {
+	// OutputTagPrefix is prepended to the number of a pull request to form the
+	// tag its builds push their output to, e.g. "pr-" pushes the builds of
+	// pull request 123 to the "pr-123" tag. If empty, the output of pull request
+	// builds is not pushed.
+	OutputTagPrefix string
+
+	// AllowForkSecrets gives the builds of pull requests opened from forks of
+	// the repository access to the secrets of the BuildConfig. By default the
+	// build secrets, the build args read from secrets and the secrets custom
+	// builders can read are removed from the builds of forks, which run code
+	// not reviewed yet. The source secret is always kept.
+	AllowForkSecrets bool
 }
 
 // ImageChangeTrigger allows builds to be triggered when an ImageStream changes
@@ -599,6 +825,12 @@ type BuildTriggerPolicy struct {
 	// GenericWebHook contains the parameters for a Generic webhook type of trigger
 	GenericWebHook *WebHookTrigger
 
+	// GitLabWebHook contains the parameters for a GitLab webhook type of trigger
+	GitLabWebHook *WebHookTrigger
+
+	// BitbucketWebHook contains the parameters for a Bitbucket webhook type of trigger
+	BitbucketWebHook *WebHookTrigger
+
 	// ImageChange contains parameters for an ImageChange type of trigger
 	ImageChange *ImageChangeTrigger
 }
@@ -610,6 +842,8 @@ type BuildTriggerType string
 var KnownTriggerTypes = sets.NewString(
 	string(GitHubWebHookBuildTriggerType),
 	string(GenericWebHookBuildTriggerType),
+	string(GitLabWebHookBuildTriggerType),
+	string(BitbucketWebHookBuildTriggerType),
 	string(ImageChangeBuildTriggerType),
 	string(ConfigChangeBuildTriggerType),
 )
@@ -625,6 +859,14 @@ const (
 	GenericWebHookBuildTriggerType           BuildTriggerType = "Generic"
 	GenericWebHookBuildTriggerTypeDeprecated BuildTriggerType = "generic"
 
+	// GitLabWebHookBuildTriggerType represents a trigger that launches builds on
+	// GitLab webhook invocations
+	GitLabWebHookBuildTriggerType BuildTriggerType = "GitLab"
+
+	// BitbucketWebHookBuildTriggerType represents a trigger that launches builds on
+	// Bitbucket webhook invocations
+	BitbucketWebHookBuildTriggerType BuildTriggerType = "Bitbucket"
+
 	// ImageChangeBuildTriggerType represents a trigger that launches builds on
 	// availability of a new version of an image
 	ImageChangeBuildTriggerType           BuildTriggerType = "ImageChange"
@@ -707,6 +949,28 @@ type BuildRequest struct {
 
 	// Env contains additional environment variables you want to pass into a builder container
 	Env []kapi.EnvVar
+
+	// PullRequest is the pull request the build is requested for. The build
+	// checks out the head of the pull request and its output tag is replaced.
+	PullRequest *PullRequestReference
+}
+
+// PullRequestReference identifies the pull request a build is requested for.
+type PullRequestReference struct {
+	// Number is the number of the pull request.
+	Number int
+
+	// Ref is the ref of the head of the pull request in the repository of the
+	// BuildConfig, e.g. refs/pull/123/head.
+	Ref string
+
+	// Fork is true if the head of the pull request is in another repository
+	// than the one of the BuildConfig.
+	Fork bool
+
+	// OutputTag is the tag the output of the build is pushed to. If empty,
+	// the output of the build is not pushed.
+	OutputTag string
 }
 
 type BinaryBuildRequestOptions struct {
@@ -199,6 +199,8 @@ func convert_api_BuildStrategy_To_v1_BuildStrategy(in *newer.BuildStrategy, out
 		out.Type = DockerBuildStrategyType
 	case in.CustomStrategy != nil:
 		out.Type = CustomBuildStrategyType
+	case in.JenkinsPipelineStrategy != nil:
+		out.Type = JenkinsPipelineBuildStrategyType
 	}
 	return nil
 }
@@ -221,6 +223,9 @@ func addConversionFuncs(scheme *runtime.Scheme) {
 			if (strategy != nil) && (strategy.Type == DockerBuildStrategyType) && (strategy.DockerStrategy == nil) {
 				strategy.DockerStrategy = &DockerBuildStrategy{}
 			}
+			if (strategy != nil) && (strategy.Type == JenkinsPipelineBuildStrategyType) && (strategy.JenkinsPipelineStrategy == nil) {
+				strategy.JenkinsPipelineStrategy = &JenkinsPipelineBuildStrategy{}
+			}
 		},
 		func(obj *SourceBuildStrategy) {
 			if len(obj.From.Kind) == 0 {
@@ -237,6 +242,11 @@ func addConversionFuncs(scheme *runtime.Scheme) {
 				obj.From.Kind = "ImageStreamTag"
 			}
 		},
+		func(obj *BuildConfigSpec) {
+			if len(obj.RunPolicy) == 0 {
+				obj.RunPolicy = BuildRunPolicyParallel
+			}
+		},
 		func(obj *BuildTriggerPolicy) {
 			if obj.Type == ImageChangeBuildTriggerType && obj.ImageChange == nil {
 				obj.ImageChange = &ImageChangeTrigger{}
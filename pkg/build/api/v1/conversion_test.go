@@ -81,6 +81,10 @@ func TestBuildConfigDefaulting(t *testing.T) {
 	if dockerStrategy == (*newer.DockerBuildStrategy)(nil) || !reflect.DeepEqual(*dockerStrategy, newer.DockerBuildStrategy{}) {
 		t.Errorf("Expected non-nil but empty Strategy.DockerStrategy as default for Spec")
 	}
+
+	if internalBuild.Spec.RunPolicy != newer.BuildRunPolicyParallel {
+		t.Errorf("Expected RunPolicy to default to %s, got %s", newer.BuildRunPolicyParallel, internalBuild.Spec.RunPolicy)
+	}
 }
 
 func TestBuildConfigConversion(t *testing.T) {
@@ -41,6 +41,18 @@ func (Build) SwaggerDoc() map[string]string {
 	return map_Build
 }
 
+var map_BuildCache = map[string]string{
+	"":                      "BuildCache describes a cache of build dependencies, such as a Maven or npm repository, that is restored into the build directory before a build and saved from the output image after it. Exactly one of PersistentVolumeClaim or Image must be set.",
+	"paths":                 "Paths lists the directories of the output image to cache and where they are restored to within the build directory.",
+	"keyFiles":              "KeyFiles lists files, relative to the build directory, whose content identifies the cache, for example pom.xml or package.json. A cache saved while these files had a different content is not restored.",
+	"persistentVolumeClaim": "PersistentVolumeClaim is the claim the cache is stored on.",
+	"image":                 "Image is the ImageStreamTag or DockerImage the cache is stored in. It is pulled and pushed using the push secret of the build output.",
+}
+
+func (BuildCache) SwaggerDoc() map[string]string {
+	return map_BuildCache
+}
+
 var map_BuildConfig = map[string]string{
 	"":         "BuildConfig is a template which can be used to create new builds.",
 	"metadata": "Standard object's metadata.",
@@ -63,8 +75,11 @@ func (BuildConfigList) SwaggerDoc() map[string]string {
 }
 
 var map_BuildConfigSpec = map[string]string{
-	"":         "BuildConfigSpec describes when and how builds are created",
-	"triggers": "Triggers determine how new Builds can be launched from a BuildConfig. If no triggers are defined, a new build can only occur as a result of an explicit client build creation.",
+	"":                             "BuildConfigSpec describes when and how builds are created",
+	"triggers":                     "Triggers determine how new Builds can be launched from a BuildConfig. If no triggers are defined, a new build can only occur as a result of an explicit client build creation.",
+	"runPolicy":                    "RunPolicy describes how the new builds created from this BuildConfig will be scheduled for execution. This is optional, if not specified we default to \"Parallel\".",
+	"successfulBuildsHistoryLimit": "SuccessfulBuildsHistoryLimit is the number of old successful builds to retain. If not specified, all successful builds are retained.",
+	"failedBuildsHistoryLimit":     "FailedBuildsHistoryLimit is the number of old failed, errored and cancelled builds to retain. If not specified, all failed builds are retained.",
 }
 
 func (BuildConfigSpec) SwaggerDoc() map[string]string {
@@ -117,15 +132,26 @@ func (BuildLogOptions) SwaggerDoc() map[string]string {
 }
 
 var map_BuildOutput = map[string]string{
-	"":           "BuildOutput is input to a build strategy and describes the Docker image that the strategy should produce.",
-	"to":         "To defines an optional location to push the output of this build to. Kind must be one of 'ImageStreamTag' or 'DockerImage'. This value will be used to look up a Docker image repository to push to. In the case of an ImageStreamTag, the ImageStreamTag will be looked for in the namespace of the build unless Namespace is specified.",
-	"pushSecret": "PushSecret is the name of a Secret that would be used for setting up the authentication for executing the Docker push to authentication enabled Docker Registry (or Docker Hub).",
+	"":             "BuildOutput is input to a build strategy and describes the Docker image that the strategy should produce.",
+	"to":           "To defines an optional location to push the output of this build to. Kind must be one of 'ImageStreamTag' or 'DockerImage'. This value will be used to look up a Docker image repository to push to. In the case of an ImageStreamTag, the ImageStreamTag will be looked for in the namespace of the build unless Namespace is specified.",
+	"pushSecret":   "PushSecret is the name of a Secret that would be used for setting up the authentication for executing the Docker push to authentication enabled Docker Registry (or Docker Hub).",
+	"destinations": "Destinations lists additional locations the output image is pushed to once it has been pushed to To, such as further tags or external registries. To must be set when destinations are specified.",
 }
 
 func (BuildOutput) SwaggerDoc() map[string]string {
 	return map_BuildOutput
 }
 
+var map_BuildOutputDestination = map[string]string{
+	"":           "BuildOutputDestination describes an additional location the output image of a build is pushed to.",
+	"to":         "To is the location to push the output image to. Kind must be one of 'ImageStreamTag' or 'DockerImage'.",
+	"pushSecret": "PushSecret is the name of a Secret used to authenticate the push to this destination. If not set, PushSecret of the build output is used.",
+}
+
+func (BuildOutputDestination) SwaggerDoc() map[string]string {
+	return map_BuildOutputDestination
+}
+
 var map_BuildPostCommitSpec = map[string]string{
 	"":        "A BuildPostCommitSpec holds a build post commit hook specification. The hook executes a command in a temporary container running the build output image, immediately after the last layer of the image is committed and before the image is pushed to a registry. The command is executed with the current working directory ($PWD) set to the image's WORKDIR.\n\nThe build will be marked as failed if the hook execution fails. It will fail if the script or command return a non-zero exit code, or if there is any other error related to starting the temporary container.\n\nThere are five different ways to configure the hook. As an example, all forms below are equivalent and will execute `rake test --verbose`.\n\n1. Shell script:\n\n\tBuildPostCommitSpec{\n\t\tScript: \"rake test --verbose\",\n\t}\n\nThe above is a convenient form which is equivalent to:\n\n\tBuildPostCommitSpec{\n\t\tCommand: []string{\"/bin/sh\", \"-ic\"},\n\t\tArgs: []string{\"rake test --verbose\"},\n\t}\n\n2. Command as the image entrypoint:\n\n\tBuildPostCommitSpec{\n\t\tCommand: []string{\"rake\", \"test\", \"--verbose\"},\n\t}\n\nCommand overrides the image entrypoint in the exec form, as documented in Docker: https://docs.docker.com/engine/reference/builder/#entrypoint.\n\n3. Pass arguments to the default entrypoint:\n\n\tBuildPostCommitSpec{\n\t\tArgs: []string{\"rake\", \"test\", \"--verbose\"},\n\t}\n\nThis form is only useful if the image entrypoint can handle arguments.\n\n4. Shell script with arguments:\n\n\tBuildPostCommitSpec{\n\t\tScript: \"rake test $1\",\n\t\tArgs: []string{\"--verbose\"},\n\t}\n\nThis form is useful if you need to pass arguments that would otherwise be hard to quote properly in the shell script. In the script, $0 will be \"/bin/sh\" and $1, $2, etc, are the positional arguments from Args.\n\n5. Command with arguments:\n\n\tBuildPostCommitSpec{\n\t\tCommand: []string{\"rake\", \"test\"},\n\t\tArgs: []string{\"--verbose\"},\n\t}\n\nThis form is equivalent to appending the arguments to the Command slice.\n\nIt is invalid to provide both Script and Command simultaneously. If none of the fields are specified, the hook is not executed.",
 	"command": "Command is the command to run. It may not be specified with Script. This might be needed if the image doesn't have `/bin/sh`, or if you do not want to use a shell. In all other cases, using Script might be more convenient.",
@@ -146,6 +172,7 @@ var map_BuildRequest = map[string]string{
 	"binary":           "Binary indicates a request to build from a binary provided to the builder",
 	"lastVersion":      "LastVersion (optional) is the LastVersion of the BuildConfig that was used to generate the build. If the BuildConfig in the generator doesn't match, a build will not be generated.",
 	"env":              "Env contains additional environment variables you want to pass into a builder container",
+	"pullRequest":      "PullRequest is the pull request the build is requested for. The build checks out the head of the pull request and its output tag is replaced.",
 }
 
 func (BuildRequest) SwaggerDoc() map[string]string {
@@ -178,6 +205,8 @@ var map_BuildSpec = map[string]string{
 	"resources":                 "Compute resource requirements to execute the build",
 	"postCommit":                "PostCommit is a build hook executed after the build output image is committed, before it is pushed to a registry.",
 	"completionDeadlineSeconds": "Optional duration in seconds, counted from the time when a build pod gets scheduled in the system, that the build may be active on a node before the system actively tries to terminate the build; value must be positive integer",
+	"cache":                     "Cache describes a cache of build dependencies that is restored before the build runs and saved once it completes.",
+	"commitStatus":              "CommitStatus, if set, reports the status of the build on the commit it builds to the Git provider hosting the source.",
 }
 
 func (BuildSpec) SwaggerDoc() map[string]string {
@@ -195,18 +224,31 @@ var map_BuildStatus = map[string]string{
 	"duration":                   "Duration contains time.Duration object describing build time.",
 	"outputDockerImageReference": "OutputDockerImageReference contains a reference to the Docker image that will be built by this build. Its value is computed from Build.Spec.Output.To, and should include the registry address, so that it can be used to push and pull the image.",
 	"config":                     "Config is an ObjectReference to the BuildConfig this Build is based on.",
+	"stages":                     "Stages contains details about each stage of a JenkinsPipeline build, as reported by the pipeline executor.",
+	"outputs":                    "Outputs lists the images pushed by this build, one for Build.Spec.Output.To followed by one for each of Build.Spec.Output.Destinations, together with the digest each push resulted in.",
 }
 
 func (BuildStatus) SwaggerDoc() map[string]string {
 	return map_BuildStatus
 }
 
+var map_BuildStatusOutput = map[string]string{
+	"":                     "BuildStatusOutput describes an image pushed by a build.",
+	"dockerImageReference": "DockerImageReference is the reference the image was pushed to.",
+	"imageDigest":          "ImageDigest is the digest of the pushed image.",
+}
+
+func (BuildStatusOutput) SwaggerDoc() map[string]string {
+	return map_BuildStatusOutput
+}
+
 var map_BuildStrategy = map[string]string{
-	"":               "BuildStrategy contains the details of how to perform a build.",
-	"type":           "Type is the kind of build strategy.",
-	"dockerStrategy": "DockerStrategy holds the parameters to the Docker build strategy.",
-	"sourceStrategy": "SourceStrategy holds the parameters to the Source build strategy.",
-	"customStrategy": "CustomStrategy holds the parameters to the Custom build strategy",
+	"":                        "BuildStrategy contains the details of how to perform a build.",
+	"type":                    "Type is the kind of build strategy.",
+	"dockerStrategy":          "DockerStrategy holds the parameters to the Docker build strategy.",
+	"sourceStrategy":          "SourceStrategy holds the parameters to the Source build strategy.",
+	"customStrategy":          "CustomStrategy holds the parameters to the Custom build strategy",
+	"jenkinsPipelineStrategy": "JenkinsPipelineStrategy holds the parameters to the Jenkins Pipeline build strategy.",
 }
 
 func (BuildStrategy) SwaggerDoc() map[string]string {
@@ -218,6 +260,8 @@ var map_BuildTriggerPolicy = map[string]string{
 	"type":        "Type is the type of build trigger",
 	"github":      "GitHubWebHook contains the parameters for a GitHub webhook type of trigger",
 	"generic":     "GenericWebHook contains the parameters for a Generic webhook type of trigger",
+	"gitlab":      "GitLabWebHook contains the parameters for a GitLab webhook type of trigger",
+	"bitbucket":   "BitbucketWebHook contains the parameters for a Bitbucket webhook type of trigger",
 	"imageChange": "ImageChange contains parameters for an ImageChange type of trigger",
 }
 
@@ -225,6 +269,18 @@ func (BuildTriggerPolicy) SwaggerDoc() map[string]string {
 	return map_BuildTriggerPolicy
 }
 
+var map_CommitStatusReporting = map[string]string{
+	"":         "CommitStatusReporting describes how the status of a build is reported to the Git provider hosting its source. A pending status is reported once the build pod is running and the final status once the build completes, for builds whose source revision is known. The statuses of pipeline builds are not reported.",
+	"provider": "Provider is the type of Git provider the statuses are reported to.",
+	"apiURL":   "APIURL is the base URL of the API of the provider. It must be on the host of the source repository or on its api subdomain. It defaults to https://api.github.com for GitHub and to the host of the source repository for GitLab.",
+	"secret":   "Secret is the name of a Secret holding the API token used to report the statuses under CommitStatusTokenKey.",
+	"context":  "Context identifies the statuses reported by the builds. It defaults to openshift/ followed by the name of the BuildConfig.",
+}
+
+func (CommitStatusReporting) SwaggerDoc() map[string]string {
+	return map_CommitStatusReporting
+}
+
 var map_CustomBuildStrategy = map[string]string{
 	"":                   "CustomBuildStrategy defines input parameters specific to Custom build.",
 	"from":               "From is reference to an DockerImage, ImageStreamTag, or ImageStreamImage from which the docker image should be pulled",
@@ -248,6 +304,7 @@ var map_DockerBuildStrategy = map[string]string{
 	"env":            "Env contains additional environment variables you want to pass into a builder container",
 	"forcePull":      "ForcePull describes if the builder should pull the images from registry prior to building.",
 	"dockerfilePath": "DockerfilePath is the path of the Dockerfile that will be used to build the Docker image, relative to the root of the context (contextDir).",
+	"buildArgs":      "BuildArgs contains build arguments passed to the Docker build with --build-arg. Their values can be taken from secrets or config maps with valueFrom. The values taken from secrets are hidden from the build log and replaced in the history and the configuration of the image.",
 }
 
 func (DockerBuildStrategy) SwaggerDoc() map[string]string {
@@ -327,6 +384,38 @@ func (ImageSourcePath) SwaggerDoc() map[string]string {
 	return map_ImageSourcePath
 }
 
+var map_JenkinsPipelineBuildStrategy = map[string]string{
+	"":                "JenkinsPipelineBuildStrategy holds parameters specific to a Jenkins Pipeline build. Builds using this strategy are not run in a build pod; they are handed off to a pipeline executor which reports the progress of each stage back on the Build.",
+	"jenkinsfilePath": "JenkinsfilePath is the optional path of the Jenkinsfile that will be used to configure the pipeline relative to the root of the context (contextDir). If both JenkinsfilePath & Jenkinsfile are both not specified, this defaults to Jenkinsfile in the root of the specified contextDir.",
+	"jenkinsfile":     "Jenkinsfile defines the optional raw contents of a Jenkinsfile which defines a Jenkins pipeline build.",
+}
+
+func (JenkinsPipelineBuildStrategy) SwaggerDoc() map[string]string {
+	return map_JenkinsPipelineBuildStrategy
+}
+
+var map_PullRequestBuildPolicy = map[string]string{
+	"":                 "PullRequestBuildPolicy defines how the builds of pull requests are created.",
+	"outputTagPrefix":  "OutputTagPrefix is prepended to the number of a pull request to form the tag its builds push their output to, e.g. \"pr-\" pushes the builds of pull request 123 to the \"pr-123\" tag. If empty, the output of pull request builds is not pushed.",
+	"allowForkSecrets": "AllowForkSecrets gives the builds of pull requests opened from forks of the repository access to the secrets of the BuildConfig. By default the build secrets, the build args read from secrets and the secrets custom builders can read are removed from the builds of forks, which run code not reviewed yet. The source secret is always kept.",
+}
+
+func (PullRequestBuildPolicy) SwaggerDoc() map[string]string {
+	return map_PullRequestBuildPolicy
+}
+
+var map_PullRequestReference = map[string]string{
+	"":          "PullRequestReference identifies the pull request a build is requested for.",
+	"number":    "Number is the number of the pull request.",
+	"ref":       "Ref is the ref of the head of the pull request in the repository of the BuildConfig, e.g. refs/pull/123/head.",
+	"fork":      "Fork is true if the head of the pull request is in another repository than the one of the BuildConfig.",
+	"outputTag": "OutputTag is the tag the output of the build is pushed to. If empty, the output of the build is not pushed.",
+}
+
+func (PullRequestReference) SwaggerDoc() map[string]string {
+	return map_PullRequestReference
+}
+
 var map_SecretBuildSource = map[string]string{
 	"":               "SecretBuildSource describes a secret and its destination directory that will be used only at the build time. The content of the secret referenced here will be copied into the destination directory instead of mounting.",
 	"secret":         "Secret is a reference to an existing secret that you want to use in your build.",
@@ -381,9 +470,23 @@ func (SourceRevision) SwaggerDoc() map[string]string {
 	return map_SourceRevision
 }
 
+var map_StageInfo = map[string]string{
+	"":                    "StageInfo contains details about a single stage of a pipeline build.",
+	"name":                "Name is the name of the stage, as defined in the Jenkinsfile.",
+	"phase":               "Phase is the point in the lifecycle of the stage.",
+	"startTimestamp":      "StartTimestamp is a timestamp representing the server time when this stage started running.",
+	"completionTimestamp": "CompletionTimestamp is a timestamp representing the server time when this stage finished, whether it failed or succeeded.",
+}
+
+func (StageInfo) SwaggerDoc() map[string]string {
+	return map_StageInfo
+}
+
 var map_WebHookTrigger = map[string]string{
-	"":       "WebHookTrigger is a trigger that gets invoked using a webhook type of post",
-	"secret": "Secret used to validate requests.",
+	"":                "WebHookTrigger is a trigger that gets invoked using a webhook type of post",
+	"secret":          "Secret used to validate requests.",
+	"secretReference": "SecretReference is the name of a Secret holding the key used to verify the signature of the request payload. The Secret must contain the key under WebHookSecretKey. Only GitHub webhooks support payload signatures, when set the Secret is optional.",
+	"pullRequests":    "PullRequests, if set, enables builds of the pull requests opened against the branch built by the BuildConfig. Only GitHub webhooks support pull request events.",
 }
 
 func (WebHookTrigger) SwaggerDoc() map[string]string {
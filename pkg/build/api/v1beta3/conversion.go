@@ -136,6 +136,10 @@ func convert_v1beta3_BuildTriggerPolicy_To_api_BuildTriggerPolicy(in *BuildTrigg
 		out.Type = newer.GenericWebHookBuildTriggerType
 	case GitHubWebHookBuildTriggerType:
 		out.Type = newer.GitHubWebHookBuildTriggerType
+	case GitLabWebHookBuildTriggerType:
+		out.Type = newer.GitLabWebHookBuildTriggerType
+	case BitbucketWebHookBuildTriggerType:
+		out.Type = newer.BitbucketWebHookBuildTriggerType
 	}
 	return nil
 }
@@ -151,6 +155,10 @@ func convert_api_BuildTriggerPolicy_To_v1beta3_BuildTriggerPolicy(in *newer.Buil
 		out.Type = GenericWebHookBuildTriggerType
 	case newer.GitHubWebHookBuildTriggerType:
 		out.Type = GitHubWebHookBuildTriggerType
+	case newer.GitLabWebHookBuildTriggerType:
+		out.Type = GitLabWebHookBuildTriggerType
+	case newer.BitbucketWebHookBuildTriggerType:
+		out.Type = BitbucketWebHookBuildTriggerType
 	}
 	return nil
 }
@@ -214,6 +222,8 @@ func convert_api_BuildStrategy_To_v1beta3_BuildStrategy(in *newer.BuildStrategy,
 		out.Type = DockerBuildStrategyType
 	case in.CustomStrategy != nil:
 		out.Type = CustomBuildStrategyType
+	case in.JenkinsPipelineStrategy != nil:
+		out.Type = JenkinsPipelineBuildStrategyType
 	}
 	return nil
 }
@@ -243,6 +253,11 @@ func addConversionFuncs(scheme *runtime.Scheme) {
 				obj.From.Kind = "ImageStreamTag"
 			}
 		},
+		func(obj *BuildConfigSpec) {
+			if len(obj.RunPolicy) == 0 {
+				obj.RunPolicy = BuildRunPolicyParallel
+			}
+		},
 		func(obj *BuildTriggerPolicy) {
 			if obj.Type == ImageChangeBuildTriggerType && obj.ImageChange == nil {
 				obj.ImageChange = &ImageChangeTrigger{}
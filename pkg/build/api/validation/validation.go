@@ -5,6 +5,7 @@ import (
 	"net/url"
 	"path"
 	"path/filepath"
+	"regexp"
 	"strings"
 
 	kapi "k8s.io/kubernetes/pkg/api"
@@ -18,6 +19,9 @@ import (
 	imageapi "github.com/openshift/origin/pkg/image/api"
 )
 
+// imageTagRegexp matches the characters allowed in image tags.
+var imageTagRegexp = regexp.MustCompile(`^[\w][\w.-]{0,127}$`)
+
 // ValidateBuild tests required fields for a Build.
 func ValidateBuild(build *buildapi.Build) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -82,11 +86,37 @@ func ValidateBuildConfig(config *buildapi.BuildConfig) field.ErrorList {
 		fromRefs[fromKey] = struct{}{}
 	}
 
+	allErrs = append(allErrs, validateRunPolicy(config.Spec.RunPolicy, specPath.Child("runPolicy"))...)
+	allErrs = append(allErrs, validateHistoryLimit(config.Spec.SuccessfulBuildsHistoryLimit, specPath.Child("successfulBuildsHistoryLimit"))...)
+	allErrs = append(allErrs, validateHistoryLimit(config.Spec.FailedBuildsHistoryLimit, specPath.Child("failedBuildsHistoryLimit"))...)
 	allErrs = append(allErrs, validateBuildSpec(&config.Spec.BuildSpec, specPath)...)
 
 	return allErrs
 }
 
+// validateRunPolicy ensures the run policy is one of the supported policies.
+// An empty run policy is allowed and treated as Parallel.
+func validateRunPolicy(policy buildapi.BuildRunPolicy, fldPath *field.Path) field.ErrorList {
+	switch policy {
+	case "", buildapi.BuildRunPolicyParallel, buildapi.BuildRunPolicySerial, buildapi.BuildRunPolicySerialLatestOnly:
+		return nil
+	}
+	validPolicies := []string{
+		string(buildapi.BuildRunPolicyParallel),
+		string(buildapi.BuildRunPolicySerial),
+		string(buildapi.BuildRunPolicySerialLatestOnly),
+	}
+	return field.ErrorList{field.NotSupported(fldPath, policy, validPolicies)}
+}
+
+// validateHistoryLimit ensures a build history limit, if set, is not negative.
+func validateHistoryLimit(limit *int, fldPath *field.Path) field.ErrorList {
+	if limit != nil && *limit < 0 {
+		return field.ErrorList{field.Invalid(fldPath, *limit, "must be greater than or equal to 0")}
+	}
+	return nil
+}
+
 func ValidateBuildConfigUpdate(config *buildapi.BuildConfig, older *buildapi.BuildConfig) field.ErrorList {
 	allErrs := field.ErrorList{}
 	allErrs = append(allErrs, validation.ValidateObjectMetaUpdate(&config.ObjectMeta, &older.ObjectMeta, field.NewPath("metadata"))...)
@@ -96,14 +126,26 @@ func ValidateBuildConfigUpdate(config *buildapi.BuildConfig, older *buildapi.Bui
 
 // ValidateBuildRequest validates a BuildRequest object
 func ValidateBuildRequest(request *buildapi.BuildRequest) field.ErrorList {
-	return validation.ValidateObjectMeta(&request.ObjectMeta, true, oapi.MinimalNameRequirements, field.NewPath("metadata"))
+	allErrs := validation.ValidateObjectMeta(&request.ObjectMeta, true, oapi.MinimalNameRequirements, field.NewPath("metadata"))
+	if pr := request.PullRequest; pr != nil {
+		fldPath := field.NewPath("pullRequest")
+		if pr.Number <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("number"), pr.Number, "must be a positive integer"))
+		}
+		if len(pr.OutputTag) > 0 && !imageTagRegexp.MatchString(pr.OutputTag) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("outputTag"), pr.OutputTag, "must be a valid image tag"))
+		}
+	}
+	return allErrs
 }
 
 func validateBuildSpec(spec *buildapi.BuildSpec, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	s := spec.Strategy
 
-	if s.CustomStrategy == nil && spec.Source.Git == nil && spec.Source.Binary == nil && spec.Source.Dockerfile == nil {
+	// a pipeline build with an inline Jenkinsfile does not require any source
+	hasInlineJenkinsfile := s.JenkinsPipelineStrategy != nil && len(s.JenkinsPipelineStrategy.Jenkinsfile) != 0
+	if s.CustomStrategy == nil && !hasInlineJenkinsfile && spec.Source.Git == nil && spec.Source.Binary == nil && spec.Source.Dockerfile == nil {
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("source"), spec.Source, "must provide a value for at least one of source, binary, or dockerfile"))
 	}
 
@@ -118,12 +160,21 @@ func validateBuildSpec(spec *buildapi.BuildSpec, fldPath *field.Path) field.Erro
 	allErrs = append(allErrs, validateOutput(&spec.Output, fldPath.Child("output"))...)
 	allErrs = append(allErrs, validateStrategy(&spec.Strategy, fldPath.Child("strategy"))...)
 	allErrs = append(allErrs, validatePostCommit(spec.PostCommit, fldPath.Child("postCommit"))...)
+	if spec.Cache != nil {
+		allErrs = append(allErrs, validateBuildCache(spec.Cache, &spec.Strategy, fldPath.Child("cache"))...)
+	}
+	if spec.CommitStatus != nil {
+		allErrs = append(allErrs, validateCommitStatus(spec.CommitStatus, &spec.Source, fldPath.Child("commitStatus"))...)
+	}
 
 	// TODO: validate resource requirements (prereq: https://github.com/kubernetes/kubernetes/pull/7059)
 	return allErrs
 }
 
-const maxDockerfileLengthBytes = 60 * 1000
+const (
+	maxDockerfileLengthBytes  = 60 * 1000
+	maxJenkinsfileLengthBytes = 100 * 1000
+)
 
 func hasProxy(source *buildapi.GitBuildSource) bool {
 	return (source.HTTPProxy != nil && len(*source.HTTPProxy) > 0) || (source.HTTPSProxy != nil && len(*source.HTTPSProxy) > 0)
@@ -274,6 +325,65 @@ func validateImageSourcePath(imagePath buildapi.ImageSourcePath, fldPath *field.
 	return allErrs
 }
 
+func validateBuildCache(cache *buildapi.BuildCache, strategy *buildapi.BuildStrategy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if strategy.DockerStrategy == nil && strategy.SourceStrategy == nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, "", "a build cache may only be used with the Docker and Source strategies"))
+	}
+	if len(cache.Paths) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("paths"), ""))
+	}
+	for i, path := range cache.Paths {
+		allErrs = append(allErrs, validateImageSourcePath(path, fldPath.Child("paths").Index(i))...)
+	}
+	for i, keyFile := range cache.KeyFiles {
+		if len(keyFile) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("keyFiles").Index(i), ""))
+		} else if filepath.IsAbs(keyFile) || strings.HasPrefix(path.Clean(keyFile), "..") {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("keyFiles").Index(i), keyFile, "must be a relative path within the build directory"))
+		}
+	}
+	switch {
+	case cache.PersistentVolumeClaim != nil && cache.Image != nil:
+		allErrs = append(allErrs, field.Invalid(fldPath, "", "only one of persistentVolumeClaim or image may be specified"))
+	case cache.PersistentVolumeClaim != nil:
+		if len(cache.PersistentVolumeClaim.Name) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("persistentVolumeClaim", "name"), ""))
+		}
+	case cache.Image != nil:
+		allErrs = append(allErrs, validateToImageReference(cache.Image, fldPath.Child("image"))...)
+	default:
+		allErrs = append(allErrs, field.Required(fldPath, "one of persistentVolumeClaim or image must be specified"))
+	}
+	return allErrs
+}
+
+func validateCommitStatus(status *buildapi.CommitStatusReporting, source *buildapi.BuildSource, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if source.Git == nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, "", "build statuses may only be reported for builds from a Git source"))
+	}
+	switch status.Provider {
+	case buildapi.GitHubCommitStatusProvider, buildapi.GitLabCommitStatusProvider:
+	case "":
+		allErrs = append(allErrs, field.Required(fldPath.Child("provider"), ""))
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("provider"), status.Provider, []string{string(buildapi.GitHubCommitStatusProvider), string(buildapi.GitLabCommitStatusProvider)}))
+	}
+	if len(status.APIURL) != 0 {
+		switch {
+		case !isHTTPScheme(status.APIURL):
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("apiURL"), status.APIURL, "must be an http or https URL"))
+		case source.Git != nil && !buildutil.IsSourceRepositoryAPIURL(status.APIURL, source.Git.URI):
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("apiURL"), status.APIURL, "must be on the host of the source repository or its api subdomain"))
+		}
+	}
+	if len(status.Secret.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("secret", "name"), ""))
+	}
+	return allErrs
+}
+
 func validateBinarySource(source *buildapi.BinaryBuildSource, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	if len(source.AsFile) != 0 {
@@ -367,6 +477,15 @@ func validateOutput(output *buildapi.BuildOutput, fldPath *field.Path) field.Err
 
 	allErrs = append(allErrs, validateSecretRef(output.PushSecret, fldPath.Child("pushSecret"))...)
 
+	if len(output.Destinations) > 0 && (output.To == nil || len(output.To.Name) == 0) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("destinations"), output.Destinations, "destinations may only be specified when 'to' is set"))
+	}
+	for i := range output.Destinations {
+		destinationPath := fldPath.Child("destinations").Index(i)
+		allErrs = append(allErrs, validateToImageReference(&output.Destinations[i].To, destinationPath.Child("to"))...)
+		allErrs = append(allErrs, validateSecretRef(output.Destinations[i].PushSecret, destinationPath.Child("pushSecret"))...)
+	}
+
 	return allErrs
 }
 
@@ -383,8 +502,11 @@ func validateStrategy(strategy *buildapi.BuildStrategy, fldPath *field.Path) fie
 	if strategy.CustomStrategy != nil {
 		strategyCount++
 	}
+	if strategy.JenkinsPipelineStrategy != nil {
+		strategyCount++
+	}
 	if strategyCount != 1 {
-		return append(allErrs, field.Invalid(fldPath, strategy, "must provide a value for exactly one of sourceStrategy, customStrategy, or dockerStrategy"))
+		return append(allErrs, field.Invalid(fldPath, strategy, "must provide a value for exactly one of sourceStrategy, customStrategy, dockerStrategy, or jenkinsPipelineStrategy"))
 	}
 
 	if strategy.SourceStrategy != nil {
@@ -396,6 +518,9 @@ func validateStrategy(strategy *buildapi.BuildStrategy, fldPath *field.Path) fie
 	if strategy.CustomStrategy != nil {
 		allErrs = append(allErrs, validateCustomStrategy(strategy.CustomStrategy, fldPath.Child("customStrategy"))...)
 	}
+	if strategy.JenkinsPipelineStrategy != nil {
+		allErrs = append(allErrs, validateJenkinsPipelineStrategy(strategy.JenkinsPipelineStrategy, fldPath.Child("jenkinsPipelineStrategy"))...)
+	}
 
 	return allErrs
 }
@@ -425,6 +550,7 @@ func validateDockerStrategy(strategy *buildapi.DockerBuildStrategy, fldPath *fie
 	}
 
 	allErrs = append(allErrs, ValidateStrategyEnv(strategy.Env, fldPath.Child("env"))...)
+	allErrs = append(allErrs, validateBuildArgs(strategy.BuildArgs, fldPath.Child("buildArgs"))...)
 
 	return allErrs
 }
@@ -445,6 +571,35 @@ func validateCustomStrategy(strategy *buildapi.CustomBuildStrategy, fldPath *fie
 	return allErrs
 }
 
+func validateJenkinsPipelineStrategy(strategy *buildapi.JenkinsPipelineBuildStrategy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(strategy.JenkinsfilePath) != 0 && len(strategy.Jenkinsfile) != 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("jenkinsfilePath"), strategy.JenkinsfilePath, "only one of jenkinsfilePath or jenkinsfile may be specified"))
+	}
+
+	if len(strategy.JenkinsfilePath) != 0 {
+		cleaned := path.Clean(strategy.JenkinsfilePath)
+		switch {
+		case strings.HasPrefix(cleaned, "/"):
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("jenkinsfilePath"), strategy.JenkinsfilePath, "jenkinsfilePath must not be an absolute path"))
+		case strings.HasPrefix(cleaned, ".."):
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("jenkinsfilePath"), strategy.JenkinsfilePath, "jenkinsfilePath must not start with .."))
+		default:
+			if cleaned == "." {
+				cleaned = ""
+			}
+			strategy.JenkinsfilePath = cleaned
+		}
+	}
+
+	if len(strategy.Jenkinsfile) > maxJenkinsfileLengthBytes {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("jenkinsfile"), "", fmt.Sprintf("must be smaller than %d bytes", maxJenkinsfileLengthBytes)))
+	}
+
+	return allErrs
+}
+
 func validateTrigger(trigger *buildapi.BuildTriggerPolicy, buildFrom *kapi.ObjectReference, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	if len(trigger.Type) == 0 {
@@ -458,13 +613,25 @@ func validateTrigger(trigger *buildapi.BuildTriggerPolicy, buildFrom *kapi.Objec
 		if trigger.GitHubWebHook == nil {
 			allErrs = append(allErrs, field.Required(fldPath.Child("github"), ""))
 		} else {
-			allErrs = append(allErrs, validateWebHook(trigger.GitHubWebHook, fldPath.Child("github"))...)
+			allErrs = append(allErrs, validateWebHook(trigger.GitHubWebHook, fldPath.Child("github"), true)...)
 		}
 	case buildapi.GenericWebHookBuildTriggerType:
 		if trigger.GenericWebHook == nil {
 			allErrs = append(allErrs, field.Required(fldPath.Child("generic"), ""))
 		} else {
-			allErrs = append(allErrs, validateWebHook(trigger.GenericWebHook, fldPath.Child("generic"))...)
+			allErrs = append(allErrs, validateWebHook(trigger.GenericWebHook, fldPath.Child("generic"), false)...)
+		}
+	case buildapi.GitLabWebHookBuildTriggerType:
+		if trigger.GitLabWebHook == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("gitlab"), ""))
+		} else {
+			allErrs = append(allErrs, validateWebHook(trigger.GitLabWebHook, fldPath.Child("gitlab"), false)...)
+		}
+	case buildapi.BitbucketWebHookBuildTriggerType:
+		if trigger.BitbucketWebHook == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("bitbucket"), ""))
+		} else {
+			allErrs = append(allErrs, validateWebHook(trigger.BitbucketWebHook, fldPath.Child("bitbucket"), false)...)
 		}
 	case buildapi.ImageChangeBuildTriggerType:
 		if trigger.ImageChange == nil {
@@ -500,8 +667,27 @@ func validateTrigger(trigger *buildapi.BuildTriggerPolicy, buildFrom *kapi.Objec
 	return allErrs
 }
 
-func validateWebHook(webHook *buildapi.WebHookTrigger, fldPath *field.Path) field.ErrorList {
+// validateWebHook validates a webhook trigger. Only GitHub webhooks, for which
+// isGitHub is true, may build pull requests and authenticate requests using
+// payload signatures verified with a referenced Secret instead of the secret
+// in the URL.
+func validateWebHook(webHook *buildapi.WebHookTrigger, fldPath *field.Path, isGitHub bool) field.ErrorList {
 	allErrs := field.ErrorList{}
+	if webHook.PullRequests != nil {
+		if !isGitHub {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("pullRequests"), "pull request builds are not supported by this webhook type"))
+		} else if prefix := webHook.PullRequests.OutputTagPrefix; len(prefix) > 0 && !imageTagRegexp.MatchString(prefix) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("pullRequests", "outputTagPrefix"), prefix, "must be a valid image tag prefix"))
+		}
+	}
+	if webHook.SecretReference != nil {
+		if !isGitHub {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("secretReference"), "payload signatures are not supported by this webhook type"))
+		} else if len(webHook.SecretReference.Name) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("secretReference", "name"), ""))
+		}
+		return allErrs
+	}
 	if len(webHook.Secret) == 0 {
 		allErrs = append(allErrs, field.Required(fldPath.Child("secret"), ""))
 	}
@@ -550,6 +736,47 @@ func ValidateStrategyEnv(vars []kapi.EnvVar, fldPath *field.Path) field.ErrorLis
 	return allErrs
 }
 
+// validateBuildArgs checks the build args of a Docker strategy. Their values
+// can only be taken from secrets and config maps.
+func validateBuildArgs(args []kapi.EnvVar, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, arg := range args {
+		idxPath := fldPath.Index(i)
+		if len(arg.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), ""))
+		} else if !kvalidation.IsCIdentifier(arg.Name) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), arg.Name, cIdentifierErrorMsg))
+		}
+		if arg.ValueFrom == nil {
+			continue
+		}
+		valueFromPath := idxPath.Child("valueFrom")
+		if len(arg.Value) > 0 {
+			allErrs = append(allErrs, field.Invalid(valueFromPath, "", "may not be specified when value is not empty"))
+		}
+		switch source := arg.ValueFrom; {
+		case source.SecretKeyRef != nil && source.ConfigMapKeyRef == nil && source.FieldRef == nil:
+			if len(source.SecretKeyRef.Name) == 0 {
+				allErrs = append(allErrs, field.Required(valueFromPath.Child("secretKeyRef", "name"), ""))
+			}
+			if len(source.SecretKeyRef.Key) == 0 {
+				allErrs = append(allErrs, field.Required(valueFromPath.Child("secretKeyRef", "key"), ""))
+			}
+		case source.ConfigMapKeyRef != nil && source.SecretKeyRef == nil && source.FieldRef == nil:
+			if len(source.ConfigMapKeyRef.Name) == 0 {
+				allErrs = append(allErrs, field.Required(valueFromPath.Child("configMapKeyRef", "name"), ""))
+			}
+			if len(source.ConfigMapKeyRef.Key) == 0 {
+				allErrs = append(allErrs, field.Required(valueFromPath.Child("configMapKeyRef", "key"), ""))
+			}
+		default:
+			allErrs = append(allErrs, field.Invalid(valueFromPath, "", "must reference exactly one key of a secret or a config map"))
+		}
+	}
+	return allErrs
+}
+
 func validatePostCommit(spec buildapi.BuildPostCommitSpec, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	if spec.Script != "" && len(spec.Command) > 0 {
@@ -327,6 +327,111 @@ func TestBuildConfigValidationFailureRequiredName(t *testing.T) {
 	}
 }
 
+func TestBuildConfigValidationRunPolicy(t *testing.T) {
+	tests := []struct {
+		policy buildapi.BuildRunPolicy
+		valid  bool
+	}{
+		{policy: "", valid: true},
+		{policy: buildapi.BuildRunPolicyParallel, valid: true},
+		{policy: buildapi.BuildRunPolicySerial, valid: true},
+		{policy: buildapi.BuildRunPolicySerialLatestOnly, valid: true},
+		{policy: "Sometimes", valid: false},
+	}
+	for _, tc := range tests {
+		buildConfig := &buildapi.BuildConfig{
+			ObjectMeta: kapi.ObjectMeta{Name: "config-id", Namespace: "namespace"},
+			Spec: buildapi.BuildConfigSpec{
+				RunPolicy: tc.policy,
+				BuildSpec: buildapi.BuildSpec{
+					Source: buildapi.BuildSource{
+						Git: &buildapi.GitBuildSource{
+							URI: "http://github.com/my/repository",
+						},
+					},
+					Strategy: buildapi.BuildStrategy{
+						DockerStrategy: &buildapi.DockerBuildStrategy{},
+					},
+					Output: buildapi.BuildOutput{
+						To: &kapi.ObjectReference{
+							Kind: "DockerImage",
+							Name: "repository/data",
+						},
+					},
+				},
+			},
+		}
+		errors := ValidateBuildConfig(buildConfig)
+		if tc.valid && len(errors) != 0 {
+			t.Errorf("%q: unexpected validation errors %v", tc.policy, errors)
+		}
+		if !tc.valid {
+			if len(errors) != 1 {
+				t.Errorf("%q: expected one validation error, got %v", tc.policy, errors)
+				continue
+			}
+			if errors[0].Type != field.ErrorTypeNotSupported || errors[0].Field != "spec.runPolicy" {
+				t.Errorf("%q: unexpected validation error %v", tc.policy, errors[0])
+			}
+		}
+	}
+}
+
+func TestBuildConfigValidationHistoryLimits(t *testing.T) {
+	zero, positive, negative := 0, 5, -1
+	tests := []struct {
+		name       string
+		successful *int
+		failed     *int
+		errField   string
+	}{
+		{name: "unset"},
+		{name: "zero", successful: &zero, failed: &zero},
+		{name: "positive", successful: &positive, failed: &positive},
+		{name: "negative successful", successful: &negative, errField: "spec.successfulBuildsHistoryLimit"},
+		{name: "negative failed", failed: &negative, errField: "spec.failedBuildsHistoryLimit"},
+	}
+	for _, tc := range tests {
+		buildConfig := &buildapi.BuildConfig{
+			ObjectMeta: kapi.ObjectMeta{Name: "config-id", Namespace: "namespace"},
+			Spec: buildapi.BuildConfigSpec{
+				SuccessfulBuildsHistoryLimit: tc.successful,
+				FailedBuildsHistoryLimit:     tc.failed,
+				BuildSpec: buildapi.BuildSpec{
+					Source: buildapi.BuildSource{
+						Git: &buildapi.GitBuildSource{
+							URI: "http://github.com/my/repository",
+						},
+					},
+					Strategy: buildapi.BuildStrategy{
+						DockerStrategy: &buildapi.DockerBuildStrategy{},
+					},
+					Output: buildapi.BuildOutput{
+						To: &kapi.ObjectReference{
+							Kind: "DockerImage",
+							Name: "repository/data",
+						},
+					},
+				},
+			},
+		}
+		errors := ValidateBuildConfig(buildConfig)
+		if len(tc.errField) == 0 {
+			if len(errors) != 0 {
+				t.Errorf("%s: unexpected validation errors %v", tc.name, errors)
+			}
+			continue
+		}
+		if len(errors) != 1 {
+			t.Errorf("%s: expected one validation error, got %v", tc.name, errors)
+			continue
+		}
+		if errors[0].Type != field.ErrorTypeInvalid || errors[0].Field != tc.errField {
+			t.Errorf("%s: unexpected validation error %v", tc.name, errors[0])
+		}
+	}
+}
+
 func TestBuildConfigImageChangeTriggers(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -639,6 +744,18 @@ func TestValidateBuildRequest(t *testing.T) {
 	testCases := map[string]*buildapi.BuildRequest{
 		string(field.ErrorTypeRequired) + "metadata.namespace": {ObjectMeta: kapi.ObjectMeta{Name: "requestName"}},
 		string(field.ErrorTypeRequired) + "metadata.name":      {ObjectMeta: kapi.ObjectMeta{Namespace: kapi.NamespaceDefault}},
+		string(field.ErrorTypeInvalid) + "pullRequest.number": {
+			ObjectMeta:  kapi.ObjectMeta{Name: "requestName", Namespace: kapi.NamespaceDefault},
+			PullRequest: &buildapi.PullRequestReference{OutputTag: "pr-1"},
+		},
+		string(field.ErrorTypeInvalid) + "pullRequest.outputTag": {
+			ObjectMeta:  kapi.ObjectMeta{Name: "requestName", Namespace: kapi.NamespaceDefault},
+			PullRequest: &buildapi.PullRequestReference{Number: 1, OutputTag: "pr:1"},
+		},
+		"": {
+			ObjectMeta:  kapi.ObjectMeta{Name: "requestName", Namespace: kapi.NamespaceDefault},
+			PullRequest: &buildapi.PullRequestReference{Number: 1, OutputTag: "pr-1"},
+		},
 	}
 
 	for desc, tc := range testCases {
@@ -1647,6 +1764,93 @@ func TestValidateDockerfilePath(t *testing.T) {
 	}
 }
 
+func TestValidateJenkinsPipelineStrategy(t *testing.T) {
+	tests := []struct {
+		spec                    *buildapi.BuildSpec
+		expectedJenkinsfilePath string
+		expectedErrors          int
+	}{
+		// inline Jenkinsfile without any source
+		{
+			spec: &buildapi.BuildSpec{
+				Strategy: buildapi.BuildStrategy{
+					JenkinsPipelineStrategy: &buildapi.JenkinsPipelineBuildStrategy{
+						Jenkinsfile: "node { stage 'build' }",
+					},
+				},
+			},
+		},
+		// Jenkinsfile path in the git repository
+		{
+			spec: &buildapi.BuildSpec{
+				Source: buildapi.BuildSource{
+					Git: &buildapi.GitBuildSource{
+						URI: "http://github.com/my/repository",
+					},
+				},
+				Strategy: buildapi.BuildStrategy{
+					JenkinsPipelineStrategy: &buildapi.JenkinsPipelineBuildStrategy{
+						JenkinsfilePath: "pipelines/../Jenkinsfile",
+					},
+				},
+			},
+			expectedJenkinsfilePath: "Jenkinsfile",
+		},
+		// Jenkinsfile path without any source
+		{
+			spec: &buildapi.BuildSpec{
+				Strategy: buildapi.BuildStrategy{
+					JenkinsPipelineStrategy: &buildapi.JenkinsPipelineBuildStrategy{
+						JenkinsfilePath: "Jenkinsfile",
+					},
+				},
+			},
+			expectedJenkinsfilePath: "Jenkinsfile",
+			expectedErrors:          1,
+		},
+		// both a path and an inline Jenkinsfile
+		{
+			spec: &buildapi.BuildSpec{
+				Strategy: buildapi.BuildStrategy{
+					JenkinsPipelineStrategy: &buildapi.JenkinsPipelineBuildStrategy{
+						JenkinsfilePath: "Jenkinsfile",
+						Jenkinsfile:     "node { stage 'build' }",
+					},
+				},
+			},
+			expectedJenkinsfilePath: "Jenkinsfile",
+			expectedErrors:          1,
+		},
+		// path outside of the context dir
+		{
+			spec: &buildapi.BuildSpec{
+				Source: buildapi.BuildSource{
+					Git: &buildapi.GitBuildSource{
+						URI: "http://github.com/my/repository",
+					},
+				},
+				Strategy: buildapi.BuildStrategy{
+					JenkinsPipelineStrategy: &buildapi.JenkinsPipelineBuildStrategy{
+						JenkinsfilePath: "../Jenkinsfile",
+					},
+				},
+			},
+			expectedJenkinsfilePath: "../Jenkinsfile",
+			expectedErrors:          1,
+		},
+	}
+
+	for i, test := range tests {
+		errors := validateBuildSpec(test.spec, nil)
+		if len(errors) != test.expectedErrors {
+			t.Errorf("%d: expected %d errors, got: %v", i, test.expectedErrors, errors)
+		}
+		if path := test.spec.Strategy.JenkinsPipelineStrategy.JenkinsfilePath; path != test.expectedJenkinsfilePath {
+			t.Errorf("%d: unexpected JenkinsfilePath: %s (expected: %s)", i, path, test.expectedJenkinsfilePath)
+		}
+	}
+}
+
 func TestValidateTrigger(t *testing.T) {
 	tests := map[string]struct {
 		trigger  buildapi.BuildTriggerPolicy
@@ -1702,6 +1906,84 @@ func TestValidateTrigger(t *testing.T) {
 			},
 			expected: []*field.Error{field.Required(field.NewPath("generic"), "")},
 		},
+		"GitLab trigger with no gitlab webhook": {
+			trigger:  buildapi.BuildTriggerPolicy{Type: buildapi.GitLabWebHookBuildTriggerType},
+			expected: []*field.Error{field.Required(field.NewPath("gitlab"), "")},
+		},
+		"GitLab trigger with no secret": {
+			trigger: buildapi.BuildTriggerPolicy{
+				Type:          buildapi.GitLabWebHookBuildTriggerType,
+				GitLabWebHook: &buildapi.WebHookTrigger{},
+			},
+			expected: []*field.Error{field.Required(field.NewPath("gitlab", "secret"), "")},
+		},
+		"GitHub trigger with a secret reference": {
+			trigger: buildapi.BuildTriggerPolicy{
+				Type: buildapi.GitHubWebHookBuildTriggerType,
+				GitHubWebHook: &buildapi.WebHookTrigger{
+					SecretReference: &kapi.LocalObjectReference{Name: "webhook"},
+				},
+			},
+		},
+		"GitHub trigger with an empty secret reference": {
+			trigger: buildapi.BuildTriggerPolicy{
+				Type: buildapi.GitHubWebHookBuildTriggerType,
+				GitHubWebHook: &buildapi.WebHookTrigger{
+					SecretReference: &kapi.LocalObjectReference{},
+				},
+			},
+			expected: []*field.Error{field.Required(field.NewPath("github", "secretReference", "name"), "")},
+		},
+		"Generic trigger with a secret reference": {
+			trigger: buildapi.BuildTriggerPolicy{
+				Type: buildapi.GenericWebHookBuildTriggerType,
+				GenericWebHook: &buildapi.WebHookTrigger{
+					Secret:          "secret101",
+					SecretReference: &kapi.LocalObjectReference{Name: "webhook"},
+				},
+			},
+			expected: []*field.Error{field.Forbidden(field.NewPath("generic", "secretReference"), "")},
+		},
+		"GitHub trigger with pull request builds": {
+			trigger: buildapi.BuildTriggerPolicy{
+				Type: buildapi.GitHubWebHookBuildTriggerType,
+				GitHubWebHook: &buildapi.WebHookTrigger{
+					Secret:       "secret101",
+					PullRequests: &buildapi.PullRequestBuildPolicy{OutputTagPrefix: "pr-"},
+				},
+			},
+		},
+		"GitHub trigger with an invalid pull request tag prefix": {
+			trigger: buildapi.BuildTriggerPolicy{
+				Type: buildapi.GitHubWebHookBuildTriggerType,
+				GitHubWebHook: &buildapi.WebHookTrigger{
+					Secret:       "secret101",
+					PullRequests: &buildapi.PullRequestBuildPolicy{OutputTagPrefix: "pr/"},
+				},
+			},
+			expected: []*field.Error{field.Invalid(field.NewPath("github", "pullRequests", "outputTagPrefix"), "pr/", "")},
+		},
+		"Generic trigger with pull request builds": {
+			trigger: buildapi.BuildTriggerPolicy{
+				Type: buildapi.GenericWebHookBuildTriggerType,
+				GenericWebHook: &buildapi.WebHookTrigger{
+					Secret:       "secret101",
+					PullRequests: &buildapi.PullRequestBuildPolicy{},
+				},
+			},
+			expected: []*field.Error{field.Forbidden(field.NewPath("generic", "pullRequests"), "")},
+		},
+		"Bitbucket trigger with no bitbucket webhook": {
+			trigger:  buildapi.BuildTriggerPolicy{Type: buildapi.BitbucketWebHookBuildTriggerType},
+			expected: []*field.Error{field.Required(field.NewPath("bitbucket"), "")},
+		},
+		"Bitbucket trigger with no secret": {
+			trigger: buildapi.BuildTriggerPolicy{
+				Type:             buildapi.BitbucketWebHookBuildTriggerType,
+				BitbucketWebHook: &buildapi.WebHookTrigger{},
+			},
+			expected: []*field.Error{field.Required(field.NewPath("bitbucket", "secret"), "")},
+		},
 		"ImageChange trigger without params": {
 			trigger: buildapi.BuildTriggerPolicy{
 				Type: buildapi.ImageChangeBuildTriggerType,
@@ -1724,6 +2006,22 @@ func TestValidateTrigger(t *testing.T) {
 				},
 			},
 		},
+		"valid GitLab trigger": {
+			trigger: buildapi.BuildTriggerPolicy{
+				Type: buildapi.GitLabWebHookBuildTriggerType,
+				GitLabWebHook: &buildapi.WebHookTrigger{
+					Secret: "secret101",
+				},
+			},
+		},
+		"valid Bitbucket trigger": {
+			trigger: buildapi.BuildTriggerPolicy{
+				Type: buildapi.BitbucketWebHookBuildTriggerType,
+				BitbucketWebHook: &buildapi.WebHookTrigger{
+					Secret: "secret101",
+				},
+			},
+		},
 		"valid ImageChange trigger": {
 			trigger: buildapi.BuildTriggerPolicy{
 				Type: buildapi.ImageChangeBuildTriggerType,
@@ -1785,6 +2083,275 @@ func TestValidateToImageReference(t *testing.T) {
 	}
 }
 
+func TestValidateOutputDestinations(t *testing.T) {
+	to := &kapi.ObjectReference{Kind: "ImageStreamTag", Name: "app:latest"}
+	tests := []struct {
+		name     string
+		output   buildapi.BuildOutput
+		errType  field.ErrorType
+		errField string
+	}{
+		{
+			name: "image stream tag and external registry",
+			output: buildapi.BuildOutput{
+				To: to,
+				Destinations: []buildapi.BuildOutputDestination{
+					{To: kapi.ObjectReference{Kind: "ImageStreamTag", Name: "app:abc123"}},
+					{
+						To:         kapi.ObjectReference{Kind: "DockerImage", Name: "registry.example.com/team/app:latest"},
+						PushSecret: &kapi.LocalObjectReference{Name: "external-registry"},
+					},
+				},
+			},
+		},
+		{
+			name: "no primary output",
+			output: buildapi.BuildOutput{
+				Destinations: []buildapi.BuildOutputDestination{
+					{To: kapi.ObjectReference{Kind: "ImageStreamTag", Name: "app:abc123"}},
+				},
+			},
+			errType:  field.ErrorTypeInvalid,
+			errField: "output.destinations",
+		},
+		{
+			name: "unsupported kind",
+			output: buildapi.BuildOutput{
+				To: to,
+				Destinations: []buildapi.BuildOutputDestination{
+					{To: kapi.ObjectReference{Kind: "ImageStreamImage", Name: "app@sha256:abc"}},
+				},
+			},
+			errType:  field.ErrorTypeInvalid,
+			errField: "output.destinations[0].to.kind",
+		},
+		{
+			name: "invalid image stream tag",
+			output: buildapi.BuildOutput{
+				To: to,
+				Destinations: []buildapi.BuildOutputDestination{
+					{To: kapi.ObjectReference{Kind: "ImageStreamTag", Name: "app:latest"}},
+					{To: kapi.ObjectReference{Kind: "ImageStreamTag", Name: "app"}},
+				},
+			},
+			errType:  field.ErrorTypeInvalid,
+			errField: "output.destinations[1].to.name",
+		},
+		{
+			name: "empty push secret name",
+			output: buildapi.BuildOutput{
+				To: to,
+				Destinations: []buildapi.BuildOutputDestination{
+					{
+						To:         kapi.ObjectReference{Kind: "DockerImage", Name: "registry.example.com/team/app:latest"},
+						PushSecret: &kapi.LocalObjectReference{},
+					},
+				},
+			},
+			errType:  field.ErrorTypeRequired,
+			errField: "output.destinations[0].pushSecret.name",
+		},
+	}
+	for _, tc := range tests {
+		errs := validateOutput(&tc.output, field.NewPath("output"))
+		if len(tc.errField) == 0 {
+			if len(errs) != 0 {
+				t.Errorf("%s: unexpected errors: %v", tc.name, errs)
+			}
+			continue
+		}
+		if len(errs) != 1 {
+			t.Errorf("%s: expected one error, got %v", tc.name, errs)
+			continue
+		}
+		if errs[0].Type != tc.errType || errs[0].Field != tc.errField {
+			t.Errorf("%s: unexpected error %v", tc.name, errs[0])
+		}
+	}
+}
+
+func TestValidateBuildCache(t *testing.T) {
+	docker := buildapi.BuildStrategy{DockerStrategy: &buildapi.DockerBuildStrategy{}}
+	paths := []buildapi.ImageSourcePath{{SourcePath: "/root/.m2", DestinationDir: "."}}
+	claim := &kapi.LocalObjectReference{Name: "maven-cache"}
+	tests := []struct {
+		name     string
+		cache    buildapi.BuildCache
+		strategy buildapi.BuildStrategy
+		errType  field.ErrorType
+		errField string
+	}{
+		{
+			name:     "claim",
+			cache:    buildapi.BuildCache{Paths: paths, KeyFiles: []string{"pom.xml"}, PersistentVolumeClaim: claim},
+			strategy: docker,
+		},
+		{
+			name:     "image",
+			cache:    buildapi.BuildCache{Paths: paths, Image: &kapi.ObjectReference{Kind: "ImageStreamTag", Name: "app-cache:latest"}},
+			strategy: buildapi.BuildStrategy{SourceStrategy: &buildapi.SourceBuildStrategy{}},
+		},
+		{
+			name:     "custom strategy",
+			cache:    buildapi.BuildCache{Paths: paths, PersistentVolumeClaim: claim},
+			strategy: buildapi.BuildStrategy{CustomStrategy: &buildapi.CustomBuildStrategy{}},
+			errType:  field.ErrorTypeInvalid,
+			errField: "cache",
+		},
+		{
+			name:     "no paths",
+			cache:    buildapi.BuildCache{PersistentVolumeClaim: claim},
+			strategy: docker,
+			errType:  field.ErrorTypeRequired,
+			errField: "cache.paths",
+		},
+		{
+			name:     "relative source path",
+			cache:    buildapi.BuildCache{Paths: []buildapi.ImageSourcePath{{SourcePath: ".m2", DestinationDir: "."}}, PersistentVolumeClaim: claim},
+			strategy: docker,
+			errType:  field.ErrorTypeInvalid,
+			errField: "cache.paths[0].sourcePath",
+		},
+		{
+			name:     "key file outside of the build directory",
+			cache:    buildapi.BuildCache{Paths: paths, KeyFiles: []string{"pom.xml", "../pom.xml"}, PersistentVolumeClaim: claim},
+			strategy: docker,
+			errType:  field.ErrorTypeInvalid,
+			errField: "cache.keyFiles[1]",
+		},
+		{
+			name:     "no storage",
+			cache:    buildapi.BuildCache{Paths: paths},
+			strategy: docker,
+			errType:  field.ErrorTypeRequired,
+			errField: "cache",
+		},
+		{
+			name:     "claim and image",
+			cache:    buildapi.BuildCache{Paths: paths, PersistentVolumeClaim: claim, Image: &kapi.ObjectReference{Kind: "DockerImage", Name: "registry/app-cache"}},
+			strategy: docker,
+			errType:  field.ErrorTypeInvalid,
+			errField: "cache",
+		},
+		{
+			name:     "empty claim name",
+			cache:    buildapi.BuildCache{Paths: paths, PersistentVolumeClaim: &kapi.LocalObjectReference{}},
+			strategy: docker,
+			errType:  field.ErrorTypeRequired,
+			errField: "cache.persistentVolumeClaim.name",
+		},
+		{
+			name:     "invalid image kind",
+			cache:    buildapi.BuildCache{Paths: paths, Image: &kapi.ObjectReference{Kind: "ImageStream", Name: "app-cache"}},
+			strategy: docker,
+			errType:  field.ErrorTypeInvalid,
+			errField: "cache.image.kind",
+		},
+	}
+	for _, tc := range tests {
+		errs := validateBuildCache(&tc.cache, &tc.strategy, field.NewPath("cache"))
+		if len(tc.errField) == 0 {
+			if len(errs) != 0 {
+				t.Errorf("%s: unexpected errors: %v", tc.name, errs)
+			}
+			continue
+		}
+		if len(errs) != 1 {
+			t.Errorf("%s: expected one error, got %v", tc.name, errs)
+			continue
+		}
+		if errs[0].Type != tc.errType || errs[0].Field != tc.errField {
+			t.Errorf("%s: unexpected error %v", tc.name, errs[0])
+		}
+	}
+}
+
+func TestValidateCommitStatus(t *testing.T) {
+	git := buildapi.BuildSource{Git: &buildapi.GitBuildSource{URI: "https://github.com/openshift/ruby-hello-world"}}
+	secret := kapi.LocalObjectReference{Name: "github-token"}
+	tests := []struct {
+		name     string
+		status   buildapi.CommitStatusReporting
+		source   buildapi.BuildSource
+		errType  field.ErrorType
+		errField string
+	}{
+		{
+			name:   "github",
+			status: buildapi.CommitStatusReporting{Provider: buildapi.GitHubCommitStatusProvider, Secret: secret},
+			source: git,
+		},
+		{
+			name:   "gitlab with api url",
+			status: buildapi.CommitStatusReporting{Provider: buildapi.GitLabCommitStatusProvider, APIURL: "https://gitlab.example.com", Secret: secret, Context: "ci/openshift"},
+			source: buildapi.BuildSource{Git: &buildapi.GitBuildSource{URI: "https://gitlab.example.com/group/app.git"}},
+		},
+		{
+			name:   "github with api url",
+			status: buildapi.CommitStatusReporting{Provider: buildapi.GitHubCommitStatusProvider, APIURL: "https://api.github.com", Secret: secret},
+			source: git,
+		},
+		{
+			name:     "api url on another host",
+			status:   buildapi.CommitStatusReporting{Provider: buildapi.GitLabCommitStatusProvider, APIURL: "https://gitlab.example.com", Secret: secret},
+			source:   git,
+			errType:  field.ErrorTypeInvalid,
+			errField: "commitStatus.apiURL",
+		},
+		{
+			name:     "binary source",
+			status:   buildapi.CommitStatusReporting{Provider: buildapi.GitHubCommitStatusProvider, Secret: secret},
+			source:   buildapi.BuildSource{Binary: &buildapi.BinaryBuildSource{}},
+			errType:  field.ErrorTypeInvalid,
+			errField: "commitStatus",
+		},
+		{
+			name:     "no provider",
+			status:   buildapi.CommitStatusReporting{Secret: secret},
+			source:   git,
+			errType:  field.ErrorTypeRequired,
+			errField: "commitStatus.provider",
+		},
+		{
+			name:     "unknown provider",
+			status:   buildapi.CommitStatusReporting{Provider: "Gitea", Secret: secret},
+			source:   git,
+			errType:  field.ErrorTypeNotSupported,
+			errField: "commitStatus.provider",
+		},
+		{
+			name:     "invalid api url",
+			status:   buildapi.CommitStatusReporting{Provider: buildapi.GitLabCommitStatusProvider, APIURL: "gitlab.example.com", Secret: secret},
+			source:   git,
+			errType:  field.ErrorTypeInvalid,
+			errField: "commitStatus.apiURL",
+		},
+		{
+			name:     "no secret",
+			status:   buildapi.CommitStatusReporting{Provider: buildapi.GitHubCommitStatusProvider},
+			source:   git,
+			errType:  field.ErrorTypeRequired,
+			errField: "commitStatus.secret.name",
+		},
+	}
+	for _, tc := range tests {
+		errs := validateCommitStatus(&tc.status, &tc.source, field.NewPath("commitStatus"))
+		if len(tc.errField) == 0 {
+			if len(errs) != 0 {
+				t.Errorf("%s: unexpected errors: %v", tc.name, errs)
+			}
+			continue
+		}
+		if len(errs) != 1 {
+			t.Errorf("%s: expected one error, got %v", tc.name, errs)
+			continue
+		}
+		if errs[0].Type != tc.errType || errs[0].Field != tc.errField {
+			t.Errorf("%s: unexpected error %v", tc.name, errs[0])
+		}
+	}
+}
+
 func TestValidateStrategyEnvVars(t *testing.T) {
 	tests := []struct {
 		env         []kapi.EnvVar
@@ -1925,3 +2492,62 @@ func TestValidatePostCommit(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateBuildArgs(t *testing.T) {
+	secretRef := &kapi.EnvVarSource{SecretKeyRef: &kapi.SecretKeySelector{LocalObjectReference: kapi.LocalObjectReference{Name: "secret"}, Key: "token"}}
+	tests := []struct {
+		name     string
+		arg      kapi.EnvVar
+		errType  field.ErrorType
+		errField string
+	}{
+		{
+			name: "value",
+			arg:  kapi.EnvVar{Name: "VERSION", Value: "1.0"},
+		},
+		{
+			name: "secret",
+			arg:  kapi.EnvVar{Name: "TOKEN", ValueFrom: secretRef},
+		},
+		{
+			name: "config map",
+			arg:  kapi.EnvVar{Name: "MIRROR", ValueFrom: &kapi.EnvVarSource{ConfigMapKeyRef: &kapi.ConfigMapKeySelector{LocalObjectReference: kapi.LocalObjectReference{Name: "config"}, Key: "mirror"}}},
+		},
+		{
+			name:     "invalid name",
+			arg:      kapi.EnvVar{Name: "build-arg", Value: "1.0"},
+			errType:  field.ErrorTypeInvalid,
+			errField: "buildArgs[0].name",
+		},
+		{
+			name:     "value and value from",
+			arg:      kapi.EnvVar{Name: "TOKEN", Value: "1.0", ValueFrom: secretRef},
+			errType:  field.ErrorTypeInvalid,
+			errField: "buildArgs[0].valueFrom",
+		},
+		{
+			name:     "field ref",
+			arg:      kapi.EnvVar{Name: "NAME", ValueFrom: &kapi.EnvVarSource{FieldRef: &kapi.ObjectFieldSelector{FieldPath: "metadata.name"}}},
+			errType:  field.ErrorTypeInvalid,
+			errField: "buildArgs[0].valueFrom",
+		},
+		{
+			name:     "secret without key",
+			arg:      kapi.EnvVar{Name: "TOKEN", ValueFrom: &kapi.EnvVarSource{SecretKeyRef: &kapi.SecretKeySelector{LocalObjectReference: kapi.LocalObjectReference{Name: "secret"}}}},
+			errType:  field.ErrorTypeRequired,
+			errField: "buildArgs[0].valueFrom.secretKeyRef.key",
+		},
+	}
+	for _, tc := range tests {
+		errs := validateBuildArgs([]kapi.EnvVar{tc.arg}, field.NewPath("buildArgs"))
+		if len(tc.errField) == 0 {
+			if len(errs) != 0 {
+				t.Errorf("%s: unexpected errors: %v", tc.name, errs)
+			}
+			continue
+		}
+		if len(errs) != 1 || errs[0].Type != tc.errType || errs[0].Field != tc.errField {
+			t.Errorf("%s: expected %s error on %s, got %v", tc.name, tc.errType, tc.errField, errs)
+		}
+	}
+}
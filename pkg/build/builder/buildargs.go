@@ -0,0 +1,296 @@
+package builder
+
+import (
+	"archive/tar"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"sync"
+
+	docker "github.com/fsouza/go-dockerclient"
+	"github.com/golang/glog"
+	kapi "k8s.io/kubernetes/pkg/api"
+	utilruntime "k8s.io/kubernetes/pkg/util/runtime"
+
+	"github.com/openshift/origin/pkg/build/controller/strategy"
+)
+
+// redactedValue replaces the values of secret build args in the build output.
+const redactedValue = "*****"
+
+// resolveBuildArgs returns the Docker build args of the build together with
+// the values taken from secrets. The values of the build args referencing a
+// secret or a config map are read from the environment variables the build
+// pod resolved them into.
+func resolveBuildArgs(args []kapi.EnvVar) ([]docker.BuildArg, []string, error) {
+	buildArgs := []docker.BuildArg{}
+	secretValues := []string{}
+	for _, arg := range args {
+		value := arg.Value
+		if arg.ValueFrom != nil {
+			var ok bool
+			value, ok = os.LookupEnv(strategy.BuildArgEnvPrefix + arg.Name)
+			if !ok {
+				return nil, nil, fmt.Errorf("the value of build arg %s was not provided to the build", arg.Name)
+			}
+			if arg.ValueFrom.SecretKeyRef != nil && len(value) > 0 {
+				secretValues = append(secretValues, value)
+			}
+		}
+		buildArgs = append(buildArgs, docker.BuildArg{Name: arg.Name, Value: value})
+	}
+	return buildArgs, secretValues, nil
+}
+
+// redactingWriter replaces the secret values in the lines written to it
+// before passing them on. Lines are buffered until complete so that a value
+// split across writes is still replaced.
+type redactingWriter struct {
+	lock     sync.Mutex
+	out      io.Writer
+	replacer *strings.Replacer
+	buf      []byte
+}
+
+// newRedactingWriter returns a writer replacing the values in everything
+// written to out. The writer must be closed to flush an incomplete last line.
+func newRedactingWriter(out io.Writer, values []string) io.WriteCloser {
+	pairs := []string{}
+	for _, value := range values {
+		pairs = append(pairs, value, redactedValue)
+	}
+	return &redactingWriter{out: out, replacer: strings.NewReplacer(pairs...)}
+}
+
+func (w *redactingWriter) Write(p []byte) (int, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	w.buf = append(w.buf, p...)
+	if i := bytes.LastIndexByte(w.buf, '\n'); i >= 0 {
+		if err := w.flush(w.buf[:i+1]); err != nil {
+			return 0, err
+		}
+		w.buf = append([]byte{}, w.buf[i+1:]...)
+	}
+	return len(p), nil
+}
+
+// Close writes out the buffered incomplete line, if any.
+func (w *redactingWriter) Close() error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if len(w.buf) == 0 {
+		return nil
+	}
+	err := w.flush(w.buf)
+	w.buf = nil
+	return err
+}
+
+func (w *redactingWriter) flush(p []byte) error {
+	_, err := io.WriteString(w.out, w.replacer.Replace(string(p)))
+	return err
+}
+
+// redactImageHistory replaces the values in the history and the configuration
+// of the image tagged with tag, where Docker records the build args of the RUN
+// instructions. The image is saved, the values are replaced in its JSON files
+// and it is loaded back with the same layers. The original image is removed
+// before the load, daemons saving images in the legacy format would otherwise
+// keep the original layer configurations.
+func redactImageHistory(client DockerClient, tag string, values []string) error {
+	image, err := client.InspectImage(tag)
+	if err != nil {
+		return err
+	}
+	if image.Config != nil && configContains(image.Config, values) {
+		fmt.Fprintf(os.Stdout, "The configuration of the image contains the value of a build arg taken from a secret, the value is replaced by %s\n", redactedValue)
+	}
+
+	saved, err := ioutil.TempFile("", "redacted-image")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(saved.Name())
+	defer saved.Close()
+
+	glog.V(4).Infof("Removing the build args from the history of image %s", tag)
+	r, w := io.Pipe()
+	exportErr := make(chan error, 1)
+	go func() {
+		defer utilruntime.HandleCrash()
+		err := client.ExportImage(docker.ExportImageOptions{Name: tag, OutputStream: w})
+		w.CloseWithError(err)
+		exportErr <- err
+	}()
+	err = redactImageArchive(r, saved, values)
+	r.Close()
+	if exportErr := <-exportErr; err == nil && exportErr != nil {
+		err = exportErr
+	}
+	if err != nil {
+		return fmt.Errorf("save image %s: %v", tag, err)
+	}
+	if _, err := saved.Seek(0, 0); err != nil {
+		return err
+	}
+
+	if err := client.RemoveImage(image.ID); err != nil {
+		glog.V(2).Infof("Failed to remove the image %s before loading it without the build args: %v", image.ID, err)
+	}
+	if err := client.LoadImage(docker.LoadImageOptions{InputStream: saved}); err != nil {
+		return fmt.Errorf("load image %s: %v", tag, err)
+	}
+	return nil
+}
+
+// redactImageArchive copies the image archive written by docker save from in
+// to out, replacing the values in the history and in the configurations
+// recorded in the JSON files of the image and of its layers. The other fields
+// and files, such as the digests and the layers, are copied unchanged.
+func redactImageArchive(in io.Reader, out io.Writer, values []string) error {
+	pairs := []string{}
+	for _, value := range values {
+		pairs = append(pairs, value, redactedValue)
+	}
+	replacer := strings.NewReplacer(pairs...)
+
+	tr := tar.NewReader(in)
+	tw := tar.NewWriter(out)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if !strings.HasSuffix(header.Name, ".json") && path.Base(header.Name) != "json" {
+			if err := tw.WriteHeader(header); err != nil {
+				return err
+			}
+			if _, err := io.Copy(tw, tr); err != nil {
+				return err
+			}
+			continue
+		}
+		data, err := ioutil.ReadAll(tr)
+		if err != nil {
+			return err
+		}
+		data, err = redactImageJSON(data, replacer)
+		if err != nil {
+			return fmt.Errorf("%s: %v", header.Name, err)
+		}
+		header.Size = int64(len(data))
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if _, err := tw.Write(data); err != nil {
+			return err
+		}
+	}
+	return tw.Close()
+}
+
+// redactImageJSON replaces the values in the strings of the configuration
+// fields and in the commands of the history of an image or layer
+// configuration. Documents that are not JSON objects, such as manifest.json,
+// and documents without any of the values are returned unchanged.
+func redactImageJSON(data []byte, replacer *strings.Replacer) ([]byte, error) {
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return data, nil
+	}
+	changed := false
+	for key, raw := range fields {
+		if key != "config" && key != "container_config" && key != "history" {
+			continue
+		}
+		decoder := json.NewDecoder(bytes.NewReader(raw))
+		decoder.UseNumber()
+		var value interface{}
+		if err := decoder.Decode(&value); err != nil {
+			return nil, err
+		}
+		redacted := false
+		if key == "history" {
+			history, _ := value.([]interface{})
+			for _, entry := range history {
+				entry, ok := entry.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				if createdBy, ok := entry["created_by"]; ok {
+					var entryRedacted bool
+					entry["created_by"], entryRedacted = redactStrings(createdBy, replacer)
+					redacted = redacted || entryRedacted
+				}
+			}
+		} else {
+			value, redacted = redactStrings(value, replacer)
+		}
+		if !redacted {
+			continue
+		}
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		fields[key] = encoded
+		changed = true
+	}
+	if !changed {
+		return data, nil
+	}
+	return json.Marshal(fields)
+}
+
+// redactStrings replaces the values in the strings held by value, leaving the
+// keys of the objects unchanged, and returns true if any string was changed.
+func redactStrings(value interface{}, replacer *strings.Replacer) (interface{}, bool) {
+	changed := false
+	switch v := value.(type) {
+	case string:
+		redacted := replacer.Replace(v)
+		return redacted, redacted != v
+	case []interface{}:
+		for i := range v {
+			var itemChanged bool
+			v[i], itemChanged = redactStrings(v[i], replacer)
+			changed = changed || itemChanged
+		}
+	case map[string]interface{}:
+		for key := range v {
+			var itemChanged bool
+			v[key], itemChanged = redactStrings(v[key], replacer)
+			changed = changed || itemChanged
+		}
+	}
+	return value, changed
+}
+
+// configContains returns true if the image configuration contains any of the
+// values.
+func configContains(config *docker.Config, values []string) bool {
+	fields := []string{config.User, config.WorkingDir}
+	fields = append(fields, config.Env...)
+	fields = append(fields, config.Cmd...)
+	fields = append(fields, config.Entrypoint...)
+	for key, value := range config.Labels {
+		fields = append(fields, key, value)
+	}
+	for _, field := range fields {
+		for _, value := range values {
+			if strings.Contains(field, value) {
+				return true
+			}
+		}
+	}
+	return false
+}
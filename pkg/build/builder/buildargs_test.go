@@ -0,0 +1,224 @@
+package builder
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"sort"
+	"testing"
+
+	docker "github.com/fsouza/go-dockerclient"
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	"github.com/openshift/origin/pkg/build/controller/strategy"
+)
+
+// redactFakeDocker returns the image built with secret build args and saves
+// it as an archive of files, recording the calls made to redact it.
+type redactFakeDocker struct {
+	FakeDocker
+	image *docker.Image
+	files map[string]string
+}
+
+func (d *redactFakeDocker) InspectImage(name string) (*docker.Image, error) {
+	return d.image, nil
+}
+func (d *redactFakeDocker) ExportImage(opts docker.ExportImageOptions) error {
+	d.callLog = append(d.callLog, methodCall{"ExportImage", []interface{}{opts.Name}})
+	tw := tar.NewWriter(opts.OutputStream)
+	names := []string{}
+	for name := range d.files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(d.files[name]))}); err != nil {
+			return err
+		}
+		if _, err := tw.Write([]byte(d.files[name])); err != nil {
+			return err
+		}
+	}
+	return tw.Close()
+}
+func (d *redactFakeDocker) LoadImage(opts docker.LoadImageOptions) error {
+	d.callLog = append(d.callLog, methodCall{"LoadImage", nil})
+	d.files = map[string]string{}
+	tr := tar.NewReader(opts.InputStream)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		data, err := ioutil.ReadAll(tr)
+		if err != nil {
+			return err
+		}
+		d.files[header.Name] = string(data)
+	}
+}
+func (d *redactFakeDocker) RemoveImage(name string) error {
+	d.callLog = append(d.callLog, methodCall{"RemoveImage", []interface{}{name}})
+	return nil
+}
+
+func TestResolveBuildArgs(t *testing.T) {
+	os.Setenv(strategy.BuildArgEnvPrefix+"TOKEN", "s3cr3t")
+	os.Setenv(strategy.BuildArgEnvPrefix+"MIRROR", "http://mirror")
+	defer os.Unsetenv(strategy.BuildArgEnvPrefix + "TOKEN")
+	defer os.Unsetenv(strategy.BuildArgEnvPrefix + "MIRROR")
+
+	args := []kapi.EnvVar{
+		{Name: "VERSION", Value: "1.0"},
+		{Name: "TOKEN", ValueFrom: &kapi.EnvVarSource{SecretKeyRef: &kapi.SecretKeySelector{Key: "token"}}},
+		{Name: "MIRROR", ValueFrom: &kapi.EnvVarSource{ConfigMapKeyRef: &kapi.ConfigMapKeySelector{Key: "mirror"}}},
+	}
+	buildArgs, secretValues, err := resolveBuildArgs(args)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []docker.BuildArg{{Name: "VERSION", Value: "1.0"}, {Name: "TOKEN", Value: "s3cr3t"}, {Name: "MIRROR", Value: "http://mirror"}}
+	if !reflect.DeepEqual(buildArgs, expected) {
+		t.Errorf("expected build args %v, got %v", expected, buildArgs)
+	}
+	if !reflect.DeepEqual(secretValues, []string{"s3cr3t"}) {
+		t.Errorf("expected only the value of the secret to be hidden, got %v", secretValues)
+	}
+
+	args = append(args, kapi.EnvVar{Name: "MISSING", ValueFrom: &kapi.EnvVarSource{SecretKeyRef: &kapi.SecretKeySelector{Key: "missing"}}})
+	if _, _, err := resolveBuildArgs(args); err == nil {
+		t.Errorf("expected an error for a build arg without a value")
+	}
+}
+
+func TestRedactingWriter(t *testing.T) {
+	out := &bytes.Buffer{}
+	w := newRedactingWriter(out, []string{"s3cr3t"})
+	for _, s := range []string{"Step 2 : RUN curl -H token:s3", "cr3t http://example.com\nStep 3 : s3cr3t"} {
+		if _, err := w.Write([]byte(s)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if out.String() != "Step 2 : RUN curl -H token:***** http://example.com\n" {
+		t.Errorf("expected complete lines to be written redacted, got %q", out.String())
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.String() != "Step 2 : RUN curl -H token:***** http://example.com\nStep 3 : *****" {
+		t.Errorf("expected the last line to be written on close, got %q", out.String())
+	}
+}
+
+func TestRedactImageHistory(t *testing.T) {
+	fd := &redactFakeDocker{
+		image: &docker.Image{ID: "built", Config: &docker.Config{Cmd: []string{"run"}}},
+		files: map[string]string{
+			"manifest.json":   `[{"Config":"built.json","RepoTags":["build-tag:latest"],"Layers":["layer/layer.tar"]}]`,
+			"built.json":      `{"history":[{"created_by":"|1 TOKEN=s3cr3t\u003e /bin/sh -c make"}]}`,
+			"layer/json":      `{"container_config":{"Cmd":["|1 TOKEN=s3cr3t\u003e /bin/sh -c make"]}}`,
+			"layer/layer.tar": "s3cr3t> in a file",
+		},
+	}
+	if err := redactImageHistory(fd, "build-tag:latest", []string{"s3cr3t>"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []methodCall{
+		{"ExportImage", []interface{}{"build-tag:latest"}},
+		{"RemoveImage", []interface{}{"built"}},
+		{"LoadImage", nil},
+	}
+	if !reflect.DeepEqual(fd.callLog, expected) {
+		t.Errorf("expected calls %v, got %v", expected, fd.callLog)
+	}
+	expectedFiles := map[string]string{
+		"manifest.json":   `[{"Config":"built.json","RepoTags":["build-tag:latest"],"Layers":["layer/layer.tar"]}]`,
+		"built.json":      `{"history":[{"created_by":"|1 TOKEN=***** /bin/sh -c make"}]}`,
+		"layer/json":      `{"container_config":{"Cmd":["|1 TOKEN=***** /bin/sh -c make"]}}`,
+		"layer/layer.tar": "s3cr3t> in a file",
+	}
+	if !reflect.DeepEqual(fd.files, expectedFiles) {
+		t.Errorf("expected the loaded image %v, got %v", expectedFiles, fd.files)
+	}
+}
+
+func TestRedactImageArchiveShortValue(t *testing.T) {
+	manifest := `[{"Config":"sha256:1a1.json","Layers":["1a1/layer.tar"]}]`
+	config := `{"id":"1a1","config":{"Env":["V=1"],"Labels":{"1":"1"}},"created":"2017-01-01T00:00:01Z","history":[{"created":"2017-01-01T00:00:01Z","created_by":"|1 V=1 /bin/sh -c make"},{"empty_layer":true}],"size":1}`
+	unchanged := `{"id":"1a1","parent":"1b1","created":"2017-01-01T00:00:01Z"}`
+	in := &bytes.Buffer{}
+	tw := tar.NewWriter(in)
+	files := []struct{ name, data string }{
+		{"manifest.json", manifest},
+		{"sha256:1a1.json", config},
+		{"1b1/json", unchanged},
+		{"1a1/layer.tar", "1"},
+	}
+	for _, file := range files {
+		if err := tw.WriteHeader(&tar.Header{Name: file.name, Mode: 0644, Size: int64(len(file.data))}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, err := tw.Write([]byte(file.data)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := &bytes.Buffer{}
+	if err := redactImageArchive(in, out, []string{"1"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := map[string]string{
+		"manifest.json":   manifest,
+		"sha256:1a1.json": `{"config":{"Env":["V=*****"],"Labels":{"1":"*****"}},"created":"2017-01-01T00:00:01Z","history":[{"created":"2017-01-01T00:00:01Z","created_by":"|***** V=***** /bin/sh -c make"},{"empty_layer":true}],"id":"1a1","size":1}`,
+		"1b1/json":        unchanged,
+		"1a1/layer.tar":   "1",
+	}
+	tr := tar.NewReader(out)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		data, err := ioutil.ReadAll(tr)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if e, a := expected[header.Name], string(data); e != a {
+			t.Errorf("expected %s to be\n%s\ngot\n%s", header.Name, e, a)
+		}
+		delete(expected, header.Name)
+	}
+	if len(expected) != 0 {
+		t.Errorf("expected files %v to be copied", expected)
+	}
+}
+
+func TestConfigContains(t *testing.T) {
+	tests := []struct {
+		config   *docker.Config
+		contains bool
+	}{
+		{config: &docker.Config{Env: []string{"PATH=/bin"}, Labels: map[string]string{"version": "1.0"}}},
+		{config: &docker.Config{Env: []string{"TOKEN=s3cr3t"}}, contains: true},
+		{config: &docker.Config{Labels: map[string]string{"token": "s3cr3t"}}, contains: true},
+		{config: &docker.Config{Cmd: []string{"login", "--token=s3cr3t"}}, contains: true},
+	}
+	for i, test := range tests {
+		if contains := configContains(test.config, []string{"s3cr3t"}); contains != test.contains {
+			t.Errorf("%d: expected %t, got %t", i, test.contains, contains)
+		}
+	}
+}
@@ -0,0 +1,227 @@
+package builder
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+
+	docker "github.com/fsouza/go-dockerclient"
+	"github.com/golang/glog"
+
+	"github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/build/builder/cmd/dockercfg"
+	"github.com/openshift/origin/pkg/build/controller/strategy"
+)
+
+// buildCacheKeyLabel is the label of a build cache image recording the key
+// the cache was saved with.
+const buildCacheKeyLabel = "io.openshift.build.cache.key"
+
+// buildCacheKey returns the checksum of the key files of the build cache
+// found in dir. A key file that does not exist contributes to the checksum
+// as well, so that adding it invalidates the cache.
+func buildCacheKey(cache *api.BuildCache, dir string) (string, error) {
+	hash := sha256.New()
+	for _, name := range cache.KeyFiles {
+		fmt.Fprintf(hash, "%s\x00", name)
+		f, err := os.Open(filepath.Join(dir, name))
+		if os.IsNotExist(err) {
+			hash.Write([]byte{0})
+			continue
+		}
+		if err != nil {
+			return "", err
+		}
+		_, err = io.Copy(hash, f)
+		f.Close()
+		if err != nil {
+			return "", err
+		}
+		hash.Write([]byte{1})
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// restoreBuildCache restores the cache of the build into dir, the directory
+// the build source was fetched to, and returns the key of the cache. Failing
+// to restore the cache does not fail the build, the dependencies are then
+// fetched again by the build.
+func restoreBuildCache(dockerClient DockerClient, build *api.Build, dir string) string {
+	cache := build.Spec.Cache
+	if cache == nil {
+		return ""
+	}
+	key, err := buildCacheKey(cache, dir)
+	if err != nil {
+		glog.Warningf("Unable to compute the build cache key: %v", err)
+		return ""
+	}
+	switch {
+	case cache.PersistentVolumeClaim != nil:
+		err = restoreCacheFromVolume(strategy.BuildCacheMountPath, cache, key, dir)
+	case cache.Image != nil:
+		err = restoreCacheFromImage(dockerClient, cache, key, dir)
+	}
+	if err != nil {
+		glog.Warningf("Unable to restore the build cache: %v", err)
+	}
+	return key
+}
+
+// saveBuildCache saves the cached paths of the built image under the provided
+// key. Failing to save the cache does not fail the build.
+func saveBuildCache(dockerClient DockerClient, build *api.Build, image, key string) {
+	cache := build.Spec.Cache
+	if cache == nil || len(key) == 0 {
+		return
+	}
+	var err error
+	switch {
+	case cache.PersistentVolumeClaim != nil:
+		err = saveCacheToVolume(dockerClient, strategy.BuildCacheMountPath, cache, key, image)
+	case cache.Image != nil:
+		err = saveCacheToImage(dockerClient, cache, key, image)
+	}
+	if err != nil {
+		glog.Warningf("Unable to save the build cache: %v", err)
+	}
+}
+
+// restoreCacheFromVolume copies the cache saved under key in cacheRoot to the
+// destination directories of the cached paths.
+func restoreCacheFromVolume(cacheRoot string, cache *api.BuildCache, key, dir string) error {
+	cacheDir := filepath.Join(cacheRoot, key)
+	if _, err := os.Stat(cacheDir); os.IsNotExist(err) {
+		glog.Infof("No build cache was saved for key %s", key)
+		return nil
+	}
+	for i, path := range cache.Paths {
+		srcDir := filepath.Join(cacheDir, strconv.Itoa(i))
+		if _, err := os.Stat(srcDir); os.IsNotExist(err) {
+			continue
+		}
+		dstDir := filepath.Join(dir, path.DestinationDir)
+		if err := os.MkdirAll(dstDir, 0755); err != nil {
+			return err
+		}
+		glog.V(4).Infof("Restoring build cache %s to %s", srcDir, dstDir)
+		if out, err := exec.Command("cp", "-a", srcDir+"/.", dstDir+"/").CombinedOutput(); err != nil {
+			return fmt.Errorf("error copying %s to %s: %v: %s", srcDir, dstDir, err, string(out))
+		}
+	}
+	glog.Infof("Restored the build cache for key %s", key)
+	return nil
+}
+
+// saveCacheToVolume copies the cached paths of image under key in cacheRoot.
+// The caches saved with other keys are kept, as builds sharing the volume may
+// still be using them.
+func saveCacheToVolume(dockerClient DockerClient, cacheRoot string, cache *api.BuildCache, key, image string) error {
+	tmpDir, err := ioutil.TempDir(cacheRoot, ".save-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	paths := make([]api.ImageSourcePath, len(cache.Paths))
+	for i, path := range cache.Paths {
+		paths[i] = api.ImageSourcePath{SourcePath: path.SourcePath, DestinationDir: strconv.Itoa(i)}
+	}
+	if err := copyPathsFromImage(dockerClient, image, tmpDir, paths); err != nil {
+		return err
+	}
+	if err := replaceCacheDir(cacheRoot, tmpDir, key); err != nil {
+		return err
+	}
+	glog.Infof("Saved the build cache for key %s", key)
+	return nil
+}
+
+// replaceCacheDir replaces the cache saved under key in cacheRoot with the
+// cache saved in dir. The previous cache is renamed away before it is removed,
+// so that the cache under key is always complete. If another build saves the
+// same key at the same time, the cache it saved is kept.
+func replaceCacheDir(cacheRoot, dir, key string) error {
+	cacheDir := filepath.Join(cacheRoot, key)
+	staleDir := filepath.Join(cacheRoot, ".stale-"+filepath.Base(dir))
+	if err := os.Rename(cacheDir, staleDir); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	defer os.RemoveAll(staleDir)
+	if err := os.Rename(dir, cacheDir); err != nil {
+		if _, statErr := os.Stat(cacheDir); statErr == nil {
+			glog.V(4).Infof("The build cache for key %s was saved by another build", key)
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+// restoreCacheFromImage copies the cached paths from the cache image to their
+// destination directories if the image was saved with the provided key.
+func restoreCacheFromImage(dockerClient DockerClient, cache *api.BuildCache, key, dir string) error {
+	name := cache.Image.Name
+	auth, _ := dockercfg.NewHelper().GetDockerAuth(name, dockercfg.PushAuthType)
+	glog.Infof("Pulling build cache image %s ...", name)
+	if err := dockerClient.PullImage(docker.PullImageOptions{Repository: name}, auth); err != nil {
+		// The cache image does not exist until a first build saved it.
+		glog.Infof("Unable to pull build cache image %s, the build will run without a cache: %v", name, err)
+		return nil
+	}
+	image, err := dockerClient.InspectImage(name)
+	if err != nil {
+		return err
+	}
+	if image.Config == nil || image.Config.Labels[buildCacheKeyLabel] != key {
+		glog.Infof("The build cache image %s was saved for a different key, the build will run without a cache", name)
+		return nil
+	}
+	if err := copyPathsFromImage(dockerClient, name, dir, cache.Paths); err != nil {
+		return err
+	}
+	glog.Infof("Restored the build cache for key %s", key)
+	return nil
+}
+
+// saveCacheToImage commits the built image as the cache image, labeled with
+// the provided key, and pushes it.
+func saveCacheToImage(dockerClient DockerClient, cache *api.BuildCache, key, image string) error {
+	container, err := dockerClient.CreateContainer(docker.CreateContainerOptions{
+		Config: &docker.Config{
+			Image: image,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error creating build cache container: %v", err)
+	}
+	defer dockerClient.RemoveContainer(docker.RemoveContainerOptions{ID: container.ID})
+
+	name := cache.Image.Name
+	repository, tag := docker.ParseRepositoryTag(name)
+	_, err = dockerClient.CommitContainer(docker.CommitContainerOptions{
+		Container:  container.ID,
+		Repository: repository,
+		Tag:        tag,
+		Run: &docker.Config{
+			Labels: map[string]string{buildCacheKeyLabel: key},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error committing build cache image %s: %v", name, err)
+	}
+
+	auth, _ := dockercfg.NewHelper().GetDockerAuth(name, dockercfg.PushAuthType)
+	glog.Infof("Pushing build cache image %s ...", name)
+	if _, err := pushImage(dockerClient, name, auth); err != nil {
+		return fmt.Errorf("error pushing build cache image %s: %v", name, err)
+	}
+	glog.Infof("Saved the build cache for key %s", key)
+	return nil
+}
@@ -0,0 +1,161 @@
+package builder
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	docker "github.com/fsouza/go-dockerclient"
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	"github.com/openshift/origin/pkg/build/api"
+)
+
+func TestBuildCacheKey(t *testing.T) {
+	dir, err := ioutil.TempDir("", "build-cache-key")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	cache := &api.BuildCache{KeyFiles: []string{"pom.xml", "missing.xml"}}
+	if err := ioutil.WriteFile(filepath.Join(dir, "pom.xml"), []byte("<project/>"), 0644); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	key, err := buildCacheKey(cache, dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if same, _ := buildCacheKey(cache, dir); same != key {
+		t.Errorf("expected the key to be stable, got %s and %s", key, same)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(dir, "pom.xml"), []byte("<project></project>"), 0644); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	changed, err := buildCacheKey(cache, dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if changed == key {
+		t.Errorf("expected the key to change with the content of a key file")
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(dir, "missing.xml"), nil, 0644); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	added, err := buildCacheKey(cache, dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if added == changed {
+		t.Errorf("expected the key to change when a missing key file is added")
+	}
+}
+
+func TestRestoreCacheFromVolume(t *testing.T) {
+	cacheRoot, err := ioutil.TempDir("", "build-cache")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(cacheRoot)
+	dir, err := ioutil.TempDir("", "build-source")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	cache := &api.BuildCache{Paths: []api.ImageSourcePath{{SourcePath: "/opt/app-root/src/.m2", DestinationDir: ".m2"}}}
+	if err := restoreCacheFromVolume(cacheRoot, cache, "key", dir); err != nil {
+		t.Fatalf("unexpected error restoring a missing cache: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, ".m2")); !os.IsNotExist(err) {
+		t.Errorf("expected nothing to be restored for a missing cache")
+	}
+
+	if err := os.MkdirAll(filepath.Join(cacheRoot, "key", "0", "repository"), 0755); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(cacheRoot, "key", "0", "repository", "artifact.jar"), []byte("jar"), 0644); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := restoreCacheFromVolume(cacheRoot, cache, "other", dir); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, ".m2")); !os.IsNotExist(err) {
+		t.Errorf("expected nothing to be restored for a different key")
+	}
+	if err := restoreCacheFromVolume(cacheRoot, cache, "key", dir); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	content, err := ioutil.ReadFile(filepath.Join(dir, ".m2", "repository", "artifact.jar"))
+	if err != nil {
+		t.Fatalf("expected the cache to be restored: %v", err)
+	}
+	if string(content) != "jar" {
+		t.Errorf("unexpected restored content %q", string(content))
+	}
+}
+
+func TestReplaceCacheDir(t *testing.T) {
+	cacheRoot, err := ioutil.TempDir("", "build-cache")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(cacheRoot)
+
+	// the caches of other keys and the caches being saved by other builds are kept
+	for _, name := range []string{"other", ".save-other", "key"} {
+		if err := os.MkdirAll(filepath.Join(cacheRoot, name, "0"), 0755); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	dir := filepath.Join(cacheRoot, ".save-1")
+	if err := os.MkdirAll(filepath.Join(dir, "0"), 0755); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "0", "artifact.jar"), []byte("jar"), 0644); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := replaceCacheDir(cacheRoot, dir, "key"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	entries, err := ioutil.ReadDir(cacheRoot)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	names := []string{}
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	if expected := []string{".save-other", "key", "other"}; !reflect.DeepEqual(names, expected) {
+		t.Errorf("expected the entries %v, got %v", expected, names)
+	}
+	if _, err := os.Stat(filepath.Join(cacheRoot, "key", "0", "artifact.jar")); err != nil {
+		t.Errorf("expected the cache to be replaced: %v", err)
+	}
+}
+
+func TestSaveCacheToImage(t *testing.T) {
+	fd := &FakeDocker{}
+	cache := &api.BuildCache{Image: &kapi.ObjectReference{Kind: "DockerImage", Name: "registry/ns/cache:latest"}}
+	if err := saveCacheToImage(fd, cache, "key", "build-image"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(fd.callLog) != 1 || fd.callLog[0].methodName != "CommitContainer" {
+		t.Fatalf("expected the cache image to be committed, got %#v", fd.callLog)
+	}
+	opts := fd.callLog[0].args[0].(docker.CommitContainerOptions)
+	if opts.Repository != "registry/ns/cache" || opts.Tag != "latest" {
+		t.Errorf("unexpected cache image %s:%s", opts.Repository, opts.Tag)
+	}
+	if opts.Run == nil || opts.Run.Labels[buildCacheKeyLabel] != "key" {
+		t.Errorf("expected the cache image to be labeled with the key, got %#v", opts.Run)
+	}
+	if !fd.pushImageCalled {
+		t.Errorf("expected the cache image to be pushed")
+	}
+}
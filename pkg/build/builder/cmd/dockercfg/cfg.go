@@ -20,9 +20,10 @@ import (
 //TODO: Remove this code once the methods in Kubernetes kubelet/dockertools/config.go are public
 
 const (
-	PushAuthType       = "PUSH_DOCKERCFG_PATH"
-	PullAuthType       = "PULL_DOCKERCFG_PATH"
-	PullSourceAuthType = "PULL_SOURCE_DOCKERCFG_PATH_"
+	PushAuthType            = "PUSH_DOCKERCFG_PATH"
+	PushDestinationAuthType = "PUSH_DESTINATION_DOCKERCFG_PATH_"
+	PullAuthType            = "PULL_DOCKERCFG_PATH"
+	PullSourceAuthType      = "PULL_SOURCE_DOCKERCFG_PATH_"
 )
 
 // Helper contains all the valid config options for reading the local dockercfg file
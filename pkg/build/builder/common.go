@@ -5,12 +5,15 @@ import (
 	"fmt"
 	"math/rand"
 	"os"
+	"time"
 
 	"github.com/docker/distribution/reference"
 	"github.com/fsouza/go-dockerclient"
 	"github.com/golang/glog"
 
 	"github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/build/builder/cmd/dockercfg"
+	buildutil "github.com/openshift/origin/pkg/build/util"
 	"github.com/openshift/origin/pkg/client"
 	"github.com/openshift/origin/pkg/generate/git"
 )
@@ -26,6 +29,7 @@ type KeyValue struct {
 // GitClient performs git operations
 type GitClient interface {
 	CloneWithOptions(dir string, url string, opts git.CloneOptions) error
+	FetchRef(dir string, ref string) error
 	Checkout(dir string, ref string) error
 	SubmoduleUpdate(dir string, init, recursive bool) error
 	ListRemote(url string, args ...string) (string, string, error)
@@ -90,6 +94,59 @@ func updateBuildRevision(c client.BuildInterface, build *api.Build, sourceInfo *
 	}
 }
 
+// pushDestinations tags the pushed output image with each of the additional
+// destinations of the build and pushes it there. It returns the images pushed
+// so far, even if pushing to one of the destinations failed.
+func pushDestinations(client DockerClient, build *api.Build, image string) ([]api.BuildStatusOutput, error) {
+	outputs := []api.BuildStatusOutput{}
+	for i, destination := range build.Spec.Output.Destinations {
+		name := destination.To.Name
+		if err := tagImage(client, image, name); err != nil {
+			return outputs, err
+		}
+		// Destinations without a push secret of their own use the push secret
+		// of the build output.
+		authType := fmt.Sprintf("%s%d", dockercfg.PushDestinationAuthType, i)
+		if len(os.Getenv(authType)) == 0 {
+			authType = dockercfg.PushAuthType
+		}
+		authConfig, authPresent := dockercfg.NewHelper().GetDockerAuth(name, authType)
+		if authPresent {
+			glog.V(4).Infof("Authenticating Docker push to %s with user %q", name, authConfig.Username)
+		}
+		glog.Infof("Pushing image %s ...", name)
+		digest, err := pushImage(client, name, authConfig)
+		if err != nil {
+			return outputs, fmt.Errorf("Failed to push image to %s: %v", name, err)
+		}
+		glog.Infof("Successfully pushed %s", name)
+		outputs = append(outputs, api.BuildStatusOutput{DockerImageReference: name, ImageDigest: digest})
+	}
+	return outputs, nil
+}
+
+// updateBuildOutputs records the images pushed by the build in its status.
+func updateBuildOutputs(c client.BuildInterface, build *api.Build, outputs []api.BuildStatusOutput) {
+	build.Status.Outputs = outputs
+
+	// Reset ResourceVersion to avoid a conflict with other updates to the build
+	build.ResourceVersion = ""
+
+	glog.V(4).Infof("Setting build outputs to %#v", outputs)
+	if _, err := c.UpdateDetails(build); err != nil {
+		glog.Warningf("An error occurred saving build outputs: %v", err)
+	}
+}
+
+// startStage marks the start of a stage of the build in the build log and
+// returns a function marking its end.
+func startStage(stage string) func() {
+	glog.Info(buildutil.StageMarker(stage, true, time.Now()))
+	return func() {
+		glog.Info(buildutil.StageMarker(stage, false, time.Now()))
+	}
+}
+
 // randomBuildTag generates a random tag used for building images in such a way
 // that the built image can be referred to unambiguously even in the face of
 // concurrent builds with the same name in the same namespace.
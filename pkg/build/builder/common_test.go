@@ -1,11 +1,13 @@
 package builder
 
 import (
+	"errors"
 	"math/rand"
 	"reflect"
 	"strings"
 	"testing"
 
+	docker "github.com/fsouza/go-dockerclient"
 	kapi "k8s.io/kubernetes/pkg/api"
 
 	"github.com/openshift/origin/pkg/build/api"
@@ -100,3 +102,60 @@ func TestContainerName(t *testing.T) {
 		t.Errorf("got %v, want %v", got, want)
 	}
 }
+
+func TestPushDestinations(t *testing.T) {
+	build := &api.Build{
+		Spec: api.BuildSpec{
+			Output: api.BuildOutput{
+				To: &kapi.ObjectReference{Kind: "DockerImage", Name: "registry/app:latest"},
+				Destinations: []api.BuildOutputDestination{
+					{To: kapi.ObjectReference{Kind: "DockerImage", Name: "registry/app:abc123"}},
+					{To: kapi.ObjectReference{Kind: "DockerImage", Name: "external.example.com/team/app:latest"}},
+				},
+			},
+		},
+	}
+	pushed := []string{}
+	client := &FakeDocker{
+		pushImageFunc: func(opts docker.PushImageOptions, auth docker.AuthConfiguration) error {
+			pushed = append(pushed, opts.Name+":"+opts.Tag)
+			return nil
+		},
+	}
+	outputs, err := pushDestinations(client, build, "registry/app:latest")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []string{"registry/app:abc123", "external.example.com/team/app:latest"}
+	if !reflect.DeepEqual(pushed, expected) {
+		t.Errorf("expected pushes to %v, got %v", expected, pushed)
+	}
+	if len(outputs) != len(expected) {
+		t.Fatalf("expected %d outputs, got %#v", len(expected), outputs)
+	}
+	for i := range expected {
+		if outputs[i].DockerImageReference != expected[i] {
+			t.Errorf("expected output %d to be %s, got %s", i, expected[i], outputs[i].DockerImageReference)
+		}
+	}
+	for i, call := range client.callLog {
+		if call.methodName != "TagImage" || call.args[0] != "registry/app:latest" {
+			t.Errorf("unexpected call %d: %#v", i, call)
+		}
+	}
+
+	pushed = []string{}
+	client.pushImageFunc = func(opts docker.PushImageOptions, auth docker.AuthConfiguration) error {
+		if opts.Name == "external.example.com/team/app" {
+			return errors.New("unauthorized")
+		}
+		return nil
+	}
+	outputs, err = pushDestinations(client, build, "registry/app:latest")
+	if err == nil || !strings.Contains(err.Error(), "unauthorized") {
+		t.Errorf("expected a push error, got %v", err)
+	}
+	if len(outputs) != 1 {
+		t.Errorf("expected the successful push to be reported, got %#v", outputs)
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/openshift/origin/pkg/build/api"
 	"github.com/openshift/origin/pkg/build/builder/cmd/dockercfg"
 	"github.com/openshift/origin/pkg/build/controller/strategy"
+	buildutil "github.com/openshift/origin/pkg/build/util"
 	"github.com/openshift/origin/pkg/client"
 	"github.com/openshift/origin/pkg/generate/git"
 	imageapi "github.com/openshift/origin/pkg/image/api"
@@ -64,13 +65,17 @@ func (d *DockerBuilder) Build() error {
 	if err != nil {
 		return err
 	}
+	endStage := startStage(buildutil.StageFetchSource)
 	sourceInfo, err := fetchSource(d.dockerClient, buildDir, d.build, d.urlTimeout, os.Stdin, d.gitClient)
 	if err != nil {
+		endStage()
 		return err
 	}
 	if sourceInfo != nil {
 		updateBuildRevision(d.client, d.build, sourceInfo)
 	}
+	cacheKey := restoreBuildCache(d.dockerClient, d.build, buildDir)
+	endStage()
 	if err := d.addBuildParameters(buildDir); err != nil {
 		return err
 	}
@@ -86,20 +91,30 @@ func (d *DockerBuilder) Build() error {
 
 	buildTag := randomBuildTag(d.build.Namespace, d.build.Name)
 
-	if err := d.dockerBuild(buildDir, buildTag, d.build.Spec.Source.Secrets); err != nil {
+	endStage = startStage(buildutil.StageAssemble)
+	err = d.dockerBuild(buildDir, buildTag, d.build.Spec.Source.Secrets)
+	endStage()
+	if err != nil {
 		return err
 	}
 
+	endStage = startStage(buildutil.StagePostCommit)
 	cname := containerName("docker", d.build.Name, d.build.Namespace, "post-commit")
-	if err := execPostCommitHook(d.dockerClient, d.build.Spec.PostCommit, buildTag, cname); err != nil {
+	err = execPostCommitHook(d.dockerClient, d.build.Spec.PostCommit, buildTag, cname)
+	endStage()
+	if err != nil {
 		return err
 	}
 
+	endStage = startStage(buildutil.StageCommit)
 	if push {
 		if err := tagImage(d.dockerClient, buildTag, pushTag); err != nil {
+			endStage()
 			return err
 		}
 	}
+	saveBuildCache(d.dockerClient, d.build, buildTag, cacheKey)
+	endStage()
 
 	if err := removeImage(d.dockerClient, buildTag); err != nil {
 		glog.Warningf("Failed to remove temporary build tag %v: %v", buildTag, err)
@@ -107,6 +122,7 @@ func (d *DockerBuilder) Build() error {
 
 	defer glog.Flush()
 	if push {
+		defer startStage(buildutil.StagePush)()
 		// Get the Docker push authentication
 		pushAuthConfig, authPresent := dockercfg.NewHelper().GetDockerAuth(
 			pushTag,
@@ -116,10 +132,17 @@ func (d *DockerBuilder) Build() error {
 			glog.V(4).Infof("Authenticating Docker push with user %q", pushAuthConfig.Username)
 		}
 		glog.Infof("Pushing image %s ...", pushTag)
-		if err := pushImage(d.dockerClient, pushTag, pushAuthConfig); err != nil {
+		digest, err := pushImage(d.dockerClient, pushTag, pushAuthConfig)
+		if err != nil {
 			return fmt.Errorf("Failed to push image: %v", err)
 		}
 		glog.Infof("Push successful")
+
+		outputs, err := pushDestinations(d.dockerClient, d.build, pushTag)
+		updateBuildOutputs(d.client, d.build, append([]api.BuildStatusOutput{{DockerImageReference: pushTag, ImageDigest: digest}}, outputs...))
+		if err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -295,7 +318,33 @@ func (d *DockerBuilder) dockerBuild(dir string, tag string, secrets []api.Secret
 	if err := d.copySecrets(secrets, dir); err != nil {
 		return err
 	}
-	return buildImage(d.dockerClient, dir, dockerfilePath, noCache, tag, d.tar, auth, forcePull, d.cgLimits)
+	if d.build.Spec.Strategy.DockerStrategy == nil || len(d.build.Spec.Strategy.DockerStrategy.BuildArgs) == 0 {
+		return buildImage(d.dockerClient, dir, dockerfilePath, noCache, tag, d.tar, auth, forcePull, d.cgLimits, nil, os.Stdout)
+	}
+	return d.dockerBuildWithArgs(dir, dockerfilePath, noCache, tag, auth, forcePull)
+}
+
+// dockerBuildWithArgs performs a docker build passing the build args of the
+// strategy. The values of the build args taken from secrets are hidden from
+// the build output and removed from the history and the configuration of the
+// built image.
+func (d *DockerBuilder) dockerBuildWithArgs(dir, dockerfilePath string, noCache bool, tag string, auth *docker.AuthConfigurations, forcePull bool) error {
+	buildArgs, secretValues, err := resolveBuildArgs(d.build.Spec.Strategy.DockerStrategy.BuildArgs)
+	if err != nil {
+		return err
+	}
+	out := newRedactingWriter(os.Stdout, secretValues)
+	err = buildImage(d.dockerClient, dir, dockerfilePath, noCache, tag, d.tar, auth, forcePull, d.cgLimits, buildArgs, out)
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil || len(secretValues) == 0 {
+		return err
+	}
+	if err := redactImageHistory(d.dockerClient, tag, secretValues); err != nil {
+		return fmt.Errorf("unable to remove the build args from the history of the image: %v", err)
+	}
+	return nil
 }
 
 // replaceLastFrom changes the last FROM instruction of node to point to the
@@ -1,9 +1,11 @@
 package builder
 
 import (
+	"bytes"
 	"fmt"
 	"io"
 	"os"
+	"regexp"
 	"strings"
 	"time"
 
@@ -29,6 +31,9 @@ var (
 		"connection reset by peer",
 		"transport closed before response was received",
 	}
+	// pushDigestPattern matches the digest reported by the Docker daemon once
+	// an image has been pushed.
+	pushDigestPattern = regexp.MustCompile(`digest: (sha256:[a-f0-9]{64})`)
 )
 
 // DockerClient is an interface to the Docker client that contains
@@ -46,29 +51,36 @@ type DockerClient interface {
 	WaitContainer(id string) (int, error)
 	Logs(opts docker.LogsOptions) error
 	TagImage(name string, opts docker.TagImageOptions) error
+	CommitContainer(opts docker.CommitContainerOptions) (*docker.Image, error)
+	ExportImage(opts docker.ExportImageOptions) error
+	LoadImage(opts docker.LoadImageOptions) error
 }
 
-// pushImage pushes a docker image to the registry specified in its tag.
+// pushImage pushes a docker image to the registry specified in its tag and
+// returns the digest of the pushed image, if the Docker daemon reported one.
 // The method will retry to push the image when following scenarios occur:
 // - Docker registry is down temporarily or permanently
 // - other image is being pushed to the registry
 // If any other scenario the push will fail, without retries.
-func pushImage(client DockerClient, name string, authConfig docker.AuthConfiguration) error {
+func pushImage(client DockerClient, name string, authConfig docker.AuthConfiguration) (string, error) {
 	repository, tag := docker.ParseRepositoryTag(name)
+	var out bytes.Buffer
 	opts := docker.PushImageOptions{
-		Name: repository,
-		Tag:  tag,
+		Name:         repository,
+		Tag:          tag,
+		OutputStream: &out,
 	}
 	if glog.V(5) {
-		opts.OutputStream = os.Stderr
+		opts.OutputStream = io.MultiWriter(&out, os.Stderr)
 	}
 	var err error
 	var retriableError = false
 
 	for retries := 0; retries <= DefaultPushRetryCount; retries++ {
+		out.Reset()
 		err = client.PushImage(opts, authConfig)
 		if err == nil {
-			return nil
+			return pushedDigest(out.String()), nil
 		}
 
 		errMsg := fmt.Sprintf("%s", err)
@@ -79,22 +91,33 @@ func pushImage(client DockerClient, name string, authConfig docker.AuthConfigura
 			}
 		}
 		if !retriableError {
-			return err
+			return "", err
 		}
 
 		utilruntime.HandleError(fmt.Errorf("push for image %s failed, will retry in %s ...", name, DefaultPushRetryDelay))
 		glog.Flush()
 		time.Sleep(DefaultPushRetryDelay)
 	}
-	return err
+	return "", err
+}
+
+// pushedDigest returns the digest reported in the output of a push, or an
+// empty string if there is none.
+func pushedDigest(output string) string {
+	matches := pushDigestPattern.FindAllStringSubmatch(output, -1)
+	if len(matches) == 0 {
+		return ""
+	}
+	return matches[len(matches)-1][1]
 }
 
 func removeImage(client DockerClient, name string) error {
 	return client.RemoveImage(name)
 }
 
-// buildImage invokes a docker build on a particular directory
-func buildImage(client DockerClient, dir string, dockerfilePath string, noCache bool, tag string, tar tar.Tar, pullAuth *docker.AuthConfigurations, forcePull bool, cgLimits *s2iapi.CGroupLimits) error {
+// buildImage invokes a docker build on a particular directory, writing the
+// output of the build to out
+func buildImage(client DockerClient, dir string, dockerfilePath string, noCache bool, tag string, tar tar.Tar, pullAuth *docker.AuthConfigurations, forcePull bool, cgLimits *s2iapi.CGroupLimits, buildArgs []docker.BuildArg, out io.Writer) error {
 	// TODO: be able to pass a stream directly to the Docker build to avoid the double temp hit
 	r, w := io.Pipe()
 	go func() {
@@ -109,11 +132,12 @@ func buildImage(client DockerClient, dir string, dockerfilePath string, noCache
 	opts := docker.BuildImageOptions{
 		Name:           tag,
 		RmTmpContainer: true,
-		OutputStream:   os.Stdout,
+		OutputStream:   out,
 		InputStream:    r,
 		Dockerfile:     dockerfilePath,
 		NoCache:        noCache,
 		Pull:           forcePull,
+		BuildArgs:      buildArgs,
 	}
 	if cgLimits != nil {
 		opts.Memory = cgLimits.MemoryLimitBytes
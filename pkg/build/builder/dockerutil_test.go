@@ -1,6 +1,9 @@
 package builder
 
 import (
+	"fmt"
+	"io"
+	"io/ioutil"
 	"reflect"
 	"testing"
 
@@ -72,6 +75,19 @@ func (d *FakeDocker) TagImage(name string, opts docker.TagImageOptions) error {
 	d.callLog = append(d.callLog, methodCall{"TagImage", []interface{}{name, opts}})
 	return nil
 }
+func (d *FakeDocker) CommitContainer(opts docker.CommitContainerOptions) (*docker.Image, error) {
+	d.callLog = append(d.callLog, methodCall{"CommitContainer", []interface{}{opts}})
+	return &docker.Image{}, nil
+}
+func (d *FakeDocker) ExportImage(opts docker.ExportImageOptions) error {
+	_, err := io.WriteString(opts.OutputStream, "exported "+opts.Name)
+	return err
+}
+func (d *FakeDocker) LoadImage(opts docker.LoadImageOptions) error {
+	data, err := ioutil.ReadAll(opts.InputStream)
+	d.callLog = append(d.callLog, methodCall{"LoadImage", []interface{}{string(data)}})
+	return err
+}
 
 func TestDockerPush(t *testing.T) {
 	verifyFunc := func(opts docker.PushImageOptions, auth docker.AuthConfiguration) error {
@@ -84,6 +100,31 @@ func TestDockerPush(t *testing.T) {
 	pushImage(fd, "test/image", docker.AuthConfiguration{})
 }
 
+func TestDockerPushDigest(t *testing.T) {
+	digest := "sha256:8c9d2a0a4e1c1e0e5d3f5a8d1c1b5e0d2f3a4b5c6d7e8f9a0b1c2d3e4f5a6b7c"
+	pushFunc := func(opts docker.PushImageOptions, auth docker.AuthConfiguration) error {
+		fmt.Fprintf(opts.OutputStream, "The push refers to a repository [test/image]\nlatest: digest: %s size: 1234\n", digest)
+		return nil
+	}
+	fd := &FakeDocker{pushImageFunc: pushFunc}
+	actual, err := pushImage(fd, "test/image", docker.AuthConfiguration{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if actual != digest {
+		t.Errorf("expected digest %s, got %s", digest, actual)
+	}
+
+	fd = &FakeDocker{}
+	actual, err = pushImage(fd, "test/image", docker.AuthConfiguration{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(actual) != 0 {
+		t.Errorf("expected no digest, got %s", actual)
+	}
+}
+
 func TestTagImage(t *testing.T) {
 	tests := []struct {
 		old, new, newRepo, newTag string
@@ -217,6 +217,16 @@ func extractGitSource(gitClient GitClient, gitSource *api.GitBuildSource, revisi
 	if usingRef {
 		commit := gitSource.Ref
 
+		// refs outside of branches and tags, like the refs/pull/<number>/head refs of
+		// GitHub pull requests, are not fetched by the clone
+		if strings.HasPrefix(gitSource.Ref, "refs/") {
+			glog.V(2).Infof("Fetching %s from %s", gitSource.Ref, gitSource.URI)
+			if err := gitClient.FetchRef(dir, gitSource.Ref); err != nil {
+				return true, err
+			}
+			commit = "FETCH_HEAD"
+		}
+
 		if revision != nil && revision.Git != nil && revision.Git.Commit != "" {
 			commit = revision.Git.Commit
 		}
@@ -322,6 +332,12 @@ func extractSourceFromImage(dockerClient DockerClient, image, buildDir string, i
 
 	}
 
+	return copyPathsFromImage(dockerClient, image, buildDir, paths)
+}
+
+// copyPathsFromImage copies the provided paths of an image present on the node
+// to their destination directories relative to buildDir.
+func copyPathsFromImage(dockerClient DockerClient, image, buildDir string, paths []api.ImageSourcePath) error {
 	// Create container to copy from
 	container, err := dockerClient.CreateContainer(docker.CreateContainerOptions{
 		Config: &docker.Config{
@@ -23,6 +23,7 @@ import (
 	"github.com/openshift/origin/pkg/build/api"
 	"github.com/openshift/origin/pkg/build/builder/cmd/dockercfg"
 	"github.com/openshift/origin/pkg/build/controller/strategy"
+	buildutil "github.com/openshift/origin/pkg/build/util"
 	"github.com/openshift/origin/pkg/client"
 )
 
@@ -237,20 +238,31 @@ func (s *S2IBuilder) Build() error {
 
 	glog.V(4).Infof("Starting S2I build from %s/%s BuildConfig ...", s.build.Namespace, s.build.Name)
 
-	if _, err = builder.Build(config); err != nil {
+	_, err = builder.Build(config)
+	if download.endAssemble != nil {
+		download.endAssemble()
+	}
+	if err != nil {
 		return err
 	}
 
+	endStage := startStage(buildutil.StagePostCommit)
 	cname := containerName("s2i", s.build.Name, s.build.Namespace, "post-commit")
-	if err := execPostCommitHook(s.dockerClient, s.build.Spec.PostCommit, buildTag, cname); err != nil {
+	err = execPostCommitHook(s.dockerClient, s.build.Spec.PostCommit, buildTag, cname)
+	endStage()
+	if err != nil {
 		return err
 	}
 
+	endStage = startStage(buildutil.StageCommit)
 	if push {
 		if err := tagImage(s.dockerClient, buildTag, pushTag); err != nil {
+			endStage()
 			return err
 		}
 	}
+	saveBuildCache(s.dockerClient, s.build, buildTag, download.cacheKey)
+	endStage()
 
 	if err := removeImage(s.dockerClient, buildTag); err != nil {
 		glog.Warningf("Failed to remove temporary build tag %v: %v", buildTag, err)
@@ -258,6 +270,7 @@ func (s *S2IBuilder) Build() error {
 
 	defer glog.Flush()
 	if push {
+		defer startStage(buildutil.StagePush)()
 		// Get the Docker push authentication
 		pushAuthConfig, authPresent := dockercfg.NewHelper().GetDockerAuth(
 			pushTag,
@@ -269,7 +282,8 @@ func (s *S2IBuilder) Build() error {
 			glog.Infof("No push secret provided")
 		}
 		glog.Infof("Pushing %s image ...", pushTag)
-		if err := pushImage(s.dockerClient, pushTag, pushAuthConfig); err != nil {
+		digest, err := pushImage(s.dockerClient, pushTag, pushAuthConfig)
+		if err != nil {
 			// write extended error message to assist in problem resolution
 			msg := fmt.Sprintf("Failed to push image. Response from registry is: %v", err)
 			if authPresent {
@@ -285,6 +299,12 @@ func (s *S2IBuilder) Build() error {
 			return errors.New(msg)
 		}
 		glog.Infof("Successfully pushed %s", pushTag)
+
+		outputs, err := pushDestinations(s.dockerClient, s.build, pushTag)
+		updateBuildOutputs(s.client, s.build, append([]api.BuildStatusOutput{{DockerImageReference: pushTag, ImageDigest: digest}}, outputs...))
+		if err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -297,6 +317,12 @@ type downloader struct {
 	dir        string
 	contextDir string
 	tmpDir     string
+
+	// cacheKey is the key of the build cache restored with the source.
+	cacheKey string
+	// endAssemble marks the end of the assemble stage, which S2I starts
+	// once the source has been downloaded.
+	endAssemble func()
 }
 
 func (d *downloader) Download(config *s2iapi.Config) (*s2iapi.SourceInfo, error) {
@@ -308,13 +334,18 @@ func (d *downloader) Download(config *s2iapi.Config) (*s2iapi.SourceInfo, error)
 	}
 
 	// fetch source
+	endStage := startStage(buildutil.StageFetchSource)
 	sourceInfo, err := fetchSource(d.s.dockerClient, targetDir, d.s.build, d.timeout, d.in, d.s.gitClient)
 	if err != nil {
+		endStage()
 		return nil, err
 	}
 	if sourceInfo != nil {
 		updateBuildRevision(d.s.client, d.s.build, sourceInfo)
 	}
+	d.cacheKey = restoreBuildCache(d.s.dockerClient, d.s.build, targetDir)
+	endStage()
+	d.endAssemble = startStage(buildutil.StageAssemble)
 	if sourceInfo != nil {
 		sourceInfo.ContextDir = config.ContextDir
 	}
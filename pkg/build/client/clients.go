@@ -1,6 +1,8 @@
 package client
 
 import (
+	kapi "k8s.io/kubernetes/pkg/api"
+
 	buildapi "github.com/openshift/origin/pkg/build/api"
 	osclient "github.com/openshift/origin/pkg/client"
 )
@@ -57,6 +59,26 @@ func (c OSClientBuildClient) Update(namespace string, build *buildapi.Build) err
 	return e
 }
 
+// BuildLister provides methods for listing the Builds.
+type BuildLister interface {
+	List(namespace string, opts kapi.ListOptions) (*buildapi.BuildList, error)
+}
+
+// List lists the builds using the OpenShift client.
+func (c OSClientBuildClient) List(namespace string, opts kapi.ListOptions) (*buildapi.BuildList, error) {
+	return c.Client.Builds(namespace).List(opts)
+}
+
+// BuildDeleter provides methods for deleting Builds.
+type BuildDeleter interface {
+	Delete(namespace, name string) error
+}
+
+// Delete deletes a build using the OpenShift client.
+func (c OSClientBuildClient) Delete(namespace, name string) error {
+	return c.Client.Builds(namespace).Delete(name)
+}
+
 // BuildCloner provides methods for cloning builds
 type BuildCloner interface {
 	Clone(namespace string, request *buildapi.BuildRequest) (*buildapi.Build, error)
@@ -96,3 +118,23 @@ func NewOSClientBuildConfigInstantiatorClient(client osclient.Interface) *OSClie
 func (c OSClientBuildConfigInstantiatorClient) Instantiate(namespace string, request *buildapi.BuildRequest) (*buildapi.Build, error) {
 	return c.Client.BuildConfigs(namespace).Instantiate(request)
 }
+
+// ImageStreamTagDeleter provides methods for deleting ImageStreamTags.
+type ImageStreamTagDeleter interface {
+	Delete(namespace, stream, tag string) error
+}
+
+// OSClientImageStreamTagClient deletes ImageStreamTags using the OpenShift client interface
+type OSClientImageStreamTagClient struct {
+	Client osclient.Interface
+}
+
+// NewOSClientImageStreamTagClient creates a new ImageStreamTag client that uses an openshift client to delete tags
+func NewOSClientImageStreamTagClient(client osclient.Interface) *OSClientImageStreamTagClient {
+	return &OSClientImageStreamTagClient{Client: client}
+}
+
+// Delete deletes an ImageStreamTag using the OpenShift client.
+func (c OSClientImageStreamTagClient) Delete(namespace, stream, tag string) error {
+	return c.Client.ImageStreamTags(namespace).Delete(stream, tag)
+}
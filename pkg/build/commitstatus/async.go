@@ -0,0 +1,71 @@
+package commitstatus
+
+import (
+	"fmt"
+
+	"github.com/golang/glog"
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+)
+
+// maxQueuedStatuses is the number of statuses an AsyncNotifier queues before
+// it drops new ones.
+const maxQueuedStatuses = 1000
+
+// buildNotifier reports the status of a build.
+type buildNotifier interface {
+	Notify(build *buildapi.Build) error
+}
+
+// AsyncNotifier reports the statuses of builds in the background, in the
+// order they were queued, so that the requests to the Git providers don't
+// stall the build controllers.
+type AsyncNotifier struct {
+	notifier buildNotifier
+	queue    chan *buildapi.Build
+}
+
+// NewAsyncNotifier returns an AsyncNotifier reporting the statuses with the
+// provided notifier until stop is closed.
+func NewAsyncNotifier(notifier *Notifier, stop <-chan struct{}) *AsyncNotifier {
+	n := newAsyncNotifier(notifier, maxQueuedStatuses)
+	go n.run(stop)
+	return n
+}
+
+func newAsyncNotifier(notifier buildNotifier, size int) *AsyncNotifier {
+	return &AsyncNotifier{
+		notifier: notifier,
+		queue:    make(chan *buildapi.Build, size),
+	}
+}
+
+// Notify queues the current status of the build. It returns an error if too
+// many statuses are waiting to be reported.
+func (n *AsyncNotifier) Notify(build *buildapi.Build) error {
+	copied, err := kapi.Scheme.DeepCopy(build)
+	if err != nil {
+		return err
+	}
+	select {
+	case n.queue <- copied.(*buildapi.Build):
+		return nil
+	default:
+		return fmt.Errorf("too many build statuses are waiting to be reported")
+	}
+}
+
+// run reports the queued statuses until stop is closed.
+func (n *AsyncNotifier) run(stop <-chan struct{}) {
+	for {
+		select {
+		case build := <-n.queue:
+			if err := n.notifier.Notify(build); err != nil {
+				glog.V(2).Infof("Failed to report the status of build %s/%s: %v", build.Namespace, build.Name, err)
+			}
+		case <-stop:
+			return
+		}
+	}
+}
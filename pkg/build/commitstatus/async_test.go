@@ -0,0 +1,43 @@
+package commitstatus
+
+import (
+	"testing"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+)
+
+type recordingNotifier struct {
+	phases chan buildapi.BuildPhase
+}
+
+func (n *recordingNotifier) Notify(build *buildapi.Build) error {
+	n.phases <- build.Status.Phase
+	return nil
+}
+
+func TestAsyncNotifier(t *testing.T) {
+	recorder := &recordingNotifier{phases: make(chan buildapi.BuildPhase, 3)}
+	notifier := newAsyncNotifier(recorder, 2)
+
+	build := mockStatusBuild(buildapi.GitHubCommitStatusProvider, "", buildapi.BuildPhaseRunning)
+	if err := notifier.Notify(build); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// the status is queued as it was when Notify was called
+	build.Status.Phase = buildapi.BuildPhaseComplete
+	if err := notifier.Notify(build); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := notifier.Notify(build); err == nil {
+		t.Errorf("expected an error when the queue is full")
+	}
+
+	stop := make(chan struct{})
+	defer close(stop)
+	go notifier.run(stop)
+	for _, expected := range []buildapi.BuildPhase{buildapi.BuildPhaseRunning, buildapi.BuildPhaseComplete} {
+		if phase := <-recorder.phases; phase != expected {
+			t.Errorf("expected the %s status to be reported, got %s", expected, phase)
+		}
+	}
+}
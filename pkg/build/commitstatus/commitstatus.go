@@ -0,0 +1,182 @@
+// Package commitstatus reports the status of builds to the Git providers
+// hosting their source.
+package commitstatus
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	buildutil "github.com/openshift/origin/pkg/build/util"
+	"github.com/openshift/origin/pkg/generate/git"
+)
+
+// defaultGitHubAPIURL is the API the statuses are reported to for GitHub
+// when the CommitStatusReporting of the build has no APIURL.
+const defaultGitHubAPIURL = "https://api.github.com"
+
+// SecretGetter retrieves the Secret holding the API token of a provider.
+type SecretGetter interface {
+	GetSecret(namespace, name string) (*kapi.Secret, error)
+}
+
+// Notifier reports the status of builds to the Git provider hosting their
+// source, as described by the CommitStatus of the build spec.
+type Notifier struct {
+	Secrets SecretGetter
+	Client  *http.Client
+}
+
+// NewNotifier returns a Notifier using the provided secret getter.
+func NewNotifier(secrets SecretGetter) *Notifier {
+	return &Notifier{
+		Secrets: secrets,
+		Client:  &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Notify reports the current phase of the build on the commit it builds. It
+// does nothing if the build does not report its status or if the commit is
+// not known yet.
+func (n *Notifier) Notify(build *buildapi.Build) error {
+	spec := build.Spec.CommitStatus
+	if spec == nil || build.Spec.Source.Git == nil {
+		return nil
+	}
+	if build.Spec.Revision == nil || build.Spec.Revision.Git == nil || len(build.Spec.Revision.Git.Commit) == 0 {
+		glog.V(4).Infof("Not reporting the status of build %s/%s, its commit is not known", build.Namespace, build.Name)
+		return nil
+	}
+	commit := build.Spec.Revision.Git.Commit
+
+	// The token of the secret is only sent to the host of the source
+	// repository, so that it cannot be sent to any server by setting APIURL.
+	if len(spec.APIURL) != 0 && !buildutil.IsSourceRepositoryAPIURL(spec.APIURL, build.Spec.Source.Git.URI) {
+		return fmt.Errorf("not reporting the status of build %s/%s to %s, which is not served by the host of its source repository", build.Namespace, build.Name, spec.APIURL)
+	}
+
+	secret, err := n.Secrets.GetSecret(build.Namespace, spec.Secret.Name)
+	if err != nil {
+		return err
+	}
+	token, ok := secret.Data[buildapi.CommitStatusTokenKey]
+	if !ok {
+		return fmt.Errorf("the commit status secret %s/%s has no %s key", build.Namespace, spec.Secret.Name, buildapi.CommitStatusTokenKey)
+	}
+
+	repo, err := git.ParseRepository(build.Spec.Source.Git.URI)
+	if err != nil {
+		return err
+	}
+	project := strings.TrimSuffix(strings.Trim(repo.Path, "/"), ".git")
+	context := spec.Context
+	if len(context) == 0 {
+		name := buildutil.ConfigNameForBuild(build)
+		if len(name) == 0 {
+			name = build.Name
+		}
+		context = "openshift/" + name
+	}
+	description := fmt.Sprintf("Build %s/%s %s", build.Namespace, build.Name, strings.ToLower(string(build.Status.Phase)))
+
+	var req *http.Request
+	switch spec.Provider {
+	case buildapi.GitHubCommitStatusProvider:
+		apiURL := spec.APIURL
+		if len(apiURL) == 0 {
+			apiURL = defaultGitHubAPIURL
+		}
+		req, err = newRequest(fmt.Sprintf("%s/repos/%s/statuses/%s", strings.TrimSuffix(apiURL, "/"), project, commit), map[string]string{
+			"state":       gitHubState(build.Status.Phase),
+			"description": description,
+			"context":     context,
+		})
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Authorization", "token "+string(token))
+	case buildapi.GitLabCommitStatusProvider:
+		apiURL := spec.APIURL
+		if len(apiURL) == 0 {
+			scheme := repo.Scheme
+			if scheme != "http" {
+				scheme = "https"
+			}
+			apiURL = scheme + "://" + repo.Host
+		}
+		req, err = newRequest(fmt.Sprintf("%s/api/v4/projects/%s/statuses/%s", strings.TrimSuffix(apiURL, "/"), url.QueryEscape(project), commit), map[string]string{
+			"state":       gitLabState(build.Status.Phase),
+			"description": description,
+			"name":        context,
+		})
+		if err != nil {
+			return err
+		}
+		req.Header.Set("PRIVATE-TOKEN", string(token))
+	default:
+		return fmt.Errorf("unknown commit status provider %q", spec.Provider)
+	}
+
+	glog.V(4).Infof("Reporting status of build %s/%s to %s", build.Namespace, build.Name, req.URL)
+	resp, err := n.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("%s responded to the status of build %s/%s with %s: %s", spec.Provider, build.Namespace, build.Name, resp.Status, string(body))
+	}
+	return nil
+}
+
+func newRequest(location string, body map[string]string) (*http.Request, error) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("POST", location, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return req, nil
+}
+
+// gitHubState returns the state of a GitHub status for a build phase.
+func gitHubState(phase buildapi.BuildPhase) string {
+	switch phase {
+	case buildapi.BuildPhaseComplete:
+		return "success"
+	case buildapi.BuildPhaseFailed:
+		return "failure"
+	case buildapi.BuildPhaseError, buildapi.BuildPhaseCancelled:
+		return "error"
+	}
+	return "pending"
+}
+
+// gitLabState returns the state of a GitLab commit status for a build phase.
+func gitLabState(phase buildapi.BuildPhase) string {
+	switch phase {
+	case buildapi.BuildPhaseRunning:
+		return "running"
+	case buildapi.BuildPhaseComplete:
+		return "success"
+	case buildapi.BuildPhaseFailed, buildapi.BuildPhaseError:
+		return "failed"
+	case buildapi.BuildPhaseCancelled:
+		return "canceled"
+	}
+	return "pending"
+}
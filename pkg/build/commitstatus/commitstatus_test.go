@@ -0,0 +1,158 @@
+package commitstatus
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+)
+
+type fakeSecretGetter struct {
+	secret *kapi.Secret
+}
+
+func (g *fakeSecretGetter) GetSecret(namespace, name string) (*kapi.Secret, error) {
+	return g.secret, nil
+}
+
+type recordedRequest struct {
+	path   string
+	header http.Header
+	body   map[string]string
+}
+
+func mockStatusBuild(provider buildapi.CommitStatusProvider, apiURL string, phase buildapi.BuildPhase) *buildapi.Build {
+	return mockStatusBuildFromSource(provider, apiURL+"/group/app.git", apiURL, phase)
+}
+
+func mockStatusBuildFromSource(provider buildapi.CommitStatusProvider, sourceURI, apiURL string, phase buildapi.BuildPhase) *buildapi.Build {
+	return &buildapi.Build{
+		ObjectMeta: kapi.ObjectMeta{
+			Namespace: "ns",
+			Name:      "app-1",
+			Labels:    map[string]string{buildapi.BuildConfigLabel: "app"},
+		},
+		Spec: buildapi.BuildSpec{
+			Source: buildapi.BuildSource{
+				Git: &buildapi.GitBuildSource{URI: sourceURI},
+			},
+			Revision: &buildapi.SourceRevision{
+				Git: &buildapi.GitSourceRevision{Commit: "0123456789abcdef"},
+			},
+			CommitStatus: &buildapi.CommitStatusReporting{
+				Provider: provider,
+				APIURL:   apiURL,
+				Secret:   kapi.LocalObjectReference{Name: "token"},
+			},
+		},
+		Status: buildapi.BuildStatus{Phase: phase},
+	}
+}
+
+func newTestNotifier(t *testing.T) (*Notifier, *httptest.Server, *[]recordedRequest) {
+	requests := &[]recordedRequest{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]string{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("unexpected error decoding the status: %v", err)
+		}
+		*requests = append(*requests, recordedRequest{path: r.URL.EscapedPath(), header: r.Header, body: body})
+		w.WriteHeader(http.StatusCreated)
+	}))
+	secret := &kapi.Secret{Data: map[string][]byte{buildapi.CommitStatusTokenKey: []byte("secret-token")}}
+	notifier := NewNotifier(&fakeSecretGetter{secret: secret})
+	return notifier, server, requests
+}
+
+func TestNotifyGitHub(t *testing.T) {
+	notifier, server, requests := newTestNotifier(t)
+	defer server.Close()
+
+	if err := notifier.Notify(mockStatusBuild(buildapi.GitHubCommitStatusProvider, server.URL, buildapi.BuildPhaseFailed)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(*requests) != 1 {
+		t.Fatalf("expected one status to be reported, got %d", len(*requests))
+	}
+	req := (*requests)[0]
+	if req.path != "/repos/group/app/statuses/0123456789abcdef" {
+		t.Errorf("unexpected status path %s", req.path)
+	}
+	if auth := req.header.Get("Authorization"); auth != "token secret-token" {
+		t.Errorf("unexpected authorization %q", auth)
+	}
+	if req.body["state"] != "failure" || req.body["context"] != "openshift/app" {
+		t.Errorf("unexpected status %v", req.body)
+	}
+}
+
+func TestNotifyGitLab(t *testing.T) {
+	notifier, server, requests := newTestNotifier(t)
+	defer server.Close()
+
+	if err := notifier.Notify(mockStatusBuild(buildapi.GitLabCommitStatusProvider, server.URL, buildapi.BuildPhaseComplete)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(*requests) != 1 {
+		t.Fatalf("expected one status to be reported, got %d", len(*requests))
+	}
+	req := (*requests)[0]
+	if req.path != "/api/v4/projects/group%2Fapp/statuses/0123456789abcdef" {
+		t.Errorf("unexpected status path %s", req.path)
+	}
+	if token := req.header.Get("PRIVATE-TOKEN"); token != "secret-token" {
+		t.Errorf("unexpected token %q", token)
+	}
+	if req.body["state"] != "success" || req.body["name"] != "openshift/app" {
+		t.Errorf("unexpected status %v", req.body)
+	}
+}
+
+func TestNotifySkipsUnknownCommit(t *testing.T) {
+	notifier, server, requests := newTestNotifier(t)
+	defer server.Close()
+
+	build := mockStatusBuild(buildapi.GitHubCommitStatusProvider, server.URL, buildapi.BuildPhasePending)
+	build.Spec.Revision = nil
+	if err := notifier.Notify(build); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	build = mockStatusBuild(buildapi.GitHubCommitStatusProvider, server.URL, buildapi.BuildPhasePending)
+	build.Spec.CommitStatus = nil
+	if err := notifier.Notify(build); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(*requests) != 0 {
+		t.Errorf("expected no status to be reported, got %v", *requests)
+	}
+}
+
+func TestNotifyMissingToken(t *testing.T) {
+	notifier, server, requests := newTestNotifier(t)
+	defer server.Close()
+	notifier.Secrets = &fakeSecretGetter{secret: &kapi.Secret{}}
+
+	if err := notifier.Notify(mockStatusBuild(buildapi.GitHubCommitStatusProvider, server.URL, buildapi.BuildPhasePending)); err == nil {
+		t.Errorf("expected an error for a secret without a token")
+	}
+	if len(*requests) != 0 {
+		t.Errorf("expected no status to be reported, got %v", *requests)
+	}
+}
+
+func TestNotifyRejectsOtherAPIHost(t *testing.T) {
+	notifier, server, requests := newTestNotifier(t)
+	defer server.Close()
+
+	build := mockStatusBuildFromSource(buildapi.GitLabCommitStatusProvider, "https://gitlab.example.com/group/app.git", server.URL, buildapi.BuildPhaseComplete)
+	if err := notifier.Notify(build); err == nil {
+		t.Errorf("expected an error for an API URL on another host than the source repository")
+	}
+	if len(*requests) != 0 {
+		t.Errorf("expected no status to be reported, got %v", *requests)
+	}
+}
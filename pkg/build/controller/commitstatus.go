@@ -0,0 +1,24 @@
+package controller
+
+import (
+	"github.com/golang/glog"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+)
+
+// CommitStatusNotifier reports the status of a build on the commit it builds.
+type CommitStatusNotifier interface {
+	Notify(build *buildapi.Build) error
+}
+
+// notifyCommitStatus reports the status of the build using the notifier. A
+// failure to report the status does not affect the build, so it is only
+// logged.
+func notifyCommitStatus(notifier CommitStatusNotifier, build *buildapi.Build) {
+	if notifier == nil || build.Spec.CommitStatus == nil {
+		return
+	}
+	if err := notifier.Notify(build); err != nil {
+		glog.V(2).Infof("Failed to report the status of build %s/%s: %v", build.Namespace, build.Name, err)
+	}
+}
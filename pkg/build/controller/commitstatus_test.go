@@ -0,0 +1,79 @@
+package controller
+
+import (
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+)
+
+type fakeCommitStatusNotifier struct {
+	phases []buildapi.BuildPhase
+}
+
+func (n *fakeCommitStatusNotifier) Notify(build *buildapi.Build) error {
+	n.phases = append(n.phases, build.Status.Phase)
+	return nil
+}
+
+func mockCommitStatusBuild(phase buildapi.BuildPhase) *buildapi.Build {
+	build := mockBuild(phase, buildapi.BuildOutput{})
+	build.Spec.CommitStatus = &buildapi.CommitStatusReporting{
+		Provider: buildapi.GitHubCommitStatusProvider,
+		Secret:   kapi.LocalObjectReference{Name: "token"},
+	}
+	return build
+}
+
+func TestHandlePodNotifiesCompletion(t *testing.T) {
+	notifier := &fakeCommitStatusNotifier{}
+	ctrl := mockBuildPodController(mockCommitStatusBuild(buildapi.BuildPhaseRunning))
+	ctrl.CommitStatusNotifier = notifier
+
+	if err := ctrl.HandlePod(mockPod(kapi.PodFailed, 1)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(notifier.phases) != 1 || notifier.phases[0] != buildapi.BuildPhaseFailed {
+		t.Errorf("expected the failed status to be reported, got %v", notifier.phases)
+	}
+}
+
+func TestHandleBuildPodDeletionNotifiesError(t *testing.T) {
+	notifier := &fakeCommitStatusNotifier{}
+	ctrl := mockBuildPodDeleteController(mockCommitStatusBuild(buildapi.BuildPhaseRunning), &customBuildUpdater{
+		UpdateFunc: func(namespace string, build *buildapi.Build) error {
+			return nil
+		},
+	}, nil)
+	ctrl.CommitStatusNotifier = notifier
+
+	if err := ctrl.HandleBuildPodDeletion(mockPod(kapi.PodRunning, 0)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(notifier.phases) != 1 || notifier.phases[0] != buildapi.BuildPhaseError {
+		t.Errorf("expected the error status to be reported, got %v", notifier.phases)
+	}
+}
+
+func TestHandleBuildPodDeletionNotifiesCancel(t *testing.T) {
+	notifier := &fakeCommitStatusNotifier{}
+	build := mockCommitStatusBuild(buildapi.BuildPhaseRunning)
+	build.Status.Cancelled = true
+	ctrl := mockBuildPodDeleteController(build, &customBuildUpdater{
+		UpdateFunc: func(namespace string, build *buildapi.Build) error {
+			return nil
+		},
+	}, nil)
+	ctrl.CommitStatusNotifier = notifier
+
+	if err := ctrl.HandleBuildPodDeletion(mockPod(kapi.PodRunning, 0)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(notifier.phases) != 1 || notifier.phases[0] != buildapi.BuildPhaseCancelled {
+		t.Errorf("expected the cancelled status to be reported, got %v", notifier.phases)
+	}
+	if build.Status.Phase != buildapi.BuildPhaseRunning {
+		t.Errorf("expected the build in the store not to be modified, got phase %s", build.Status.Phase)
+	}
+}
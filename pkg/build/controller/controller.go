@@ -2,6 +2,7 @@ package controller
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/golang/glog"
 
@@ -10,10 +11,12 @@ import (
 	"k8s.io/kubernetes/pkg/api/unversioned"
 	"k8s.io/kubernetes/pkg/client/cache"
 	"k8s.io/kubernetes/pkg/client/record"
+	kutilerrors "k8s.io/kubernetes/pkg/util/errors"
 
 	buildapi "github.com/openshift/origin/pkg/build/api"
 	buildclient "github.com/openshift/origin/pkg/build/client"
 	strategy "github.com/openshift/origin/pkg/build/controller/strategy"
+	"github.com/openshift/origin/pkg/build/logarchive"
 	buildutil "github.com/openshift/origin/pkg/build/util"
 	imageapi "github.com/openshift/origin/pkg/image/api"
 )
@@ -22,7 +25,11 @@ import (
 type BuildController struct {
 	BuildUpdater      buildclient.BuildUpdater
 	PodManager        podManager
+	BuildLister       buildclient.BuildLister
+	BuildDeleter      buildclient.BuildDeleter
+	BuildConfigGetter buildclient.BuildConfigGetter
 	BuildStrategy     BuildStrategy
+	PipelineExecutor  PipelineExecutor
 	ImageStreamClient imageStreamClient
 	Recorder          record.EventRecorder
 }
@@ -32,6 +39,17 @@ type BuildStrategy interface {
 	CreateBuildPod(build *buildapi.Build) (*kapi.Pod, error)
 }
 
+// PipelineExecutor runs builds that use the JenkinsPipeline strategy. Instead of
+// creating a build pod for those builds, the BuildController hands them off to
+// the executor, which reports the progress of each pipeline stage by updating
+// the build status. Implementations must not modify the build passed in.
+type PipelineExecutor interface {
+	// Start begins running the pipeline defined by the build.
+	Start(build *buildapi.Build) error
+	// Cancel stops the pipeline running for the build.
+	Cancel(build *buildapi.Build) error
+}
+
 type podManager interface {
 	CreatePod(namespace string, pod *kapi.Pod) (*kapi.Pod, error)
 	DeletePod(namespace string, pod *kapi.Pod) error
@@ -51,15 +69,23 @@ func (bc *BuildController) CancelBuild(build *buildapi.Build) error {
 
 	glog.V(4).Infof("Cancelling build %s/%s.", build.Namespace, build.Name)
 
-	pod, err := bc.PodManager.GetPod(build.Namespace, buildutil.GetBuildPodName(build))
-	if err != nil {
-		if !errors.IsNotFound(err) {
-			return fmt.Errorf("Failed to get pod for build %s/%s: %v", build.Namespace, build.Name, err)
+	if buildutil.IsPipelineBuild(build) {
+		if bc.PipelineExecutor != nil {
+			if err := bc.PipelineExecutor.Cancel(build); err != nil {
+				return fmt.Errorf("Failed to cancel pipeline for build %s/%s: %v", build.Namespace, build.Name, err)
+			}
 		}
 	} else {
-		err := bc.PodManager.DeletePod(build.Namespace, pod)
-		if err != nil && !errors.IsNotFound(err) {
-			return fmt.Errorf("Couldn't delete build pod %s/%s: %v", build.Namespace, pod.Name, err)
+		pod, err := bc.PodManager.GetPod(build.Namespace, buildutil.GetBuildPodName(build))
+		if err != nil {
+			if !errors.IsNotFound(err) {
+				return fmt.Errorf("Failed to get pod for build %s/%s: %v", build.Namespace, build.Name, err)
+			}
+		} else {
+			err := bc.PodManager.DeletePod(build.Namespace, pod)
+			if err != nil && !errors.IsNotFound(err) {
+				return fmt.Errorf("Couldn't delete build pod %s/%s: %v", build.Namespace, pod.Name, err)
+			}
 		}
 	}
 
@@ -68,11 +94,15 @@ func (bc *BuildController) CancelBuild(build *buildapi.Build) error {
 	build.Status.Message = ""
 	now := unversioned.Now()
 	build.Status.CompletionTimestamp = &now
+	handled := setCompletionHandled(build)
 	if err := bc.BuildUpdater.Update(build.Namespace, build); err != nil {
 		return fmt.Errorf("Failed to update build %s/%s: %v", build.Namespace, build.Name, err)
 	}
 
 	glog.V(4).Infof("Build %s/%s was successfully cancelled.", build.Namespace, build.Name)
+	if handled {
+		buildCompleted(bc.BuildConfigGetter, bc.BuildLister, bc.BuildUpdater, bc.BuildDeleter, build)
+	}
 	return nil
 }
 
@@ -89,11 +119,36 @@ func (bc *BuildController) HandleBuild(build *buildapi.Build) error {
 		}
 	}
 
+	// Builds without a build pod, such as pipeline builds updated by Jenkins or
+	// builds that failed before their pod was created, complete here.
+	if buildutil.IsBuildComplete(build) && !hasBuildPod(build) {
+		bc.handleCompletion(build)
+		return nil
+	}
+
 	// Handle new builds
 	if build.Status.Phase != buildapi.BuildPhaseNew {
 		return nil
 	}
 
+	// Builds that cannot run yet are retried once the builds before them complete.
+	runnable, err := bc.isRunnable(build)
+	if err != nil {
+		return err
+	}
+	if !runnable {
+		glog.V(4).Infof("Build %s/%s is waiting for the previous builds of its BuildConfig to complete", build.Namespace, build.Name)
+		if build.Status.Reason == buildapi.StatusReasonWaitingForPreviousBuild {
+			return nil
+		}
+		build.Status.Reason = buildapi.StatusReasonWaitingForPreviousBuild
+		build.Status.Message = fmt.Sprintf("Waiting for the previous builds to complete as required by the %s run policy.", buildutil.RunPolicyForBuild(build))
+		if err := bc.BuildUpdater.Update(build.Namespace, build); err != nil {
+			glog.V(2).Infof("Failed to record changes to build %s/%s: %v", build.Namespace, build.Name, err)
+		}
+		return nil
+	}
+
 	if err := bc.nextBuildPhase(build); err != nil {
 		return err
 	}
@@ -108,18 +163,89 @@ func (bc *BuildController) HandleBuild(build *buildapi.Build) error {
 	return nil
 }
 
+// handleCompletion runs the completion hooks of a build handled by the
+// BuildController once. The build is marked as handled before the hooks run, so
+// that resyncs and restarts of the controller don't run them again.
+func (bc *BuildController) handleCompletion(build *buildapi.Build) {
+	if !setCompletionHandled(build) {
+		return
+	}
+	if err := bc.BuildUpdater.Update(build.Namespace, build); err != nil {
+		// The completion is handled again on the next update or resync of the build.
+		glog.V(2).Infof("Failed to record the completion of build %s/%s: %v", build.Namespace, build.Name, err)
+		return
+	}
+	buildCompleted(bc.BuildConfigGetter, bc.BuildLister, bc.BuildUpdater, bc.BuildDeleter, build)
+}
+
+// setCompletionHandled marks the completion of build as handled. It returns
+// false if the build was already marked.
+func setCompletionHandled(build *buildapi.Build) bool {
+	if _, ok := build.Annotations[buildapi.BuildCompletionHandledAnnotation]; ok {
+		return false
+	}
+	if build.Annotations == nil {
+		build.Annotations = make(map[string]string)
+	}
+	build.Annotations[buildapi.BuildCompletionHandledAnnotation] = "true"
+	return true
+}
+
+// hasBuildPod returns true if a build pod was created for the build. The
+// completion of those builds is handled by the BuildPodController.
+func hasBuildPod(build *buildapi.Build) bool {
+	return !buildutil.IsPipelineBuild(build) && len(build.Annotations[buildapi.BuildPodNameAnnotation]) > 0
+}
+
+// buildCompleted starts the next build queued on the BuildConfig of the
+// completed build and prunes the build history of the BuildConfig.
+func buildCompleted(getter buildclient.BuildConfigGetter, lister buildclient.BuildLister, updater buildclient.BuildUpdater, deleter buildclient.BuildDeleter, build *buildapi.Build) {
+	if err := startNextBuild(lister, updater, build); err != nil {
+		glog.V(2).Infof("Failed to start the build queued after build %s/%s: %v", build.Namespace, build.Name, err)
+	}
+	if err := pruneBuildHistory(getter, lister, deleter, build); err != nil {
+		glog.V(2).Infof("Failed to prune the build history after build %s/%s: %v", build.Namespace, build.Name, err)
+	}
+}
+
 // nextBuildPhase updates build with any appropriate changes, or returns an error if
 // the change cannot occur. When returning nil, be sure to set build.Status and optionally
 // build.Message.
 func (bc *BuildController) nextBuildPhase(build *buildapi.Build) error {
+	// Pipeline builds do not run in a build pod.
+	if buildutil.IsPipelineBuild(build) {
+		return bc.startPipeline(build)
+	}
+
 	// Set the output Docker image reference.
-	ref, err := bc.resolveOutputDockerImageReference(build)
+	ref, err := bc.resolveOutputDockerImageReference(build, build.Spec.Output.To)
 	if err != nil {
 		build.Status.Reason = buildapi.StatusReasonInvalidOutputReference
 		return err
 	}
 	build.Status.OutputDockerImageReference = ref
 
+	// Resolve the additional locations the output image is pushed to.
+	destinations := make([]string, len(build.Spec.Output.Destinations))
+	for i := range build.Spec.Output.Destinations {
+		destination, err := bc.resolveOutputDockerImageReference(build, &build.Spec.Output.Destinations[i].To)
+		if err != nil {
+			build.Status.Reason = buildapi.StatusReasonInvalidOutputReference
+			return err
+		}
+		destinations[i] = destination
+	}
+
+	// Resolve the image the build cache is stored in.
+	var cacheImage string
+	if build.Spec.Cache != nil && build.Spec.Cache.Image != nil {
+		cacheImage, err = bc.resolveOutputDockerImageReference(build, build.Spec.Cache.Image)
+		if err != nil {
+			build.Status.Reason = buildapi.StatusReasonInvalidOutputReference
+			return err
+		}
+	}
+
 	// Make a copy to avoid mutating the build from this point on.
 	copy, err := kapi.Scheme.Copy(build)
 	if err != nil {
@@ -140,6 +266,20 @@ func (bc *BuildController) nextBuildPhase(build *buildapi.Build) error {
 			Name: ref,
 		}
 	}
+	// The same applies to the additional destinations of the output image
+	// and to the image the build cache is stored in.
+	for i, destination := range destinations {
+		buildCopy.Spec.Output.Destinations[i].To = kapi.ObjectReference{
+			Kind: "DockerImage",
+			Name: destination,
+		}
+	}
+	if len(cacheImage) != 0 {
+		buildCopy.Spec.Cache.Image = &kapi.ObjectReference{
+			Kind: "DockerImage",
+			Name: cacheImage,
+		}
+	}
 
 	// Invoke the strategy to get a build pod.
 	podSpec, err := bc.BuildStrategy.CreateBuildPod(buildCopy)
@@ -176,10 +316,32 @@ func (bc *BuildController) nextBuildPhase(build *buildapi.Build) error {
 	return nil
 }
 
+// startPipeline hands a JenkinsPipeline build off to the pipeline executor and
+// puts the build in the pending state. The executor is then responsible for
+// moving the build through its remaining phases.
+func (bc *BuildController) startPipeline(build *buildapi.Build) error {
+	if bc.PipelineExecutor == nil {
+		build.Status.Reason = buildapi.StatusReasonCannotStartPipeline
+		return strategy.FatalError(fmt.Sprintf("no pipeline executor is available to run build %s/%s", build.Namespace, build.Name))
+	}
+	if err := bc.PipelineExecutor.Start(build); err != nil {
+		bc.Recorder.Eventf(build, kapi.EventTypeWarning, "FailedStartPipeline", "Error starting pipeline: %v", err)
+		build.Status.Reason = buildapi.StatusReasonCannotStartPipeline
+		return fmt.Errorf("failed to start pipeline for build %s/%s: %v", build.Namespace, build.Name, err)
+	}
+	glog.V(4).Infof("Pipeline for build %s/%s was started", build.Namespace, build.Name)
+
+	// Set the build phase, which will be persisted.
+	build.Status.Phase = buildapi.BuildPhasePending
+	build.Status.Reason = ""
+	build.Status.Message = ""
+	return nil
+}
+
 // resolveOutputDockerImageReference returns a reference to a Docker image
-// computed from the buid.Spec.Output.To reference.
-func (bc *BuildController) resolveOutputDockerImageReference(build *buildapi.Build) (string, error) {
-	outputTo := build.Spec.Output.To
+// computed from the provided output reference of the build, either
+// build.Spec.Output.To or one of its additional destinations.
+func (bc *BuildController) resolveOutputDockerImageReference(build *buildapi.Build, outputTo *kapi.ObjectReference) (string, error) {
 	if outputTo == nil || outputTo.Name == "" {
 		return "", nil
 	}
@@ -223,9 +385,25 @@ func (bc *BuildController) resolveOutputDockerImageReference(build *buildapi.Bui
 
 // BuildPodController watches pods running builds and manages the build state
 type BuildPodController struct {
-	BuildStore   cache.Store
-	BuildUpdater buildclient.BuildUpdater
-	PodManager   podManager
+	BuildStore        cache.Store
+	BuildLister       buildclient.BuildLister
+	BuildUpdater      buildclient.BuildUpdater
+	BuildDeleter      buildclient.BuildDeleter
+	BuildConfigGetter buildclient.BuildConfigGetter
+	PodManager        podManager
+	// LogArchive, if set, stores the logs of the builds once they complete.
+	LogArchive     logarchive.Archive
+	PodLogStreamer podLogStreamer
+	// CommitStatusNotifier, if set, reports the status of the builds to the
+	// Git provider hosting their source.
+	CommitStatusNotifier CommitStatusNotifier
+
+	// archiveOnce initializes archiveSlots on first use.
+	archiveOnce sync.Once
+	// archiveSlots limits the number of logs archived at the same time.
+	archiveSlots chan struct{}
+	// archiving tracks the logs being archived.
+	archiving sync.WaitGroup
 }
 
 // HandlePod updates the state of the build based on the pod state
@@ -284,6 +462,19 @@ func (bc *BuildPodController) HandlePod(pod *kapi.Pod) error {
 			return fmt.Errorf("failed to update build %s/%s: %v", build.Namespace, build.Name, err)
 		}
 		glog.V(4).Infof("Build %s/%s status was updated %s -> %s", build.Namespace, build.Name, build.Status.Phase, nextStatus)
+		notifyCommitStatus(bc.CommitStatusNotifier, build)
+		if buildutil.IsBuildComplete(build) {
+			if err := startNextBuild(bc.BuildLister, bc.BuildUpdater, build); err != nil {
+				glog.V(2).Infof("Failed to start the build queued after build %s/%s: %v", build.Namespace, build.Name, err)
+			}
+			// Pruning the build history may delete the build and its pod, so
+			// it waits for the log of the build to be archived.
+			bc.archiveBuildLogAsync(build, func() {
+				if err := pruneBuildHistory(bc.BuildConfigGetter, bc.BuildLister, bc.BuildDeleter, build); err != nil {
+					glog.V(2).Infof("Failed to prune the build history after build %s/%s: %v", build.Namespace, build.Name, err)
+				}
+			})
+		}
 	}
 	return nil
 }
@@ -295,8 +486,14 @@ func isBuildCancellable(build *buildapi.Build) bool {
 
 // BuildPodDeleteController watches pods running builds and updates the build if the pod is deleted
 type BuildPodDeleteController struct {
-	BuildStore   cache.Store
-	BuildUpdater buildclient.BuildUpdater
+	BuildStore        cache.Store
+	BuildLister       buildclient.BuildLister
+	BuildUpdater      buildclient.BuildUpdater
+	BuildDeleter      buildclient.BuildDeleter
+	BuildConfigGetter buildclient.BuildConfigGetter
+	// CommitStatusNotifier, if set, reports the status of the builds to the
+	// Git provider hosting their source.
+	CommitStatusNotifier CommitStatusNotifier
 }
 
 // HandleBuildPodDeletion sets the status of a build to error if the build pod has been deleted
@@ -316,6 +513,9 @@ func (bc *BuildPodDeleteController) HandleBuildPodDeletion(pod *kapi.Pod) error
 	// If build was cancelled, we'll leave HandleBuild to update the build
 	if build.Status.Cancelled {
 		glog.V(4).Infof("Cancelation for build was already triggered, ignoring")
+		cancelled := *build
+		cancelled.Status.Phase = buildapi.BuildPhaseCancelled
+		notifyCommitStatus(bc.CommitStatusNotifier, &cancelled)
 		return nil
 	}
 
@@ -335,18 +535,39 @@ func (bc *BuildPodDeleteController) HandleBuildPodDeletion(pod *kapi.Pod) error
 		if err := bc.BuildUpdater.Update(build.Namespace, build); err != nil {
 			return fmt.Errorf("Failed to update build %s/%s: %v", build.Namespace, build.Name, err)
 		}
+		notifyCommitStatus(bc.CommitStatusNotifier, build)
+		buildCompleted(bc.BuildConfigGetter, bc.BuildLister, bc.BuildUpdater, bc.BuildDeleter, build)
 	}
 	return nil
 }
 
 // BuildDeleteController watches for builds being deleted and cleans up associated pods
+// and archived logs
 type BuildDeleteController struct {
 	PodManager podManager
+	LogArchive logarchive.Archive
 }
 
-// HandleBuildDeletion deletes a build pod if the corresponding build has been deleted
+// HandleBuildDeletion deletes a build pod and the archived build log if the
+// corresponding build has been deleted. Failing to delete the archived log
+// does not prevent the pod from being deleted.
 func (bc *BuildDeleteController) HandleBuildDeletion(build *buildapi.Build) error {
 	glog.V(4).Infof("Handling deletion of build %s", build.Name)
+	errs := []error{}
+	if err := bc.deleteBuildPod(build); err != nil {
+		errs = append(errs, err)
+	}
+	if bc.LogArchive != nil {
+		if err := bc.LogArchive.Delete(build.Namespace, build.Name); err != nil {
+			glog.V(2).Infof("Failed to delete the archived log of build %s/%s: %v", build.Namespace, build.Name, err)
+			errs = append(errs, err)
+		}
+	}
+	return kutilerrors.NewAggregate(errs)
+}
+
+// deleteBuildPod deletes the pod of the deleted build, if it still exists.
+func (bc *BuildDeleteController) deleteBuildPod(build *buildapi.Build) error {
 	podName := buildutil.GetBuildPodName(build)
 	pod, err := bc.PodManager.GetPod(build.Namespace, podName)
 	if err != nil && !errors.IsNotFound(err) {
@@ -417,6 +417,85 @@ func TestHandleBuild(t *testing.T) {
 	}
 }
 
+func TestHandleBuildOutputDestinations(t *testing.T) {
+	build := mockBuild(buildapi.BuildPhaseNew, buildapi.BuildOutput{
+		To: &kapi.ObjectReference{
+			Kind: "ImageStreamTag",
+			Name: "foo:latest",
+		},
+		Destinations: []buildapi.BuildOutputDestination{
+			{To: kapi.ObjectReference{Kind: "ImageStreamTag", Name: "foo:abc123"}},
+			{To: kapi.ObjectReference{Kind: "DockerImage", Name: "registry.example.com/team/foo:latest"}},
+		},
+	})
+	ctrl := mockBuildController()
+	if err := ctrl.HandleBuild(build); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if build.Status.Phase != buildapi.BuildPhasePending {
+		t.Errorf("expected phase %s, got %s", buildapi.BuildPhasePending, build.Status.Phase)
+	}
+	if build.Spec.Output.Destinations[0].To.Kind != "ImageStreamTag" {
+		t.Errorf("build.Spec mutated: %#v", build.Spec.Output)
+	}
+
+	expected := []kapi.ObjectReference{
+		{Kind: "DockerImage", Name: "image/repo:abc123"},
+		{Kind: "DockerImage", Name: "registry.example.com/team/foo:latest"},
+	}
+	destinations := ctrl.BuildStrategy.(*okStrategy).build.Spec.Output.Destinations
+	if len(destinations) != len(expected) {
+		t.Fatalf("expected %d destinations sent to strategy, got %#v", len(expected), destinations)
+	}
+	for i := range expected {
+		if destinations[i].To != expected[i] {
+			t.Errorf("expected destination %d sent to strategy to be %#v, got %#v", i, expected[i], destinations[i].To)
+		}
+	}
+
+	build = mockBuild(buildapi.BuildPhaseNew, buildapi.BuildOutput{
+		To: &kapi.ObjectReference{
+			Kind: "DockerImage",
+			Name: "repository/dataBuild",
+		},
+		Destinations: []buildapi.BuildOutputDestination{
+			{To: kapi.ObjectReference{Kind: "ImageStreamTag", Name: "foo:abc123"}},
+		},
+	})
+	ctrl = mockBuildController()
+	ctrl.ImageStreamClient = &errNotFoundImageStreamClient{}
+	if err := ctrl.HandleBuild(build); err == nil {
+		t.Errorf("expected an error for a missing destination image stream")
+	}
+	if build.Status.Reason != buildapi.StatusReasonInvalidOutputReference {
+		t.Errorf("expected reason %s, got %s", buildapi.StatusReasonInvalidOutputReference, build.Status.Reason)
+	}
+}
+
+func TestHandleBuildCacheImage(t *testing.T) {
+	build := mockBuild(buildapi.BuildPhaseNew, buildapi.BuildOutput{
+		To: &kapi.ObjectReference{
+			Kind: "DockerImage",
+			Name: "repository/dataBuild",
+		},
+	})
+	build.Spec.Cache = &buildapi.BuildCache{
+		Paths: []buildapi.ImageSourcePath{{SourcePath: "/root/.m2", DestinationDir: "."}},
+		Image: &kapi.ObjectReference{Kind: "ImageStreamTag", Name: "foo-cache:latest"},
+	}
+	ctrl := mockBuildController()
+	if err := ctrl.HandleBuild(build); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if build.Spec.Cache.Image.Kind != "ImageStreamTag" {
+		t.Errorf("build.Spec mutated: %#v", build.Spec.Cache)
+	}
+	expected := kapi.ObjectReference{Kind: "DockerImage", Name: "image/repo:latest"}
+	if cache := ctrl.BuildStrategy.(*okStrategy).build.Spec.Cache; cache.Image == nil || *cache.Image != expected {
+		t.Errorf("expected cache image sent to strategy to be %#v, got %#v", expected, cache.Image)
+	}
+}
+
 func TestHandlePod(t *testing.T) {
 	type handlePodTest struct {
 		matchID             bool
@@ -675,6 +754,11 @@ func TestCancelBuild(t *testing.T) {
 		if build.Status.Phase != tc.outStatus {
 			t.Errorf("(%d) Expected %s, got %s!", i, tc.outStatus, build.Status.Phase)
 		}
+
+		_, handled := build.Annotations[buildapi.BuildCompletionHandledAnnotation]
+		if cancelled := tc.inStatus != buildapi.BuildPhaseCancelled && tc.outStatus == buildapi.BuildPhaseCancelled; handled != cancelled {
+			t.Errorf("(%d) Expected the completion to be handled %t, got %t", i, cancelled, handled)
+		}
 	}
 }
 
@@ -699,7 +783,7 @@ func (c *customPodManager) GetPod(namespace, name string) (*kapi.Pod, error) {
 func TestHandleHandleBuildDeletionOK(t *testing.T) {
 	deleteWasCalled := false
 	build := mockBuild(buildapi.BuildPhaseComplete, buildapi.BuildOutput{})
-	ctrl := BuildDeleteController{&customPodManager{
+	ctrl := BuildDeleteController{PodManager: &customPodManager{
 		GetPodFunc: func(namespace, names string) (*kapi.Pod, error) {
 			return &kapi.Pod{ObjectMeta: kapi.ObjectMeta{Labels: map[string]string{buildapi.BuildLabel: build.Name}}}, nil
 		},
@@ -721,7 +805,7 @@ func TestHandleHandleBuildDeletionOK(t *testing.T) {
 func TestHandleHandleBuildDeletionOKDeprecatedLabel(t *testing.T) {
 	deleteWasCalled := false
 	build := mockBuild(buildapi.BuildPhaseComplete, buildapi.BuildOutput{})
-	ctrl := BuildDeleteController{&customPodManager{
+	ctrl := BuildDeleteController{PodManager: &customPodManager{
 		GetPodFunc: func(namespace, names string) (*kapi.Pod, error) {
 			return &kapi.Pod{ObjectMeta: kapi.ObjectMeta{Labels: map[string]string{buildapi.BuildLabel: build.Name}}}, nil
 		},
@@ -742,7 +826,7 @@ func TestHandleHandleBuildDeletionOKDeprecatedLabel(t *testing.T) {
 
 func TestHandleHandleBuildDeletionFailGetPod(t *testing.T) {
 	build := mockBuild(buildapi.BuildPhaseComplete, buildapi.BuildOutput{})
-	ctrl := BuildDeleteController{&customPodManager{
+	ctrl := BuildDeleteController{PodManager: &customPodManager{
 		GetPodFunc: func(namespace, name string) (*kapi.Pod, error) {
 			return nil, errors.New("random")
 		},
@@ -757,7 +841,7 @@ func TestHandleHandleBuildDeletionFailGetPod(t *testing.T) {
 func TestHandleHandleBuildDeletionGetPodNotFound(t *testing.T) {
 	deleteWasCalled := false
 	build := mockBuild(buildapi.BuildPhaseComplete, buildapi.BuildOutput{})
-	ctrl := BuildDeleteController{&customPodManager{
+	ctrl := BuildDeleteController{PodManager: &customPodManager{
 		GetPodFunc: func(namespace, name string) (*kapi.Pod, error) {
 			return nil, kerrors.NewNotFound(kapi.Resource("Pod"), name)
 		},
@@ -779,7 +863,7 @@ func TestHandleHandleBuildDeletionGetPodNotFound(t *testing.T) {
 func TestHandleHandleBuildDeletionMismatchedLabels(t *testing.T) {
 	deleteWasCalled := false
 	build := mockBuild(buildapi.BuildPhaseComplete, buildapi.BuildOutput{})
-	ctrl := BuildDeleteController{&customPodManager{
+	ctrl := BuildDeleteController{PodManager: &customPodManager{
 		GetPodFunc: func(namespace, names string) (*kapi.Pod, error) {
 			return &kapi.Pod{}, nil
 		},
@@ -800,7 +884,7 @@ func TestHandleHandleBuildDeletionMismatchedLabels(t *testing.T) {
 
 func TestHandleHandleBuildDeletionDeletePodError(t *testing.T) {
 	build := mockBuild(buildapi.BuildPhaseComplete, buildapi.BuildOutput{})
-	ctrl := BuildDeleteController{&customPodManager{
+	ctrl := BuildDeleteController{PodManager: &customPodManager{
 		GetPodFunc: func(namespace, names string) (*kapi.Pod, error) {
 			return &kapi.Pod{ObjectMeta: kapi.ObjectMeta{Labels: map[string]string{buildapi.BuildLabel: build.Name}}}, nil
 		},
@@ -936,3 +1020,156 @@ func TestHandleBuildPodDeletionBuildUpdateError(t *testing.T) {
 		t.Error("Expected random error, but got none!")
 	}
 }
+
+func mockPipelineBuild(phase buildapi.BuildPhase) *buildapi.Build {
+	build := mockBuild(phase, buildapi.BuildOutput{})
+	build.Spec.Strategy = buildapi.BuildStrategy{
+		JenkinsPipelineStrategy: &buildapi.JenkinsPipelineBuildStrategy{
+			Jenkinsfile: "node { stage 'build' }",
+		},
+	}
+	return build
+}
+
+func TestHandlePipelineBuild(t *testing.T) {
+	tests := []struct {
+		name        string
+		executor    *buildtest.FakePipelineExecutor
+		outStatus   buildapi.BuildPhase
+		outReason   buildapi.StatusReason
+		errExpected bool
+	}{
+		{
+			name:      "started",
+			executor:  &buildtest.FakePipelineExecutor{},
+			outStatus: buildapi.BuildPhasePending,
+		},
+		{
+			name:        "start error",
+			executor:    &buildtest.FakePipelineExecutor{StartErr: errors.New("jenkins unavailable")},
+			outStatus:   buildapi.BuildPhaseNew,
+			outReason:   buildapi.StatusReasonCannotStartPipeline,
+			errExpected: true,
+		},
+		{
+			name:        "no executor",
+			outStatus:   buildapi.BuildPhaseNew,
+			outReason:   buildapi.StatusReasonCannotStartPipeline,
+			errExpected: true,
+		},
+	}
+
+	for _, tc := range tests {
+		build := mockPipelineBuild(buildapi.BuildPhaseNew)
+		ctrl := mockBuildController()
+		ctrl.PodManager = &errPodManager{}
+		if tc.executor != nil {
+			ctrl.PipelineExecutor = tc.executor
+		}
+
+		err := ctrl.HandleBuild(build)
+		if tc.errExpected && err == nil {
+			t.Errorf("%s: expected error, got none", tc.name)
+		}
+		if !tc.errExpected && err != nil {
+			t.Errorf("%s: unexpected error: %v", tc.name, err)
+		}
+		if build.Status.Phase != tc.outStatus {
+			t.Errorf("%s: expected phase %s, got %s", tc.name, tc.outStatus, build.Status.Phase)
+		}
+		if build.Status.Reason != tc.outReason {
+			t.Errorf("%s: expected reason %q, got %q", tc.name, tc.outReason, build.Status.Reason)
+		}
+		if tc.executor != nil && tc.executor.StartErr == nil && len(tc.executor.Started) != 1 {
+			t.Errorf("%s: expected the pipeline to be started once, got %d", tc.name, len(tc.executor.Started))
+		}
+		if len(build.Status.OutputDockerImageReference) != 0 {
+			t.Errorf("%s: expected no output image reference for a pipeline build", tc.name)
+		}
+	}
+}
+
+func TestRunPipelineStages(t *testing.T) {
+	tests := []struct {
+		name      string
+		failStage string
+		outStatus buildapi.BuildPhase
+		outStages []buildapi.BuildPhase
+	}{
+		{
+			name:      "success",
+			outStatus: buildapi.BuildPhaseComplete,
+			outStages: []buildapi.BuildPhase{buildapi.BuildPhaseComplete, buildapi.BuildPhaseComplete, buildapi.BuildPhaseComplete},
+		},
+		{
+			name:      "failed test",
+			failStage: "test",
+			outStatus: buildapi.BuildPhaseFailed,
+			outStages: []buildapi.BuildPhase{buildapi.BuildPhaseComplete, buildapi.BuildPhaseFailed},
+		},
+	}
+
+	for _, tc := range tests {
+		executor := &buildtest.FakePipelineExecutor{
+			Stages:    []string{"build", "test", "promote"},
+			FailStage: tc.failStage,
+		}
+		build := mockPipelineBuild(buildapi.BuildPhasePending)
+		updates := 0
+		if err := executor.Run(build, func(*buildapi.Build) error {
+			updates++
+			return nil
+		}); err != nil {
+			t.Errorf("%s: unexpected error: %v", tc.name, err)
+			continue
+		}
+		if build.Status.Phase != tc.outStatus {
+			t.Errorf("%s: expected phase %s, got %s", tc.name, tc.outStatus, build.Status.Phase)
+		}
+		if build.Status.StartTimestamp == nil || build.Status.CompletionTimestamp == nil {
+			t.Errorf("%s: expected start and completion timestamps to be set", tc.name)
+		}
+		if updates != 2+2*len(tc.outStages) {
+			t.Errorf("%s: expected %d updates, got %d", tc.name, 2+2*len(tc.outStages), updates)
+		}
+		if len(build.Status.Stages) != len(tc.outStages) {
+			t.Errorf("%s: expected %d stages, got %#v", tc.name, len(tc.outStages), build.Status.Stages)
+			continue
+		}
+		for i, stage := range build.Status.Stages {
+			if stage.Name != executor.Stages[i] || stage.Phase != tc.outStages[i] {
+				t.Errorf("%s: expected stage %s to be %s, got %#v", tc.name, executor.Stages[i], tc.outStages[i], stage)
+			}
+			if stage.CompletionTimestamp == nil {
+				t.Errorf("%s: expected stage %s to have a completion timestamp", tc.name, stage.Name)
+			}
+		}
+	}
+}
+
+func TestCancelPipelineBuild(t *testing.T) {
+	build := mockPipelineBuild(buildapi.BuildPhaseRunning)
+	executor := &buildtest.FakePipelineExecutor{}
+	ctrl := mockBuildController()
+	ctrl.PodManager = &errPodManager{}
+	ctrl.PipelineExecutor = executor
+
+	if err := ctrl.CancelBuild(build); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(executor.Cancelled) != 1 {
+		t.Errorf("expected the pipeline to be cancelled, got %d cancellations", len(executor.Cancelled))
+	}
+	if build.Status.Phase != buildapi.BuildPhaseCancelled {
+		t.Errorf("expected phase %s, got %s", buildapi.BuildPhaseCancelled, build.Status.Phase)
+	}
+
+	build = mockPipelineBuild(buildapi.BuildPhaseRunning)
+	ctrl.PipelineExecutor = &buildtest.FakePipelineExecutor{CancelErr: errors.New("jenkins unavailable")}
+	if err := ctrl.CancelBuild(build); err == nil {
+		t.Errorf("expected error, got none")
+	}
+	if build.Status.Phase != buildapi.BuildPhaseRunning {
+		t.Errorf("expected phase %s, got %s", buildapi.BuildPhaseRunning, build.Status.Phase)
+	}
+}
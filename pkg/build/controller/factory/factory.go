@@ -3,6 +3,7 @@ package factory
 import (
 	"fmt"
 	"github.com/golang/glog"
+	"io"
 	"time"
 
 	kapi "k8s.io/kubernetes/pkg/api"
@@ -19,8 +20,10 @@ import (
 
 	buildapi "github.com/openshift/origin/pkg/build/api"
 	buildclient "github.com/openshift/origin/pkg/build/client"
+	"github.com/openshift/origin/pkg/build/commitstatus"
 	buildcontroller "github.com/openshift/origin/pkg/build/controller"
 	strategy "github.com/openshift/origin/pkg/build/controller/strategy"
+	"github.com/openshift/origin/pkg/build/logarchive"
 	buildutil "github.com/openshift/origin/pkg/build/util"
 	osclient "github.com/openshift/origin/pkg/client"
 	controller "github.com/openshift/origin/pkg/controller"
@@ -61,9 +64,17 @@ type BuildControllerFactory struct {
 	OSClient            osclient.Interface
 	KubeClient          kclient.Interface
 	BuildUpdater        buildclient.BuildUpdater
+	BuildLister         buildclient.BuildLister
+	BuildDeleter        buildclient.BuildDeleter
+	BuildConfigGetter   buildclient.BuildConfigGetter
 	DockerBuildStrategy *strategy.DockerBuildStrategy
 	SourceBuildStrategy *strategy.SourceBuildStrategy
 	CustomBuildStrategy *strategy.CustomBuildStrategy
+	// PipelineExecutor runs JenkinsPipeline builds. If nil, the pipelines are
+	// expected to be run by an external Jenkins server.
+	PipelineExecutor buildcontroller.PipelineExecutor
+	// LogArchive, if set, stores the logs of completed builds.
+	LogArchive logarchive.Archive
 	// Stop may be set to allow controllers created by this factory to be terminated.
 	Stop <-chan struct{}
 }
@@ -77,8 +88,15 @@ func (factory *BuildControllerFactory) Create() controller.RunnableController {
 	eventBroadcaster.StartRecordingToSink(factory.KubeClient.Events(""))
 
 	client := ControllerClient{factory.KubeClient, factory.OSClient}
+	pipelineExecutor := factory.PipelineExecutor
+	if pipelineExecutor == nil {
+		pipelineExecutor = externalPipelineExecutor{}
+	}
 	buildController := &buildcontroller.BuildController{
 		BuildUpdater:      factory.BuildUpdater,
+		BuildLister:       factory.BuildLister,
+		BuildDeleter:      factory.BuildDeleter,
+		BuildConfigGetter: factory.BuildConfigGetter,
 		ImageStreamClient: client,
 		PodManager:        client,
 		BuildStrategy: &typeBasedFactoryStrategy{
@@ -86,7 +104,8 @@ func (factory *BuildControllerFactory) Create() controller.RunnableController {
 			SourceBuildStrategy: factory.SourceBuildStrategy,
 			CustomBuildStrategy: factory.CustomBuildStrategy,
 		},
-		Recorder: eventBroadcaster.NewRecorder(kapi.EventSource{Component: "build-controller"}),
+		PipelineExecutor: pipelineExecutor,
+		Recorder:         eventBroadcaster.NewRecorder(kapi.EventSource{Component: "build-controller"}),
 	}
 
 	return &controller.RetryController{
@@ -126,6 +145,7 @@ func (factory *BuildControllerFactory) CreateDeleteController() controller.Runna
 
 	buildDeleteController := &buildcontroller.BuildDeleteController{
 		PodManager: client,
+		LogArchive: factory.LogArchive,
 	}
 
 	return &controller.RetryController{
@@ -149,13 +169,30 @@ func (factory *BuildControllerFactory) CreateDeleteController() controller.Runna
 
 // BuildPodControllerFactory construct BuildPodController objects
 type BuildPodControllerFactory struct {
-	OSClient     osclient.Interface
-	KubeClient   kclient.Interface
-	BuildUpdater buildclient.BuildUpdater
+	OSClient          osclient.Interface
+	KubeClient        kclient.Interface
+	BuildUpdater      buildclient.BuildUpdater
+	BuildLister       buildclient.BuildLister
+	BuildDeleter      buildclient.BuildDeleter
+	BuildConfigGetter buildclient.BuildConfigGetter
+	// LogArchive, if set, stores the logs of completed builds.
+	LogArchive logarchive.Archive
 	// Stop may be set to allow controllers created by this factory to be terminated.
 	Stop <-chan struct{}
 
 	buildStore cache.Store
+	// commitStatusNotifier is shared by the controllers created by this factory, so
+	// that the statuses of a build are reported in order.
+	commitStatusNotifier *commitstatus.AsyncNotifier
+}
+
+// getCommitStatusNotifier returns the notifier reporting the statuses of the builds
+// handled by the controllers created by this factory.
+func (factory *BuildPodControllerFactory) getCommitStatusNotifier(client ControllerClient) *commitstatus.AsyncNotifier {
+	if factory.commitStatusNotifier == nil {
+		factory.commitStatusNotifier = commitstatus.NewAsyncNotifier(commitstatus.NewNotifier(client), factory.Stop)
+	}
+	return factory.commitStatusNotifier
 }
 
 // retryFunc returns a function to retry a controller event
@@ -190,9 +227,15 @@ func (factory *BuildPodControllerFactory) Create() controller.RunnableController
 
 	client := ControllerClient{factory.KubeClient, factory.OSClient}
 	buildPodController := &buildcontroller.BuildPodController{
-		BuildStore:   factory.buildStore,
-		BuildUpdater: factory.BuildUpdater,
-		PodManager:   client,
+		BuildStore:           factory.buildStore,
+		BuildLister:          factory.BuildLister,
+		BuildUpdater:         factory.BuildUpdater,
+		BuildDeleter:         factory.BuildDeleter,
+		BuildConfigGetter:    factory.BuildConfigGetter,
+		PodManager:           client,
+		LogArchive:           factory.LogArchive,
+		PodLogStreamer:       client,
+		CommitStatusNotifier: factory.getCommitStatusNotifier(client),
 	}
 
 	return &controller.RetryController{
@@ -240,8 +283,12 @@ func (factory *BuildPodControllerFactory) CreateDeleteController() controller.Ru
 	cache.NewReflector(&buildPodDeleteLW{client, queue}, &kapi.Pod{}, queue, 5*time.Minute).RunUntil(factory.Stop)
 
 	buildPodDeleteController := &buildcontroller.BuildPodDeleteController{
-		BuildStore:   factory.buildStore,
-		BuildUpdater: factory.BuildUpdater,
+		BuildStore:           factory.buildStore,
+		BuildLister:          factory.BuildLister,
+		BuildUpdater:         factory.BuildUpdater,
+		BuildDeleter:         factory.BuildDeleter,
+		BuildConfigGetter:    factory.BuildConfigGetter,
+		CommitStatusNotifier: factory.getCommitStatusNotifier(client),
 	}
 
 	return &controller.RetryController{
@@ -381,6 +428,22 @@ func (f *typeBasedFactoryStrategy) CreateBuildPod(build *buildapi.Build) (*kapi.
 	return pod, err
 }
 
+// externalPipelineExecutor leaves the execution of JenkinsPipeline builds to a
+// Jenkins server that watches for them and reports the progress of each stage
+// by updating the build status.
+type externalPipelineExecutor struct{}
+
+func (externalPipelineExecutor) Start(build *buildapi.Build) error {
+	glog.V(4).Infof("Waiting for Jenkins to run the pipeline for build %s/%s", build.Namespace, build.Name)
+	return nil
+}
+
+// Cancel is a no-op, Jenkins stops the pipeline once the build is marked as
+// cancelled.
+func (externalPipelineExecutor) Cancel(build *buildapi.Build) error {
+	return nil
+}
+
 // panicIfStopped panics with the provided object if the channel is closed
 func panicIfStopped(ch <-chan struct{}, message interface{}) {
 	select {
@@ -561,6 +624,10 @@ func (lw *buildPodDeleteLW) List(options kapi.ListOptions) (runtime.Object, erro
 			glog.V(5).Infof("Ignoring build %s/%s because it is complete", build.Namespace, build.Name)
 			continue
 		}
+		if buildutil.IsPipelineBuild(&build) {
+			glog.V(5).Infof("Ignoring build %s/%s because it does not run in a pod", build.Namespace, build.Name)
+			continue
+		}
 		pod, err := lw.KubeClient.Pods(build.Namespace).Get(buildutil.GetBuildPodName(&build))
 		if err != nil {
 			if !kerrors.IsNotFound(err) {
@@ -628,6 +695,16 @@ func (c ControllerClient) GetPod(namespace, name string) (*kapi.Pod, error) {
 	return c.KubeClient.Pods(namespace).Get(name)
 }
 
+// StreamPodLog streams the log of a pod using the Kubernetes client.
+func (c ControllerClient) StreamPodLog(namespace, name string) (io.ReadCloser, error) {
+	return c.KubeClient.Pods(namespace).GetLogs(name, &kapi.PodLogOptions{}).Stream()
+}
+
+// GetSecret retrieves a secret using the Kubernetes client.
+func (c ControllerClient) GetSecret(namespace, name string) (*kapi.Secret, error) {
+	return c.KubeClient.Secrets(namespace).Get(name)
+}
+
 // GetImageStream retrieves an image repository by namespace and name
 func (c ControllerClient) GetImageStream(namespace, name string) (*imageapi.ImageStream, error) {
 	return c.Client.ImageStreams(namespace).Get(name)
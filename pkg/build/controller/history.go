@@ -0,0 +1,65 @@
+package controller
+
+import (
+	"github.com/golang/glog"
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	buildclient "github.com/openshift/origin/pkg/build/client"
+	"github.com/openshift/origin/pkg/build/prune"
+	buildutil "github.com/openshift/origin/pkg/build/util"
+)
+
+// pruneBuildHistory deletes the oldest completed builds of the BuildConfig of
+// the provided build beyond the history limits set on the BuildConfig. The
+// pods of the deleted builds are removed by the BuildDeleteController.
+func pruneBuildHistory(getter buildclient.BuildConfigGetter, lister buildclient.BuildLister, deleter buildclient.BuildDeleter, build *buildapi.Build) error {
+	configName := buildutil.ConfigNameForBuild(build)
+	if len(configName) == 0 || getter == nil || deleter == nil {
+		return nil
+	}
+	buildConfig, err := getter.Get(build.Namespace, configName)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if buildConfig.Spec.SuccessfulBuildsHistoryLimit == nil && buildConfig.Spec.FailedBuildsHistoryLimit == nil {
+		return nil
+	}
+
+	opts := kapi.ListOptions{LabelSelector: buildutil.BuildConfigSelector(configName)}
+	list, err := lister.List(build.Namespace, opts)
+	if err != nil {
+		return err
+	}
+	builds := []*buildapi.Build{}
+	for i := range list.Items {
+		builds = append(builds, &list.Items[i])
+	}
+
+	dataSet := prune.NewDataSet([]*buildapi.BuildConfig{buildConfig}, builds)
+	resolver := prune.NewPerBuildConfigResolver(dataSet, historyLimit(buildConfig.Spec.SuccessfulBuildsHistoryLimit), historyLimit(buildConfig.Spec.FailedBuildsHistoryLimit))
+	prunable, err := resolver.Resolve()
+	if err != nil {
+		return err
+	}
+	for _, b := range prunable {
+		glog.V(4).Infof("Deleting build %s/%s to honor the build history limits of BuildConfig %s", b.Namespace, b.Name, configName)
+		if err := deleter.Delete(b.Namespace, b.Name); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// historyLimit returns the number of builds to keep for the provided limit,
+// where -1 means all builds are kept.
+func historyLimit(limit *int) int {
+	if limit == nil {
+		return -1
+	}
+	return *limit
+}
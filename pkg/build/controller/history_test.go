@@ -0,0 +1,105 @@
+package controller
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+)
+
+type fakeBuildConfigGetter struct {
+	buildConfig *buildapi.BuildConfig
+}
+
+func (g *fakeBuildConfigGetter) Get(namespace, name string) (*buildapi.BuildConfig, error) {
+	return g.buildConfig, nil
+}
+
+type recordingBuildDeleter struct {
+	deleted []string
+}
+
+func (d *recordingBuildDeleter) Delete(namespace, name string) error {
+	d.deleted = append(d.deleted, name)
+	return nil
+}
+
+func mockHistoryBuild(version int, phase buildapi.BuildPhase) buildapi.Build {
+	build := mockConfigBuild(version, phase, buildapi.BuildRunPolicySerial)
+	build.Namespace = "namespace"
+	build.CreationTimestamp = unversioned.NewTime(time.Unix(int64(version), 0))
+	build.Status.Config = &kapi.ObjectReference{Namespace: "namespace", Name: "config"}
+	return *build
+}
+
+func TestPruneBuildHistory(t *testing.T) {
+	zero, one := 0, 1
+	builds := []buildapi.Build{
+		mockHistoryBuild(1, buildapi.BuildPhaseComplete),
+		mockHistoryBuild(2, buildapi.BuildPhaseFailed),
+		mockHistoryBuild(3, buildapi.BuildPhaseComplete),
+		mockHistoryBuild(4, buildapi.BuildPhaseCancelled),
+		mockHistoryBuild(5, buildapi.BuildPhaseComplete),
+		mockHistoryBuild(6, buildapi.BuildPhaseRunning),
+	}
+	tests := []struct {
+		name       string
+		successful *int
+		failed     *int
+		deleted    []string
+	}{
+		{
+			name: "no limits",
+		},
+		{
+			name:       "successful limit",
+			successful: &one,
+			deleted:    []string{"config-1", "config-3"},
+		},
+		{
+			name:    "failed limit",
+			failed:  &zero,
+			deleted: []string{"config-2", "config-4"},
+		},
+		{
+			name:       "both limits",
+			successful: &zero,
+			failed:     &one,
+			deleted:    []string{"config-1", "config-2", "config-3", "config-5"},
+		},
+	}
+	for _, tc := range tests {
+		getter := &fakeBuildConfigGetter{buildConfig: &buildapi.BuildConfig{
+			ObjectMeta: kapi.ObjectMeta{Namespace: "namespace", Name: "config"},
+			Spec: buildapi.BuildConfigSpec{
+				SuccessfulBuildsHistoryLimit: tc.successful,
+				FailedBuildsHistoryLimit:     tc.failed,
+			},
+		}}
+		deleter := &recordingBuildDeleter{}
+		completed := builds[4]
+		if err := pruneBuildHistory(getter, &fakeBuildLister{builds: builds}, deleter, &completed); err != nil {
+			t.Errorf("%s: unexpected error: %v", tc.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(sets.NewString(deleter.deleted...), sets.NewString(tc.deleted...)) {
+			t.Errorf("%s: expected deleted builds %v, got %v", tc.name, tc.deleted, deleter.deleted)
+		}
+	}
+}
+
+func TestPruneBuildHistoryWithoutConfig(t *testing.T) {
+	deleter := &recordingBuildDeleter{}
+	build := mockBuild(buildapi.BuildPhaseComplete, buildapi.BuildOutput{})
+	if err := pruneBuildHistory(&fakeBuildConfigGetter{}, &fakeBuildLister{}, deleter, build); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if len(deleter.deleted) != 0 {
+		t.Errorf("expected no builds to be deleted, got %v", deleter.deleted)
+	}
+}
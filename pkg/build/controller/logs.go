@@ -0,0 +1,57 @@
+package controller
+
+import (
+	"io"
+
+	"github.com/golang/glog"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/build/logarchive"
+	buildutil "github.com/openshift/origin/pkg/build/util"
+)
+
+// maxConcurrentLogArchives is the number of build logs archived at the same
+// time by a BuildPodController.
+const maxConcurrentLogArchives = 5
+
+// podLogStreamer streams the log of a pod.
+type podLogStreamer interface {
+	StreamPodLog(namespace, name string) (io.ReadCloser, error)
+}
+
+// archiveBuildLogAsync archives the log of the completed build in the
+// background, so that streaming the log does not stall the handling of the
+// other build pods. done is called once the log is archived or failed to be.
+func (bc *BuildPodController) archiveBuildLogAsync(build *buildapi.Build, done func()) {
+	if bc.LogArchive == nil || bc.PodLogStreamer == nil {
+		done()
+		return
+	}
+	bc.archiveOnce.Do(func() {
+		bc.archiveSlots = make(chan struct{}, maxConcurrentLogArchives)
+	})
+	namespace, name, podName := build.Namespace, build.Name, buildutil.GetBuildPodName(build)
+	bc.archiving.Add(1)
+	go func() {
+		defer bc.archiving.Done()
+		bc.archiveSlots <- struct{}{}
+		err := archivePodLog(bc.LogArchive, bc.PodLogStreamer, namespace, name, podName)
+		<-bc.archiveSlots
+		if err != nil {
+			glog.V(2).Infof("Failed to archive the log of build %s/%s: %v", namespace, name, err)
+		}
+		done()
+	}()
+}
+
+// archivePodLog saves the log of the pod of a completed build to the archive,
+// so that it can be served once the pod has been deleted.
+func archivePodLog(archive logarchive.Archive, streamer podLogStreamer, namespace, name, podName string) error {
+	log, err := streamer.StreamPodLog(namespace, podName)
+	if err != nil {
+		return err
+	}
+	defer log.Close()
+	glog.V(4).Infof("Archiving the log of build %s/%s", namespace, name)
+	return archive.Save(namespace, name, log)
+}
@@ -0,0 +1,154 @@
+package controller
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/build/logarchive"
+)
+
+type fakePodLogStreamer struct {
+	log string
+	pod string
+}
+
+func (s *fakePodLogStreamer) StreamPodLog(namespace, name string) (io.ReadCloser, error) {
+	s.pod = name
+	return ioutil.NopCloser(strings.NewReader(s.log)), nil
+}
+
+func readArchivedLog(t *testing.T, archive logarchive.Archive, build *buildapi.Build) string {
+	r, err := archive.Open(build.Namespace, build.Name)
+	if err != nil {
+		t.Fatalf("expected the log of build %s to be archived: %v", build.Name, err)
+	}
+	defer r.Close()
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return string(data)
+}
+
+func TestHandlePodArchivesBuildLog(t *testing.T) {
+	build := mockBuild(buildapi.BuildPhaseRunning, buildapi.BuildOutput{})
+	archive := logarchive.NewMemoryArchive()
+	streamer := &fakePodLogStreamer{log: "build log"}
+	ctrl := mockBuildPodController(build)
+	ctrl.LogArchive = archive
+	ctrl.PodLogStreamer = streamer
+
+	if err := ctrl.HandlePod(mockPod(kapi.PodRunning, 0)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := archive.Open(build.Namespace, build.Name); err != logarchive.ErrNotFound {
+		t.Errorf("expected the log of a running build not to be archived, got %v", err)
+	}
+
+	if err := ctrl.HandlePod(mockPod(kapi.PodSucceeded, 0)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ctrl.archiving.Wait()
+	if streamer.pod != "data-build-build" {
+		t.Errorf("expected the log of pod data-build-build to be archived, got %q", streamer.pod)
+	}
+	if log := readArchivedLog(t, archive, build); log != "build log" {
+		t.Errorf("unexpected archived log %q", log)
+	}
+}
+
+// archiveCheckingDeleter records whether the log of the builds it deletes was
+// archived before.
+type archiveCheckingDeleter struct {
+	archive  logarchive.Archive
+	archived map[string]bool
+}
+
+func (d *archiveCheckingDeleter) Delete(namespace, name string) error {
+	_, err := d.archive.Open(namespace, name)
+	d.archived[name] = err == nil
+	return nil
+}
+
+func TestHandlePodPrunesBuildHistoryAfterArchivingLog(t *testing.T) {
+	zero := 0
+	build := mockHistoryBuild(1, buildapi.BuildPhaseRunning)
+	build.Name = "data-build"
+	archive := logarchive.NewMemoryArchive()
+	deleter := &archiveCheckingDeleter{archive: archive, archived: map[string]bool{}}
+	ctrl := mockBuildPodController(&build)
+	ctrl.LogArchive = archive
+	ctrl.PodLogStreamer = &fakePodLogStreamer{log: "build log"}
+	ctrl.BuildDeleter = deleter
+	completed := build
+	completed.Status.Phase = buildapi.BuildPhaseComplete
+	ctrl.BuildLister = &fakeBuildLister{builds: []buildapi.Build{completed}}
+	ctrl.BuildConfigGetter = &fakeBuildConfigGetter{buildConfig: &buildapi.BuildConfig{
+		ObjectMeta: kapi.ObjectMeta{Namespace: "namespace", Name: "config"},
+		Spec:       buildapi.BuildConfigSpec{SuccessfulBuildsHistoryLimit: &zero},
+	}}
+
+	if err := ctrl.HandlePod(mockPod(kapi.PodSucceeded, 0)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ctrl.archiving.Wait()
+	archived, deleted := deleter.archived["data-build"]
+	if !deleted {
+		t.Fatalf("expected the completed build to be pruned")
+	}
+	if !archived {
+		t.Errorf("expected the log of the build to be archived before the build is pruned")
+	}
+}
+
+func TestHandleBuildDeletionDeletesArchivedLog(t *testing.T) {
+	build := mockBuild(buildapi.BuildPhaseComplete, buildapi.BuildOutput{})
+	archive := logarchive.NewMemoryArchive()
+	if err := archive.Save(build.Namespace, build.Name, strings.NewReader("build log")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ctrl := BuildDeleteController{PodManager: &okPodManager{}, LogArchive: archive}
+	if err := ctrl.HandleBuildDeletion(build); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := archive.Open(build.Namespace, build.Name); err != logarchive.ErrNotFound {
+		t.Errorf("expected the archived log to be deleted, got %v", err)
+	}
+}
+
+type errArchive struct {
+	logarchive.Archive
+}
+
+func (errArchive) Delete(namespace, name string) error {
+	return errors.New("archive unavailable")
+}
+
+func TestHandleBuildDeletionArchiveError(t *testing.T) {
+	build := mockBuild(buildapi.BuildPhaseComplete, buildapi.BuildOutput{})
+	deleted := false
+	ctrl := BuildDeleteController{
+		PodManager: &customPodManager{
+			GetPodFunc: func(namespace, names string) (*kapi.Pod, error) {
+				return &kapi.Pod{ObjectMeta: kapi.ObjectMeta{Labels: map[string]string{buildapi.BuildLabel: build.Name}}}, nil
+			},
+			DeletePodFunc: func(namespace string, pod *kapi.Pod) error {
+				deleted = true
+				return nil
+			},
+		},
+		LogArchive: errArchive{},
+	}
+	if err := ctrl.HandleBuildDeletion(build); err == nil {
+		t.Errorf("expected the archive error to be returned")
+	}
+	if !deleted {
+		t.Errorf("expected the build pod to be deleted when the archived log can't be deleted")
+	}
+}
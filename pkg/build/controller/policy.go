@@ -0,0 +1,117 @@
+package controller
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/golang/glog"
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	buildclient "github.com/openshift/origin/pkg/build/client"
+	buildutil "github.com/openshift/origin/pkg/build/util"
+)
+
+// byVersion sorts builds of the same BuildConfig by their build number.
+type byVersion []*buildapi.Build
+
+func (b byVersion) Len() int      { return len(b) }
+func (b byVersion) Swap(i, j int) { b[i], b[j] = b[j], b[i] }
+func (b byVersion) Less(i, j int) bool {
+	return buildutil.VersionForBuild(b[i]) < buildutil.VersionForBuild(b[j])
+}
+
+// configBuilds returns the builds created from the same BuildConfig as the
+// provided build, excluding the build itself, sorted by their build number.
+func configBuilds(lister buildclient.BuildLister, build *buildapi.Build) ([]*buildapi.Build, error) {
+	opts := kapi.ListOptions{LabelSelector: buildutil.BuildConfigSelector(buildutil.ConfigNameForBuild(build))}
+	list, err := lister.List(build.Namespace, opts)
+	if err != nil {
+		return nil, err
+	}
+	builds := []*buildapi.Build{}
+	for i := range list.Items {
+		if list.Items[i].Name == build.Name {
+			continue
+		}
+		builds = append(builds, &list.Items[i])
+	}
+	sort.Sort(byVersion(builds))
+	return builds, nil
+}
+
+// isActive returns true if the build is pending or running.
+func isActive(build *buildapi.Build) bool {
+	return build.Status.Phase == buildapi.BuildPhasePending || build.Status.Phase == buildapi.BuildPhaseRunning
+}
+
+// isQueued returns true if the build is new and is not being cancelled.
+func isQueued(build *buildapi.Build) bool {
+	return build.Status.Phase == buildapi.BuildPhaseNew && !build.Status.Cancelled
+}
+
+// isRunnable returns true if the provided new build can be started according
+// to the run policy of its BuildConfig. For the SerialLatestOnly policy, the
+// builds queued before the provided build are cancelled.
+func (bc *BuildController) isRunnable(build *buildapi.Build) (bool, error) {
+	policy := buildutil.RunPolicyForBuild(build)
+	if policy == buildapi.BuildRunPolicyParallel {
+		return true, nil
+	}
+	builds, err := configBuilds(bc.BuildLister, build)
+	if err != nil {
+		return false, fmt.Errorf("failed to list builds of the BuildConfig for build %s/%s: %v", build.Namespace, build.Name, err)
+	}
+	version := buildutil.VersionForBuild(build)
+	runnable := true
+	for _, b := range builds {
+		switch {
+		case isActive(b):
+			runnable = false
+		case isQueued(b) && buildutil.VersionForBuild(b) < version:
+			if policy == buildapi.BuildRunPolicySerialLatestOnly {
+				glog.V(4).Infof("Cancelling build %s/%s because it was superseded by build %s", b.Namespace, b.Name, build.Name)
+				b.Status.Cancelled = true
+				if err := bc.CancelBuild(b); err != nil {
+					return false, err
+				}
+				continue
+			}
+			runnable = false
+		}
+	}
+	return runnable, nil
+}
+
+// startNextBuild signals the build controller that the next build queued on
+// the BuildConfig of the provided completed build can be started. Builds
+// waiting on the run policy are also retried periodically, this only makes
+// them start sooner.
+func startNextBuild(lister buildclient.BuildLister, updater buildclient.BuildUpdater, build *buildapi.Build) error {
+	if buildutil.RunPolicyForBuild(build) == buildapi.BuildRunPolicyParallel {
+		return nil
+	}
+	builds, err := configBuilds(lister, build)
+	if err != nil {
+		return err
+	}
+	var next *buildapi.Build
+	for _, b := range builds {
+		if isActive(b) {
+			return nil
+		}
+		if next == nil && isQueued(b) {
+			next = b
+		}
+	}
+	if next == nil {
+		return nil
+	}
+	glog.V(4).Infof("Build %s/%s completed, starting build %s", build.Namespace, build.Name, next.Name)
+	if next.Annotations == nil {
+		next.Annotations = make(map[string]string)
+	}
+	next.Annotations[buildapi.BuildAcceptedAnnotation] = time.Now().UTC().Format(time.RFC3339Nano)
+	return updater.Update(next.Namespace, next)
+}
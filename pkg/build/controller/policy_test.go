@@ -0,0 +1,280 @@
+package controller
+
+import (
+	"strconv"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/labels"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+)
+
+type fakeBuildLister struct {
+	builds []buildapi.Build
+}
+
+func (l *fakeBuildLister) List(namespace string, opts kapi.ListOptions) (*buildapi.BuildList, error) {
+	list := &buildapi.BuildList{}
+	for _, b := range l.builds {
+		if opts.LabelSelector.Matches(labels.Set(b.Labels)) {
+			list.Items = append(list.Items, b)
+		}
+	}
+	return list, nil
+}
+
+type recordingBuildUpdater struct {
+	updated []*buildapi.Build
+}
+
+func (u *recordingBuildUpdater) Update(namespace string, build *buildapi.Build) error {
+	u.updated = append(u.updated, build)
+	return nil
+}
+
+func mockConfigBuild(version int, phase buildapi.BuildPhase, policy buildapi.BuildRunPolicy) *buildapi.Build {
+	build := mockBuild(phase, buildapi.BuildOutput{})
+	build.Name = "config-" + strconv.Itoa(version)
+	build.Labels = map[string]string{
+		buildapi.BuildConfigLabel:    "config",
+		buildapi.BuildRunPolicyLabel: string(policy),
+	}
+	build.Annotations = map[string]string{
+		buildapi.BuildNumberAnnotation: strconv.Itoa(version),
+	}
+	return build
+}
+
+func TestHandleBuildRunPolicy(t *testing.T) {
+	tests := []struct {
+		name         string
+		policy       buildapi.BuildRunPolicy
+		others       []*buildapi.Build
+		outStatus    buildapi.BuildPhase
+		outReason    buildapi.StatusReason
+		outCancelled []string
+	}{
+		{
+			name:      "parallel with a running build",
+			policy:    buildapi.BuildRunPolicyParallel,
+			others:    []*buildapi.Build{mockConfigBuild(1, buildapi.BuildPhaseRunning, buildapi.BuildRunPolicyParallel)},
+			outStatus: buildapi.BuildPhasePending,
+		},
+		{
+			name:      "serial without other builds",
+			policy:    buildapi.BuildRunPolicySerial,
+			outStatus: buildapi.BuildPhasePending,
+		},
+		{
+			name:   "serial with completed builds",
+			policy: buildapi.BuildRunPolicySerial,
+			others: []*buildapi.Build{
+				mockConfigBuild(1, buildapi.BuildPhaseComplete, buildapi.BuildRunPolicySerial),
+				mockConfigBuild(0, buildapi.BuildPhaseFailed, buildapi.BuildRunPolicySerial),
+			},
+			outStatus: buildapi.BuildPhasePending,
+		},
+		{
+			name:      "serial with a running build",
+			policy:    buildapi.BuildRunPolicySerial,
+			others:    []*buildapi.Build{mockConfigBuild(1, buildapi.BuildPhaseRunning, buildapi.BuildRunPolicySerial)},
+			outStatus: buildapi.BuildPhaseNew,
+			outReason: buildapi.StatusReasonWaitingForPreviousBuild,
+		},
+		{
+			name:      "serial with an older queued build",
+			policy:    buildapi.BuildRunPolicySerial,
+			others:    []*buildapi.Build{mockConfigBuild(1, buildapi.BuildPhaseNew, buildapi.BuildRunPolicySerial)},
+			outStatus: buildapi.BuildPhaseNew,
+			outReason: buildapi.StatusReasonWaitingForPreviousBuild,
+		},
+		{
+			name:      "serial with a newer queued build",
+			policy:    buildapi.BuildRunPolicySerial,
+			others:    []*buildapi.Build{mockConfigBuild(3, buildapi.BuildPhaseNew, buildapi.BuildRunPolicySerial)},
+			outStatus: buildapi.BuildPhasePending,
+		},
+		{
+			name:   "serial latest only cancels older queued builds",
+			policy: buildapi.BuildRunPolicySerialLatestOnly,
+			others: []*buildapi.Build{
+				mockConfigBuild(1, buildapi.BuildPhaseNew, buildapi.BuildRunPolicySerialLatestOnly),
+				mockConfigBuild(3, buildapi.BuildPhaseNew, buildapi.BuildRunPolicySerialLatestOnly),
+			},
+			outStatus:    buildapi.BuildPhasePending,
+			outCancelled: []string{"config-1"},
+		},
+		{
+			name:   "serial latest only with a running build",
+			policy: buildapi.BuildRunPolicySerialLatestOnly,
+			others: []*buildapi.Build{
+				mockConfigBuild(0, buildapi.BuildPhaseRunning, buildapi.BuildRunPolicySerialLatestOnly),
+				mockConfigBuild(1, buildapi.BuildPhaseNew, buildapi.BuildRunPolicySerialLatestOnly),
+			},
+			outStatus:    buildapi.BuildPhaseNew,
+			outReason:    buildapi.StatusReasonWaitingForPreviousBuild,
+			outCancelled: []string{"config-1"},
+		},
+	}
+
+	for _, tc := range tests {
+		build := mockConfigBuild(2, buildapi.BuildPhaseNew, tc.policy)
+		lister := &fakeBuildLister{builds: []buildapi.Build{*build}}
+		for _, b := range tc.others {
+			lister.builds = append(lister.builds, *b)
+		}
+		updater := &recordingBuildUpdater{}
+		ctrl := mockBuildController()
+		ctrl.BuildLister = lister
+		ctrl.BuildUpdater = updater
+
+		if err := ctrl.HandleBuild(build); err != nil {
+			t.Errorf("%s: unexpected error: %v", tc.name, err)
+			continue
+		}
+		if build.Status.Phase != tc.outStatus {
+			t.Errorf("%s: expected phase %s, got %s", tc.name, tc.outStatus, build.Status.Phase)
+		}
+		if build.Status.Reason != tc.outReason {
+			t.Errorf("%s: expected reason %q, got %q", tc.name, tc.outReason, build.Status.Reason)
+		}
+		cancelled := []string{}
+		for _, b := range updater.updated {
+			if b.Status.Phase == buildapi.BuildPhaseCancelled {
+				cancelled = append(cancelled, b.Name)
+			}
+		}
+		if len(cancelled) != len(tc.outCancelled) {
+			t.Errorf("%s: expected cancelled builds %v, got %v", tc.name, tc.outCancelled, cancelled)
+			continue
+		}
+		for i := range cancelled {
+			if cancelled[i] != tc.outCancelled[i] {
+				t.Errorf("%s: expected cancelled builds %v, got %v", tc.name, tc.outCancelled, cancelled)
+			}
+		}
+	}
+}
+
+func TestHandleWaitingBuildNotUpdatedTwice(t *testing.T) {
+	build := mockConfigBuild(2, buildapi.BuildPhaseNew, buildapi.BuildRunPolicySerial)
+	running := mockConfigBuild(1, buildapi.BuildPhaseRunning, buildapi.BuildRunPolicySerial)
+	updater := &recordingBuildUpdater{}
+	ctrl := mockBuildController()
+	ctrl.BuildLister = &fakeBuildLister{builds: []buildapi.Build{*build, *running}}
+	ctrl.BuildUpdater = updater
+
+	for i := 0; i < 2; i++ {
+		if err := ctrl.HandleBuild(build); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if len(updater.updated) != 1 {
+		t.Errorf("expected the waiting build to be updated once, got %d updates", len(updater.updated))
+	}
+}
+
+func TestStartNextBuild(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  buildapi.BuildRunPolicy
+		others  []*buildapi.Build
+		outNext string
+	}{
+		{
+			name:   "parallel",
+			policy: buildapi.BuildRunPolicyParallel,
+			others: []*buildapi.Build{mockConfigBuild(2, buildapi.BuildPhaseNew, buildapi.BuildRunPolicyParallel)},
+		},
+		{
+			name:   "serial starts the oldest queued build",
+			policy: buildapi.BuildRunPolicySerial,
+			others: []*buildapi.Build{
+				mockConfigBuild(3, buildapi.BuildPhaseNew, buildapi.BuildRunPolicySerial),
+				mockConfigBuild(2, buildapi.BuildPhaseNew, buildapi.BuildRunPolicySerial),
+			},
+			outNext: "config-2",
+		},
+		{
+			name:   "serial with an active build",
+			policy: buildapi.BuildRunPolicySerial,
+			others: []*buildapi.Build{
+				mockConfigBuild(2, buildapi.BuildPhaseRunning, buildapi.BuildRunPolicySerial),
+				mockConfigBuild(3, buildapi.BuildPhaseNew, buildapi.BuildRunPolicySerial),
+			},
+		},
+		{
+			name:   "serial without queued builds",
+			policy: buildapi.BuildRunPolicySerial,
+			others: []*buildapi.Build{mockConfigBuild(2, buildapi.BuildPhaseComplete, buildapi.BuildRunPolicySerial)},
+		},
+	}
+
+	for _, tc := range tests {
+		build := mockConfigBuild(1, buildapi.BuildPhaseComplete, tc.policy)
+		lister := &fakeBuildLister{builds: []buildapi.Build{*build}}
+		for _, b := range tc.others {
+			lister.builds = append(lister.builds, *b)
+		}
+		updater := &recordingBuildUpdater{}
+		if err := startNextBuild(lister, updater, build); err != nil {
+			t.Errorf("%s: unexpected error: %v", tc.name, err)
+			continue
+		}
+		if len(tc.outNext) == 0 {
+			if len(updater.updated) != 0 {
+				t.Errorf("%s: expected no build to be started, got %s", tc.name, updater.updated[0].Name)
+			}
+			continue
+		}
+		if len(updater.updated) != 1 {
+			t.Errorf("%s: expected build %s to be started, got %d updates", tc.name, tc.outNext, len(updater.updated))
+			continue
+		}
+		next := updater.updated[0]
+		if next.Name != tc.outNext {
+			t.Errorf("%s: expected build %s to be started, got %s", tc.name, tc.outNext, next.Name)
+		}
+		if len(next.Annotations[buildapi.BuildAcceptedAnnotation]) == 0 {
+			t.Errorf("%s: expected build %s to be annotated", tc.name, next.Name)
+		}
+	}
+}
+
+func TestHandleCompletedPipelineBuild(t *testing.T) {
+	build := mockConfigBuild(1, buildapi.BuildPhaseComplete, buildapi.BuildRunPolicySerial)
+	build.UID = "pipeline-1"
+	build.Spec.Strategy = buildapi.BuildStrategy{
+		JenkinsPipelineStrategy: &buildapi.JenkinsPipelineBuildStrategy{Jenkinsfile: "node { stage 'build' }"},
+	}
+	queued := mockConfigBuild(2, buildapi.BuildPhaseNew, buildapi.BuildRunPolicySerial)
+	updater := &recordingBuildUpdater{}
+	ctrl := mockBuildController()
+	ctrl.BuildLister = &fakeBuildLister{builds: []buildapi.Build{*build, *queued}}
+	ctrl.BuildUpdater = updater
+
+	if err := ctrl.HandleBuild(build); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(updater.updated) != 2 {
+		t.Fatalf("expected the completion to be recorded and the next build to be started, got %d updates", len(updater.updated))
+	}
+	if _, ok := updater.updated[0].Annotations[buildapi.BuildCompletionHandledAnnotation]; !ok || updater.updated[0].Name != build.Name {
+		t.Errorf("expected the completion of build %s to be recorded, got %v", build.Name, updater.updated[0])
+	}
+	if updater.updated[1].Name != queued.Name {
+		t.Errorf("expected build %s to be started, got %s", queued.Name, updater.updated[1].Name)
+	}
+
+	// resyncs of the completed build, also by a new controller, don't start builds again
+	ctrl = mockBuildController()
+	ctrl.BuildLister = &fakeBuildLister{builds: []buildapi.Build{*build, *queued}}
+	ctrl.BuildUpdater = updater
+	if err := ctrl.HandleBuild(build); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(updater.updated) != 2 {
+		t.Errorf("expected the completion to be handled once, got %d updates", len(updater.updated))
+	}
+}
@@ -108,6 +108,7 @@ func (bs *CustomBuildStrategy) CreateBuildPod(build *buildapi.Build) (*kapi.Pod,
 	if strategy.ExposeDockerSocket {
 		setupDockerSocket(pod)
 		setupDockerSecrets(pod, build.Spec.Output.PushSecret, strategy.PullSecret, build.Spec.Source.Images)
+		setupDestinationSecrets(pod, build.Spec.Output.Destinations)
 	}
 	setupSourceSecrets(pod, build.Spec.Source.SourceSecret)
 	setupSecrets(pod, build.Spec.Source.Secrets)
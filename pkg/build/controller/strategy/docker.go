@@ -42,6 +42,7 @@ func (bs *DockerBuildStrategy) CreateBuildPod(build *buildapi.Build) (*kapi.Pod,
 	if len(strategy.Env) > 0 {
 		mergeTrustedEnvWithoutDuplicates(strategy.Env, &containerEnv)
 	}
+	addBuildArgEnvVars(strategy.BuildArgs, &containerEnv)
 
 	pod := &kapi.Pod{
 		ObjectMeta: kapi.ObjectMeta{
@@ -79,6 +80,8 @@ func (bs *DockerBuildStrategy) CreateBuildPod(build *buildapi.Build) (*kapi.Pod,
 
 	setupDockerSocket(pod)
 	setupDockerSecrets(pod, build.Spec.Output.PushSecret, strategy.PullSecret, build.Spec.Source.Images)
+	setupDestinationSecrets(pod, build.Spec.Output.Destinations)
+	setupBuildCache(pod, build.Spec.Cache)
 	setupSourceSecrets(pod, build.Spec.Source.SourceSecret)
 	setupSecrets(pod, build.Spec.Source.Secrets)
 
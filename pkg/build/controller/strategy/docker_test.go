@@ -97,6 +97,39 @@ func TestDockerCreateBuildPod(t *testing.T) {
 	}
 }
 
+func TestDockerCreateBuildPodBuildArgs(t *testing.T) {
+	strategy := DockerBuildStrategy{
+		Image: "docker-test-image",
+		Codec: kapi.Codecs.LegacyCodec(buildapi.SchemeGroupVersion),
+	}
+	tokenRef := &kapi.EnvVarSource{SecretKeyRef: &kapi.SecretKeySelector{LocalObjectReference: kapi.LocalObjectReference{Name: "secret"}, Key: "token"}}
+	build := mockDockerBuild()
+	build.Spec.Strategy.DockerStrategy.BuildArgs = []kapi.EnvVar{
+		{Name: "VERSION", Value: "1.0"},
+		{Name: "TOKEN", ValueFrom: tokenRef},
+	}
+
+	pod, err := strategy.CreateBuildPod(build)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var found bool
+	for _, env := range pod.Spec.Containers[0].Env {
+		switch env.Name {
+		case BuildArgEnvPrefix + "TOKEN":
+			found = true
+			if !reflect.DeepEqual(env.ValueFrom, tokenRef) {
+				t.Errorf("Expected the build arg to reference the secret, got %#v", env.ValueFrom)
+			}
+		case BuildArgEnvPrefix + "VERSION":
+			t.Errorf("Unexpected environment variable for a build arg with a value: %#v", env)
+		}
+	}
+	if !found {
+		t.Errorf("Expected an environment variable for the TOKEN build arg, got %#v", pod.Spec.Containers[0].Env)
+	}
+}
+
 func mockDockerBuild() *buildapi.Build {
 	timeout := int64(60)
 	return &buildapi.Build{
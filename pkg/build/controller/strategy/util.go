@@ -17,12 +17,18 @@ import (
 
 const (
 	// dockerSocketPath is the default path for the Docker socket inside the builder container
-	dockerSocketPath               = "/var/run/docker.sock"
-	DockerPushSecretMountPath      = "/var/run/secrets/openshift.io/push"
-	DockerPullSecretMountPath      = "/var/run/secrets/openshift.io/pull"
-	SecretBuildSourceBaseMountPath = "/var/run/secrets/openshift.io/build"
-	SourceImagePullSecretMountPath = "/var/run/secrets/openshift.io/source-image"
-	sourceSecretMountPath          = "/var/run/secrets/openshift.io/source"
+	dockerSocketPath                     = "/var/run/docker.sock"
+	DockerPushSecretMountPath            = "/var/run/secrets/openshift.io/push"
+	DockerPushDestinationSecretMountPath = "/var/run/secrets/openshift.io/push-destination"
+	DockerPullSecretMountPath            = "/var/run/secrets/openshift.io/pull"
+	SecretBuildSourceBaseMountPath       = "/var/run/secrets/openshift.io/build"
+	SourceImagePullSecretMountPath       = "/var/run/secrets/openshift.io/source-image"
+	sourceSecretMountPath                = "/var/run/secrets/openshift.io/source"
+	BuildCacheMountPath                  = "/var/run/openshift.io/build-cache"
+	// BuildArgEnvPrefix prefixes the names of the environment variables the
+	// values of the build args taken from secrets and config maps are passed
+	// to the builder container in.
+	BuildArgEnvPrefix = "OPENSHIFT_BUILD_ARG_"
 )
 
 var whitelistEnvVarNames = []string{"BUILD_LOGLEVEL"}
@@ -118,6 +124,45 @@ func setupDockerSecrets(pod *kapi.Pod, pushSecret, pullSecret *kapi.LocalObjectR
 	}
 }
 
+// setupDestinationSecrets mounts the push secrets of the additional
+// destinations of the build output into Pod running the build.
+func setupDestinationSecrets(pod *kapi.Pod, destinations []buildapi.BuildOutputDestination) {
+	for i, destination := range destinations {
+		if destination.PushSecret == nil {
+			continue
+		}
+		mountPath := filepath.Join(DockerPushDestinationSecretMountPath, strconv.Itoa(i))
+		mountSecretVolume(pod, destination.PushSecret.Name, mountPath, fmt.Sprintf("push-destination-%d", i))
+		pod.Spec.Containers[0].Env = append(pod.Spec.Containers[0].Env, []kapi.EnvVar{
+			{Name: fmt.Sprintf("%s%d", dockercfg.PushDestinationAuthType, i), Value: mountPath},
+		}...)
+		glog.V(3).Infof("%s will be used for docker push in %s", mountPath, pod.Name)
+	}
+}
+
+// setupBuildCache mounts the persistent volume claim the build cache is
+// stored on into Pod running the build.
+func setupBuildCache(pod *kapi.Pod, cache *buildapi.BuildCache) {
+	if cache == nil || cache.PersistentVolumeClaim == nil {
+		return
+	}
+	volume := kapi.Volume{
+		Name: "build-cache",
+		VolumeSource: kapi.VolumeSource{
+			PersistentVolumeClaim: &kapi.PersistentVolumeClaimVolumeSource{
+				ClaimName: cache.PersistentVolumeClaim.Name,
+			},
+		},
+	}
+	volumeMount := kapi.VolumeMount{
+		Name:      volume.Name,
+		MountPath: BuildCacheMountPath,
+	}
+	pod.Spec.Volumes = append(pod.Spec.Volumes, volume)
+	pod.Spec.Containers[0].VolumeMounts = append(pod.Spec.Containers[0].VolumeMounts, volumeMount)
+	glog.V(3).Infof("Build cache claim %s will be mounted at %s in %s", cache.PersistentVolumeClaim.Name, BuildCacheMountPath, pod.Name)
+}
+
 // setupSourceSecrets mounts SSH key used for accessing private SCM to clone
 // application source code during build.
 func setupSourceSecrets(pod *kapi.Pod, sourceSecret *kapi.LocalObjectReference) {
@@ -159,6 +204,17 @@ func addSourceEnvVars(source buildapi.BuildSource, output *[]kapi.EnvVar) {
 	*output = append(*output, sourceVars...)
 }
 
+// addBuildArgEnvVars adds environment variables resolving the build args taken
+// from secrets and config maps to the builder container. The values of the
+// other build args are read from the build.
+func addBuildArgEnvVars(args []kapi.EnvVar, output *[]kapi.EnvVar) {
+	for _, arg := range args {
+		if arg.ValueFrom != nil {
+			*output = append(*output, kapi.EnvVar{Name: BuildArgEnvPrefix + arg.Name, ValueFrom: arg.ValueFrom})
+		}
+	}
+}
+
 func addOriginVersionVar(output *[]kapi.EnvVar) {
 	version := kapi.EnvVar{Name: buildapi.OriginVersion, Value: version.Get().String()}
 	*output = append(*output, version)
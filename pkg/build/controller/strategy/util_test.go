@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	kapi "k8s.io/kubernetes/pkg/api"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
 )
 
 func TestSetupDockerSocketHostSocket(t *testing.T) {
@@ -55,6 +57,49 @@ func TestSetupDockerSocketHostSocket(t *testing.T) {
 	}
 }
 
+func TestSetupDestinationSecrets(t *testing.T) {
+	pod := kapi.Pod{
+		Spec: kapi.PodSpec{
+			Containers: []kapi.Container{
+				{},
+			},
+		},
+	}
+
+	setupDestinationSecrets(&pod, []buildapi.BuildOutputDestination{
+		{To: kapi.ObjectReference{Kind: "DockerImage", Name: "registry.example.com/app"}, PushSecret: &kapi.LocalObjectReference{Name: "external"}},
+		{To: kapi.ObjectReference{Kind: "DockerImage", Name: "registry.example.com/app:abc123"}},
+		{To: kapi.ObjectReference{Kind: "DockerImage", Name: "other.example.com/app"}, PushSecret: &kapi.LocalObjectReference{Name: "external"}},
+	})
+
+	if len(pod.Spec.Volumes) != 2 {
+		t.Fatalf("Expected 2 volumes, got: %#v", pod.Spec.Volumes)
+	}
+	if pod.Spec.Volumes[0].Name == pod.Spec.Volumes[1].Name {
+		t.Errorf("Expected distinct volume names, got %s twice", pod.Spec.Volumes[0].Name)
+	}
+	mounts := pod.Spec.Containers[0].VolumeMounts
+	if len(mounts) != 2 {
+		t.Fatalf("Expected 2 volume mounts, got: %#v", mounts)
+	}
+	if e, a := "/var/run/secrets/openshift.io/push-destination/2", mounts[1].MountPath; e != a {
+		t.Errorf("Expected %s, got %s", e, a)
+	}
+	expectedEnv := []kapi.EnvVar{
+		{Name: "PUSH_DESTINATION_DOCKERCFG_PATH_0", Value: "/var/run/secrets/openshift.io/push-destination/0"},
+		{Name: "PUSH_DESTINATION_DOCKERCFG_PATH_2", Value: "/var/run/secrets/openshift.io/push-destination/2"},
+	}
+	env := pod.Spec.Containers[0].Env
+	if len(env) != len(expectedEnv) {
+		t.Fatalf("Expected env %#v, got %#v", expectedEnv, env)
+	}
+	for i := range expectedEnv {
+		if env[i] != expectedEnv[i] {
+			t.Errorf("Expected env %#v, got %#v", expectedEnv[i], env[i])
+		}
+	}
+}
+
 func isVolumeSourceEmpty(volumeSource kapi.VolumeSource) bool {
 	if volumeSource.EmptyDir == nil &&
 		volumeSource.HostPath == nil &&
@@ -95,3 +140,31 @@ func TestTrustedMergeEnvWithoutDuplicates(t *testing.T) {
 		t.Errorf("Expected output env 'foo' to have value 'loglevel', got %+v", output[0])
 	}
 }
+
+func TestSetupBuildCache(t *testing.T) {
+	pod := kapi.Pod{
+		Spec: kapi.PodSpec{
+			Containers: []kapi.Container{
+				{},
+			},
+		},
+	}
+
+	setupBuildCache(&pod, &buildapi.BuildCache{Image: &kapi.ObjectReference{Kind: "DockerImage", Name: "registry/app-cache"}})
+	if len(pod.Spec.Volumes) != 0 {
+		t.Fatalf("Expected no volumes for an image cache, got: %#v", pod.Spec.Volumes)
+	}
+
+	setupBuildCache(&pod, &buildapi.BuildCache{PersistentVolumeClaim: &kapi.LocalObjectReference{Name: "maven-cache"}})
+	if len(pod.Spec.Volumes) != 1 {
+		t.Fatalf("Expected 1 volume, got: %#v", pod.Spec.Volumes)
+	}
+	volume := pod.Spec.Volumes[0]
+	if volume.PersistentVolumeClaim == nil || volume.PersistentVolumeClaim.ClaimName != "maven-cache" {
+		t.Errorf("Expected a volume for claim maven-cache, got %#v", volume)
+	}
+	mounts := pod.Spec.Containers[0].VolumeMounts
+	if len(mounts) != 1 || mounts[0].Name != volume.Name || mounts[0].MountPath != BuildCacheMountPath {
+		t.Errorf("Expected the claim to be mounted at %s, got %#v", BuildCacheMountPath, mounts)
+	}
+}
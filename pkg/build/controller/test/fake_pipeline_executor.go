@@ -0,0 +1,79 @@
+package test
+
+import (
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	buildutil "github.com/openshift/origin/pkg/build/util"
+)
+
+// FakePipelineExecutor is a pipeline executor that runs pipelines locally by
+// walking the build through a fixed list of stages.
+type FakePipelineExecutor struct {
+	// Stages are the names of the stages every pipeline runs, in order.
+	Stages []string
+	// FailStage is the name of the stage that fails, if any.
+	FailStage string
+	// StartErr and CancelErr are returned from Start and Cancel.
+	StartErr  error
+	CancelErr error
+
+	Started   []*buildapi.Build
+	Cancelled []*buildapi.Build
+}
+
+// Start records the build as started.
+func (e *FakePipelineExecutor) Start(build *buildapi.Build) error {
+	if e.StartErr != nil {
+		return e.StartErr
+	}
+	copied, err := kapi.Scheme.Copy(build)
+	if err != nil {
+		return err
+	}
+	e.Started = append(e.Started, copied.(*buildapi.Build))
+	return nil
+}
+
+// Cancel records the build as cancelled.
+func (e *FakePipelineExecutor) Cancel(build *buildapi.Build) error {
+	if e.CancelErr != nil {
+		return e.CancelErr
+	}
+	e.Cancelled = append(e.Cancelled, build)
+	return nil
+}
+
+// Run moves the build through every stage of the pipeline, invoking update
+// after each change to the build status. It stops at FailStage, marking the
+// build failed.
+func (e *FakePipelineExecutor) Run(build *buildapi.Build, update func(*buildapi.Build) error) error {
+	now := unversioned.Now()
+	build.Status.Phase = buildapi.BuildPhaseRunning
+	build.Status.StartTimestamp = &now
+	if err := update(build); err != nil {
+		return err
+	}
+	phase := buildapi.BuildPhaseComplete
+	for _, stage := range e.Stages {
+		buildutil.UpdateStage(build, stage, buildapi.BuildPhaseRunning)
+		if err := update(build); err != nil {
+			return err
+		}
+		if stage == e.FailStage {
+			phase = buildapi.BuildPhaseFailed
+		}
+		buildutil.UpdateStage(build, stage, phase)
+		if err := update(build); err != nil {
+			return err
+		}
+		if phase == buildapi.BuildPhaseFailed {
+			break
+		}
+	}
+	now = unversioned.Now()
+	build.Status.Phase = phase
+	build.Status.CompletionTimestamp = &now
+	return update(build)
+}
@@ -176,9 +176,107 @@ func describeBuildRequest(request *buildapi.BuildRequest) string {
 	if request.LastVersion != nil {
 		desc += fmt.Sprintf(", LastVersion: %d", *request.LastVersion)
 	}
+	if request.PullRequest != nil {
+		desc += fmt.Sprintf(", PullRequest: %d", request.PullRequest.Number)
+	}
 	return desc
 }
 
+// updateBuildPullRequest makes the build check out the head of the pull
+// request, labels it with the number of the pull request and replaces the tag
+// of its output. The output is not pushed if the pull request has no output
+// tag. The builds of forks do not use the build cache, which trusted builds
+// restore, and their secrets are removed unless the policy allows them.
+func updateBuildPullRequest(build *buildapi.Build, pr *buildapi.PullRequestReference, policy *buildapi.PullRequestBuildPolicy) error {
+	if build.Spec.Source.Git == nil {
+		return &GeneratorFatalError{fmt.Sprintf("can't build pull request %d: the BuildConfig has no Git source", pr.Number)}
+	}
+	if len(pr.Ref) > 0 {
+		build.Spec.Source.Git.Ref = pr.Ref
+	}
+	build.Labels[buildapi.BuildPullRequestLabel] = strconv.Itoa(pr.Number)
+	if pr.Fork {
+		build.Spec.Cache = nil
+	}
+	if pr.Fork && (policy == nil || !policy.AllowForkSecrets) {
+		glog.V(4).Infof("Removing the secrets from the build of pull request %d, opened from a fork", pr.Number)
+		removeBuildSecrets(build)
+	}
+
+	output := &build.Spec.Output
+	output.Destinations = nil
+	if output.To == nil {
+		return nil
+	}
+	if len(pr.OutputTag) == 0 {
+		output.To = nil
+		output.PushSecret = nil
+		return nil
+	}
+	switch output.To.Kind {
+	case "ImageStreamTag":
+		stream, _, ok := imageapi.SplitImageStreamTag(output.To.Name)
+		if !ok {
+			return fmt.Errorf("invalid output ImageStreamTag %q", output.To.Name)
+		}
+		output.To.Name = imageapi.JoinImageStreamTag(stream, pr.OutputTag)
+	case "DockerImage":
+		ref, err := imageapi.ParseDockerImageReference(output.To.Name)
+		if err != nil {
+			return err
+		}
+		ref.Tag, ref.ID = pr.OutputTag, ""
+		output.To.Name = ref.String()
+	}
+	return nil
+}
+
+// pullRequestBuildPolicy returns the pull request build policy of the GitHub
+// webhook triggers of the BuildConfig, or nil if none is set.
+func pullRequestBuildPolicy(bc *buildapi.BuildConfig) *buildapi.PullRequestBuildPolicy {
+	for _, trigger := range bc.Spec.Triggers {
+		if trigger.GitHubWebHook != nil && trigger.GitHubWebHook.PullRequests != nil {
+			return trigger.GitHubWebHook.PullRequests
+		}
+	}
+	return nil
+}
+
+// removeBuildSecrets removes the secrets the code being built has access to:
+// the build secrets and the build args read from secrets. Custom builders
+// run the code being built with their secrets and, when the Docker socket is
+// exposed, with the pull and push secrets of the build, so these are removed
+// from custom builds as well. The source secret is kept since only the
+// builder uses it to clone the source.
+func removeBuildSecrets(build *buildapi.Build) {
+	build.Spec.Source.Secrets = nil
+
+	strategy := &build.Spec.Strategy
+	switch {
+	case strategy.DockerStrategy != nil:
+		strategy.DockerStrategy.BuildArgs = removeSecretEnv(strategy.DockerStrategy.BuildArgs)
+	case strategy.CustomStrategy != nil:
+		strategy.CustomStrategy.Secrets = nil
+		strategy.CustomStrategy.PullSecret = nil
+		for i := range build.Spec.Source.Images {
+			build.Spec.Source.Images[i].PullSecret = nil
+		}
+		build.Spec.Output.PushSecret = nil
+	}
+}
+
+// removeSecretEnv returns the variables of env not read from a secret.
+func removeSecretEnv(env []kapi.EnvVar) []kapi.EnvVar {
+	kept := []kapi.EnvVar{}
+	for _, v := range env {
+		if v.ValueFrom != nil && v.ValueFrom.SecretKeyRef != nil {
+			continue
+		}
+		kept = append(kept, v)
+	}
+	return kept
+}
+
 // updateBuildEnv updates the strategy environment
 // This will replace the existing variable definitions with provided env
 func updateBuildEnv(strategy *buildapi.BuildStrategy, env []kapi.EnvVar) {
@@ -191,6 +289,9 @@ func updateBuildEnv(strategy *buildapi.BuildStrategy, env []kapi.EnvVar) {
 		buildEnv = &strategy.DockerStrategy.Env
 	case strategy.CustomStrategy != nil:
 		buildEnv = &strategy.CustomStrategy.Env
+	default:
+		// pipeline builds do not have an environment
+		return
 	}
 
 	newEnv := []kapi.EnvVar{}
@@ -238,6 +339,11 @@ func (g *BuildGenerator) Instantiate(ctx kapi.Context, request *buildapi.BuildRe
 	if len(request.Env) > 0 {
 		updateBuildEnv(&newBuild.Spec.Strategy, request.Env)
 	}
+	if request.PullRequest != nil {
+		if err := updateBuildPullRequest(newBuild, request.PullRequest, pullRequestBuildPolicy(bc)); err != nil {
+			return nil, err
+		}
+	}
 	glog.V(4).Infof("Build %s/%s has been generated from %s/%s BuildConfig", newBuild.Namespace, newBuild.ObjectMeta.Name, bc.Namespace, bc.ObjectMeta.Name)
 
 	// need to update the BuildConfig because LastVersion and possibly LastTriggeredImageID changed
@@ -415,6 +521,11 @@ func (g *BuildGenerator) generateBuildFromConfig(ctx kapi.Context, bc *buildapi.
 	}
 	build.Labels[buildapi.BuildConfigLabelDeprecated] = bcCopy.Name
 	build.Labels[buildapi.BuildConfigLabel] = bcCopy.Name
+	runPolicy := bc.Spec.RunPolicy
+	if len(runPolicy) == 0 {
+		runPolicy = buildapi.BuildRunPolicyParallel
+	}
+	build.Labels[buildapi.BuildRunPolicyLabel] = string(runPolicy)
 
 	builderSecrets, err := g.FetchServiceAccountSecrets(bc.Namespace, serviceAccount)
 	if err != nil {
@@ -423,6 +534,12 @@ func (g *BuildGenerator) generateBuildFromConfig(ctx kapi.Context, bc *buildapi.
 	if build.Spec.Output.PushSecret == nil {
 		build.Spec.Output.PushSecret = g.resolveImageSecret(ctx, builderSecrets, build.Spec.Output.To, bc.Namespace)
 	}
+	for i := range build.Spec.Output.Destinations {
+		destination := &build.Spec.Output.Destinations[i]
+		if destination.PushSecret == nil {
+			destination.PushSecret = g.resolveImageSecret(ctx, builderSecrets, &destination.To, bc.Namespace)
+		}
+	}
 	strategyImageChangeTrigger := getStrategyImageChangeTrigger(bc)
 
 	// Resolve image source if present
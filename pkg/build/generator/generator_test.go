@@ -340,6 +340,191 @@ func TestInstantiateWithLastVersion(t *testing.T) {
 	}
 }
 
+func TestInstantiateWithPullRequest(t *testing.T) {
+	generator := mockBuildGenerator()
+	var created *buildapi.Build
+	c := generator.Client.(Client)
+	c.GetBuildConfigFunc = func(ctx kapi.Context, name string) (*buildapi.BuildConfig, error) {
+		output := buildapi.BuildOutput{To: &kapi.ObjectReference{Kind: "ImageStreamTag", Name: "image:latest"}}
+		return mocks.MockBuildConfig(mocks.MockSource(), mocks.MockSourceStrategyForImageRepository(), output), nil
+	}
+	c.CreateBuildFunc = func(ctx kapi.Context, build *buildapi.Build) error {
+		created = build
+		return nil
+	}
+	generator.Client = c
+
+	request := &buildapi.BuildRequest{
+		PullRequest: &buildapi.PullRequestReference{
+			Number:    123,
+			Ref:       "refs/pull/123/head",
+			OutputTag: "pr-123",
+		},
+	}
+	if _, err := generator.Instantiate(kapi.NewDefaultContext(), request); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if created.Spec.Source.Git.URI != mocks.MockSource().Git.URI || created.Spec.Source.Git.Ref != "refs/pull/123/head" {
+		t.Errorf("expected the head of the pull request to be built, got %#v", created.Spec.Source.Git)
+	}
+	if created.Labels[buildapi.BuildPullRequestLabel] != "123" {
+		t.Errorf("expected the build to be labeled with the pull request, got %v", created.Labels)
+	}
+	if created.Spec.Output.To.Name != "image:pr-123" {
+		t.Errorf("unexpected output %s", created.Spec.Output.To.Name)
+	}
+}
+
+func TestUpdateBuildPullRequest(t *testing.T) {
+	tests := map[string]struct {
+		output    *kapi.ObjectReference
+		outputTag string
+		expected  *kapi.ObjectReference
+	}{
+		"image stream tag": {
+			output:    &kapi.ObjectReference{Kind: "ImageStreamTag", Name: "app:latest"},
+			outputTag: "pr-1",
+			expected:  &kapi.ObjectReference{Kind: "ImageStreamTag", Name: "app:pr-1"},
+		},
+		"docker image": {
+			output:    &kapi.ObjectReference{Kind: "DockerImage", Name: "registry/ns/app:latest"},
+			outputTag: "pr-1",
+			expected:  &kapi.ObjectReference{Kind: "DockerImage", Name: "registry/ns/app:pr-1"},
+		},
+		"no push": {
+			output:   &kapi.ObjectReference{Kind: "ImageStreamTag", Name: "app:latest"},
+			expected: nil,
+		},
+		"no output": {
+			outputTag: "pr-1",
+			expected:  nil,
+		},
+	}
+	for name, test := range tests {
+		build := &buildapi.Build{
+			ObjectMeta: kapi.ObjectMeta{Labels: map[string]string{}},
+			Spec: buildapi.BuildSpec{
+				Source: mocks.MockSource(),
+				Output: buildapi.BuildOutput{
+					To:           test.output,
+					PushSecret:   &kapi.LocalObjectReference{Name: "push"},
+					Destinations: []buildapi.BuildOutputDestination{{To: kapi.ObjectReference{Kind: "DockerImage", Name: "external/app"}}},
+				},
+			},
+		}
+		if err := updateBuildPullRequest(build, &buildapi.PullRequestReference{Number: 1, OutputTag: test.outputTag}, nil); err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if !reflect.DeepEqual(build.Spec.Output.To, test.expected) {
+			t.Errorf("%s: expected output %#v, got %#v", name, test.expected, build.Spec.Output.To)
+		}
+		if len(build.Spec.Output.Destinations) != 0 {
+			t.Errorf("%s: expected the additional destinations to be removed", name)
+		}
+		if build.Spec.Source.Git.Ref != "test-tag" {
+			t.Errorf("%s: expected the ref to be kept, got %s", name, build.Spec.Source.Git.Ref)
+		}
+	}
+
+	build := &buildapi.Build{Spec: buildapi.BuildSpec{Source: buildapi.BuildSource{Binary: &buildapi.BinaryBuildSource{}}}}
+	if err := updateBuildPullRequest(build, &buildapi.PullRequestReference{Number: 1}, nil); err == nil {
+		t.Errorf("expected an error for a build without Git source")
+	}
+}
+
+func TestUpdateBuildPullRequestFork(t *testing.T) {
+	tests := map[string]struct {
+		fork          bool
+		policy        *buildapi.PullRequestBuildPolicy
+		expectSecrets bool
+	}{
+		"same repository": {
+			expectSecrets: true,
+		},
+		"fork": {
+			fork:   true,
+			policy: &buildapi.PullRequestBuildPolicy{},
+		},
+		"fork allowed secrets": {
+			fork:          true,
+			policy:        &buildapi.PullRequestBuildPolicy{AllowForkSecrets: true},
+			expectSecrets: true,
+		},
+	}
+	for name, test := range tests {
+		source := mocks.MockSource()
+		source.SourceSecret = &kapi.LocalObjectReference{Name: "source"}
+		source.Secrets = []buildapi.SecretBuildSource{{Secret: kapi.LocalObjectReference{Name: "build"}}}
+		build := &buildapi.Build{
+			ObjectMeta: kapi.ObjectMeta{Labels: map[string]string{}},
+			Spec: buildapi.BuildSpec{
+				Source: source,
+				Cache:  &buildapi.BuildCache{KeyFiles: []string{"pom.xml"}},
+				Strategy: buildapi.BuildStrategy{
+					DockerStrategy: &buildapi.DockerBuildStrategy{
+						BuildArgs: []kapi.EnvVar{
+							{Name: "VERSION", Value: "1"},
+							{Name: "TOKEN", ValueFrom: &kapi.EnvVarSource{SecretKeyRef: &kapi.SecretKeySelector{Key: "token"}}},
+						},
+					},
+				},
+			},
+		}
+		if err := updateBuildPullRequest(build, &buildapi.PullRequestReference{Number: 1, Fork: test.fork}, test.policy); err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		hasSecrets := len(build.Spec.Source.Secrets) == 1 && len(build.Spec.Strategy.DockerStrategy.BuildArgs) == 2
+		hasNoSecrets := len(build.Spec.Source.Secrets) == 0 && len(build.Spec.Strategy.DockerStrategy.BuildArgs) == 1
+		if test.expectSecrets && !hasSecrets || !test.expectSecrets && !hasNoSecrets {
+			t.Errorf("%s: expected secrets %t, got %#v", name, test.expectSecrets, build.Spec)
+		}
+		if build.Spec.Source.SourceSecret == nil {
+			t.Errorf("%s: expected the source secret to be kept", name)
+		}
+		if test.fork && build.Spec.Cache != nil {
+			t.Errorf("%s: expected the build cache to be removed from the build of a fork", name)
+		}
+		if !test.fork && build.Spec.Cache == nil {
+			t.Errorf("%s: expected the build cache to be kept", name)
+		}
+	}
+}
+
+func TestUpdateBuildPullRequestForkCustom(t *testing.T) {
+	source := mocks.MockSource()
+	source.SourceSecret = &kapi.LocalObjectReference{Name: "source"}
+	source.Images = []buildapi.ImageSource{{PullSecret: &kapi.LocalObjectReference{Name: "image"}}}
+	build := &buildapi.Build{
+		ObjectMeta: kapi.ObjectMeta{Labels: map[string]string{}},
+		Spec: buildapi.BuildSpec{
+			Source: source,
+			Strategy: buildapi.BuildStrategy{
+				CustomStrategy: &buildapi.CustomBuildStrategy{
+					ExposeDockerSocket: true,
+					PullSecret:         &kapi.LocalObjectReference{Name: "pull"},
+					Secrets:            []buildapi.SecretSpec{{SecretSource: kapi.LocalObjectReference{Name: "custom"}}},
+				},
+			},
+			Output: buildapi.BuildOutput{
+				To:         &kapi.ObjectReference{Kind: "DockerImage", Name: "registry/test:latest"},
+				PushSecret: &kapi.LocalObjectReference{Name: "push"},
+			},
+		},
+	}
+	if err := updateBuildPullRequest(build, &buildapi.PullRequestReference{Number: 1, Fork: true, OutputTag: "pr-1"}, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	strategy := build.Spec.Strategy.CustomStrategy
+	if strategy.PullSecret != nil || len(strategy.Secrets) != 0 || build.Spec.Source.Images[0].PullSecret != nil || build.Spec.Output.PushSecret != nil {
+		t.Errorf("expected the secrets the custom builder can read to be removed, got %#v", build.Spec)
+	}
+	if build.Spec.Source.SourceSecret == nil {
+		t.Errorf("expected the source secret to be kept")
+	}
+}
+
 func TestFindImageTrigger(t *testing.T) {
 	defaultTrigger := &buildapi.ImageChangeTrigger{}
 	image1Trigger := &buildapi.ImageChangeTrigger{
@@ -1432,6 +1617,9 @@ func TestGenerateBuildFromConfigWithSecrets(t *testing.T) {
 		// Setup the BuildGenerator
 		strategy := mockDockerStrategyForDockerImage(imageName)
 		output := mockOutputWithImageName(imageName)
+		output.Destinations = []buildapi.BuildOutputDestination{
+			{To: kapi.ObjectReference{Kind: "DockerImage", Name: imageName + ":abcd"}},
+		}
 		generator := mockBuildGenerator()
 		bc := mocks.MockBuildConfig(source, strategy, output)
 		build, err := generator.generateBuildFromConfig(kapi.NewContext(), bc, revision, nil)
@@ -1440,6 +1628,9 @@ func TestGenerateBuildFromConfigWithSecrets(t *testing.T) {
 			t.Errorf("Expected PushSecret for image '%s' to be set, got nil", imageName)
 			continue
 		}
+		if build.Spec.Output.Destinations[0].PushSecret == nil {
+			t.Errorf("Expected PushSecret for destination '%s:abcd' to be set, got nil", imageName)
+		}
 		if build.Spec.Strategy.DockerStrategy.PullSecret == nil {
 			t.Errorf("Expected PullSecret for image '%s' to be set, got nil", imageName)
 			continue
@@ -0,0 +1,118 @@
+// Package logarchive stores the logs of completed builds, so that they can be
+// served after the build pods have been deleted.
+package logarchive
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// ErrNotFound is returned when no log was archived for a build.
+var ErrNotFound = errors.New("no log was archived for the build")
+
+// Archive stores the logs of completed builds.
+type Archive interface {
+	// Save stores the log of a build, replacing the log saved for it before.
+	Save(namespace, name string, log io.Reader) error
+	// Open returns the log saved for a build, or ErrNotFound.
+	Open(namespace, name string) (io.ReadCloser, error)
+	// Delete removes the log saved for a build, if any.
+	Delete(namespace, name string) error
+}
+
+// directoryArchive stores build logs as files of a local directory.
+type directoryArchive struct {
+	dir string
+}
+
+// NewDirectoryArchive returns an Archive storing the log of every build in
+// the <dir>/<namespace>/<name>.log file.
+func NewDirectoryArchive(dir string) Archive {
+	return &directoryArchive{dir: dir}
+}
+
+func (a *directoryArchive) path(namespace, name string) string {
+	return filepath.Join(a.dir, namespace, name+".log")
+}
+
+func (a *directoryArchive) Save(namespace, name string, log io.Reader) error {
+	dir := filepath.Join(a.dir, namespace)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	// write to a temporary file first so that a partial log is never served
+	f, err := ioutil.TempFile(dir, "."+name+"-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	_, err = io.Copy(f, log)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), a.path(namespace, name))
+}
+
+func (a *directoryArchive) Open(namespace, name string) (io.ReadCloser, error) {
+	f, err := os.Open(a.path(namespace, name))
+	if os.IsNotExist(err) {
+		return nil, ErrNotFound
+	}
+	return f, err
+}
+
+func (a *directoryArchive) Delete(namespace, name string) error {
+	err := os.Remove(a.path(namespace, name))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+// memoryArchive stores build logs in memory. It stands in for an object
+// store in development and test environments, the logs are lost on restart.
+type memoryArchive struct {
+	lock sync.RWMutex
+	logs map[string][]byte
+}
+
+// NewMemoryArchive returns an Archive storing build logs in memory.
+func NewMemoryArchive() Archive {
+	return &memoryArchive{logs: map[string][]byte{}}
+}
+
+func (a *memoryArchive) Save(namespace, name string, log io.Reader) error {
+	data, err := ioutil.ReadAll(log)
+	if err != nil {
+		return err
+	}
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	a.logs[namespace+"/"+name] = data
+	return nil
+}
+
+func (a *memoryArchive) Open(namespace, name string) (io.ReadCloser, error) {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+	data, ok := a.logs[namespace+"/"+name]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return ioutil.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (a *memoryArchive) Delete(namespace, name string) error {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	delete(a.logs, namespace+"/"+name)
+	return nil
+}
@@ -0,0 +1,62 @@
+package logarchive
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+)
+
+func testArchive(t *testing.T, name string, archive Archive) {
+	if _, err := archive.Open("ns", "build-1"); err != ErrNotFound {
+		t.Errorf("%s: expected ErrNotFound for a missing log, got %v", name, err)
+	}
+	if err := archive.Save("ns", "build-1", strings.NewReader("first")); err != nil {
+		t.Fatalf("%s: unexpected error: %v", name, err)
+	}
+	if err := archive.Save("ns", "build-1", strings.NewReader("second")); err != nil {
+		t.Fatalf("%s: unexpected error: %v", name, err)
+	}
+	if err := archive.Save("other", "build-1", strings.NewReader("other")); err != nil {
+		t.Fatalf("%s: unexpected error: %v", name, err)
+	}
+
+	r, err := archive.Open("ns", "build-1")
+	if err != nil {
+		t.Fatalf("%s: unexpected error: %v", name, err)
+	}
+	data, err := ioutil.ReadAll(r)
+	r.Close()
+	if err != nil {
+		t.Fatalf("%s: unexpected error: %v", name, err)
+	}
+	if string(data) != "second" {
+		t.Errorf("%s: expected the last saved log, got %q", name, string(data))
+	}
+
+	if err := archive.Delete("ns", "build-1"); err != nil {
+		t.Fatalf("%s: unexpected error: %v", name, err)
+	}
+	if err := archive.Delete("ns", "build-1"); err != nil {
+		t.Errorf("%s: unexpected error deleting a missing log: %v", name, err)
+	}
+	if _, err := archive.Open("ns", "build-1"); err != ErrNotFound {
+		t.Errorf("%s: expected ErrNotFound for a deleted log, got %v", name, err)
+	}
+	if _, err := archive.Open("other", "build-1"); err != nil {
+		t.Errorf("%s: expected the log of another namespace to be kept, got %v", name, err)
+	}
+}
+
+func TestDirectoryArchive(t *testing.T) {
+	dir, err := ioutil.TempDir("", "build-logs")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	testArchive(t, "directory", NewDirectoryArchive(dir))
+}
+
+func TestMemoryArchive(t *testing.T) {
+	testArchive(t, "memory", NewMemoryArchive())
+}
@@ -109,25 +109,31 @@ type detailsStrategy struct {
 }
 
 // Prepares a build for update by only allowing an update to build details.
-// For now, this is the Spec.Revision field
+// For now, these are the Spec.Revision and Status.Outputs fields
 func (detailsStrategy) PrepareForUpdate(obj, old runtime.Object) {
 	newBuild := obj.(*api.Build)
 	oldBuild := old.(*api.Build)
 	revision := newBuild.Spec.Revision
+	outputs := newBuild.Status.Outputs
 	*newBuild = *oldBuild
 	newBuild.Spec.Revision = revision
+	newBuild.Status.Outputs = outputs
 }
 
-// Validates that an update is valid by ensuring that no Revision exists and that it's not getting updated to blank
+// Validates that an update is valid by ensuring that neither the Revision nor the Outputs are changed once set
+// and that the update does not leave both of them blank
 func (detailsStrategy) ValidateUpdate(ctx kapi.Context, obj, old runtime.Object) field.ErrorList {
 	newBuild := obj.(*api.Build)
 	oldBuild := old.(*api.Build)
 	errors := field.ErrorList{}
-	if oldBuild.Spec.Revision != nil {
+	if oldBuild.Spec.Revision != nil && !kapi.Semantic.DeepEqual(oldBuild.Spec.Revision, newBuild.Spec.Revision) {
 		// If there was already a revision, then return an error
 		errors = append(errors, field.Duplicate(field.NewPath("status", "revision"), oldBuild.Spec.Revision))
 	}
-	if newBuild.Spec.Revision == nil {
+	if len(oldBuild.Status.Outputs) != 0 && !kapi.Semantic.DeepEqual(oldBuild.Status.Outputs, newBuild.Status.Outputs) {
+		errors = append(errors, field.Duplicate(field.NewPath("status", "outputs"), oldBuild.Status.Outputs))
+	}
+	if newBuild.Spec.Revision == nil && len(newBuild.Status.Outputs) == 0 {
 		errors = append(errors, field.Invalid(field.NewPath("status", "revision"), nil, "cannot set an empty revision in build status"))
 	}
 	return errors
@@ -94,3 +94,66 @@ func TestBuildDecorator(t *testing.T) {
 		t.Errorf("Build duration should be greater than zero")
 	}
 }
+
+func TestBuildDetailsStrategy(t *testing.T) {
+	ctx := kapi.NewDefaultContext()
+	revision := &buildapi.SourceRevision{Git: &buildapi.GitSourceRevision{Commit: "abcd"}}
+	outputs := []buildapi.BuildStatusOutput{{DockerImageReference: "registry/app:latest", ImageDigest: "sha256:abcd"}}
+	tests := []struct {
+		name      string
+		old       *buildapi.Build
+		update    *buildapi.Build
+		errFields []string
+	}{
+		{
+			name:   "set revision",
+			old:    &buildapi.Build{},
+			update: &buildapi.Build{Spec: buildapi.BuildSpec{Revision: revision}},
+		},
+		{
+			name:      "change revision",
+			old:       &buildapi.Build{Spec: buildapi.BuildSpec{Revision: revision}},
+			update:    &buildapi.Build{Spec: buildapi.BuildSpec{Revision: &buildapi.SourceRevision{Git: &buildapi.GitSourceRevision{Commit: "efgh"}}}},
+			errFields: []string{"status.revision"},
+		},
+		{
+			name:   "set outputs after revision",
+			old:    &buildapi.Build{Spec: buildapi.BuildSpec{Revision: revision}},
+			update: &buildapi.Build{Spec: buildapi.BuildSpec{Revision: revision}, Status: buildapi.BuildStatus{Outputs: outputs}},
+		},
+		{
+			name:   "set outputs without revision",
+			old:    &buildapi.Build{},
+			update: &buildapi.Build{Status: buildapi.BuildStatus{Outputs: outputs}},
+		},
+		{
+			name:      "change outputs",
+			old:       &buildapi.Build{Status: buildapi.BuildStatus{Outputs: outputs}},
+			update:    &buildapi.Build{Status: buildapi.BuildStatus{Outputs: []buildapi.BuildStatusOutput{{DockerImageReference: "registry/app:latest"}}}},
+			errFields: []string{"status.outputs"},
+		},
+		{
+			name:      "empty update",
+			old:       &buildapi.Build{},
+			update:    &buildapi.Build{},
+			errFields: []string{"status.revision"},
+		},
+	}
+	for _, tc := range tests {
+		tc.old.Status.Phase = buildapi.BuildPhaseRunning
+		DetailsStrategy.PrepareForUpdate(tc.update, tc.old)
+		if tc.update.Status.Phase != buildapi.BuildPhaseRunning {
+			t.Errorf("%s: expected fields other than the details to be preserved, got %#v", tc.name, tc.update)
+		}
+		errs := DetailsStrategy.ValidateUpdate(ctx, tc.update, tc.old)
+		if len(errs) != len(tc.errFields) {
+			t.Errorf("%s: expected errors for %v, got %v", tc.name, tc.errFields, errs)
+			continue
+		}
+		for i := range errs {
+			if errs[i].Field != tc.errFields[i] {
+				t.Errorf("%s: expected an error for %s, got %v", tc.name, tc.errFields[i], errs[i])
+			}
+		}
+	}
+}
@@ -3,39 +3,66 @@ package buildconfig
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 
+	"github.com/golang/glog"
 	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/client/record"
+	"k8s.io/kubernetes/pkg/labels"
 
 	buildapi "github.com/openshift/origin/pkg/build/api"
 	"github.com/openshift/origin/pkg/build/client"
 	"github.com/openshift/origin/pkg/build/webhook"
+	imageapi "github.com/openshift/origin/pkg/image/api"
 	"github.com/openshift/origin/pkg/util/rest"
 )
 
-func NewWebHookREST(registry Registry, instantiator client.BuildConfigInstantiator, plugins map[string]webhook.Plugin) *rest.WebHook {
+// NewWebHookREST returns the storage for the webhooks of build configs. Rejected
+// webhook requests are recorded as events on the build config. The builds of
+// closed pull requests are deleted using builds and the tags they pushed using
+// tags.
+func NewWebHookREST(registry Registry, instantiator client.BuildConfigInstantiator, builds buildListDeleter, tags client.ImageStreamTagDeleter, plugins map[string]webhook.Plugin, recorder record.EventRecorder) *rest.WebHook {
 	controller := &controller{
 		registry:     registry,
 		instantiator: instantiator,
+		builds:       builds,
+		tags:         tags,
 		plugins:      plugins,
+		recorder:     recorder,
 	}
 	return rest.NewWebHook(controller, false)
 }
 
+// buildListDeleter lists and deletes builds.
+type buildListDeleter interface {
+	client.BuildLister
+	client.BuildDeleter
+}
+
 type controller struct {
 	registry     Registry
 	instantiator client.BuildConfigInstantiator
+	builds       buildListDeleter
+	tags         client.ImageStreamTagDeleter
 	plugins      map[string]webhook.Plugin
+	recorder     record.EventRecorder
 }
 
 // ServeHTTP implements rest.HookHandler
 func (c *controller) ServeHTTP(w http.ResponseWriter, req *http.Request, ctx kapi.Context, name, subpath string) error {
+	// the secret may be omitted for hooks that verify the payload signature
 	parts := strings.Split(subpath, "/")
-	if len(parts) < 2 {
+	var secret, hookType string
+	switch {
+	case len(parts) == 1 && len(parts[0]) > 0:
+		hookType = parts[0]
+	case len(parts) >= 2:
+		secret, hookType = parts[0], parts[1]
+	default:
 		return errors.NewBadRequest(fmt.Sprintf("unexpected hook subpath %s", subpath))
 	}
-	secret, hookType := parts[0], parts[1]
 
 	plugin, ok := c.plugins[hookType]
 	if !ok {
@@ -49,25 +76,80 @@ func (c *controller) ServeHTTP(w http.ResponseWriter, req *http.Request, ctx kap
 		return errors.NewUnauthorized(fmt.Sprintf("the webhook %q for %q did not accept your secret", hookType, name))
 	}
 
-	revision, proceed, err := plugin.Extract(config, secret, "", req)
+	var revision *buildapi.SourceRevision
+	var pullRequest *buildapi.PullRequestReference
+	var proceed bool
+	if prPlugin, ok := plugin.(webhook.PullRequestPlugin); ok {
+		revision, pullRequest, proceed, err = prPlugin.ExtractPullRequest(config, secret, "", req)
+	} else {
+		revision, proceed, err = plugin.Extract(config, secret, "", req)
+	}
 	switch err {
-	case webhook.ErrSecretMismatch, webhook.ErrHookNotEnabled:
+	case webhook.ErrSecretMismatch, webhook.ErrSignatureMismatch, webhook.ErrHookNotEnabled:
+		c.recorder.Eventf(config, kapi.EventTypeWarning, "WebHookRejected", "The %s webhook request was rejected: %v", hookType, err)
 		return errors.NewUnauthorized(fmt.Sprintf("the webhook %q for %q did not accept your secret", hookType, name))
 	case nil:
 	default:
+		c.recorder.Eventf(config, kapi.EventTypeWarning, "WebHookFailed", "The %s webhook request could not be processed: %v", hookType, err)
 		return errors.NewInternalError(fmt.Errorf("hook failed: %v", err))
 	}
 
 	if !proceed {
+		if pullRequest != nil {
+			return c.deletePullRequestBuilds(config, pullRequest)
+		}
 		return nil
 	}
 
 	request := &buildapi.BuildRequest{
-		ObjectMeta: kapi.ObjectMeta{Name: name},
-		Revision:   revision,
+		ObjectMeta:  kapi.ObjectMeta{Name: name},
+		Revision:    revision,
+		PullRequest: pullRequest,
 	}
 	if _, err := c.instantiator.Instantiate(config.Namespace, request); err != nil {
 		return errors.NewInternalError(fmt.Errorf("could not generate a build: %v", err))
 	}
 	return nil
 }
+
+// deletePullRequestBuilds deletes the builds of the build config created for
+// a closed pull request and the ImageStreamTag they pushed their output to.
+func (c *controller) deletePullRequestBuilds(config *buildapi.BuildConfig, pullRequest *buildapi.PullRequestReference) error {
+	number := pullRequest.Number
+	selector := labels.Set{
+		buildapi.BuildConfigLabel:      config.Name,
+		buildapi.BuildPullRequestLabel: strconv.Itoa(number),
+	}.AsSelector()
+	builds, err := c.builds.List(config.Namespace, kapi.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return errors.NewInternalError(fmt.Errorf("could not list the builds of pull request %d: %v", number, err))
+	}
+	for _, build := range builds.Items {
+		glog.V(4).Infof("Deleting build %s/%s of closed pull request %d", build.Namespace, build.Name, number)
+		if err := c.builds.Delete(build.Namespace, build.Name); err != nil && !errors.IsNotFound(err) {
+			return errors.NewInternalError(fmt.Errorf("could not delete build %s of pull request %d: %v", build.Name, number, err))
+		}
+	}
+
+	output := config.Spec.Output.To
+	if len(pullRequest.OutputTag) == 0 || output == nil {
+		return nil
+	}
+	if output.Kind != "ImageStreamTag" {
+		glog.V(4).Infof("Keeping the %s output %s of closed pull request %d, only ImageStreamTags are removed", output.Kind, output.Name, number)
+		return nil
+	}
+	stream, _, ok := imageapi.SplitImageStreamTag(output.Name)
+	if !ok {
+		return nil
+	}
+	namespace := output.Namespace
+	if len(namespace) == 0 {
+		namespace = config.Namespace
+	}
+	glog.V(4).Infof("Deleting ImageStreamTag %s/%s:%s of closed pull request %d", namespace, stream, pullRequest.OutputTag, number)
+	if err := c.tags.Delete(namespace, stream, pullRequest.OutputTag); err != nil && !errors.IsNotFound(err) {
+		return errors.NewInternalError(fmt.Errorf("could not delete the tag %s of pull request %d: %v", pullRequest.OutputTag, number, err))
+	}
+	return nil
+}
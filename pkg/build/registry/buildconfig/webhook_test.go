@@ -10,6 +10,7 @@ import (
 	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/errors"
 	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/client/record"
 	"k8s.io/kubernetes/pkg/runtime"
 
 	"github.com/openshift/origin/pkg/build/api"
@@ -39,20 +40,57 @@ func (p *plugin) Extract(buildCfg *api.BuildConfig, secret, path string, req *ht
 	return nil, true, p.Err
 }
 
-func newStorage() (*rest.WebHook, *buildConfigInstantiator, *test.BuildConfigRegistry) {
+type pullRequestPlugin struct {
+	plugin
+	PullRequest *api.PullRequestReference
+	Proceed     bool
+}
+
+func (p *pullRequestPlugin) ExtractPullRequest(buildCfg *api.BuildConfig, secret, path string, req *http.Request) (*api.SourceRevision, *api.PullRequestReference, bool, error) {
+	return nil, p.PullRequest, p.Proceed, nil
+}
+
+type fakeBuilds struct {
+	Builds   []api.Build
+	Selector string
+	Deleted  []string
+}
+
+func (b *fakeBuilds) List(namespace string, opts kapi.ListOptions) (*api.BuildList, error) {
+	b.Selector = opts.LabelSelector.String()
+	return &api.BuildList{Items: b.Builds}, nil
+}
+
+func (b *fakeBuilds) Delete(namespace, name string) error {
+	b.Deleted = append(b.Deleted, name)
+	return nil
+}
+
+type fakeImageStreamTags struct {
+	Deleted []string
+}
+
+func (t *fakeImageStreamTags) Delete(namespace, stream, tag string) error {
+	t.Deleted = append(t.Deleted, fmt.Sprintf("%s/%s:%s", namespace, stream, tag))
+	return nil
+}
+
+func newStorage() (*rest.WebHook, *buildConfigInstantiator, *test.BuildConfigRegistry, *record.FakeRecorder) {
 	mockRegistry := &test.BuildConfigRegistry{}
 	bci := &buildConfigInstantiator{}
-	hook := NewWebHookREST(mockRegistry, bci, map[string]webhook.Plugin{
-		"ok":        &plugin{},
-		"errsecret": &plugin{Err: webhook.ErrSecretMismatch},
-		"errhook":   &plugin{Err: webhook.ErrHookNotEnabled},
-		"err":       &plugin{Err: fmt.Errorf("test error")},
-	})
-	return hook, bci, mockRegistry
+	recorder := &record.FakeRecorder{}
+	hook := NewWebHookREST(mockRegistry, bci, &fakeBuilds{}, &fakeImageStreamTags{}, map[string]webhook.Plugin{
+		"ok":           &plugin{},
+		"errsecret":    &plugin{Err: webhook.ErrSecretMismatch},
+		"errsignature": &plugin{Err: webhook.ErrSignatureMismatch},
+		"errhook":      &plugin{Err: webhook.ErrHookNotEnabled},
+		"err":          &plugin{Err: fmt.Errorf("test error")},
+	}, recorder)
+	return hook, bci, mockRegistry, recorder
 }
 
 func TestNewWebHook(t *testing.T) {
-	hook, _, _ := newStorage()
+	hook, _, _, _ := newStorage()
 	if out, ok := hook.New().(*unversioned.Status); !ok {
 		t.Errorf("unexpected new: %#v", out)
 	}
@@ -90,6 +128,7 @@ func TestConnectWebHook(t *testing.T) {
 		RegErr error
 		ErrFn  func(error) bool
 		WFn    func(*httptest.ResponseRecorder) bool
+		Events int
 	}{
 		"hook returns generic error": {
 			Name: "test",
@@ -97,16 +136,26 @@ func TestConnectWebHook(t *testing.T) {
 			ErrFn: func(err error) bool {
 				return strings.Contains(err.Error(), "Internal error occurred: hook failed: test error")
 			},
+			Events: 1,
 		},
 		"hook returns unauthorized for bad secret": {
-			Name:  "test",
-			Path:  "secret/errsecret/extra",
-			ErrFn: errors.IsUnauthorized,
+			Name:   "test",
+			Path:   "secret/errsecret/extra",
+			ErrFn:  errors.IsUnauthorized,
+			Events: 1,
+		},
+		"hook returns unauthorized for bad signature": {
+			Name:   "test",
+			Path:   "errsignature",
+			Obj:    &api.BuildConfig{ObjectMeta: kapi.ObjectMeta{Name: "test", Namespace: "default"}},
+			ErrFn:  errors.IsUnauthorized,
+			Events: 1,
 		},
 		"hook returns unauthorized for bad hook": {
-			Name:  "test",
-			Path:  "secret/errhook/extra",
-			ErrFn: errors.IsUnauthorized,
+			Name:   "test",
+			Path:   "secret/errhook/extra",
+			ErrFn:  errors.IsUnauthorized,
+			Events: 1,
 		},
 		"hook returns unauthorized for missing build config": {
 			Name:   "test",
@@ -114,6 +163,20 @@ func TestConnectWebHook(t *testing.T) {
 			RegErr: fmt.Errorf("any old error"),
 			ErrFn:  errors.IsUnauthorized,
 		},
+		"hook returns bad request for empty path": {
+			Name:  "test",
+			Path:  "",
+			ErrFn: errors.IsBadRequest,
+		},
+		"hook returns 200 for ok hook without secret": {
+			Name:  "test",
+			Path:  "ok",
+			Obj:   &api.BuildConfig{ObjectMeta: kapi.ObjectMeta{Name: "test", Namespace: "default"}},
+			ErrFn: func(err error) bool { return err == nil },
+			WFn: func(w *httptest.ResponseRecorder) bool {
+				return w.Code == http.StatusOK
+			},
+		},
 		"hook returns 200 for ok hook": {
 			Name:  "test",
 			Path:  "secret/ok/extra",
@@ -125,7 +188,7 @@ func TestConnectWebHook(t *testing.T) {
 		},
 	}
 	for k, testCase := range testCases {
-		hook, bci, registry := newStorage()
+		hook, bci, registry, recorder := newStorage()
 		if testCase.Obj != nil {
 			registry.BuildConfig = testCase.Obj
 		}
@@ -148,7 +211,10 @@ func TestConnectWebHook(t *testing.T) {
 			t.Errorf("%s: unexpected response: %#v", k, w)
 			continue
 		}
-		if testCase.Obj != nil {
+		if len(recorder.Events) != testCase.Events {
+			t.Errorf("%s: expected %d events, got %v", k, testCase.Events, recorder.Events)
+		}
+		if testCase.Obj != nil && testCase.Events == 0 {
 			if bci.Request == nil {
 				t.Errorf("%s: instantiator not invoked", k)
 				continue
@@ -165,3 +231,57 @@ func TestConnectWebHook(t *testing.T) {
 		}
 	}
 }
+
+func TestConnectWebHookPullRequest(t *testing.T) {
+	pullRequest := &api.PullRequestReference{Number: 12, OutputTag: "pr-12"}
+	builds := &fakeBuilds{Builds: []api.Build{{ObjectMeta: kapi.ObjectMeta{Name: "test-3", Namespace: "default"}}}}
+	bci := &buildConfigInstantiator{}
+	tags := &fakeImageStreamTags{}
+	registry := &test.BuildConfigRegistry{BuildConfig: &api.BuildConfig{
+		ObjectMeta: kapi.ObjectMeta{Name: "test", Namespace: "default"},
+		Spec: api.BuildConfigSpec{
+			BuildSpec: api.BuildSpec{
+				Output: api.BuildOutput{To: &kapi.ObjectReference{Kind: "ImageStreamTag", Name: "app:latest"}},
+			},
+		},
+	}}
+	hook := NewWebHookREST(registry, bci, builds, tags, map[string]webhook.Plugin{
+		"opened": &pullRequestPlugin{PullRequest: pullRequest, Proceed: true},
+		"closed": &pullRequestPlugin{PullRequest: pullRequest},
+	}, &record.FakeRecorder{})
+
+	connect := func(path string) {
+		responder := &fakeResponder{}
+		handler, err := hook.Connect(kapi.NewDefaultContext(), "test", &kapi.PodProxyOptions{Path: path}, responder)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		handler.ServeHTTP(httptest.NewRecorder(), &http.Request{})
+		if responder.err != nil {
+			t.Fatalf("unexpected error: %v", responder.err)
+		}
+	}
+
+	connect("secret/opened")
+	if bci.Request == nil || bci.Request.PullRequest != pullRequest {
+		t.Errorf("expected a build of the pull request to be instantiated, got %#v", bci.Request)
+	}
+	if len(builds.Deleted) != 0 || len(tags.Deleted) != 0 {
+		t.Errorf("unexpected deleted builds %v or tags %v", builds.Deleted, tags.Deleted)
+	}
+
+	bci.Request = nil
+	connect("secret/closed")
+	if bci.Request != nil {
+		t.Errorf("unexpected build instantiated for a closed pull request: %#v", bci.Request)
+	}
+	if builds.Selector != "openshift.io/build-config.name=test,openshift.io/build.pull-request=12" {
+		t.Errorf("unexpected build selector %s", builds.Selector)
+	}
+	if len(builds.Deleted) != 1 || builds.Deleted[0] != "test-3" {
+		t.Errorf("expected the builds of the pull request to be deleted, got %v", builds.Deleted)
+	}
+	if len(tags.Deleted) != 1 || tags.Deleted[0] != "default/app:pr-12" {
+		t.Errorf("expected the tag of the pull request to be deleted, got %v", tags.Deleted)
+	}
+}
@@ -0,0 +1,75 @@
+package buildlog
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"io/ioutil"
+
+	"k8s.io/kubernetes/pkg/api/rest"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+
+	"github.com/openshift/origin/pkg/build/api"
+)
+
+// archivedLogStreamer streams the archived log of a completed build. The
+// archived log has no timestamps, so only the TailLines and LimitBytes
+// options are honored.
+type archivedLogStreamer struct {
+	log  io.ReadCloser
+	opts *api.BuildLogOptions
+}
+
+// an archivedLogStreamer must implement a rest.ResourceStreamer
+var _ rest.ResourceStreamer = &archivedLogStreamer{}
+
+func (s *archivedLogStreamer) GetObjectKind() unversioned.ObjectKind {
+	return unversioned.EmptyObjectKind
+}
+
+// InputStream returns the archived log, limited according to the options.
+func (s *archivedLogStreamer) InputStream(apiVersion, acceptHeader string) (io.ReadCloser, bool, string, error) {
+	if s.opts.TailLines == nil {
+		return &readCloser{Reader: s.limit(s.log), Closer: s.log}, false, "text/plain", nil
+	}
+	defer s.log.Close()
+	tail, err := tailLines(s.log, *s.opts.TailLines)
+	if err != nil {
+		return nil, false, "", err
+	}
+	return ioutil.NopCloser(s.limit(bytes.NewReader(tail))), false, "text/plain", nil
+}
+
+func (s *archivedLogStreamer) limit(r io.Reader) io.Reader {
+	if s.opts.LimitBytes == nil {
+		return r
+	}
+	return io.LimitReader(r, *s.opts.LimitBytes)
+}
+
+// tailLines returns the last n lines read from r.
+func tailLines(r io.Reader, n int64) ([]byte, error) {
+	lines := [][]byte{}
+	reader := bufio.NewReader(r)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if len(line) > 0 {
+			lines = append(lines, line)
+			if int64(len(lines)) > n {
+				lines = lines[1:]
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return bytes.Join(lines, nil), nil
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
@@ -17,6 +17,7 @@ import (
 
 	"github.com/openshift/origin/pkg/build/api"
 	"github.com/openshift/origin/pkg/build/api/validation"
+	"github.com/openshift/origin/pkg/build/logarchive"
 	"github.com/openshift/origin/pkg/build/registry"
 	buildutil "github.com/openshift/origin/pkg/build/util"
 )
@@ -28,6 +29,8 @@ type REST struct {
 	PodGetter      pod.ResourceGetter
 	ConnectionInfo kubeletclient.ConnectionInfoGetter
 	Timeout        time.Duration
+	// Archive, if set, serves the logs of completed builds.
+	Archive logarchive.Archive
 }
 
 type podGetter struct {
@@ -47,13 +50,15 @@ const defaultTimeout time.Duration = 10 * time.Second
 // NewREST creates a new REST for BuildLog
 // Takes build registry and pod client to get necessary attributes to assemble
 // URL to which the request shall be redirected in order to get build logs.
-func NewREST(getter rest.Getter, watcher rest.Watcher, pn unversioned.PodsNamespacer, connectionInfo kubeletclient.ConnectionInfoGetter) *REST {
+// The logs of completed builds are served from the archive, if one is provided.
+func NewREST(getter rest.Getter, watcher rest.Watcher, pn unversioned.PodsNamespacer, connectionInfo kubeletclient.ConnectionInfoGetter, archive logarchive.Archive) *REST {
 	return &REST{
 		Getter:         getter,
 		Watcher:        watcher,
 		PodGetter:      &podGetter{pn},
 		ConnectionInfo: connectionInfo,
 		Timeout:        defaultTimeout,
+		Archive:        archive,
 	}
 }
 
@@ -115,6 +120,25 @@ func (r *REST) Get(ctx kapi.Context, name string, opts runtime.Object) (runtime.
 	case api.BuildPhaseError:
 		return nil, errors.NewBadRequest(fmt.Sprintf("build %s is in an error state. %s", build.Name, buildutil.NoBuildLogsMessage))
 	}
+	// Pipeline builds do not run in a build pod, report the progress of their stages instead
+	if buildutil.IsPipelineBuild(build) {
+		return &stageStreamer{
+			build:   build,
+			follow:  buildLogOpts.Follow,
+			watcher: r.Watcher,
+			ctx:     ctx,
+		}, nil
+	}
+	// Completed builds are served from the archive, as their pod may have been deleted
+	if r.Archive != nil && buildutil.IsBuildComplete(build) {
+		log, err := r.Archive.Open(build.Namespace, build.Name)
+		if err == nil {
+			return &archivedLogStreamer{log: log, opts: buildLogOpts}, nil
+		}
+		if err != logarchive.ErrNotFound {
+			return nil, errors.NewInternalError(err)
+		}
+	}
 	// The container should be the default build container, so setting it to blank
 	buildPodName := buildutil.GetBuildPodName(build)
 	logOpts := api.BuildToPodLogOptions(buildLogOpts)
@@ -2,6 +2,7 @@ package buildlog
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -17,6 +18,7 @@ import (
 	"k8s.io/kubernetes/pkg/watch"
 
 	"github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/build/logarchive"
 	"github.com/openshift/origin/pkg/build/registry/test"
 )
 
@@ -315,3 +317,121 @@ func TestPreviousBuildLogs(t *testing.T) {
 		t.Fatalf("expected location:\n\t%s\ngot location:\n\t%s\n", exp, got)
 	}
 }
+
+func mockPipelineBuild(status api.BuildPhase, stages ...api.StageInfo) *api.Build {
+	build := mockBuild(status, "pipeline", 1)
+	build.Spec.Strategy.JenkinsPipelineStrategy = &api.JenkinsPipelineBuildStrategy{JenkinsfilePath: "Jenkinsfile"}
+	build.Status.Stages = stages
+	return build
+}
+
+func TestPipelineBuildLogs(t *testing.T) {
+	ctx := kapi.NewDefaultContext()
+	build := mockPipelineBuild(api.BuildPhaseRunning, api.StageInfo{Name: "build", Phase: api.BuildPhaseRunning})
+	ch := make(chan watch.Event)
+	watcher := &buildWatcher{
+		Build: build,
+		Watcher: &fakeWatch{
+			Channel: ch,
+		},
+	}
+	storage := REST{
+		Getter:  watcher,
+		Watcher: watcher,
+		Timeout: defaultTimeout,
+	}
+	go func() {
+		for _, update := range []*api.Build{
+			mockPipelineBuild(api.BuildPhaseRunning, api.StageInfo{Name: "build", Phase: api.BuildPhaseComplete}, api.StageInfo{Name: "test", Phase: api.BuildPhaseRunning}),
+			mockPipelineBuild(api.BuildPhaseFailed, api.StageInfo{Name: "build", Phase: api.BuildPhaseComplete}, api.StageInfo{Name: "test", Phase: api.BuildPhaseFailed}),
+		} {
+			ch <- watch.Event{Type: watch.Modified, Object: update}
+		}
+	}()
+
+	obj, err := storage.Get(ctx, build.Name, &api.BuildLogOptions{Follow: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	streamer, ok := obj.(rest.ResourceStreamer)
+	if !ok {
+		t.Fatalf("unexpected object: %#v", obj)
+	}
+	stream, flush, contentType, err := streamer.InputStream("", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer stream.Close()
+	if !flush || contentType != "text/plain" {
+		t.Errorf("unexpected stream options: flush=%t, contentType=%s", flush, contentType)
+	}
+	out, err := ioutil.ReadAll(stream)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := "Stage build: Running\nStage build: Complete\nStage test: Running\nStage test: Failed\nPipeline pipeline: Failed\n"
+	if string(out) != expected {
+		t.Errorf("expected log:\n%s\ngot:\n%s", expected, out)
+	}
+}
+
+func TestArchivedBuildLogs(t *testing.T) {
+	ctx := kapi.NewDefaultContext()
+	complete := mockBuild(api.BuildPhaseComplete, "bc-1", 1)
+	running := mockBuild(api.BuildPhaseRunning, "bc-2", 2)
+	internal := &test.BuildStorage{Builds: &api.BuildList{Items: []api.Build{*complete, *running}}}
+	archive := logarchive.NewMemoryArchive()
+	for _, build := range []*api.Build{complete, running} {
+		if err := archive.Save(build.Namespace, build.Name, strings.NewReader("first\nsecond\nthird\n")); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	storage := &REST{
+		Getter:         internal,
+		PodGetter:      &anotherTestPodGetter{},
+		ConnectionInfo: &kubeletclient.HTTPKubeletClient{Config: &kubeletclient.KubeletClientConfig{EnableHttps: true, Port: 12345}, Client: &http.Client{}},
+		Timeout:        defaultTimeout,
+		Archive:        archive,
+	}
+
+	one, five := int64(1), int64(5)
+	tests := []struct {
+		opts     *api.BuildLogOptions
+		expected string
+	}{
+		{opts: &api.BuildLogOptions{}, expected: "first\nsecond\nthird\n"},
+		{opts: &api.BuildLogOptions{TailLines: &one}, expected: "third\n"},
+		{opts: &api.BuildLogOptions{LimitBytes: &five}, expected: "first"},
+	}
+	for _, tc := range tests {
+		obj, err := storage.Get(ctx, "bc-1", tc.opts)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		streamer, ok := obj.(*archivedLogStreamer)
+		if !ok {
+			t.Fatalf("expected the archived log to be served, got %#v", obj)
+		}
+		stream, _, _, err := streamer.InputStream("", "")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		out, err := ioutil.ReadAll(stream)
+		stream.Close()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if string(out) != tc.expected {
+			t.Errorf("expected log %q, got %q", tc.expected, string(out))
+		}
+	}
+
+	// running builds are served from their pod
+	obj, err := storage.Get(ctx, "bc-2", &api.BuildLogOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := obj.(*genericrest.LocationStreamer); !ok {
+		t.Errorf("expected the log of a running build to be served from its pod, got %#v", obj)
+	}
+}
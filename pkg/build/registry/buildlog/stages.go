@@ -0,0 +1,95 @@
+package buildlog
+
+import (
+	"fmt"
+	"io"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/rest"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/watch"
+
+	"github.com/openshift/origin/pkg/build/api"
+	buildutil "github.com/openshift/origin/pkg/build/util"
+)
+
+// stageStreamer streams the progress of the stages of a pipeline build. When
+// following, the build is watched and stage transitions are written until the
+// build completes.
+type stageStreamer struct {
+	build   *api.Build
+	follow  bool
+	watcher rest.Watcher
+	ctx     kapi.Context
+}
+
+// a stageStreamer must implement a rest.ResourceStreamer
+var _ rest.ResourceStreamer = &stageStreamer{}
+
+func (s *stageStreamer) GetObjectKind() unversioned.ObjectKind {
+	return unversioned.EmptyObjectKind
+}
+
+// InputStream returns a stream with one line for every stage transition of the build.
+func (s *stageStreamer) InputStream(apiVersion, acceptHeader string) (io.ReadCloser, bool, string, error) {
+	var w watch.Interface
+	if s.follow && !buildutil.IsBuildComplete(s.build) {
+		fieldSelector := fields.OneTermEqualSelector("metadata.name", s.build.Name)
+		options := &kapi.ListOptions{FieldSelector: fieldSelector, ResourceVersion: s.build.ResourceVersion}
+		var err error
+		if w, err = s.watcher.Watch(s.ctx, options); err != nil {
+			return nil, false, "", err
+		}
+	}
+
+	reader, writer := io.Pipe()
+	go func() {
+		writer.CloseWithError(s.stream(writer, w))
+	}()
+	return reader, s.follow, "text/plain", nil
+}
+
+func (s *stageStreamer) stream(out io.Writer, w watch.Interface) error {
+	seen := map[string]api.BuildPhase{}
+	if err := writeStages(out, s.build, seen); err != nil {
+		return err
+	}
+	if w == nil {
+		return nil
+	}
+	defer w.Stop()
+	for event := range w.ResultChan() {
+		build, ok := event.Object.(*api.Build)
+		if !ok {
+			return fmt.Errorf("received unknown object while watching for builds")
+		}
+		if err := writeStages(out, build, seen); err != nil {
+			return err
+		}
+		if event.Type == watch.Deleted || buildutil.IsBuildComplete(build) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// writeStages writes the stages whose phase differs from the one recorded in
+// seen, and the phase of the build once it completes.
+func writeStages(out io.Writer, build *api.Build, seen map[string]api.BuildPhase) error {
+	for _, stage := range build.Status.Stages {
+		if phase, ok := seen[stage.Name]; ok && phase == stage.Phase {
+			continue
+		}
+		seen[stage.Name] = stage.Phase
+		if _, err := fmt.Fprintf(out, "Stage %s: %s\n", stage.Name, stage.Phase); err != nil {
+			return err
+		}
+	}
+	if buildutil.IsBuildComplete(build) {
+		if _, err := fmt.Fprintf(out, "Pipeline %s: %s\n", build.Name, build.Status.Phase); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package util
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"time"
+)
+
+// The stages of a build marked in the build log.
+const (
+	StageFetchSource = "FetchSource"
+	StageAssemble    = "Assemble"
+	StagePostCommit  = "PostCommit"
+	StageCommit      = "Commit"
+	StagePush        = "Push"
+)
+
+const (
+	stageStarted  = "started"
+	stageFinished = "finished"
+)
+
+// stageMarkerPattern matches the markers written by StageMarker. The builders
+// log through glog, so the markers may be prefixed.
+var stageMarkerPattern = regexp.MustCompile(`\[build-stage\] (\w+) (` + stageStarted + `|` + stageFinished + `) (\S+)`)
+
+// StageMarker returns the line a builder writes to the build log when a stage
+// of the build starts or finishes.
+func StageMarker(stage string, started bool, at time.Time) string {
+	event := stageFinished
+	if started {
+		event = stageStarted
+	}
+	return fmt.Sprintf("[build-stage] %s %s %s", stage, event, at.UTC().Format(time.RFC3339Nano))
+}
+
+// StageTiming records when a stage of a build started and finished. Finished
+// is zero if the build log does not mark the end of the stage.
+type StageTiming struct {
+	Name     string
+	Started  time.Time
+	Finished time.Time
+}
+
+// Duration returns the time spent in the stage, or zero if it did not finish.
+func (t StageTiming) Duration() time.Duration {
+	if t.Finished.IsZero() {
+		return 0
+	}
+	return t.Finished.Sub(t.Started)
+}
+
+// ParseStageTimings reads a build log and returns the timings of the stages
+// marked in it, in the order the stages started.
+func ParseStageTimings(log io.Reader) ([]StageTiming, error) {
+	timings := []StageTiming{}
+	index := map[string]int{}
+	scanner := bufio.NewScanner(log)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		match := stageMarkerPattern.FindStringSubmatch(scanner.Text())
+		if match == nil {
+			continue
+		}
+		at, err := time.Parse(time.RFC3339Nano, match[3])
+		if err != nil {
+			continue
+		}
+		name := match[1]
+		switch match[2] {
+		case stageStarted:
+			index[name] = len(timings)
+			timings = append(timings, StageTiming{Name: name, Started: at})
+		case stageFinished:
+			if i, ok := index[name]; ok {
+				timings[i].Finished = at
+			}
+		}
+	}
+	return timings, scanner.Err()
+}
@@ -2,13 +2,17 @@ package util
 
 import (
 	"fmt"
+	"net"
+	"net/url"
 	"strconv"
 	"strings"
 
 	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
 	"k8s.io/kubernetes/pkg/labels"
 
 	buildapi "github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/generate/git"
 )
 
 const (
@@ -67,6 +71,56 @@ func IsBuildComplete(build *buildapi.Build) bool {
 	return build.Status.Phase != buildapi.BuildPhaseRunning && build.Status.Phase != buildapi.BuildPhasePending && build.Status.Phase != buildapi.BuildPhaseNew
 }
 
+// IsPipelineBuild returns true if the provided build is run by a pipeline
+// executor instead of a build pod.
+func IsPipelineBuild(build *buildapi.Build) bool {
+	return build.Spec.Strategy.JenkinsPipelineStrategy != nil
+}
+
+// UpdateStage records the phase of the named pipeline stage in the status of
+// the provided build, appending the stage if it has not been reported before.
+func UpdateStage(build *buildapi.Build, name string, phase buildapi.BuildPhase) {
+	var stage *buildapi.StageInfo
+	for i := range build.Status.Stages {
+		if build.Status.Stages[i].Name == name {
+			stage = &build.Status.Stages[i]
+			break
+		}
+	}
+	if stage == nil {
+		build.Status.Stages = append(build.Status.Stages, buildapi.StageInfo{Name: name})
+		stage = &build.Status.Stages[len(build.Status.Stages)-1]
+	}
+	stage.Phase = phase
+	now := unversioned.Now()
+	switch phase {
+	case buildapi.BuildPhaseNew, buildapi.BuildPhasePending:
+	case buildapi.BuildPhaseRunning:
+		if stage.StartTimestamp == nil {
+			stage.StartTimestamp = &now
+		}
+	default:
+		if stage.StartTimestamp == nil {
+			stage.StartTimestamp = &now
+		}
+		stage.CompletionTimestamp = &now
+	}
+}
+
+// RunPolicyForBuild returns the run policy of the BuildConfig the provided
+// build was created from. Builds that do not carry a run policy, for example
+// because they were created before run policies existed or without a
+// BuildConfig, run in parallel.
+func RunPolicyForBuild(build *buildapi.Build) buildapi.BuildRunPolicy {
+	if len(ConfigNameForBuild(build)) == 0 {
+		return buildapi.BuildRunPolicyParallel
+	}
+	if policy := build.Labels[buildapi.BuildRunPolicyLabel]; len(policy) > 0 {
+		return buildapi.BuildRunPolicy(policy)
+	}
+	return buildapi.BuildRunPolicyParallel
+}
+
 // IsPaused returns true if the provided BuildConfig is paused and cannot be used to create a new Build
 func IsPaused(bc *buildapi.BuildConfig) bool {
 	return strings.ToLower(bc.Annotations[buildapi.BuildConfigPausedAnnotation]) == "true"
@@ -115,3 +169,31 @@ func VersionForBuild(build *buildapi.Build) int {
 	}
 	return version
 }
+
+// IsSourceRepositoryAPIURL returns true if the API at apiURL is served by the
+// host of the Git repository at sourceURI, either on the same host name or on
+// the api subdomain of it as for github.com. Builds only send their commit
+// status tokens to such APIs.
+func IsSourceRepositoryAPIURL(apiURL, sourceURI string) bool {
+	api, err := url.Parse(apiURL)
+	if err != nil {
+		return false
+	}
+	repo, err := git.ParseRepository(sourceURI)
+	if err != nil {
+		return false
+	}
+	apiHost, repoHost := hostName(api.Host), hostName(repo.Host)
+	if len(apiHost) == 0 || len(repoHost) == 0 {
+		return false
+	}
+	return apiHost == repoHost || apiHost == "api."+repoHost
+}
+
+// hostName returns the lower case host name of host, without its port.
+func hostName(host string) string {
+	if name, _, err := net.SplitHostPort(host); err == nil {
+		host = name
+	}
+	return strings.ToLower(host)
+}
@@ -1,7 +1,9 @@
 package util
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	buildapi "github.com/openshift/origin/pkg/build/api"
 	kapi "k8s.io/kubernetes/pkg/api"
@@ -12,3 +14,89 @@ func TestGetBuildPodName(t *testing.T) {
 		t.Errorf("Expected %s, got %s", expected, actual)
 	}
 }
+
+func TestUpdateStage(t *testing.T) {
+	build := &buildapi.Build{}
+
+	UpdateStage(build, "build", buildapi.BuildPhaseRunning)
+	if len(build.Status.Stages) != 1 {
+		t.Fatalf("expected one stage, got %#v", build.Status.Stages)
+	}
+	stage := build.Status.Stages[0]
+	if stage.Name != "build" || stage.Phase != buildapi.BuildPhaseRunning || stage.StartTimestamp == nil || stage.CompletionTimestamp != nil {
+		t.Errorf("unexpected running stage: %#v", stage)
+	}
+
+	UpdateStage(build, "build", buildapi.BuildPhaseComplete)
+	UpdateStage(build, "test", buildapi.BuildPhaseFailed)
+	if len(build.Status.Stages) != 2 {
+		t.Fatalf("expected two stages, got %#v", build.Status.Stages)
+	}
+	if stage := build.Status.Stages[0]; stage.Phase != buildapi.BuildPhaseComplete || stage.CompletionTimestamp == nil {
+		t.Errorf("unexpected completed stage: %#v", stage)
+	}
+	if stage := build.Status.Stages[1]; stage.Name != "test" || stage.Phase != buildapi.BuildPhaseFailed || stage.StartTimestamp == nil || stage.CompletionTimestamp == nil {
+		t.Errorf("unexpected failed stage: %#v", stage)
+	}
+}
+
+func TestParseStageTimings(t *testing.T) {
+	start := time.Date(2016, 5, 1, 10, 0, 0, 0, time.UTC)
+	log := strings.Join([]string{
+		"I0501 10:00:00.000000       1 docker.go:60] " + StageMarker(StageFetchSource, true, start),
+		"Cloning \"https://github.com/openshift/ruby-hello-world\" ...",
+		StageMarker(StageFetchSource, false, start.Add(2*time.Second)),
+		StageMarker(StageAssemble, true, start.Add(2*time.Second)),
+		"Step 1 : FROM centos",
+		StageMarker(StageAssemble, false, start.Add(30*time.Second)),
+		StageMarker(StagePush, true, start.Add(30*time.Second)),
+		"[build-stage] malformed",
+	}, "\n")
+
+	timings, err := ParseStageTimings(strings.NewReader(log))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(timings) != 3 {
+		t.Fatalf("expected three stages, got %#v", timings)
+	}
+	expected := []struct {
+		name     string
+		duration time.Duration
+	}{
+		{StageFetchSource, 2 * time.Second},
+		{StageAssemble, 28 * time.Second},
+		{StagePush, 0},
+	}
+	for i, e := range expected {
+		if timings[i].Name != e.name || timings[i].Duration() != e.duration {
+			t.Errorf("expected stage %s to take %s, got %s taking %s", e.name, e.duration, timings[i].Name, timings[i].Duration())
+		}
+	}
+	if !timings[2].Finished.IsZero() {
+		t.Errorf("expected the push stage not to be finished, got %v", timings[2].Finished)
+	}
+}
+
+func TestIsSourceRepositoryAPIURL(t *testing.T) {
+	tests := []struct {
+		apiURL    string
+		sourceURI string
+		expected  bool
+	}{
+		{"https://api.github.com", "https://github.com/openshift/origin", true},
+		{"https://github.example.com/api/v3", "ssh://git@github.example.com/openshift/origin.git", true},
+		{"https://GitLab.example.com:8443", "https://gitlab.example.com/group/app.git", true},
+		{"http://127.0.0.1:8080", "http://127.0.0.1/group/app.git", true},
+		{"https://attacker.example.com", "https://github.com/openshift/origin", false},
+		{"https://api.github.com.example.com", "https://github.com/openshift/origin", false},
+		{"https://github.com", "https://api.github.com/openshift/origin", false},
+		{"https://169.254.169.254", "https://gitlab.example.com/group/app.git", false},
+		{"://github.com", "https://github.com/openshift/origin", false},
+	}
+	for _, test := range tests {
+		if actual := IsSourceRepositoryAPIURL(test.apiURL, test.sourceURI); actual != test.expected {
+			t.Errorf("%s for %s: expected %t, got %t", test.apiURL, test.sourceURI, test.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package bitbucket
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/mail"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/build/webhook"
+)
+
+const (
+	// pushEventType is the X-Event-Key value sent for repository pushes.
+	pushEventType = "repo:push"
+	// signatureHeader carries the HMAC of the payload computed with the
+	// secret configured on the Bitbucket webhook.
+	signatureHeader = "X-Hub-Signature"
+	signaturePrefix = "sha256="
+)
+
+// WebHook used for processing bitbucket webhook requests.
+type WebHook struct{}
+
+// New returns bitbucket webhook plugin.
+func New() *WebHook {
+	return &WebHook{}
+}
+
+type author struct {
+	// Raw is the author as recorded in the commit, e.g. "Name <email>"
+	Raw string `json:"raw,omitempty"`
+}
+
+type commit struct {
+	Hash    string `json:"hash,omitempty"`
+	Message string `json:"message,omitempty"`
+	Author  author `json:"author,omitempty"`
+}
+
+type reference struct {
+	Type   string `json:"type,omitempty"`
+	Name   string `json:"name,omitempty"`
+	Target commit `json:"target,omitempty"`
+}
+
+type change struct {
+	// New is nil when the reference was deleted
+	New *reference `json:"new,omitempty"`
+}
+
+type pushEvent struct {
+	Push struct {
+		Changes []change `json:"changes,omitempty"`
+	} `json:"push,omitempty"`
+}
+
+// Extract services webhooks from bitbucket.org
+func (p *WebHook) Extract(buildCfg *api.BuildConfig, secret, path string, req *http.Request) (revision *api.SourceRevision, proceed bool, err error) {
+	trigger, ok := webhook.FindTriggerPolicy(api.BitbucketWebHookBuildTriggerType, buildCfg)
+	if !ok {
+		err = webhook.ErrHookNotEnabled
+		return
+	}
+	glog.V(4).Infof("Checking if the provided secret for BuildConfig %s/%s matches", buildCfg.Namespace, buildCfg.Name)
+	if !hmac.Equal([]byte(trigger.BitbucketWebHook.Secret), []byte(secret)) {
+		err = webhook.ErrSecretMismatch
+		return
+	}
+	glog.V(4).Infof("Verifying build request for BuildConfig %s/%s", buildCfg.Namespace, buildCfg.Name)
+	if err = verifyRequest(req); err != nil {
+		return
+	}
+	if event := req.Header.Get("X-Event-Key"); event != pushEventType {
+		err = fmt.Errorf("Unknown X-Event-Key %s", event)
+		return
+	}
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return
+	}
+	// the payload must be signed with the secret of the trigger
+	if secret := trigger.BitbucketWebHook.Secret; len(secret) > 0 {
+		if !verifySignature(secret, req.Header.Get(signatureHeader), body) {
+			err = webhook.ErrSignatureMismatch
+			return
+		}
+	}
+	if buildCfg.Spec.Source.Git == nil {
+		glog.V(4).Infof("No source defined for BuildConfig %s/%s, but triggering anyway", buildCfg.Namespace, buildCfg.Name)
+		return nil, true, nil
+	}
+	var event pushEvent
+	if err = json.Unmarshal(body, &event); err != nil {
+		return
+	}
+	ref := matchingBranch(&event, buildCfg.Spec.Source.Git.Ref)
+	if ref == nil {
+		glog.V(2).Infof("Skipping build for BuildConfig %s/%s.  No pushed branch matches configuration", buildCfg.Namespace, buildCfg.Name)
+		return
+	}
+	proceed = true
+
+	user := commitAuthor(ref.Target.Author.Raw)
+	revision = &api.SourceRevision{
+		Git: &api.GitSourceRevision{
+			Commit:    ref.Target.Hash,
+			Author:    user,
+			Committer: user,
+			Message:   ref.Target.Message,
+		},
+	}
+
+	return
+}
+
+// matchingBranch returns the first pushed branch that matches the configured
+// ref, or nil if none does.
+func matchingBranch(event *pushEvent, configRef string) *reference {
+	for _, c := range event.Push.Changes {
+		if c.New == nil || c.New.Type != "branch" {
+			continue
+		}
+		if webhook.GitRefMatches(c.New.Name, configRef) {
+			return c.New
+		}
+	}
+	return nil
+}
+
+// commitAuthor parses the raw "Name <email>" author of a Bitbucket commit.
+func commitAuthor(raw string) api.SourceControlUser {
+	if addr, err := mail.ParseAddress(raw); err == nil {
+		return api.SourceControlUser{Name: addr.Name, Email: addr.Address}
+	}
+	return api.SourceControlUser{Name: strings.TrimSpace(raw)}
+}
+
+// verifySignature checks the hex encoded HMAC-SHA256 signature of the payload.
+func verifySignature(secret, signature string, body []byte) bool {
+	if !strings.HasPrefix(signature, signaturePrefix) {
+		return false
+	}
+	actual, err := hex.DecodeString(strings.TrimPrefix(signature, signaturePrefix))
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(mac.Sum(nil), actual)
+}
+
+func verifyRequest(req *http.Request) error {
+	if method := req.Method; method != "POST" {
+		return fmt.Errorf("unsupported HTTP method %s", method)
+	}
+	contentType := req.Header.Get("Content-Type")
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return fmt.Errorf("non-parseable Content-Type %s (%s)", contentType, err)
+	}
+	if mediaType != "application/json" {
+		return fmt.Errorf("unsupported Content-Type %s", contentType)
+	}
+	if len(req.Header.Get("X-Event-Key")) == 0 {
+		return errors.New("missing X-Event-Key")
+	}
+	return nil
+}
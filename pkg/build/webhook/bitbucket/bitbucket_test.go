@@ -0,0 +1,228 @@
+package bitbucket
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	"github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/build/webhook"
+)
+
+type okBuildConfigGetter struct{}
+
+func (c *okBuildConfigGetter) Get(namespace, name string) (*api.BuildConfig, error) {
+	return mockBuildConfig(), nil
+}
+
+func mockBuildConfig() *api.BuildConfig {
+	return &api.BuildConfig{
+		Spec: api.BuildConfigSpec{
+			Triggers: []api.BuildTriggerPolicy{
+				{
+					Type: api.BitbucketWebHookBuildTriggerType,
+					BitbucketWebHook: &api.WebHookTrigger{
+						Secret: "secret101",
+					},
+				},
+			},
+			BuildSpec: api.BuildSpec{
+				Source: api.BuildSource{
+					Git: &api.GitBuildSource{
+						URI: "git://bitbucket.org/my/repo.git",
+					},
+				},
+				Strategy: api.BuildStrategy{
+					SourceStrategy: &api.SourceBuildStrategy{
+						From: kapi.ObjectReference{
+							Kind: "DockerImage",
+							Name: "repository/image",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type okBuildConfigInstantiator struct{}
+
+func (*okBuildConfigInstantiator) Instantiate(namespace string, request *api.BuildRequest) (*api.Build, error) {
+	return &api.Build{}, nil
+}
+
+func TestWrongSecret(t *testing.T) {
+	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
+		map[string]webhook.Plugin{"bitbucket": New()}))
+	defer server.Close()
+
+	client := &http.Client{}
+	req, _ := http.NewRequest("POST", server.URL+"/build100/wrongsecret/bitbucket", nil)
+	resp, _ := client.Do(req)
+	body, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusBadRequest ||
+		!strings.Contains(string(body), webhook.ErrSecretMismatch.Error()) {
+		t.Errorf("Expected BadRequest, got %s: %s!", resp.Status, string(body))
+	}
+}
+
+func TestMissingEvent(t *testing.T) {
+	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
+		map[string]webhook.Plugin{"bitbucket": New()}))
+	defer server.Close()
+
+	client := &http.Client{}
+	req, _ := http.NewRequest("POST", server.URL+"/build100/secret101/bitbucket", nil)
+	req.Header.Add("Content-Type", "application/json")
+	resp, _ := client.Do(req)
+	body, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusBadRequest ||
+		!strings.Contains(string(body), "missing X-Event-Key") {
+		t.Errorf("Expected BadRequest, got %s: %s!", resp.Status, string(body))
+	}
+}
+
+func TestJsonPushEvent(t *testing.T) {
+	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
+		map[string]webhook.Plugin{"bitbucket": New()}))
+	defer server.Close()
+
+	data, err := ioutil.ReadFile("fixtures/pushevent.json")
+	if err != nil {
+		t.Fatalf("Failed to open pushevent.json: %v", err)
+	}
+	req, _ := http.NewRequest("POST", server.URL+"/build100/secret101/bitbucket", bytes.NewReader(data))
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("X-Event-Key", "repo:push")
+	sign(req, "secret101", data)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Failed posting webhook: %v", err)
+	}
+	body, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Wrong response code, expecting %d, got %s: %s!", http.StatusOK, resp.Status, string(body))
+	}
+}
+
+func setup(t *testing.T, filename, eventType string) (*api.BuildConfig, *http.Request, []byte) {
+	event, err := ioutil.ReadFile("fixtures/" + filename)
+	if err != nil {
+		t.Errorf("Failed to open %s: %v", filename, err)
+	}
+	req, _ := http.NewRequest("POST", "http://origin.com", bytes.NewReader(event))
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("X-Event-Key", eventType)
+	sign(req, "secret101", event)
+	return mockBuildConfig(), req, event
+}
+
+// sign sets the signature header of the request to the HMAC of body computed with key
+func sign(req *http.Request, key string, body []byte) {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write(body)
+	req.Header.Set("X-Hub-Signature", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+}
+
+func TestExtractProvidesValidBuildForAPushEvent(t *testing.T) {
+	buildCfg, req, _ := setup(t, "pushevent.json", "repo:push")
+
+	revision, proceed, err := New().Extract(buildCfg, "secret101", "", req)
+	if err != nil {
+		t.Fatalf("Error while extracting build info: %s", err)
+	}
+	if !proceed {
+		t.Errorf("The 'proceed' return value should equal 'true' %t", proceed)
+	}
+	if revision == nil || revision.Git == nil {
+		t.Fatal("Expecting the revision to not be nil")
+	}
+	if revision.Git.Commit != "709d658dc5b6d6afcd46049c2f332ee3f515a67d" {
+		t.Errorf("Expecting the revision to contain the commit id from the push event, got %s", revision.Git.Commit)
+	}
+	if revision.Git.Author.Name != "Anonymous User" || revision.Git.Author.Email != "anonUser@example.com" {
+		t.Errorf("Unexpected author %#v", revision.Git.Author)
+	}
+	if revision.Git.Message != "Added license\n" {
+		t.Errorf("Unexpected message %q", revision.Git.Message)
+	}
+}
+
+func TestExtractProvidesValidBuildForAPushEventOtherThanMaster(t *testing.T) {
+	buildCfg, req, _ := setup(t, "pushevent-not-master-branch.json", "repo:push")
+	buildCfg.Spec.Source.Git.Ref = "my_other_branch"
+
+	_, proceed, err := New().Extract(buildCfg, "secret101", "", req)
+	if err != nil {
+		t.Fatalf("Error while extracting build info: %s", err)
+	}
+	if !proceed {
+		t.Errorf("The 'proceed' return value should equal 'true' %t", proceed)
+	}
+}
+
+func TestExtractSkipsBuildForUnmatchedBranches(t *testing.T) {
+	buildCfg, req, _ := setup(t, "pushevent.json", "repo:push")
+	buildCfg.Spec.Source.Git.Ref = "adfj32qrafdavckeaewra"
+
+	_, proceed, _ := New().Extract(buildCfg, "secret101", "", req)
+	if proceed {
+		t.Errorf("Expecting to not continue from this event because the branch is not for this buildConfig '%s'", buildCfg.Spec.Source.Git.Ref)
+	}
+}
+
+func TestExtractWithSignature(t *testing.T) {
+	tests := map[string]struct {
+		key string
+		err error
+	}{
+		"matching signature": {key: "secret101"},
+		"wrong signature":    {key: "wrong", err: webhook.ErrSignatureMismatch},
+		"missing signature":  {err: webhook.ErrSignatureMismatch},
+	}
+	for name, test := range tests {
+		buildCfg, req, body := setup(t, "pushevent.json", "repo:push")
+		if len(test.key) > 0 {
+			sign(req, test.key, body)
+		} else {
+			req.Header.Del("X-Hub-Signature")
+		}
+		_, _, err := New().Extract(buildCfg, "secret101", "", req)
+		if err != test.err {
+			t.Errorf("%s: expected error %v, got %v", name, test.err, err)
+		}
+	}
+}
+
+func TestExtractWithoutGitSource(t *testing.T) {
+	buildCfg, req, _ := setup(t, "pushevent.json", "repo:push")
+	buildCfg.Spec.Source.Git = nil
+
+	revision, proceed, err := New().Extract(buildCfg, "secret101", "", req)
+	if err != nil {
+		t.Fatalf("Error while extracting build info: %s", err)
+	}
+	if !proceed || revision != nil {
+		t.Errorf("Expected to proceed without a revision, got %t, %#v", proceed, revision)
+	}
+}
+
+func TestCommitAuthor(t *testing.T) {
+	tests := map[string]api.SourceControlUser{
+		"Anonymous User <anonUser@example.com>": {Name: "Anonymous User", Email: "anonUser@example.com"},
+		"anonUser":                              {Name: "anonUser"},
+	}
+	for raw, expected := range tests {
+		if actual := commitAuthor(raw); actual != expected {
+			t.Errorf("%s: expected %#v, got %#v", raw, expected, actual)
+		}
+	}
+}
@@ -0,0 +1,3 @@
+// Package bitbucket contains webhook.Plugin implementation of bitbucket webhooks
+// according to https://confluence.atlassian.com/bitbucket/manage-webhooks-735643732.html
+package bitbucket
@@ -23,6 +23,16 @@ type Plugin interface {
 	Extract(buildCfg *buildapi.BuildConfig, secret, path string, req *http.Request) (*buildapi.SourceRevision, bool, error)
 }
 
+// PullRequestPlugin is implemented by the webhook plugins able to build pull
+// requests.
+type PullRequestPlugin interface {
+	Plugin
+	// ExtractPullRequest extracts build information like Extract, and the
+	// pull request the request was sent for, if any. A pull request returned
+	// without proceeding with the build has been closed.
+	ExtractPullRequest(buildCfg *buildapi.BuildConfig, secret, path string, req *http.Request) (*buildapi.SourceRevision, *buildapi.PullRequestReference, bool, error)
+}
+
 // controller used for processing webhook requests.
 type controller struct {
 	buildConfigInstantiator buildclient.BuildConfigInstantiator
@@ -2,24 +2,35 @@ package github
 
 import (
 	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"hash"
 	"io/ioutil"
 	"mime"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/golang/glog"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+
 	"github.com/openshift/origin/pkg/build/api"
 	"github.com/openshift/origin/pkg/build/webhook"
 )
 
 // WebHook used for processing github webhook requests.
-type WebHook struct{}
+type WebHook struct {
+	// secrets is used to read the keys payload signatures are verified with.
+	secrets kclient.SecretsNamespacer
+}
 
 // New returns github webhook plugin.
-func New() *WebHook {
-	return &WebHook{}
+func New(secrets kclient.SecretsNamespacer) *WebHook {
+	return &WebHook{secrets: secrets}
 }
 
 type commit struct {
@@ -35,24 +46,70 @@ type pushEvent struct {
 	HeadCommit commit `json:"head_commit,omitempty"`
 }
 
-// Extract services webhooks from github.com
+type pullRequestBranch struct {
+	Ref string `json:"ref,omitempty"`
+	SHA string `json:"sha,omitempty"`
+	// Repo is nil if the repository of the branch was deleted
+	Repo *struct {
+		FullName string `json:"full_name,omitempty"`
+	} `json:"repo,omitempty"`
+}
+
+type pullRequestEvent struct {
+	Action      string `json:"action,omitempty"`
+	Number      int    `json:"number,omitempty"`
+	PullRequest struct {
+		Title string `json:"title,omitempty"`
+		User  struct {
+			Login string `json:"login,omitempty"`
+		} `json:"user,omitempty"`
+		Head pullRequestBranch `json:"head,omitempty"`
+		Base pullRequestBranch `json:"base,omitempty"`
+	} `json:"pull_request,omitempty"`
+}
+
+// Extract services webhooks from github.com. Pull request events are ignored,
+// they are handled by ExtractPullRequest.
 func (p *WebHook) Extract(buildCfg *api.BuildConfig, secret, path string, req *http.Request) (revision *api.SourceRevision, proceed bool, err error) {
+	revision, pullRequest, proceed, err := p.ExtractPullRequest(buildCfg, secret, path, req)
+	if pullRequest != nil {
+		return nil, false, err
+	}
+	return revision, proceed, err
+}
+
+// ExtractPullRequest services webhooks from github.com, including the
+// pull_request events of the BuildConfigs with pull request builds enabled.
+func (p *WebHook) ExtractPullRequest(buildCfg *api.BuildConfig, secret, path string, req *http.Request) (revision *api.SourceRevision, pullRequest *api.PullRequestReference, proceed bool, err error) {
 	trigger, ok := webhook.FindTriggerPolicy(api.GitHubWebHookBuildTriggerType, buildCfg)
 	if !ok {
 		err = webhook.ErrHookNotEnabled
 		return
 	}
-	glog.V(4).Infof("Checking if the provided secret for BuildConfig %s/%s matches", buildCfg.Namespace, buildCfg.Name)
-	if !hmac.Equal([]byte(trigger.GitHubWebHook.Secret), []byte(secret)) {
-		err = webhook.ErrSecretMismatch
-		return
+	// the secret in the URL is optional when the payload signature is verified
+	if trigger.GitHubWebHook.SecretReference == nil || len(secret) > 0 {
+		glog.V(4).Infof("Checking if the provided secret for BuildConfig %s/%s matches", buildCfg.Namespace, buildCfg.Name)
+		if !hmac.Equal([]byte(trigger.GitHubWebHook.Secret), []byte(secret)) {
+			err = webhook.ErrSecretMismatch
+			return
+		}
 	}
 	glog.V(4).Infof("Verifying build request for BuildConfig %s/%s", buildCfg.Namespace, buildCfg.Name)
 	if err = verifyRequest(req); err != nil {
 		return
 	}
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return
+	}
+	if ref := trigger.GitHubWebHook.SecretReference; ref != nil {
+		glog.V(4).Infof("Verifying the payload signature for BuildConfig %s/%s", buildCfg.Namespace, buildCfg.Name)
+		if err = p.verifySignature(buildCfg.Namespace, ref.Name, req.Header, body); err != nil {
+			return
+		}
+	}
 	method := getEvent(req.Header)
-	if method != "ping" && method != "push" {
+	if method != "ping" && method != "push" && method != "pull_request" {
 		err = fmt.Errorf("Unknown X-GitHub-Event or X-Gogs-Event %s", method)
 		return
 	}
@@ -60,9 +117,12 @@ func (p *WebHook) Extract(buildCfg *api.BuildConfig, secret, path string, req *h
 		proceed = false
 		return
 	}
-	body, err := ioutil.ReadAll(req.Body)
-	if err != nil {
-		return
+	if method == "pull_request" {
+		return extractPullRequest(buildCfg, trigger.GitHubWebHook.PullRequests, body)
+	}
+	if buildCfg.Spec.Source.Git == nil {
+		glog.V(4).Infof("No source defined for BuildConfig %s/%s, but triggering anyway", buildCfg.Namespace, buildCfg.Name)
+		return nil, nil, true, nil
 	}
 	var event pushEvent
 	if err = json.Unmarshal(body, &event); err != nil {
@@ -85,6 +145,101 @@ func (p *WebHook) Extract(buildCfg *api.BuildConfig, secret, path string, req *h
 	return
 }
 
+// extractPullRequest returns the pull request of a pull_request event. The
+// build proceeds when the pull request is opened or updated. The pull request
+// is returned without proceeding when it is closed, so that its builds can be
+// removed.
+func extractPullRequest(buildCfg *api.BuildConfig, policy *api.PullRequestBuildPolicy, body []byte) (revision *api.SourceRevision, pullRequest *api.PullRequestReference, proceed bool, err error) {
+	if policy == nil {
+		glog.V(2).Infof("Skipping pull request event for BuildConfig %s/%s, pull request builds are not enabled", buildCfg.Namespace, buildCfg.Name)
+		return
+	}
+	if buildCfg.Spec.Source.Git == nil {
+		glog.V(2).Infof("Skipping pull request event for BuildConfig %s/%s, the BuildConfig has no Git source", buildCfg.Namespace, buildCfg.Name)
+		return
+	}
+	var event pullRequestEvent
+	if err = json.Unmarshal(body, &event); err != nil {
+		return
+	}
+	if !webhook.GitRefMatches(event.PullRequest.Base.Ref, buildCfg.Spec.Source.Git.Ref) {
+		glog.V(2).Infof("Skipping build for BuildConfig %s/%s.  Pull request %d is not opened against the configured branch", buildCfg.Namespace, buildCfg.Name, event.Number)
+		return
+	}
+
+	switch event.Action {
+	case "opened", "reopened", "synchronize":
+	case "closed":
+		pullRequest = &api.PullRequestReference{Number: event.Number}
+		if len(policy.OutputTagPrefix) > 0 {
+			pullRequest.OutputTag = policy.OutputTagPrefix + strconv.Itoa(event.Number)
+		}
+		return
+	default:
+		glog.V(4).Infof("Ignoring %s action of pull request %d for BuildConfig %s/%s", event.Action, event.Number, buildCfg.Namespace, buildCfg.Name)
+		return
+	}
+
+	// the head of the pull request is fetched from the repository of the
+	// BuildConfig, forks may be deleted and aren't trusted with its secrets
+	head, base := event.PullRequest.Head.Repo, event.PullRequest.Base.Repo
+	pullRequest = &api.PullRequestReference{
+		Number: event.Number,
+		Ref:    fmt.Sprintf("refs/pull/%d/head", event.Number),
+		Fork:   head == nil || base == nil || head.FullName != base.FullName,
+	}
+	if len(policy.OutputTagPrefix) > 0 {
+		pullRequest.OutputTag = policy.OutputTagPrefix + strconv.Itoa(event.Number)
+	}
+	revision = &api.SourceRevision{
+		Git: &api.GitSourceRevision{
+			Commit:  event.PullRequest.Head.SHA,
+			Author:  api.SourceControlUser{Name: event.PullRequest.User.Login},
+			Message: event.PullRequest.Title,
+		},
+	}
+	proceed = true
+	return
+}
+
+// verifySignature checks the X-Hub-Signature-256 or X-Hub-Signature header of
+// the request against the HMAC of the body computed with the key stored in the
+// named Secret.
+func (p *WebHook) verifySignature(namespace, name string, header http.Header, body []byte) error {
+	var newHash func() hash.Hash
+	var signature string
+	switch {
+	case len(header.Get("X-Hub-Signature-256")) > 0:
+		newHash, signature = sha256.New, strings.TrimPrefix(header.Get("X-Hub-Signature-256"), "sha256=")
+	case strings.HasPrefix(header.Get("X-Hub-Signature"), "sha256="):
+		newHash, signature = sha256.New, strings.TrimPrefix(header.Get("X-Hub-Signature"), "sha256=")
+	case strings.HasPrefix(header.Get("X-Hub-Signature"), "sha1="):
+		newHash, signature = sha1.New, strings.TrimPrefix(header.Get("X-Hub-Signature"), "sha1=")
+	default:
+		return webhook.ErrSignatureMismatch
+	}
+	actual, err := hex.DecodeString(signature)
+	if err != nil {
+		return webhook.ErrSignatureMismatch
+	}
+
+	secret, err := p.secrets.Secrets(namespace).Get(name)
+	if err != nil {
+		return fmt.Errorf("unable to get the webhook secret %s/%s: %v", namespace, name, err)
+	}
+	key, ok := secret.Data[api.WebHookSecretKey]
+	if !ok || len(key) == 0 {
+		return fmt.Errorf("the webhook secret %s/%s has no %s key", namespace, name, api.WebHookSecretKey)
+	}
+
+	mac := hmac.New(newHash, key)
+	mac.Write(body)
+	if !hmac.Equal(mac.Sum(nil), actual) {
+		return webhook.ErrSignatureMismatch
+	}
+	return nil
+}
+
 func verifyRequest(req *http.Request) error {
 	if method := req.Method; method != "POST" {
 		return fmt.Errorf("unsupported HTTP method %s", method)
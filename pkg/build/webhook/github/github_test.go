@@ -2,13 +2,20 @@ package github
 
 import (
 	"bytes"
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"strings"
 	"testing"
 
 	kapi "k8s.io/kubernetes/pkg/api"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
 
 	"github.com/openshift/origin/pkg/build/api"
 	"github.com/openshift/origin/pkg/build/webhook"
@@ -56,7 +63,7 @@ func (*okBuildConfigInstantiator) Instantiate(namespace string, request *api.Bui
 
 func TestWrongSecret(t *testing.T) {
 	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
-		map[string]webhook.Plugin{"github": New()}))
+		map[string]webhook.Plugin{"github": New(nil)}))
 	defer server.Close()
 
 	client := &http.Client{}
@@ -71,7 +78,7 @@ func TestWrongSecret(t *testing.T) {
 
 func TestWrongMethod(t *testing.T) {
 	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
-		map[string]webhook.Plugin{"github": New()}))
+		map[string]webhook.Plugin{"github": New(nil)}))
 	defer server.Close()
 
 	resp, _ := http.Get(server.URL + "/build100/secret101/github")
@@ -84,7 +91,7 @@ func TestWrongMethod(t *testing.T) {
 
 func TestWrongContentType(t *testing.T) {
 	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
-		map[string]webhook.Plugin{"github": New()}))
+		map[string]webhook.Plugin{"github": New(nil)}))
 	defer server.Close()
 
 	client := &http.Client{}
@@ -101,7 +108,7 @@ func TestWrongContentType(t *testing.T) {
 
 func TestMissingEvent(t *testing.T) {
 	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
-		map[string]webhook.Plugin{"github": New()}))
+		map[string]webhook.Plugin{"github": New(nil)}))
 	defer server.Close()
 
 	client := &http.Client{}
@@ -117,7 +124,7 @@ func TestMissingEvent(t *testing.T) {
 
 func TestWrongGitHubEvent(t *testing.T) {
 	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
-		map[string]webhook.Plugin{"github": New()}))
+		map[string]webhook.Plugin{"github": New(nil)}))
 	defer server.Close()
 
 	client := &http.Client{}
@@ -134,7 +141,7 @@ func TestWrongGitHubEvent(t *testing.T) {
 
 func TestJsonPingEvent(t *testing.T) {
 	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
-		map[string]webhook.Plugin{"github": New()}))
+		map[string]webhook.Plugin{"github": New(nil)}))
 	defer server.Close()
 
 	postFile("X-GitHub-Event", "ping", "pingevent.json", server.URL+"/build100/secret101/github",
@@ -143,7 +150,7 @@ func TestJsonPingEvent(t *testing.T) {
 
 func TestJsonPushEventError(t *testing.T) {
 	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
-		map[string]webhook.Plugin{"github": New()}))
+		map[string]webhook.Plugin{"github": New(nil)}))
 	defer server.Close()
 
 	post("X-GitHub-Event", "push", []byte{}, server.URL+"/build100/secret101/github", http.StatusBadRequest, t)
@@ -151,7 +158,7 @@ func TestJsonPushEventError(t *testing.T) {
 
 func TestJsonGitHubPushEvent(t *testing.T) {
 	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
-		map[string]webhook.Plugin{"github": New()}))
+		map[string]webhook.Plugin{"github": New(nil)}))
 	defer server.Close()
 
 	postFile("X-GitHub-Event", "push", "pushevent.json", server.URL+"/build100/secret101/github",
@@ -160,7 +167,7 @@ func TestJsonGitHubPushEvent(t *testing.T) {
 
 func TestJsonGitHubPushEventWithCharset(t *testing.T) {
 	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
-		map[string]webhook.Plugin{"github": New()}))
+		map[string]webhook.Plugin{"github": New(nil)}))
 	defer server.Close()
 
 	postFileWithCharset("X-GitHub-Event", "push", "pushevent.json", server.URL+"/build100/secret101/github",
@@ -169,7 +176,7 @@ func TestJsonGitHubPushEventWithCharset(t *testing.T) {
 
 func TestJsonGogsPushEvent(t *testing.T) {
 	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
-		map[string]webhook.Plugin{"github": New()}))
+		map[string]webhook.Plugin{"github": New(nil)}))
 	defer server.Close()
 
 	postFile("X-Gogs-Event", "push", "pushevent.json", server.URL+"/build100/secret101/github",
@@ -332,3 +339,207 @@ func TestExtractSkipsBuildForUnmatchedBranches(t *testing.T) {
 		t.Errorf("Expecting to not continue from this event because the branch is not for this buildConfig '%s'", context.buildCfg.Spec.Source.Git.Ref)
 	}
 }
+
+func sign(newHash func() hash.Hash, key string, body []byte) string {
+	mac := hmac.New(newHash, []byte(key))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestExtractWithSignature(t *testing.T) {
+	body, err := ioutil.ReadFile("fixtures/pushevent.json")
+	if err != nil {
+		t.Fatalf("Failed to open pushevent.json: %v", err)
+	}
+	tests := map[string]struct {
+		secret   string
+		header   string
+		value    string
+		proceed  bool
+		expected error
+	}{
+		"valid sha1 signature": {
+			header:  "X-Hub-Signature",
+			value:   "sha1=" + sign(sha1.New, "signingkey", body),
+			proceed: true,
+		},
+		"valid sha256 signature": {
+			header:  "X-Hub-Signature-256",
+			value:   "sha256=" + sign(sha256.New, "signingkey", body),
+			proceed: true,
+		},
+		"valid signature and url secret": {
+			secret:  "secret101",
+			header:  "X-Hub-Signature-256",
+			value:   "sha256=" + sign(sha256.New, "signingkey", body),
+			proceed: true,
+		},
+		"valid signature and wrong url secret": {
+			secret:   "wrongsecret",
+			header:   "X-Hub-Signature-256",
+			value:    "sha256=" + sign(sha256.New, "signingkey", body),
+			expected: webhook.ErrSecretMismatch,
+		},
+		"signature with the wrong key": {
+			header:   "X-Hub-Signature",
+			value:    "sha1=" + sign(sha1.New, "wrongkey", body),
+			expected: webhook.ErrSignatureMismatch,
+		},
+		"malformed signature": {
+			header:   "X-Hub-Signature",
+			value:    "sha1=notahexvalue",
+			expected: webhook.ErrSignatureMismatch,
+		},
+		"missing signature": {
+			expected: webhook.ErrSignatureMismatch,
+		},
+	}
+	for name, test := range tests {
+		secret := &kapi.Secret{
+			ObjectMeta: kapi.ObjectMeta{Name: "webhook", Namespace: "default"},
+			Data:       map[string][]byte{api.WebHookSecretKey: []byte("signingkey")},
+		}
+		context := setup(t, "pushevent.json", "push")
+		context.buildCfg.Namespace = "default"
+		context.buildCfg.Spec.Triggers[0].GitHubWebHook.SecretReference = &kapi.LocalObjectReference{Name: "webhook"}
+		if len(test.header) > 0 {
+			context.req.Header.Add(test.header, test.value)
+		}
+		plugin := New(ktestclient.NewSimpleFake(secret))
+
+		_, proceed, err := plugin.Extract(context.buildCfg, test.secret, context.path, context.req)
+		if err != test.expected {
+			t.Errorf("%s: expected error %v, got %v", name, test.expected, err)
+		}
+		if proceed != test.proceed {
+			t.Errorf("%s: expected proceed %t, got %t", name, test.proceed, proceed)
+		}
+	}
+}
+
+func TestExtractWithMissingSignatureSecret(t *testing.T) {
+	context := setup(t, "pushevent.json", "push")
+	context.buildCfg.Spec.Triggers[0].GitHubWebHook.SecretReference = &kapi.LocalObjectReference{Name: "webhook"}
+	context.req.Header.Add("X-Hub-Signature", "sha1=0123")
+	plugin := New(ktestclient.NewSimpleFake())
+
+	_, proceed, err := plugin.Extract(context.buildCfg, "", context.path, context.req)
+	if err == nil || err == webhook.ErrSignatureMismatch {
+		t.Errorf("Expected an error reading the secret, got %v", err)
+	}
+	if proceed {
+		t.Errorf("The 'proceed' return value should equal 'false' %t", proceed)
+	}
+}
+
+func setupPullRequest(t *testing.T, action string) *testContext {
+	context := setup(t, "pullrequestevent.json", "pull_request")
+	context.buildCfg.Spec.Triggers[0].GitHubWebHook.PullRequests = &api.PullRequestBuildPolicy{OutputTagPrefix: "pr-"}
+	body, _ := ioutil.ReadAll(context.req.Body)
+	body = bytes.Replace(body, []byte(`"action": "opened"`), []byte(`"action": "`+action+`"`), 1)
+	context.req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return context
+}
+
+func TestExtractPullRequestOpened(t *testing.T) {
+	context := setupPullRequest(t, "opened")
+
+	revision, pullRequest, proceed, err := context.plugin.ExtractPullRequest(context.buildCfg, "secret101", context.path, context.req)
+	if err != nil {
+		t.Fatalf("Error while extracting build info: %s", err)
+	}
+	if !proceed {
+		t.Errorf("The 'proceed' return value should equal 'true' %t", proceed)
+	}
+	expected := &api.PullRequestReference{
+		Number:    42,
+		Ref:       "refs/pull/42/head",
+		Fork:      true,
+		OutputTag: "pr-42",
+	}
+	if !reflect.DeepEqual(pullRequest, expected) {
+		t.Errorf("Expected pull request %#v, got %#v", expected, pullRequest)
+	}
+	if revision == nil || revision.Git.Commit != "2a35c2b8b2b3fa4ed6a39ad9b76c25c3d5d15b6e" {
+		t.Errorf("Expecting the revision to contain the head commit of the pull request, got %#v", revision)
+	}
+}
+
+func TestExtractPullRequestFork(t *testing.T) {
+	tests := map[string]struct {
+		head string
+		fork bool
+	}{
+		"same repository": {
+			head: `"repo": {"full_name": "my/repo"}`,
+		},
+		"deleted fork": {
+			head: `"repo": null`,
+			fork: true,
+		},
+	}
+	for name, test := range tests {
+		context := setupPullRequest(t, "opened")
+		body, _ := ioutil.ReadAll(context.req.Body)
+		body = bytes.Replace(body, []byte(`"repo": {
+        "full_name": "contributor/repo",
+        "clone_url": "https://github.com/contributor/repo.git"
+      }`), []byte(test.head), 1)
+		context.req.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		_, pullRequest, proceed, err := context.plugin.ExtractPullRequest(context.buildCfg, "secret101", context.path, context.req)
+		if err != nil {
+			t.Errorf("%s: error while extracting build info: %s", name, err)
+			continue
+		}
+		if !proceed || pullRequest == nil {
+			t.Errorf("%s: expected the pull request to be built", name)
+			continue
+		}
+		if pullRequest.Fork != test.fork || pullRequest.Ref != "refs/pull/42/head" {
+			t.Errorf("%s: unexpected pull request %#v", name, pullRequest)
+		}
+	}
+}
+
+func TestExtractPullRequestClosed(t *testing.T) {
+	context := setupPullRequest(t, "closed")
+
+	_, pullRequest, proceed, err := context.plugin.ExtractPullRequest(context.buildCfg, "secret101", context.path, context.req)
+	if err != nil {
+		t.Fatalf("Error while extracting build info: %s", err)
+	}
+	if proceed {
+		t.Errorf("The 'proceed' return value should equal 'false' %t", proceed)
+	}
+	if pullRequest == nil || pullRequest.Number != 42 || pullRequest.OutputTag != "pr-42" {
+		t.Errorf("Expected the closed pull request to be returned, got %#v", pullRequest)
+	}
+}
+
+func TestExtractPullRequestSkipped(t *testing.T) {
+	tests := map[string]func(*testContext){
+		"pull request builds disabled": func(context *testContext) {
+			context.buildCfg.Spec.Triggers[0].GitHubWebHook.PullRequests = nil
+		},
+		"other base branch": func(context *testContext) {
+			context.buildCfg.Spec.Source.Git.Ref = "release"
+		},
+	}
+	for name, update := range tests {
+		context := setupPullRequest(t, "opened")
+		update(context)
+		_, pullRequest, proceed, err := context.plugin.ExtractPullRequest(context.buildCfg, "secret101", context.path, context.req)
+		if err != nil {
+			t.Errorf("%s: error while extracting build info: %s", name, err)
+		}
+		if proceed || pullRequest != nil {
+			t.Errorf("%s: expected the pull request to be skipped, got %#v", name, pullRequest)
+		}
+	}
+
+	context := setupPullRequest(t, "opened")
+	if _, proceed, err := context.plugin.Extract(context.buildCfg, "secret101", context.path, context.req); err != nil || proceed {
+		t.Errorf("Expected Extract to skip pull request events, got %t, %v", proceed, err)
+	}
+}
@@ -0,0 +1,131 @@
+package gitlab
+
+import (
+	"crypto/hmac"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net/http"
+
+	"github.com/golang/glog"
+	"github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/build/webhook"
+)
+
+const (
+	// pushEventType is the X-Gitlab-Event value sent for branch pushes.
+	pushEventType = "Push Hook"
+	// tokenHeader carries the secret token configured on the GitLab webhook.
+	tokenHeader = "X-Gitlab-Token"
+)
+
+// WebHook used for processing gitlab webhook requests.
+type WebHook struct{}
+
+// New returns gitlab webhook plugin.
+func New() *WebHook {
+	return &WebHook{}
+}
+
+type commit struct {
+	ID      string                `json:"id,omitempty"`
+	Message string                `json:"message,omitempty"`
+	Author  api.SourceControlUser `json:"author,omitempty"`
+}
+
+type pushEvent struct {
+	ObjectKind  string   `json:"object_kind,omitempty"`
+	Ref         string   `json:"ref,omitempty"`
+	After       string   `json:"after,omitempty"`
+	CheckoutSHA string   `json:"checkout_sha,omitempty"`
+	Commits     []commit `json:"commits,omitempty"`
+}
+
+// Extract services webhooks from gitlab.com and GitLab servers
+func (p *WebHook) Extract(buildCfg *api.BuildConfig, secret, path string, req *http.Request) (revision *api.SourceRevision, proceed bool, err error) {
+	trigger, ok := webhook.FindTriggerPolicy(api.GitLabWebHookBuildTriggerType, buildCfg)
+	if !ok {
+		err = webhook.ErrHookNotEnabled
+		return
+	}
+	glog.V(4).Infof("Checking if the provided secret for BuildConfig %s/%s matches", buildCfg.Namespace, buildCfg.Name)
+	if !hmac.Equal([]byte(trigger.GitLabWebHook.Secret), []byte(secret)) {
+		err = webhook.ErrSecretMismatch
+		return
+	}
+	// GitLab sends the secret token configured on the hook, if any, in a
+	// header; when present it has to match the secret as well.
+	if token := req.Header.Get(tokenHeader); len(token) > 0 && !hmac.Equal([]byte(trigger.GitLabWebHook.Secret), []byte(token)) {
+		err = webhook.ErrSecretMismatch
+		return
+	}
+	glog.V(4).Infof("Verifying build request for BuildConfig %s/%s", buildCfg.Namespace, buildCfg.Name)
+	if err = verifyRequest(req); err != nil {
+		return
+	}
+	if event := req.Header.Get("X-Gitlab-Event"); event != pushEventType {
+		err = fmt.Errorf("Unknown X-Gitlab-Event %s", event)
+		return
+	}
+	if buildCfg.Spec.Source.Git == nil {
+		glog.V(4).Infof("No source defined for BuildConfig %s/%s, but triggering anyway", buildCfg.Namespace, buildCfg.Name)
+		return nil, true, nil
+	}
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return
+	}
+	var event pushEvent
+	if err = json.Unmarshal(body, &event); err != nil {
+		return
+	}
+	proceed = webhook.GitRefMatches(event.Ref, buildCfg.Spec.Source.Git.Ref)
+	if !proceed {
+		glog.V(2).Infof("Skipping build for BuildConfig %s/%s.  Branch reference from '%s' does not match configuration", buildCfg.Namespace, buildCfg.Name, event.Ref)
+	}
+
+	revision = &api.SourceRevision{
+		Git: headCommit(&event),
+	}
+
+	return
+}
+
+// headCommit returns the revision of the commit the pushed ref points to.
+// GitLab does not report the committer, only the author of each commit.
+func headCommit(event *pushEvent) *api.GitSourceRevision {
+	sha := event.CheckoutSHA
+	if len(sha) == 0 {
+		sha = event.After
+	}
+	for _, c := range event.Commits {
+		if c.ID == sha {
+			return &api.GitSourceRevision{
+				Commit:  c.ID,
+				Author:  c.Author,
+				Message: c.Message,
+			}
+		}
+	}
+	return &api.GitSourceRevision{Commit: sha}
+}
+
+func verifyRequest(req *http.Request) error {
+	if method := req.Method; method != "POST" {
+		return fmt.Errorf("unsupported HTTP method %s", method)
+	}
+	contentType := req.Header.Get("Content-Type")
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return fmt.Errorf("non-parseable Content-Type %s (%s)", contentType, err)
+	}
+	if mediaType != "application/json" {
+		return fmt.Errorf("unsupported Content-Type %s", contentType)
+	}
+	if len(req.Header.Get("X-Gitlab-Event")) == 0 {
+		return errors.New("missing X-Gitlab-Event")
+	}
+	return nil
+}
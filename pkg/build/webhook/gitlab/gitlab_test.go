@@ -0,0 +1,211 @@
+package gitlab
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	"github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/build/webhook"
+)
+
+type okBuildConfigGetter struct{}
+
+func (c *okBuildConfigGetter) Get(namespace, name string) (*api.BuildConfig, error) {
+	return mockBuildConfig(), nil
+}
+
+func mockBuildConfig() *api.BuildConfig {
+	return &api.BuildConfig{
+		Spec: api.BuildConfigSpec{
+			Triggers: []api.BuildTriggerPolicy{
+				{
+					Type: api.GitLabWebHookBuildTriggerType,
+					GitLabWebHook: &api.WebHookTrigger{
+						Secret: "secret101",
+					},
+				},
+			},
+			BuildSpec: api.BuildSpec{
+				Source: api.BuildSource{
+					Git: &api.GitBuildSource{
+						URI: "git://example.com/my/repo.git",
+					},
+				},
+				Strategy: api.BuildStrategy{
+					SourceStrategy: &api.SourceBuildStrategy{
+						From: kapi.ObjectReference{
+							Kind: "DockerImage",
+							Name: "repository/image",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type okBuildConfigInstantiator struct{}
+
+func (*okBuildConfigInstantiator) Instantiate(namespace string, request *api.BuildRequest) (*api.Build, error) {
+	return &api.Build{}, nil
+}
+
+func TestWrongSecret(t *testing.T) {
+	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
+		map[string]webhook.Plugin{"gitlab": New()}))
+	defer server.Close()
+
+	client := &http.Client{}
+	req, _ := http.NewRequest("POST", server.URL+"/build100/wrongsecret/gitlab", nil)
+	resp, _ := client.Do(req)
+	body, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusBadRequest ||
+		!strings.Contains(string(body), webhook.ErrSecretMismatch.Error()) {
+		t.Errorf("Expected BadRequest, got %s: %s!", resp.Status, string(body))
+	}
+}
+
+func TestMissingEvent(t *testing.T) {
+	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
+		map[string]webhook.Plugin{"gitlab": New()}))
+	defer server.Close()
+
+	client := &http.Client{}
+	req, _ := http.NewRequest("POST", server.URL+"/build100/secret101/gitlab", nil)
+	req.Header.Add("Content-Type", "application/json")
+	resp, _ := client.Do(req)
+	body, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusBadRequest ||
+		!strings.Contains(string(body), "missing X-Gitlab-Event") {
+		t.Errorf("Expected BadRequest, got %s: %s!", resp.Status, string(body))
+	}
+}
+
+func TestJsonPushEvent(t *testing.T) {
+	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
+		map[string]webhook.Plugin{"gitlab": New()}))
+	defer server.Close()
+
+	data, err := ioutil.ReadFile("fixtures/pushevent.json")
+	if err != nil {
+		t.Fatalf("Failed to open pushevent.json: %v", err)
+	}
+	req, _ := http.NewRequest("POST", server.URL+"/build100/secret101/gitlab", bytes.NewReader(data))
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("X-Gitlab-Event", "Push Hook")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Failed posting webhook: %v", err)
+	}
+	body, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Wrong response code, expecting %d, got %s: %s!", http.StatusOK, resp.Status, string(body))
+	}
+}
+
+func setup(t *testing.T, filename, eventType string) (*api.BuildConfig, *http.Request) {
+	event, err := ioutil.ReadFile("fixtures/" + filename)
+	if err != nil {
+		t.Errorf("Failed to open %s: %v", filename, err)
+	}
+	req, _ := http.NewRequest("POST", "http://origin.com", bytes.NewReader(event))
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("X-Gitlab-Event", eventType)
+	return mockBuildConfig(), req
+}
+
+func TestExtractProvidesValidBuildForAPushEvent(t *testing.T) {
+	buildCfg, req := setup(t, "pushevent.json", "Push Hook")
+
+	revision, proceed, err := New().Extract(buildCfg, "secret101", "", req)
+	if err != nil {
+		t.Fatalf("Error while extracting build info: %s", err)
+	}
+	if !proceed {
+		t.Errorf("The 'proceed' return value should equal 'true' %t", proceed)
+	}
+	if revision == nil || revision.Git == nil {
+		t.Fatal("Expecting the revision to not be nil")
+	}
+	if revision.Git.Commit != "da1560886d4f094c3e6c9ef40349f7d38b5d27d7" {
+		t.Errorf("Expecting the revision to contain the checkout commit id, got %s", revision.Git.Commit)
+	}
+	if revision.Git.Author.Name != "Anonymous User" || revision.Git.Author.Email != "anonUser@example.com" {
+		t.Errorf("Unexpected author %#v", revision.Git.Author)
+	}
+	if revision.Git.Message != "fixed readme" {
+		t.Errorf("Unexpected message %q", revision.Git.Message)
+	}
+}
+
+func TestExtractProvidesValidBuildForAPushEventOtherThanMaster(t *testing.T) {
+	buildCfg, req := setup(t, "pushevent-not-master-branch.json", "Push Hook")
+	buildCfg.Spec.Source.Git.Ref = "my_other_branch"
+
+	_, proceed, err := New().Extract(buildCfg, "secret101", "", req)
+	if err != nil {
+		t.Fatalf("Error while extracting build info: %s", err)
+	}
+	if !proceed {
+		t.Errorf("The 'proceed' return value should equal 'true' %t", proceed)
+	}
+}
+
+func TestExtractSkipsBuildForUnmatchedBranches(t *testing.T) {
+	buildCfg, req := setup(t, "pushevent.json", "Push Hook")
+	buildCfg.Spec.Source.Git.Ref = "adfj32qrafdavckeaewra"
+
+	_, proceed, _ := New().Extract(buildCfg, "secret101", "", req)
+	if proceed {
+		t.Errorf("Expecting to not continue from this event because the branch is not for this buildConfig '%s'", buildCfg.Spec.Source.Git.Ref)
+	}
+}
+
+func TestExtractWithToken(t *testing.T) {
+	tests := map[string]struct {
+		token string
+		err   error
+	}{
+		"matching token": {token: "secret101"},
+		"wrong token":    {token: "wrong", err: webhook.ErrSecretMismatch},
+	}
+	for name, test := range tests {
+		buildCfg, req := setup(t, "pushevent.json", "Push Hook")
+		req.Header.Add("X-Gitlab-Token", test.token)
+		_, _, err := New().Extract(buildCfg, "secret101", "", req)
+		if err != test.err {
+			t.Errorf("%s: expected error %v, got %v", name, test.err, err)
+		}
+	}
+}
+
+func TestExtractUnknownEvent(t *testing.T) {
+	buildCfg, req := setup(t, "pushevent.json", "Issue Hook")
+
+	_, proceed, err := New().Extract(buildCfg, "secret101", "", req)
+	if err == nil || !strings.Contains(err.Error(), "Unknown X-Gitlab-Event") {
+		t.Errorf("Expected an unknown event error, got %v", err)
+	}
+	if proceed {
+		t.Errorf("The 'proceed' return value should equal 'false' %t", proceed)
+	}
+}
+
+func TestExtractWithoutGitSource(t *testing.T) {
+	buildCfg, req := setup(t, "pushevent.json", "Push Hook")
+	buildCfg.Spec.Source.Git = nil
+
+	revision, proceed, err := New().Extract(buildCfg, "secret101", "", req)
+	if err != nil {
+		t.Fatalf("Error while extracting build info: %s", err)
+	}
+	if !proceed || revision != nil {
+		t.Errorf("Expected to proceed without a revision, got %t, %#v", proceed, revision)
+	}
+}
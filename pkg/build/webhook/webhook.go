@@ -8,8 +8,9 @@ import (
 )
 
 var (
-	ErrSecretMismatch = fmt.Errorf("the provided secret does not match")
-	ErrHookNotEnabled = fmt.Errorf("the specified hook is not enabled")
+	ErrSecretMismatch    = fmt.Errorf("the provided secret does not match")
+	ErrHookNotEnabled    = fmt.Errorf("the specified hook is not enabled")
+	ErrSignatureMismatch = fmt.Errorf("the payload signature does not match")
 )
 
 // GitRefMatches determines if the ref from a webhook event matches a build configuration
@@ -74,8 +74,15 @@ func (c *buildConfigs) WebHookURL(name string, trigger *buildapi.BuildTriggerPol
 	switch {
 	case trigger.GenericWebHook != nil:
 		return c.r.Get().Namespace(c.ns).Resource("buildConfigs").Name(name).SubResource("webhooks").Suffix(trigger.GenericWebHook.Secret, "generic").URL(), nil
+	case trigger.GitHubWebHook != nil && len(trigger.GitHubWebHook.Secret) == 0:
+		// the hook is authenticated by its payload signature only
+		return c.r.Get().Namespace(c.ns).Resource("buildConfigs").Name(name).SubResource("webhooks").Suffix("github").URL(), nil
 	case trigger.GitHubWebHook != nil:
 		return c.r.Get().Namespace(c.ns).Resource("buildConfigs").Name(name).SubResource("webhooks").Suffix(trigger.GitHubWebHook.Secret, "github").URL(), nil
+	case trigger.GitLabWebHook != nil:
+		return c.r.Get().Namespace(c.ns).Resource("buildConfigs").Name(name).SubResource("webhooks").Suffix(trigger.GitLabWebHook.Secret, "gitlab").URL(), nil
+	case trigger.BitbucketWebHook != nil:
+		return c.r.Get().Namespace(c.ns).Resource("buildConfigs").Name(name).SubResource("webhooks").Suffix(trigger.BitbucketWebHook.Secret, "bitbucket").URL(), nil
 	default:
 		return nil, ErrTriggerIsNotAWebHook
 	}
@@ -21,10 +21,12 @@ type DeploymentConfigInterface interface {
 	Get(name string) (*deployapi.DeploymentConfig, error)
 	Create(config *deployapi.DeploymentConfig) (*deployapi.DeploymentConfig, error)
 	Update(config *deployapi.DeploymentConfig) (*deployapi.DeploymentConfig, error)
+	UpdateStatus(config *deployapi.DeploymentConfig) (*deployapi.DeploymentConfig, error)
 	Delete(name string) error
 	Watch(opts kapi.ListOptions) (watch.Interface, error)
 	Generate(name string) (*deployapi.DeploymentConfig, error)
 	Rollback(config *deployapi.DeploymentConfigRollback) (*deployapi.DeploymentConfig, error)
+	RollbackDiff(config *deployapi.DeploymentConfigRollback) (*deployapi.DeploymentConfigRollbackDiff, error)
 	GetScale(name string) (*extensions.Scale, error)
 	UpdateScale(scale *extensions.Scale) (*extensions.Scale, error)
 }
@@ -76,6 +78,13 @@ func (c *deploymentConfigs) Update(deploymentConfig *deployapi.DeploymentConfig)
 	return
 }
 
+// UpdateStatus updates the status of an existing deploymentConfig.
+func (c *deploymentConfigs) UpdateStatus(deploymentConfig *deployapi.DeploymentConfig) (result *deployapi.DeploymentConfig, err error) {
+	result = &deployapi.DeploymentConfig{}
+	err = c.r.Put().Namespace(c.ns).Resource("deploymentConfigs").Name(deploymentConfig.Name).SubResource("status").Body(deploymentConfig).Do().Into(result)
+	return
+}
+
 // Delete deletes an existing deploymentConfig.
 func (c *deploymentConfigs) Delete(name string) error {
 	return c.r.Delete().Namespace(c.ns).Resource("deploymentConfigs").Name(name).Do().Error()
@@ -109,6 +118,19 @@ func (c *deploymentConfigs) Rollback(config *deployapi.DeploymentConfigRollback)
 	return
 }
 
+// RollbackDiff returns the changes the rollback would make to the current
+// deploymentConfig. The rollback must only ask for the diff.
+func (c *deploymentConfigs) RollbackDiff(config *deployapi.DeploymentConfigRollback) (result *deployapi.DeploymentConfigRollbackDiff, err error) {
+	result = &deployapi.DeploymentConfigRollbackDiff{}
+	err = c.r.Post().
+		Namespace(c.ns).
+		Resource("deploymentConfigRollbacks").
+		Body(config).
+		Do().
+		Into(result)
+	return
+}
+
 // Get returns information about a particular deploymentConfig
 func (c *deploymentConfigs) GetScale(name string) (result *extensions.Scale, err error) {
 	result = &extensions.Scale{}
@@ -52,6 +52,15 @@ func (c *FakeDeploymentConfigs) Update(inObj *deployapi.DeploymentConfig) (*depl
 	return obj.(*deployapi.DeploymentConfig), err
 }
 
+func (c *FakeDeploymentConfigs) UpdateStatus(inObj *deployapi.DeploymentConfig) (*deployapi.DeploymentConfig, error) {
+	obj, err := c.Fake.Invokes(ktestclient.NewUpdateAction("deploymentconfigs/status", c.Namespace, inObj), inObj)
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*deployapi.DeploymentConfig), err
+}
+
 func (c *FakeDeploymentConfigs) Delete(name string) error {
 	_, err := c.Fake.Invokes(ktestclient.NewDeleteAction("deploymentconfigs", c.Namespace, name), &deployapi.DeploymentConfig{})
 	return err
@@ -80,6 +89,15 @@ func (c *FakeDeploymentConfigs) Rollback(inObj *deployapi.DeploymentConfigRollba
 	return obj.(*deployapi.DeploymentConfig), err
 }
 
+func (c *FakeDeploymentConfigs) RollbackDiff(inObj *deployapi.DeploymentConfigRollback) (*deployapi.DeploymentConfigRollbackDiff, error) {
+	obj, err := c.Fake.Invokes(ktestclient.NewCreateAction("deploymentconfigrollbacks", c.Namespace, inObj), &deployapi.DeploymentConfigRollbackDiff{})
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*deployapi.DeploymentConfigRollbackDiff), err
+}
+
 func (c *FakeDeploymentConfigs) GetScale(name string) (*extensions.Scale, error) {
 	obj, err := c.Fake.Invokes(ktestclient.NewGetAction("deploymentconfigs/scale", c.Namespace, name), &extensions.Scale{})
 	if obj == nil {
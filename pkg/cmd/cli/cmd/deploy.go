@@ -18,6 +18,7 @@ import (
 	kclient "k8s.io/kubernetes/pkg/client/unversioned"
 	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
 	"k8s.io/kubernetes/pkg/kubectl/resource"
+	"k8s.io/kubernetes/pkg/util/wait"
 
 	"github.com/openshift/origin/pkg/client"
 	"github.com/openshift/origin/pkg/cmd/cli/describe"
@@ -40,6 +41,15 @@ type DeployOptions struct {
 	retryDeploy          bool
 	cancelDeploy         bool
 	enableTriggers       bool
+	pauseDeploy          bool
+	resumeDeploy         bool
+	waitForRollout       bool
+	// timeout is how long to wait for the rollout, zero waits for as long as
+	// a deployment may run.
+	timeout time.Duration
+	// pollInterval is how often the config is checked while waiting for its
+	// rollout.
+	pollInterval time.Duration
 }
 
 const (
@@ -61,11 +71,17 @@ There are several deployment strategies defined:
   Use when your application cannot tolerate two versions of code running at the same time
 * Custom - run your own deployment process inside a Docker container using your own scripts.
 
+If you need to make several changes to a deployment config, you can pause it first so that the
+changes do not start new deployments, then resume it to deploy all of them at once.
+
 If a deployment fails, you may opt to retry it (if the error was transient). Some deployments may
 never successfully complete - in which case you can use the '--latest' flag to force a redeployment.
 When rolling back to a previous deployment, a new deployment will be created with an identical copy
 of your config at the latest position.
 
+Use the '--wait' flag alone, or together with '--latest' or '--resume', to wait until the latest
+deployment has been rolled out. The command fails if the deployment fails.
+
 If no options are given, shows information about the latest deployment.`
 
 	deployExample = `  # Display the latest deployment for the 'database' deployment config
@@ -79,17 +95,27 @@ If no options are given, shows information about the latest deployment.`
   $ %[1]s deploy frontend --retry
 
   # Cancel the in-progress deployment based on 'frontend'
-  $ %[1]s deploy frontend --cancel`
+  $ %[1]s deploy frontend --cancel
+
+  # Pause the 'frontend' deployment config, so that changes to it do not start new deployments
+  $ %[1]s deploy frontend --pause
+
+  # Resume the 'frontend' deployment config, deploying the changes made while it was paused
+  $ %[1]s deploy frontend --resume
+
+  # Start a new deployment based on 'frontend' and wait until it is rolled out
+  $ %[1]s deploy frontend --latest --wait`
 )
 
 // NewCmdDeploy creates a new `deploy` command.
 func NewCmdDeploy(fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
 	options := &DeployOptions{
 		baseCommandName: fullName,
+		pollInterval:    2 * time.Second,
 	}
 
 	cmd := &cobra.Command{
-		Use:        "deploy DEPLOYMENTCONFIG [--latest|--retry|--cancel|--enable-triggers]",
+		Use:        "deploy DEPLOYMENTCONFIG [--latest|--retry|--cancel|--enable-triggers|--pause|--resume] [--wait]",
 		Short:      "View, start, cancel, or retry a deployment",
 		Long:       deployLong,
 		Example:    fmt.Sprintf(deployExample, fullName),
@@ -113,6 +139,10 @@ func NewCmdDeploy(fullName string, f *clientcmd.Factory, out io.Writer) *cobra.C
 	cmd.Flags().BoolVar(&options.retryDeploy, "retry", false, "Retry the latest failed deployment.")
 	cmd.Flags().BoolVar(&options.cancelDeploy, "cancel", false, "Cancel the in-progress deployment.")
 	cmd.Flags().BoolVar(&options.enableTriggers, "enable-triggers", false, "Enables all image triggers for the deployment config.")
+	cmd.Flags().BoolVar(&options.pauseDeploy, "pause", false, "Pause the deployment config, changes to it do not start new deployments.")
+	cmd.Flags().BoolVar(&options.resumeDeploy, "resume", false, "Resume the paused deployment config.")
+	cmd.Flags().BoolVar(&options.waitForRollout, "wait", false, "Wait until the latest deployment is rolled out.")
+	cmd.Flags().DurationVar(&options.timeout, "timeout", 0, "The length of time to wait for the rollout with --wait (e.g. 5m). Zero waits for as long as a deployment may run.")
 
 	return cmd
 }
@@ -161,8 +191,20 @@ func (o DeployOptions) Validate() error {
 	if o.enableTriggers {
 		numOptions++
 	}
+	if o.pauseDeploy {
+		numOptions++
+	}
+	if o.resumeDeploy {
+		numOptions++
+	}
 	if numOptions > 1 {
-		return errors.New("only one of --latest, --retry, --cancel, or --enable-triggers is allowed.")
+		return errors.New("only one of --latest, --retry, --cancel, --enable-triggers, --pause, or --resume is allowed.")
+	}
+	if o.waitForRollout && (o.retryDeploy || o.cancelDeploy || o.enableTriggers || o.pauseDeploy) {
+		return errors.New("--wait can only be combined with --latest or --resume.")
+	}
+	if o.timeout < 0 {
+		return errors.New("--timeout may not be negative.")
 	}
 	return nil
 }
@@ -191,6 +233,12 @@ func (o DeployOptions) RunDeploy() error {
 		err = o.cancel(config, o.out)
 	case o.enableTriggers:
 		err = o.reenableTriggers(config, o.out)
+	case o.pauseDeploy:
+		err = o.pause(config, o.out)
+	case o.resumeDeploy:
+		err = o.resume(config, o.out)
+	case o.waitForRollout:
+		// Only wait for the rollout in progress.
 	default:
 		describer := describe.NewLatestDeploymentsDescriber(o.osClient, o.kubeClient, -1)
 		desc, err := describer.Describe(config.Namespace, config.Name)
@@ -200,12 +248,18 @@ func (o DeployOptions) RunDeploy() error {
 		fmt.Fprint(o.out, desc)
 	}
 
+	if err == nil && o.waitForRollout {
+		err = o.waitForLatest(config, o.out)
+	}
 	return err
 }
 
 // deploy launches a new deployment unless there's already a deployment
 // process in progress for config.
 func (o DeployOptions) deploy(config *deployapi.DeploymentConfig, out io.Writer) error {
+	if config.Spec.Paused {
+		return fmt.Errorf("cannot deploy a paused deployment config.\nYou can resume it using the --resume option.")
+	}
 	deploymentName := deployutil.LatestDeploymentNameForConfig(config)
 	deployment, err := o.kubeClient.ReplicationControllers(config.Namespace).Get(deploymentName)
 	if err == nil {
@@ -348,3 +402,90 @@ func (o DeployOptions) reenableTriggers(config *deployapi.DeploymentConfig, out
 	fmt.Fprintf(out, "Enabled image triggers: %s\n", strings.Join(enabled, ","))
 	return nil
 }
+
+// pause marks config as paused, so that changes to it do not trigger new
+// deployments.
+func (o DeployOptions) pause(config *deployapi.DeploymentConfig, out io.Writer) error {
+	if config.Spec.Paused {
+		fmt.Fprintf(out, "%s is already paused\n", config.Name)
+		return nil
+	}
+	config.Spec.Paused = true
+	if _, err := o.osClient.DeploymentConfigs(config.Namespace).Update(config); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Paused %s\n", config.Name)
+	return nil
+}
+
+// resume unpauses config. The triggers deploy the changes made while it was
+// paused.
+func (o DeployOptions) resume(config *deployapi.DeploymentConfig, out io.Writer) error {
+	if !config.Spec.Paused {
+		fmt.Fprintf(out, "%s is not paused\n", config.Name)
+		return nil
+	}
+	config.Spec.Paused = false
+	if _, err := o.osClient.DeploymentConfigs(config.Namespace).Update(config); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Resumed %s\n", config.Name)
+	return nil
+}
+
+// waitForLatest waits until the deployment config controller has observed the latest
+// changes to config and reports its latest deployment as rolled out. An error
+// is returned if the deployment fails, if the config is paused or if the rollout
+// takes longer than the timeout.
+func (o DeployOptions) waitForLatest(config *deployapi.DeploymentConfig, out io.Writer) error {
+	timeout := o.timeout
+	if timeout == 0 {
+		timeout = time.Duration(deployapi.MaxDeploymentDurationSeconds) * time.Second
+	}
+	lastMessage := ""
+	latestVersion := config.Status.LatestVersion
+	var rolloutErr error
+	err := wait.Poll(o.pollInterval, timeout, func() (bool, error) {
+		// The generation of the config is incremented by the changes made
+		// above, so it is read again before checking whether the controller
+		// observed it.
+		current, err := o.osClient.DeploymentConfigs(config.Namespace).Get(config.Name)
+		if err != nil {
+			return false, err
+		}
+		if current.Spec.Paused {
+			rolloutErr = fmt.Errorf("%s is paused, its latest deployment will not be rolled out until it is resumed", current.Name)
+			return true, nil
+		}
+		if current.Status.ObservedGeneration < current.Generation {
+			return false, nil
+		}
+		latestVersion = current.Status.LatestVersion
+		message := ""
+		progressing := deployutil.GetDeploymentCondition(current.Status, deployapi.DeploymentProgressing)
+		switch {
+		case progressing == nil:
+			message = fmt.Sprintf("Waiting for the first deployment of %s", current.Name)
+		case progressing.Status == kapi.ConditionFalse:
+			rolloutErr = fmt.Errorf("deployment #%d failed: %s", current.Status.LatestVersion, progressing.Message)
+			return true, nil
+		case progressing.Reason == deployapi.NewRcAvailableReason:
+			fmt.Fprintf(out, "Deployment #%d successfully rolled out\n", current.Status.LatestVersion)
+			return true, nil
+		default:
+			message = fmt.Sprintf("Waiting for deployment #%d to be rolled out: %s", current.Status.LatestVersion, progressing.Message)
+		}
+		if message != lastMessage {
+			fmt.Fprintln(out, message)
+			lastMessage = message
+		}
+		return false, nil
+	})
+	if err == wait.ErrWaitTimeout {
+		return fmt.Errorf("timed out waiting for deployment #%d of %s to be rolled out", latestVersion, config.Name)
+	}
+	if err != nil {
+		return err
+	}
+	return rolloutErr
+}
@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"sort"
 	"testing"
+	"time"
 
 	kapi "k8s.io/kubernetes/pkg/api"
 	kerrors "k8s.io/kubernetes/pkg/api/errors"
@@ -323,3 +324,112 @@ func TestDeploy_reenableTriggers(t *testing.T) {
 		}
 	}
 }
+
+func TestDeploy_pauseAndResume(t *testing.T) {
+	var updated *deployapi.DeploymentConfig
+
+	osClient := &tc.Fake{}
+	osClient.AddReactor("update", "deploymentconfigs", func(action ktc.Action) (handled bool, ret runtime.Object, err error) {
+		updated = action.(ktc.UpdateAction).GetObject().(*deployapi.DeploymentConfig)
+		return true, updated, nil
+	})
+
+	config := deploytest.OkDeploymentConfig(1)
+	o := &DeployOptions{osClient: osClient}
+	if err := o.pause(config, ioutil.Discard); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated == nil || !updated.Spec.Paused {
+		t.Fatalf("expected the config to be paused")
+	}
+
+	if err := o.deploy(config, ioutil.Discard); err == nil {
+		t.Errorf("expected an error deploying a paused config")
+	}
+
+	updated = nil
+	if err := o.resume(config, ioutil.Discard); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated == nil || updated.Spec.Paused {
+		t.Fatalf("expected the config to be resumed")
+	}
+
+	updated = nil
+	if err := o.resume(config, ioutil.Discard); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated != nil {
+		t.Errorf("expected a config which is not paused not to be updated")
+	}
+}
+
+func TestDeploy_waitForLatest(t *testing.T) {
+	mkconfig := func(observed int64, condition *deployapi.DeploymentCondition) *deployapi.DeploymentConfig {
+		config := deploytest.OkDeploymentConfig(2)
+		config.Generation = 2
+		config.Status.ObservedGeneration = observed
+		if condition != nil {
+			config.Status.Conditions = []deployapi.DeploymentCondition{*condition}
+		}
+		return config
+	}
+	running := &deployapi.DeploymentCondition{Type: deployapi.DeploymentProgressing, Status: kapi.ConditionTrue, Reason: deployapi.ReplicationControllerUpdatedReason, Message: "progressing"}
+	complete := &deployapi.DeploymentCondition{Type: deployapi.DeploymentProgressing, Status: kapi.ConditionTrue, Reason: deployapi.NewRcAvailableReason}
+	failed := &deployapi.DeploymentCondition{Type: deployapi.DeploymentProgressing, Status: kapi.ConditionFalse, Reason: deployapi.RolloutFailedReason, Message: "failed"}
+
+	paused := mkconfig(2, running)
+	paused.Spec.Paused = true
+
+	tests := []struct {
+		name        string
+		configs     []*deployapi.DeploymentConfig
+		timeout     time.Duration
+		errExpected bool
+	}{
+		{
+			name:    "rolled out",
+			configs: []*deployapi.DeploymentConfig{mkconfig(1, complete), mkconfig(2, running), mkconfig(2, complete)},
+		},
+		{
+			name:        "failed",
+			configs:     []*deployapi.DeploymentConfig{mkconfig(2, running), mkconfig(2, failed)},
+			errExpected: true,
+		},
+		{
+			name:        "paused",
+			configs:     []*deployapi.DeploymentConfig{mkconfig(2, running), paused},
+			errExpected: true,
+		},
+		{
+			name:        "timed out",
+			configs:     []*deployapi.DeploymentConfig{mkconfig(2, running)},
+			timeout:     10 * time.Millisecond,
+			errExpected: true,
+		},
+	}
+
+	for _, test := range tests {
+		gets := 0
+		osClient := &tc.Fake{}
+		osClient.AddReactor("get", "deploymentconfigs", func(action ktc.Action) (handled bool, ret runtime.Object, err error) {
+			config := test.configs[gets]
+			if gets < len(test.configs)-1 {
+				gets++
+			}
+			return true, config, nil
+		})
+
+		o := &DeployOptions{osClient: osClient, pollInterval: time.Millisecond, timeout: test.timeout}
+		err := o.waitForLatest(deploytest.OkDeploymentConfig(2), ioutil.Discard)
+		if err != nil && !test.errExpected {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		}
+		if err == nil && test.errExpected {
+			t.Errorf("%s: expected an error", test.name)
+		}
+		if gets != len(test.configs)-1 {
+			t.Errorf("%s: expected to wait until the last status, got %d checks", test.name, gets+1)
+		}
+	}
+}
@@ -1,9 +1,12 @@
 package cmd
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io"
+	"text/tabwriter"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -15,6 +18,7 @@ import (
 	"k8s.io/kubernetes/pkg/runtime"
 
 	buildapi "github.com/openshift/origin/pkg/build/api"
+	buildutil "github.com/openshift/origin/pkg/build/util"
 	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
 )
@@ -33,7 +37,10 @@ specified via -c. When a build config or deployment config is specified, you can
 the logs for a particular version of it via --version.
 
 If your pod is failing to start, you may need to use the --previous option to see the
-logs of the last attempt.`
+logs of the last attempt.
+
+When a build or build config is specified, --timings prints how long each stage of the
+build took instead of its logs.`
 
 	logsExample = `  # Start streaming the logs of the most recent build of the openldap build config.
   $ %[1]s -f bc/openldap
@@ -41,6 +48,9 @@ logs of the last attempt.`
   # Start streaming the logs of the latest deployment of the mysql deployment config.
   $ %[1]s -f dc/mysql
 
+  # Show how long each stage of the most recent build of the openldap build config took.
+  $ %[1]s --timings bc/openldap
+
   # Get the logs of the first deployment for the mysql deployment config. Note that logs
   # from older deployments may not exist either because the deployment was successful
   # or due to deployment pruning or manual deletion of the deployment.
@@ -60,6 +70,9 @@ type OpenShiftLogsOptions struct {
 	// KubeLogOptions contains all the necessary options for
 	// running the upstream logs command.
 	KubeLogOptions *kcmd.LogsOptions
+	// Timings prints the time spent in each stage of a build
+	// instead of its logs.
+	Timings bool
 }
 
 // NewCmdLogs creates a new logs command that supports OpenShift resources.
@@ -80,6 +93,7 @@ func NewCmdLogs(name, parent string, f *clientcmd.Factory, out io.Writer) *cobra
 		kcmdutil.CheckErr(o.RunLog())
 	}
 	cmd.Flags().Int64("version", 0, "View the logs of a particular build or deployment by version if greater than zero")
+	cmd.Flags().BoolVar(&o.Timings, "timings", false, "Print the time spent in each stage of a build instead of its logs")
 
 	return cmd
 }
@@ -151,6 +165,10 @@ func (o *OpenShiftLogsOptions) Complete(f *clientcmd.Factory, out io.Writer, cmd
 	return nil
 }
 
+// errTimingsNotSupported is returned when --timings is used for a resource other
+// than a build or build config.
+var errTimingsNotSupported = errors.New("--timings is only supported for builds and build configs")
+
 // Validate runs the upstream validation for the logs command and then it
 // will validate any OpenShift-specific log options.
 func (o OpenShiftLogsOptions) Validate() error {
@@ -158,6 +176,9 @@ func (o OpenShiftLogsOptions) Validate() error {
 		return err
 	}
 	if o.Options == nil {
+		if o.Timings {
+			return errTimingsNotSupported
+		}
 		return nil
 	}
 	switch t := o.Options.(type) {
@@ -169,6 +190,9 @@ func (o OpenShiftLogsOptions) Validate() error {
 		if t.Previous && t.Version != nil {
 			return errors.New("cannot use both --previous and --version")
 		}
+		if o.Timings {
+			return errTimingsNotSupported
+		}
 	default:
 		return errors.New("invalid log options object provided")
 	}
@@ -182,6 +206,38 @@ func (o OpenShiftLogsOptions) RunLog() error {
 		// Use our own options object.
 		o.KubeLogOptions.Options = o.Options
 	}
+	if o.Timings {
+		return o.runTimings()
+	}
 	_, err := o.KubeLogOptions.RunLogs()
 	return err
 }
+
+// runTimings reads the build log and prints the time spent in each of the
+// stages marked in it.
+func (o OpenShiftLogsOptions) runTimings() error {
+	out := o.KubeLogOptions.Out
+	log := &bytes.Buffer{}
+	o.KubeLogOptions.Out = log
+	if _, err := o.KubeLogOptions.RunLogs(); err != nil {
+		return err
+	}
+	timings, err := buildutil.ParseStageTimings(log)
+	if err != nil {
+		return err
+	}
+	if len(timings) == 0 {
+		fmt.Fprintln(out, "The build log has no stage markers.")
+		return nil
+	}
+	w := tabwriter.NewWriter(out, 10, 4, 3, ' ', 0)
+	fmt.Fprintln(w, "STAGE\tSTARTED\tDURATION")
+	for _, timing := range timings {
+		duration := "<running>"
+		if !timing.Finished.IsZero() {
+			duration = timing.Duration().String()
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", timing.Name, timing.Started.Local().Format(time.RFC1123Z), duration)
+	}
+	return w.Flush()
+}
@@ -7,9 +7,13 @@ import (
 
 	"github.com/spf13/pflag"
 
+	kapi "k8s.io/kubernetes/pkg/api"
 	kcmd "k8s.io/kubernetes/pkg/kubectl/cmd"
+	"k8s.io/kubernetes/pkg/runtime"
 
+	buildapi "github.com/openshift/origin/pkg/build/api"
 	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
 )
 
 // TestFlagParity makes sure that our copied flags don't slip during rebases
@@ -30,3 +34,28 @@ func TestFlagParity(t *testing.T) {
 		}
 	})
 }
+
+func TestValidateTimings(t *testing.T) {
+	tests := []struct {
+		options     runtime.Object
+		expectError bool
+	}{
+		{options: &buildapi.BuildLogOptions{}},
+		{options: &deployapi.DeploymentLogOptions{}, expectError: true},
+		{options: nil, expectError: true},
+	}
+	for _, tc := range tests {
+		o := OpenShiftLogsOptions{
+			KubeLogOptions: &kcmd.LogsOptions{ResourceArg: "bc/openldap", Options: &kapi.PodLogOptions{}},
+			Options:        tc.options,
+			Timings:        true,
+		}
+		err := o.Validate()
+		if err != nil && !tc.expectError {
+			t.Errorf("unexpected error for %#v: %v", tc.options, err)
+		}
+		if err == nil && tc.expectError {
+			t.Errorf("expected an error for %#v", tc.options)
+		}
+	}
+}
@@ -41,8 +41,9 @@ triggers, use the 'deploy' command.
 
 If you would like to review the outcome of the rollback, pass '--dry-run' to print
 a human-readable representation of the updated deployment configuration instead of
-executing the rollback. This is useful if you're not quite sure what the outcome
-will be.`
+executing the rollback, followed by the changes the rollback makes to the images,
+environment, strategy and triggers of the current configuration. This is useful if
+you're not quite sure what the outcome will be.`
 
 	rollbackExample = `  # Perform a rollback to the last successfully completed deployment for a deploymentconfig
   $ %[1]s rollback frontend
@@ -228,6 +229,18 @@ func (o *RollbackOptions) Run() error {
 			return err
 		}
 		o.out.Write([]byte(description))
+
+		diffRollback := *rollback
+		diffRollback.Spec.DiffOnly = true
+		diff, err := o.oc.DeploymentConfigs(o.Namespace).RollbackDiff(&diffRollback)
+		if err != nil {
+			return err
+		}
+		changes, err := describe.DescribeRollbackDiff(diff)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(o.out, "\n%s", changes)
 		return nil
 	}
 
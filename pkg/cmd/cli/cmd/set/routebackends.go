@@ -0,0 +1,328 @@
+package set
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/golang/glog"
+	"github.com/spf13/cobra"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/meta"
+	kcmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	"k8s.io/kubernetes/pkg/kubectl/resource"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	cmdutil "github.com/openshift/origin/pkg/cmd/util"
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+	routeapi "github.com/openshift/origin/pkg/route/api"
+)
+
+const (
+	backendsLong = `
+Set or show the services a route sends traffic to
+
+Routes may send traffic to one primary service and up to %[1]d alternate services.
+Each service receives a share of the traffic in proportion to its weight, between 0 and
+%[2]d, which is split evenly across its endpoints. A service with a weight of 0 receives
+no traffic.
+Services without a weight have a weight of %[3]d.
+
+Without any service arguments or flags this command prints the services of the route.
+Passing SERVICE=WEIGHT arguments replaces the services of the route: the first service
+becomes the primary service, the others become the alternate services.`
+
+	backendsExample = `  # Print the services of the route 'web'
+  $ %[1]s route-backends web
+
+  # Send 90%% of the traffic to service 'a' and 10%% to service 'b'
+  $ %[1]s route-backends web a=90 b=10
+
+  # Stop sending traffic to all services of the route
+  $ %[1]s route-backends web --zero
+
+  # Send the same share of traffic to each service of the route
+  $ %[1]s route-backends web --equal`
+)
+
+// BackendsOptions holds the options of the set route-backends command
+type BackendsOptions struct {
+	Out io.Writer
+	Err io.Writer
+
+	Filenames []string
+	Selector  string
+	All       bool
+
+	Builder *resource.Builder
+	Infos   []*resource.Info
+
+	Encoder runtime.Encoder
+
+	ShortOutput bool
+	Mapper      meta.RESTMapper
+
+	PrintTable  bool
+	PrintObject func(runtime.Object) error
+
+	Zero  bool
+	Equal bool
+
+	// Backends are the services and weights the route is set to, in order.
+	Backends []routeapi.RouteTargetReference
+}
+
+// NewCmdRouteBackends implements the set route-backends command
+func NewCmdRouteBackends(fullName string, f *clientcmd.Factory, out, errOut io.Writer) *cobra.Command {
+	options := &BackendsOptions{
+		Out: out,
+		Err: errOut,
+	}
+	cmd := &cobra.Command{
+		Use:     "route-backends ROUTENAME [--zero|--equal] [SERVICE=WEIGHT ...]",
+		Short:   "Update the services a route sends traffic to",
+		Long:    fmt.Sprintf(backendsLong, routeapi.MaxAlternateBackends, routeapi.MaxRouteWeight, routeapi.DefaultRouteWeight),
+		Example: fmt.Sprintf(backendsExample, fullName),
+		Run: func(cmd *cobra.Command, args []string) {
+			kcmdutil.CheckErr(options.Complete(f, cmd, args))
+			kcmdutil.CheckErr(options.Validate())
+			if err := options.Run(); err != nil {
+				// TODO: move met to kcmdutil
+				if err == cmdutil.ErrExit {
+					os.Exit(1)
+				}
+				kcmdutil.CheckErr(err)
+			}
+		},
+	}
+
+	kcmdutil.AddPrinterFlags(cmd)
+	cmd.Flags().StringVarP(&options.Selector, "selector", "l", options.Selector, "Selector (label query) to filter on")
+	cmd.Flags().BoolVar(&options.All, "all", options.All, "Select all routes in the namespace")
+	cmd.Flags().StringSliceVarP(&options.Filenames, "filename", "f", options.Filenames, "Filename, directory, or URL to file to use to edit the resource.")
+
+	cmd.Flags().BoolVar(&options.Zero, "zero", options.Zero, "If true, set the weight of all services to zero")
+	cmd.Flags().BoolVar(&options.Equal, "equal", options.Equal, "If true, set the weight of all services to the same value")
+
+	cmd.MarkFlagFilename("filename", "yaml", "yml", "json")
+
+	return cmd
+}
+
+// Complete parses the routes and the SERVICE=WEIGHT arguments
+func (o *BackendsOptions) Complete(f *clientcmd.Factory, cmd *cobra.Command, args []string) error {
+	cmdNamespace, explicit, err := f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+
+	resources := []string{}
+	for _, arg := range args {
+		if !strings.Contains(arg, "=") {
+			resources = append(resources, arg)
+			continue
+		}
+		backend, err := parseBackend(arg)
+		if err != nil {
+			return kcmdutil.UsageError(cmd, "%v", err)
+		}
+		o.Backends = append(o.Backends, backend)
+	}
+	if len(o.Filenames) == 0 && len(resources) == 0 && !o.All && len(o.Selector) == 0 {
+		return kcmdutil.UsageError(cmd, "one or more routes must be specified")
+	}
+	// Routes may be passed by name only.
+	if len(resources) == 1 && !strings.Contains(resources[0], "/") {
+		resources = []string{"routes", resources[0]}
+	}
+	if len(resources) == 0 && (o.All || len(o.Selector) > 0) {
+		resources = []string{"routes"}
+	}
+
+	o.PrintTable = len(o.Backends) == 0 && !o.Zero && !o.Equal
+
+	mapper, typer := f.Object()
+	o.Builder = resource.NewBuilder(mapper, typer, resource.ClientMapperFunc(f.ClientForMapping), kapi.Codecs.UniversalDecoder()).
+		ContinueOnError().
+		NamespaceParam(cmdNamespace).DefaultNamespace().
+		FilenameParam(explicit, o.Filenames...).
+		SelectorParam(o.Selector).
+		ResourceTypeOrNameArgs(o.All, resources...).
+		Flatten()
+
+	output := kcmdutil.GetFlagString(cmd, "output")
+	if len(output) != 0 {
+		o.PrintObject = func(obj runtime.Object) error { return f.PrintObject(cmd, obj, o.Out) }
+	}
+
+	o.Encoder = f.JSONEncoder()
+	o.ShortOutput = kcmdutil.GetFlagString(cmd, "output") == "name"
+	o.Mapper = mapper
+
+	return nil
+}
+
+// parseBackend parses a SERVICE=WEIGHT argument
+func parseBackend(arg string) (routeapi.RouteTargetReference, error) {
+	parts := strings.SplitN(arg, "=", 2)
+	weight, err := strconv.ParseInt(parts[1], 10, 32)
+	if len(parts[0]) == 0 || err != nil {
+		return routeapi.RouteTargetReference{}, fmt.Errorf("backends must be specified as SERVICE=WEIGHT, got %q", arg)
+	}
+	w := int32(weight)
+	return routeapi.RouteTargetReference{Kind: "Service", Name: parts[0], Weight: &w}, nil
+}
+
+// Validate checks that the options are consistent
+func (o *BackendsOptions) Validate() error {
+	switch {
+	case o.Zero && o.Equal:
+		return fmt.Errorf("you must specify at most one of --zero or --equal")
+	case (o.Zero || o.Equal) && len(o.Backends) > 0:
+		return fmt.Errorf("--zero and --equal may not be used with SERVICE=WEIGHT arguments")
+	case len(o.Backends) > routeapi.MaxAlternateBackends+1:
+		return fmt.Errorf("a route may send traffic to at most %d services", routeapi.MaxAlternateBackends+1)
+	}
+	names := map[string]bool{}
+	for _, backend := range o.Backends {
+		if names[backend.Name] {
+			return fmt.Errorf("service %q may only be specified once", backend.Name)
+		}
+		names[backend.Name] = true
+		if *backend.Weight < 0 || *backend.Weight > routeapi.MaxRouteWeight {
+			return fmt.Errorf("the weight of service %q must be between 0 and %d", backend.Name, routeapi.MaxRouteWeight)
+		}
+	}
+	return nil
+}
+
+// Run prints or updates the services of the routes
+func (o *BackendsOptions) Run() error {
+	infos := o.Infos
+	singular := len(o.Infos) <= 1
+	if o.Builder != nil {
+		loaded, err := o.Builder.Do().IntoSingular(&singular).Infos()
+		if err != nil {
+			return err
+		}
+		infos = loaded
+	}
+
+	if o.PrintTable && o.PrintObject == nil {
+		return o.printBackends(infos)
+	}
+
+	patches := CalculatePatches(infos, o.Encoder, func(info *resource.Info) (bool, error) {
+		route, ok := info.Object.(*routeapi.Route)
+		if !ok {
+			return false, nil
+		}
+		o.updateBackends(route)
+		return true, nil
+	})
+	if singular && len(patches) == 0 {
+		return fmt.Errorf("%s/%s is not a route", infos[0].Mapping.Resource, infos[0].Name)
+	}
+	if len(patches) == 0 {
+		return nil
+	}
+
+	if o.PrintObject != nil {
+		var infos []*resource.Info
+		for _, patch := range patches {
+			info := patch.Info
+			if patch.Err != nil {
+				fmt.Fprintf(o.Err, "error: %s/%s %v\n", info.Mapping.Resource, info.Name, patch.Err)
+				continue
+			}
+			infos = append(infos, info)
+		}
+		if len(infos) == 0 {
+			return cmdutil.ErrExit
+		}
+		object, err := resource.AsVersionedObject(infos, !singular, "", nil)
+		if err != nil {
+			return err
+		}
+		return o.PrintObject(object)
+	}
+
+	failed := false
+	for _, patch := range patches {
+		info := patch.Info
+		if patch.Err != nil {
+			failed = true
+			fmt.Fprintf(o.Err, "error: %s/%s %v\n", info.Mapping.Resource, info.Name, patch.Err)
+			continue
+		}
+
+		if string(patch.Patch) == "{}" || len(patch.Patch) == 0 {
+			fmt.Fprintf(o.Err, "info: %s %q was not changed\n", info.Mapping.Resource, info.Name)
+			continue
+		}
+
+		glog.V(4).Infof("Calculated patch %s", patch.Patch)
+
+		obj, err := resource.NewHelper(info.Client, info.Mapping).Patch(info.Namespace, info.Name, kapi.StrategicMergePatchType, patch.Patch)
+		if err != nil {
+			fmt.Fprintf(o.Err, "error: %v\n", err)
+			failed = true
+			continue
+		}
+
+		info.Refresh(obj, true)
+		kcmdutil.PrintSuccess(o.Mapper, o.ShortOutput, o.Out, info.Mapping.Resource, info.Name, "updated")
+	}
+	if failed {
+		return cmdutil.ErrExit
+	}
+	return nil
+}
+
+// printBackends prints the services of the routes with their weights
+func (o *BackendsOptions) printBackends(infos []*resource.Info) error {
+	w := tabwriter.NewWriter(o.Out, 0, 2, 2, ' ', 0)
+	defer w.Flush()
+	fmt.Fprintf(w, "NAME\tKIND\tTO\tWEIGHT\n")
+	for _, info := range infos {
+		route, ok := info.Object.(*routeapi.Route)
+		if !ok {
+			fmt.Fprintf(w, "%s/%s\t%s\t%s\t%s\n", info.Mapping.Resource, info.Name, "<error>", "", "")
+			continue
+		}
+		fmt.Fprintf(w, "%s/%s\t%s\t%s\t%d\n", info.Mapping.Resource, info.Name, route.Spec.To.Kind, route.Spec.To.Name, routeapi.BackendWeight(route.Spec.Weight))
+		for _, backend := range route.Spec.AlternateBackends {
+			fmt.Fprintf(w, "%s/%s\t%s\t%s\t%d\n", info.Mapping.Resource, info.Name, backend.Kind, backend.Name, routeapi.BackendWeight(backend.Weight))
+		}
+	}
+	return nil
+}
+
+// updateBackends replaces the services of the route with the specified ones, or
+// resets the weights of its services
+func (o *BackendsOptions) updateBackends(route *routeapi.Route) {
+	switch {
+	case o.Zero, o.Equal:
+		weight := routeapi.DefaultRouteWeight
+		if o.Zero {
+			weight = 0
+		}
+		route.Spec.Weight = newWeight(weight)
+		for i := range route.Spec.AlternateBackends {
+			route.Spec.AlternateBackends[i].Weight = newWeight(weight)
+		}
+	case len(o.Backends) > 0:
+		primary := o.Backends[0]
+		route.Spec.To = kapi.ObjectReference{Kind: "Service", Name: primary.Name}
+		route.Spec.Weight = primary.Weight
+		route.Spec.AlternateBackends = append([]routeapi.RouteTargetReference(nil), o.Backends[1:]...)
+	}
+}
+
+func newWeight(weight int32) *int32 {
+	return &weight
+}
@@ -39,8 +39,8 @@ Deployments support triggering off of image changes and on config changes. Confi
 alterations to the pod template, while image changes will result in the container image value being
 updated whenever an image stream tag is updated.
 
-Build configs support triggering off of image changes, config changes, and webhooks (both GitHub-specific
-and generic). The config change trigger for a build config will only trigger the first build.`
+Build configs support triggering off of image changes, config changes, and webhooks (GitHub, GitLab and
+Bitbucket specific, and generic). The config change trigger for a build config will only trigger the first build.`
 
 	triggersExample = `  # Print the triggers on the registry
   $ %[1]s triggers dc/registry
@@ -55,6 +55,9 @@ and generic). The config change trigger for a build config will only trigger the
   $ %[1]s triggers bc/webapp --from-github=
   $ %[1]s triggers bc/webapp --from-webhook=
 
+  # Add a GitLab webhook to a build
+  $ %[1]s triggers bc/webapp --from-gitlab
+
   # Remove all triggers
   $ %[1]s triggers bc/webapp --remove-all
 
@@ -93,6 +96,8 @@ type TriggersOptions struct {
 	ContainerNames string
 	FromConfig     bool
 	FromGitHub     *bool
+	FromGitLab     *bool
+	FromBitbucket  *bool
 	FromWebHook    *bool
 	FromImage      string
 	// FromImageNamespace is the namespace for the FromImage
@@ -106,7 +111,7 @@ func NewCmdTriggers(fullName string, f *clientcmd.Factory, out, errOut io.Writer
 		Err: errOut,
 	}
 	cmd := &cobra.Command{
-		Use:     "triggers RESOURCE/NAME [--from-config|--from-image|--from-github|--from-webhook] [--auto|--manual]",
+		Use:     "triggers RESOURCE/NAME [--from-config|--from-image|--from-github|--from-gitlab|--from-bitbucket|--from-webhook] [--auto|--manual]",
 		Short:   "Update the triggers on a build or deployment config",
 		Long:    triggersLong,
 		Example: fmt.Sprintf(triggersExample, fullName),
@@ -137,6 +142,8 @@ func NewCmdTriggers(fullName string, f *clientcmd.Factory, out, errOut io.Writer
 	cmd.Flags().StringVarP(&options.ContainerNames, "containers", "c", options.ContainerNames, "Comma delimited list of container names this trigger applies to on deployments; defaults to the name of the only container")
 	cmd.Flags().StringVar(&options.FromImage, "from-image", options.FromImage, "An image stream tag to trigger off of")
 	options.FromGitHub = cmd.Flags().Bool("from-github", false, "A GitHub webhook - a secret value will be generated automatically")
+	options.FromGitLab = cmd.Flags().Bool("from-gitlab", false, "A GitLab webhook - a secret value will be generated automatically")
+	options.FromBitbucket = cmd.Flags().Bool("from-bitbucket", false, "A Bitbucket webhook - a secret value will be generated automatically")
 	options.FromWebHook = cmd.Flags().Bool("from-webhook", false, "A generic webhook - a secret value will be generated automatically")
 
 	cmd.MarkFlagFilename("filename", "yaml", "yml", "json")
@@ -153,6 +160,12 @@ func (o *TriggersOptions) Complete(f *clientcmd.Factory, cmd *cobra.Command, arg
 	if !cmd.Flags().Lookup("from-github").Changed {
 		o.FromGitHub = nil
 	}
+	if !cmd.Flags().Lookup("from-gitlab").Changed {
+		o.FromGitLab = nil
+	}
+	if !cmd.Flags().Lookup("from-bitbucket").Changed {
+		o.FromBitbucket = nil
+	}
 	if !cmd.Flags().Lookup("from-webhook").Changed {
 		o.FromWebHook = nil
 	}
@@ -210,6 +223,12 @@ func (o *TriggersOptions) count() int {
 	if o.FromGitHub != nil {
 		count++
 	}
+	if o.FromGitLab != nil {
+		count++
+	}
+	if o.FromBitbucket != nil {
+		count++
+	}
 	if o.FromWebHook != nil {
 		count++
 	}
@@ -349,6 +368,12 @@ func (o *TriggersOptions) printTriggers(infos []*resource.Info) error {
 			for _, s := range triggers.GitHubWebHooks {
 				fmt.Fprintf(w, "%s/%s\t%s\t%s\t%s\n", info.Mapping.Resource, info.Name, "github", s, "")
 			}
+			for _, s := range triggers.GitLabWebHooks {
+				fmt.Fprintf(w, "%s/%s\t%s\t%s\t%s\n", info.Mapping.Resource, info.Name, "gitlab", s, "")
+			}
+			for _, s := range triggers.BitbucketWebHooks {
+				fmt.Fprintf(w, "%s/%s\t%s\t%s\t%s\n", info.Mapping.Resource, info.Name, "bitbucket", s, "")
+			}
 			return nil
 		})
 		if err != nil {
@@ -386,6 +411,12 @@ func (o *TriggersOptions) updateTriggers(triggers *TriggerDefinition) {
 		if o.FromGitHub != nil && *o.FromGitHub {
 			triggers.GitHubWebHooks = nil
 		}
+		if o.FromGitLab != nil && *o.FromGitLab {
+			triggers.GitLabWebHooks = nil
+		}
+		if o.FromBitbucket != nil && *o.FromBitbucket {
+			triggers.BitbucketWebHooks = nil
+		}
 		return
 	}
 
@@ -431,6 +462,12 @@ func (o *TriggersOptions) updateTriggers(triggers *TriggerDefinition) {
 	if o.FromGitHub != nil && *o.FromGitHub {
 		triggers.GitHubWebHooks = []string{app.GenerateSecret(20)}
 	}
+	if o.FromGitLab != nil && *o.FromGitLab {
+		triggers.GitLabWebHooks = []string{app.GenerateSecret(20)}
+	}
+	if o.FromBitbucket != nil && *o.FromBitbucket {
+		triggers.BitbucketWebHooks = []string{app.GenerateSecret(20)}
+	}
 }
 
 // ImageChangeTrigger represents the capabilities present in deployment config and build
@@ -448,10 +485,12 @@ type ImageChangeTrigger struct {
 
 // TriggerDefinition is the abstract representation of triggers for builds and deploymnet configs.
 type TriggerDefinition struct {
-	ConfigChange   bool
-	ImageChange    []ImageChangeTrigger
-	WebHooks       []string
-	GitHubWebHooks []string
+	ConfigChange      bool
+	ImageChange       []ImageChangeTrigger
+	WebHooks          []string
+	GitHubWebHooks    []string
+	GitLabWebHooks    []string
+	BitbucketWebHooks []string
 }
 
 // defaultNamespace returns an empty string if the provided namespace matches the default namespace, or
@@ -494,6 +533,10 @@ func NewBuildConfigTriggers(config *buildapi.BuildConfig) *TriggerDefinition {
 			t.WebHooks = append(t.WebHooks, trigger.GenericWebHook.Secret)
 		case buildapi.GitHubWebHookBuildTriggerType:
 			t.GitHubWebHooks = append(t.GitHubWebHooks, trigger.GitHubWebHook.Secret)
+		case buildapi.GitLabWebHookBuildTriggerType:
+			t.GitLabWebHooks = append(t.GitLabWebHooks, trigger.GitLabWebHook.Secret)
+		case buildapi.BitbucketWebHookBuildTriggerType:
+			t.BitbucketWebHooks = append(t.BitbucketWebHooks, trigger.BitbucketWebHook.Secret)
 		case buildapi.ImageChangeBuildTriggerType:
 			if trigger.ImageChange.From == nil {
 				if strategyTrigger := strategyTrigger(config); strategyTrigger != nil {
@@ -527,6 +570,12 @@ func (t *TriggerDefinition) Apply(obj runtime.Object) error {
 		if len(t.GitHubWebHooks) > 0 {
 			return fmt.Errorf("deployment configs do not support GitHub web hooks")
 		}
+		if len(t.GitLabWebHooks) > 0 {
+			return fmt.Errorf("deployment configs do not support GitLab web hooks")
+		}
+		if len(t.BitbucketWebHooks) > 0 {
+			return fmt.Errorf("deployment configs do not support Bitbucket web hooks")
+		}
 		if len(t.WebHooks) > 0 {
 			return fmt.Errorf("deployment configs do not support web hooks")
 		}
@@ -583,6 +632,23 @@ func (t *TriggerDefinition) Apply(obj runtime.Object) error {
 			triggers = append(triggers, buildapi.BuildTriggerPolicy{
 				Type: buildapi.GitHubWebHookBuildTriggerType,
 				GitHubWebHook: &buildapi.WebHookTrigger{
+					Secret:          trigger,
+					SecretReference: gitHubSecretReference(c.Spec.Triggers, trigger),
+				},
+			})
+		}
+		for _, trigger := range t.GitLabWebHooks {
+			triggers = append(triggers, buildapi.BuildTriggerPolicy{
+				Type: buildapi.GitLabWebHookBuildTriggerType,
+				GitLabWebHook: &buildapi.WebHookTrigger{
+					Secret: trigger,
+				},
+			})
+		}
+		for _, trigger := range t.BitbucketWebHooks {
+			triggers = append(triggers, buildapi.BuildTriggerPolicy{
+				Type: buildapi.BitbucketWebHookBuildTriggerType,
+				BitbucketWebHook: &buildapi.WebHookTrigger{
 					Secret: trigger,
 				},
 			})
@@ -654,6 +720,18 @@ func filterBuildImageTriggers(src []buildapi.BuildTriggerPolicy, trigger ImageCh
 	return dst
 }
 
+// gitHubSecretReference returns the signature secret reference of the existing GitHub
+// webhook trigger with the provided secret, so that it is preserved when the triggers
+// are written back.
+func gitHubSecretReference(triggers []buildapi.BuildTriggerPolicy, secret string) *kapi.LocalObjectReference {
+	for _, trigger := range triggers {
+		if trigger.GitHubWebHook != nil && trigger.GitHubWebHook.Secret == secret {
+			return trigger.GitHubWebHook.SecretReference
+		}
+	}
+	return nil
+}
+
 // filterDeploymentTriggers returns only triggers that do not have one of the provided types.
 func filterDeploymentTriggers(src []deployapi.DeploymentTriggerPolicy, types ...deployapi.DeploymentTriggerType) []deployapi.DeploymentTriggerPolicy {
 	var dst []deployapi.DeploymentTriggerPolicy
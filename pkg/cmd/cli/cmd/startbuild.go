@@ -100,7 +100,7 @@ func NewCmdStartBuild(fullName string, f *clientcmd.Factory, in io.Reader, out i
 	cmd.Flags().String("from-repo", "", "The path to a local source code repository to use as the binary input for a build.")
 	cmd.Flags().String("commit", "", "Specify the source code commit identifier the build should use; requires a build based on a Git repository")
 
-	cmd.Flags().Var(&webhooks, "list-webhooks", "List the webhooks for the specified build config or build; accepts 'all', 'generic', or 'github'")
+	cmd.Flags().Var(&webhooks, "list-webhooks", "List the webhooks for the specified build config or build; accepts 'all', 'generic', 'github', 'gitlab', or 'bitbucket'")
 	cmd.Flags().String("from-webhook", "", "Specify a webhook URL for an existing build config to trigger")
 
 	cmd.Flags().String("git-post-receive", "", "The contents of the post-receive hook to trigger a build")
@@ -292,18 +292,22 @@ func RunStartBuild(f *clientcmd.Factory, in io.Reader, out io.Writer, cmd *cobra
 
 // RunListBuildWebHooks prints the webhooks for the provided build config.
 func RunListBuildWebHooks(f *clientcmd.Factory, out, errOut io.Writer, name, resource, webhookFilter string) error {
-	generic, github := false, false
+	generic, github, gitlab, bitbucket := false, false, false, false
 	prefix := false
 	switch webhookFilter {
 	case "all":
-		generic, github = true, true
+		generic, github, gitlab, bitbucket = true, true, true, true
 		prefix = true
 	case "generic":
 		generic = true
 	case "github":
 		github = true
+	case "gitlab":
+		gitlab = true
+	case "bitbucket":
+		bitbucket = true
 	default:
-		return fmt.Errorf("--list-webhooks must be 'all', 'generic', or 'github'")
+		return fmt.Errorf("--list-webhooks must be 'all', 'generic', 'github', 'gitlab', or 'bitbucket'")
 	}
 	client, _, err := f.Clients()
 	if err != nil {
@@ -350,6 +354,14 @@ func RunListBuildWebHooks(f *clientcmd.Factory, out, errOut io.Writer, name, res
 			if prefix {
 				hookType = "github "
 			}
+		case t.GitLabWebHook != nil && gitlab:
+			if prefix {
+				hookType = "gitlab "
+			}
+		case t.BitbucketWebHook != nil && bitbucket:
+			if prefix {
+				hookType = "bitbucket "
+			}
 		default:
 			continue
 		}
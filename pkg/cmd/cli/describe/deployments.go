@@ -132,10 +132,24 @@ func (d *DeploymentConfigDescriber) Describe(namespace, name string) (string, er
 		} else {
 			formatString(out, "Latest Version", strconv.Itoa(deploymentConfig.Status.LatestVersion))
 		}
+		if deploymentConfig.Spec.Paused {
+			formatString(out, "Paused", "yes, triggers do not start new deployments until the config is resumed")
+		}
 
 		printDeploymentConfigSpec(deploymentConfig.Spec, out)
 		fmt.Fprintln(out)
 
+		if deploymentConfig.Status.ObservedGeneration > 0 {
+			formatString(out, "Replicas", fmt.Sprintf("%d current / %d updated / %d available", deploymentConfig.Status.Replicas, deploymentConfig.Status.UpdatedReplicas, deploymentConfig.Status.AvailableReplicas))
+		}
+		if len(deploymentConfig.Status.Conditions) > 0 {
+			fmt.Fprint(out, "Conditions:\n  Type\tStatus\tReason\tMessage\n")
+			fmt.Fprint(out, "  ----\t------\t------\t-------\n")
+			for _, condition := range deploymentConfig.Status.Conditions {
+				fmt.Fprintf(out, "  %s\t%s\t%s\t%s\n", condition.Type, condition.Status, condition.Reason, condition.Message)
+			}
+			fmt.Fprintln(out)
+		}
 		if deploymentConfig.Status.Details != nil && len(deploymentConfig.Status.Details.Message) > 0 {
 			fmt.Fprintf(out, "Warning:\t%s\n", deploymentConfig.Status.Details.Message)
 		}
@@ -200,6 +214,33 @@ func printStrategy(strategy deployapi.DeploymentStrategy, w *tabwriter.Writer) {
 				printHook("Post-deployment", post, w)
 			}
 		}
+	case deployapi.DeploymentStrategyTypeCanary:
+		if strategy.CanaryParams != nil {
+			pre := strategy.CanaryParams.Pre
+			post := strategy.CanaryParams.Post
+			if len(strategy.CanaryParams.Traffic) > 0 {
+				fmt.Fprintf(w, "\t  Traffic:\t%s\n", strategy.CanaryParams.Traffic)
+			}
+			if len(strategy.CanaryParams.RouteName) > 0 {
+				fmt.Fprintf(w, "\t  Route:\t%s\n", strategy.CanaryParams.RouteName)
+			}
+			if pre != nil {
+				printHook("Pre-deployment", pre, w)
+			}
+			for i, step := range strategy.CanaryParams.Steps {
+				approval := ""
+				if step.Approve {
+					approval = ", approval required"
+				}
+				fmt.Fprintf(w, "\t  Step %d:\t%d%%%s\n", i+1, step.Weight, approval)
+				if step.Verify != nil {
+					printHook(fmt.Sprintf("Step %d verification", i+1), step.Verify, w)
+				}
+			}
+			if post != nil {
+				printHook("Post-deployment", post, w)
+			}
+		}
 	case deployapi.DeploymentStrategyTypeCustom:
 		fmt.Fprintf(w, "\t  Image:\t%s\n", strategy.CustomParams.Image)
 
@@ -219,6 +260,32 @@ func printHook(prefix string, hook *deployapi.LifecycleHook, w io.Writer) {
 		fmt.Fprintf(w, "\t    Container:\t%s\n", hook.ExecNewPod.ContainerName)
 		fmt.Fprintf(w, "\t    Command:\t%v\n", strings.Join(hook.ExecNewPod.Command, " "))
 		fmt.Fprintf(w, "\t    Env:\t%s\n", formatLabels(convertEnv(hook.ExecNewPod.Env)))
+		if len(hook.ExecNewPod.ServiceAccountName) > 0 {
+			fmt.Fprintf(w, "\t    Service Account:\t%s\n", hook.ExecNewPod.ServiceAccountName)
+		}
+		if len(hook.ExecNewPod.NodeSelector) > 0 {
+			fmt.Fprintf(w, "\t    Node Selector:\t%s\n", formatLabels(hook.ExecNewPod.NodeSelector))
+		}
+		for _, volume := range hook.ExecNewPod.ExtraVolumes {
+			source := "empty dir"
+			if volume.Secret != nil {
+				source = "secret " + volume.Secret.SecretName
+			}
+			fmt.Fprintf(w, "\t    Volume %s:\t%s at %s\n", volume.Name, source, volume.MountPath)
+		}
+	}
+	if hook.HTTPGet != nil {
+		fmt.Fprintf(w, "\t  %s hook (http type, failure policy: %s):\n", prefix, hook.FailurePolicy)
+		fmt.Fprintf(w, "\t    URL:\t%s\n", hook.HTTPGet.URL)
+	}
+	if hook.WaitForImage != nil {
+		fmt.Fprintf(w, "\t  %s hook (image gate type, failure policy: %s):\n", prefix, hook.FailurePolicy)
+		fmt.Fprintf(w, "\t    Image Stream Tag:\t%s\n", hook.WaitForImage.From.Name)
+		if len(hook.WaitForImage.ContainerName) > 0 {
+			fmt.Fprintf(w, "\t    Image Of Container:\t%s\n", hook.WaitForImage.ContainerName)
+		} else {
+			fmt.Fprintf(w, "\t    Digest:\t%s\n", hook.WaitForImage.Digest)
+		}
 	}
 }
 
@@ -239,6 +306,14 @@ func printTriggers(triggers []deployapi.DeploymentTriggerPolicy, w *tabwriter.Wr
 				name, tag, _ := imageapi.SplitImageStreamTag(t.ImageChangeParams.From.Name)
 				labels = append(labels, fmt.Sprintf("Image(%s@%s, auto=%v)", name, tag, t.ImageChangeParams.Automatic))
 			}
+		case deployapi.DeploymentTriggerOnSecretChange:
+			if t.SecretChangeParams != nil {
+				labels = append(labels, fmt.Sprintf("Secret(%s)", t.SecretChangeParams.Name))
+			}
+		case deployapi.DeploymentTriggerOnConfigMapChange:
+			if t.ConfigMapChangeParams != nil {
+				labels = append(labels, fmt.Sprintf("ConfigMap(%s)", t.ConfigMapChangeParams.Name))
+			}
 		}
 	}
 
@@ -257,6 +332,17 @@ func printDeploymentConfigSpec(spec deployapi.DeploymentConfigSpec, w *tabwriter
 	}
 	formatString(w, "Replicas", fmt.Sprintf("%d%s", spec.Replicas, test))
 
+	// Progress deadline and rollback
+	if spec.ProgressDeadlineSeconds != nil {
+		formatString(w, "Progress Deadline", fmt.Sprintf("%ds", *spec.ProgressDeadlineSeconds))
+	}
+	if spec.AutoRollback {
+		formatString(w, "Auto Rollback", "yes, failed deployments are rolled back to the last complete deployment")
+	}
+	if spec.RevisionHistoryLimit != nil {
+		formatString(w, "Revision History Limit", strconv.Itoa(*spec.RevisionHistoryLimit))
+	}
+
 	// Triggers
 	printTriggers(spec.Triggers, w)
 
@@ -283,6 +369,9 @@ func printDeploymentRc(deployment *kapi.ReplicationController, client deployment
 	fmt.Fprintf(w, "\tCreated:\t%s ago\n", timeAt)
 	fmt.Fprintf(w, "\tStatus:\t%s\n", deployutil.DeploymentStatusFor(deployment))
 	fmt.Fprintf(w, "\tReplicas:\t%d current / %d desired\n", deployment.Status.Replicas, deployment.Spec.Replicas)
+	if step, ok := deployment.Annotations[deployapi.CanaryStepAnnotation]; ok && !deployutil.IsTerminatedDeployment(deployment) {
+		fmt.Fprintf(w, "\tCanary Step:\t%s\n", step)
+	}
 
 	if verbose {
 		fmt.Fprintf(w, "\tSelector:\t%s\n", formatLabels(deployment.Spec.Selector))
@@ -392,6 +481,31 @@ func (d *LatestDeploymentsDescriber) Describe(namespace, name string) (string, e
 	})
 }
 
+// DescribeRollbackDiff returns a human-readable table of the changes a
+// rollback makes to a deployment config.
+func DescribeRollbackDiff(diff *deployapi.DeploymentConfigRollbackDiff) (string, error) {
+	return tabbedString(func(out *tabwriter.Writer) error {
+		if len(diff.Changes) == 0 {
+			formatString(out, "Changes", "<none>")
+			return nil
+		}
+		fmt.Fprintf(out, "Changes:\n")
+		fmt.Fprintf(out, "  Type\tField\tCurrent\tRolled Back\n")
+		fmt.Fprintf(out, "  ----\t-----\t-------\t-----------\n")
+		for _, change := range diff.Changes {
+			current, rolledBack := change.Current, change.RolledBack
+			if len(current) == 0 {
+				current = "<none>"
+			}
+			if len(rolledBack) == 0 {
+				rolledBack = "<none>"
+			}
+			fmt.Fprintf(out, "  %s\t%s\t%s\t%s\n", change.Type, change.Field, current, rolledBack)
+		}
+		return nil
+	})
+}
+
 type rcSorter []kapi.ReplicationController
 
 func (s rcSorter) Len() int {
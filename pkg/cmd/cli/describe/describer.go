@@ -135,13 +135,17 @@ func (d *BuildDescriber) Describe(namespace, name string) (string, error) {
 		// Create the time object with second-level precision so we don't get
 		// output like "duration: 1.2724395728934s"
 		formatString(out, "Duration", describeBuildDuration(build))
-		formatString(out, "Build Pod", buildutil.GetBuildPodName(build))
+		if !buildutil.IsPipelineBuild(build) {
+			formatString(out, "Build Pod", buildutil.GetBuildPodName(build))
+		}
 		describeBuildSpec(build.Spec, out)
 		status := bold(build.Status.Phase)
 		if build.Status.Message != "" {
 			status += " (" + build.Status.Message + ")"
 		}
 		formatString(out, "Status", status)
+		describeBuildStages(build.Status.Stages, out)
+		describeBuildOutputs(build.Status.Outputs, out)
 		kctl.DescribeEvents(events, out)
 
 		return nil
@@ -248,11 +252,20 @@ func describeBuildSpec(p buildapi.BuildSpec, out *tabwriter.Writer) {
 		describeSourceStrategy(p.Strategy.SourceStrategy, out)
 	case p.Strategy.CustomStrategy != nil:
 		describeCustomStrategy(p.Strategy.CustomStrategy, out)
+	case p.Strategy.JenkinsPipelineStrategy != nil:
+		describeJenkinsPipelineStrategy(p.Strategy.JenkinsPipelineStrategy, out)
 	}
 
 	if p.Output.To != nil {
 		formatString(out, "Output to", fmt.Sprintf("%s %s", p.Output.To.Kind, nameAndNamespace(p.Output.To.Namespace, p.Output.To.Name)))
 	}
+	for _, destination := range p.Output.Destinations {
+		to := fmt.Sprintf("%s %s", destination.To.Kind, nameAndNamespace(destination.To.Namespace, destination.To.Name))
+		if destination.PushSecret != nil {
+			to = fmt.Sprintf("%s (push secret %s)", to, destination.PushSecret.Name)
+		}
+		formatString(out, "Also Output to", to)
+	}
 
 	describePostCommitHook(p.PostCommit, out)
 
@@ -274,6 +287,73 @@ func describeBuildSpec(p buildapi.BuildSpec, out *tabwriter.Writer) {
 	if p.CompletionDeadlineSeconds != nil {
 		formatString(out, "Fail Build After", time.Duration(*p.CompletionDeadlineSeconds)*time.Second)
 	}
+
+	if p.Cache != nil {
+		describeBuildCache(p.Cache, out)
+	}
+
+	if p.CommitStatus != nil {
+		formatString(out, "Commit Status", fmt.Sprintf("%s (secret %s)", p.CommitStatus.Provider, p.CommitStatus.Secret.Name))
+	}
+}
+
+func describeBuildCache(c *buildapi.BuildCache, out *tabwriter.Writer) {
+	switch {
+	case c.PersistentVolumeClaim != nil:
+		formatString(out, "Build Cache", fmt.Sprintf("persistent volume claim %s", c.PersistentVolumeClaim.Name))
+	case c.Image != nil:
+		formatString(out, "Build Cache", fmt.Sprintf("%s %s", c.Image.Kind, nameAndNamespace(c.Image.Namespace, c.Image.Name)))
+	}
+	for _, path := range c.Paths {
+		fmt.Fprintf(out, "\t- %s -> %s\n", path.SourcePath, path.DestinationDir)
+	}
+	if len(c.KeyFiles) > 0 {
+		formatString(out, "Build Cache Key Files", strings.Join(c.KeyFiles, ", "))
+	}
+}
+
+func describeJenkinsPipelineStrategy(s *buildapi.JenkinsPipelineBuildStrategy, out *tabwriter.Writer) {
+	if len(s.JenkinsfilePath) != 0 {
+		formatString(out, "Jenkinsfile Path", s.JenkinsfilePath)
+	}
+	if len(s.Jenkinsfile) != 0 {
+		fmt.Fprintf(out, "Jenkinsfile:\n")
+		for _, line := range strings.Split(s.Jenkinsfile, "\n") {
+			fmt.Fprintf(out, "  %s\n", line)
+		}
+	}
+}
+
+func describeBuildStages(stages []buildapi.StageInfo, out *tabwriter.Writer) {
+	if len(stages) == 0 {
+		return
+	}
+	fmt.Fprintf(out, "Stages:\n")
+	fmt.Fprintf(out, "  Name\tStatus\tDuration\n")
+	fmt.Fprintf(out, "  ----\t------\t--------\n")
+	for _, stage := range stages {
+		duration := "<unknown>"
+		if stage.StartTimestamp != nil && stage.CompletionTimestamp != nil {
+			duration = stage.CompletionTimestamp.Sub(stage.StartTimestamp.Time).String()
+		}
+		fmt.Fprintf(out, "  %s\t%s\t%s\n", stage.Name, stage.Phase, duration)
+	}
+}
+
+func describeBuildOutputs(outputs []buildapi.BuildStatusOutput, out *tabwriter.Writer) {
+	if len(outputs) == 0 {
+		return
+	}
+	fmt.Fprintf(out, "Pushed Images:\n")
+	fmt.Fprintf(out, "  Image\tDigest\n")
+	fmt.Fprintf(out, "  -----\t------\n")
+	for _, output := range outputs {
+		digest := output.ImageDigest
+		if len(digest) == 0 {
+			digest = "<unknown>"
+		}
+		fmt.Fprintf(out, "  %s\t%s\n", output.DockerImageReference, digest)
+	}
 }
 
 func describePostCommitHook(hook buildapi.BuildPostCommitSpec, out *tabwriter.Writer) {
@@ -336,6 +416,13 @@ func describeDockerStrategy(s *buildapi.DockerBuildStrategy, out *tabwriter.Writ
 	if s.ForcePull {
 		formatString(out, "Force Pull", "true")
 	}
+	if len(s.BuildArgs) > 0 {
+		names := []string{}
+		for _, arg := range s.BuildArgs {
+			names = append(names, arg.Name)
+		}
+		formatString(out, "Build Args", strings.Join(names, ", "))
+	}
 }
 
 func describeCustomStrategy(s *buildapi.CustomBuildStrategy, out *tabwriter.Writer) {
@@ -368,6 +455,16 @@ func (d *BuildConfigDescriber) DescribeTriggers(bc *buildapi.BuildConfig, out *t
 		t := strings.Title(whType)
 		formatString(out, "Webhook "+t, whURL)
 	}
+	for _, t := range bc.Spec.Triggers {
+		if t.GitHubWebHook == nil || t.GitHubWebHook.PullRequests == nil {
+			continue
+		}
+		if prefix := t.GitHubWebHook.PullRequests.OutputTagPrefix; len(prefix) > 0 {
+			formatString(out, "Pull Request Builds", fmt.Sprintf("pushed to tag %s<number>", prefix))
+		} else {
+			formatString(out, "Pull Request Builds", "not pushed")
+		}
+	}
 }
 
 func describeBuildTriggers(triggers []buildapi.BuildTriggerPolicy, w *tabwriter.Writer) {
@@ -380,7 +477,8 @@ func describeBuildTriggers(triggers []buildapi.BuildTriggerPolicy, w *tabwriter.
 
 	for _, t := range triggers {
 		switch t.Type {
-		case buildapi.GitHubWebHookBuildTriggerType, buildapi.GenericWebHookBuildTriggerType:
+		case buildapi.GitHubWebHookBuildTriggerType, buildapi.GenericWebHookBuildTriggerType,
+			buildapi.GitLabWebHookBuildTriggerType, buildapi.BitbucketWebHookBuildTriggerType:
 			continue
 		case buildapi.ConfigChangeBuildTriggerType:
 			labels = append(labels, "Config")
@@ -421,6 +519,15 @@ func (d *BuildConfigDescriber) Describe(namespace, name string) (string, error)
 		} else {
 			formatString(out, "Latest Version", strconv.Itoa(buildConfig.Status.LastVersion))
 		}
+		if len(buildConfig.Spec.RunPolicy) > 0 {
+			formatString(out, "Run Policy", buildConfig.Spec.RunPolicy)
+		}
+		if buildConfig.Spec.SuccessfulBuildsHistoryLimit != nil {
+			formatString(out, "Successful Builds History Limit", *buildConfig.Spec.SuccessfulBuildsHistoryLimit)
+		}
+		if buildConfig.Spec.FailedBuildsHistoryLimit != nil {
+			formatString(out, "Failed Builds History Limit", *buildConfig.Spec.FailedBuildsHistoryLimit)
+		}
 		describeBuildSpec(buildConfig.Spec.BuildSpec, out)
 		d.DescribeTriggers(buildConfig, out)
 		if len(buildList.Items) == 0 {
@@ -657,6 +764,9 @@ func (d *RouteDescriber) Describe(namespace, name string) (string, error) {
 			}
 		}
 		formatString(out, "Path", route.Spec.Path)
+		if route.Spec.WildcardPolicy == routeapi.WildcardPolicySubdomain {
+			formatString(out, "Wildcard Policy", route.Spec.WildcardPolicy)
+		}
 
 		tlsTerm := ""
 		insecurePolicy := ""
@@ -668,6 +778,14 @@ func (d *RouteDescriber) Describe(namespace, name string) (string, error) {
 		formatString(out, "Insecure Policy", insecurePolicy)
 
 		formatString(out, "Service", route.Spec.To.Name)
+		if len(route.Spec.AlternateBackends) > 0 {
+			formatString(out, "Weight", routeapi.BackendWeight(route.Spec.Weight))
+			backends := []string{}
+			for _, backend := range route.Spec.AlternateBackends {
+				backends = append(backends, fmt.Sprintf("%s (weight %d)", backend.Name, routeapi.BackendWeight(backend.Weight)))
+			}
+			formatString(out, "Alternate Backends", strings.Join(backends, ", "))
+		}
 		if route.Spec.Port != nil {
 			formatString(out, "Endpoint Port", route.Spec.Port.TargetPort.String())
 		} else {
@@ -50,6 +50,7 @@ var DescriberCoverageExceptions = []reflect.Type{
 	reflect.TypeOf(&buildapi.BinaryBuildRequestOptions{}),             // normal users don't ever look at these
 	reflect.TypeOf(&buildapi.BuildRequest{}),                          // normal users don't ever look at these
 	reflect.TypeOf(&deployapi.DeploymentConfigRollback{}),             // normal users don't ever look at these
+	reflect.TypeOf(&deployapi.DeploymentConfigRollbackDiff{}),         // described by oc rollback --dry-run
 	reflect.TypeOf(&deployapi.DeploymentLog{}),                        // normal users don't ever look at these
 	reflect.TypeOf(&deployapi.DeploymentLogOptions{}),                 // normal users don't ever look at these
 	reflect.TypeOf(&imageapi.DockerImage{}),                           // not a top level resource
@@ -183,6 +184,12 @@ func TestDeploymentConfigDescriber(t *testing.T) {
 	config.Spec.Triggers = append(config.Spec.Triggers, deployapitest.OkConfigChangeTrigger())
 	describe()
 
+	config.Spec.Triggers = append(config.Spec.Triggers, deployapi.DeploymentTriggerPolicy{
+		Type:               deployapi.DeploymentTriggerOnSecretChange,
+		SecretChangeParams: &deployapi.DeploymentTriggerObjectChangeParams{Name: "credentials"},
+	})
+	describe()
+
 	config.Spec.Strategy = deployapitest.OkCustomStrategy()
 	describe()
 
@@ -221,6 +228,40 @@ func TestDeploymentConfigDescriber(t *testing.T) {
 	describe()
 }
 
+func TestDescribeRollbackDiff(t *testing.T) {
+	diff := &deployapi.DeploymentConfigRollbackDiff{
+		Name: "config",
+		Changes: []deployapi.DeploymentConfigChange{
+			{Type: deployapi.DeploymentConfigChangeImage, Field: "spec.template.spec.containers[app].image", Current: "app:v2", RolledBack: "app:v1"},
+			{Type: deployapi.DeploymentConfigChangeEnv, Field: "spec.template.spec.containers[app].env[DEBUG]", Current: "1"},
+		},
+	}
+	out, err := DescribeRollbackDiff(diff)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	lines := strings.Split(out, "\n")
+	if len(lines) < 5 {
+		t.Fatalf("expected a table of the changes, got:\n%s", out)
+	}
+	for i, expected := range [][]string{
+		{"Image", "spec.template.spec.containers[app].image", "app:v2", "app:v1"},
+		{"Env", "spec.template.spec.containers[app].env[DEBUG]", "1", "<none>"},
+	} {
+		if fields := strings.Fields(lines[i+3]); !reflect.DeepEqual(fields, expected) {
+			t.Errorf("expected change %v, got %v", expected, fields)
+		}
+	}
+
+	out, err = DescribeRollbackDiff(&deployapi.DeploymentConfigRollbackDiff{Name: "config"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(out, "<none>") {
+		t.Errorf("expected no changes to be described, got:\n%s", out)
+	}
+}
+
 func TestDescribeBuildDuration(t *testing.T) {
 	type testBuild struct {
 		build  *buildapi.Build
@@ -140,14 +140,19 @@ func formatMeta(out *tabwriter.Writer, m api.ObjectMeta) {
 func webhookURL(c *buildapi.BuildConfig, cli client.BuildConfigsNamespacer) map[string]string {
 	result := map[string]string{}
 	for _, trigger := range c.Spec.Triggers {
-		whTrigger := ""
+		hasSecret := false
 		switch trigger.Type {
 		case buildapi.GitHubWebHookBuildTriggerType:
-			whTrigger = trigger.GitHubWebHook.Secret
+			// hooks verifying payload signatures may have no secret in the URL
+			hasSecret = trigger.GitHubWebHook.Secret != "" || trigger.GitHubWebHook.SecretReference != nil
 		case buildapi.GenericWebHookBuildTriggerType:
-			whTrigger = trigger.GenericWebHook.Secret
+			hasSecret = trigger.GenericWebHook.Secret != ""
+		case buildapi.GitLabWebHookBuildTriggerType:
+			hasSecret = trigger.GitLabWebHook.Secret != ""
+		case buildapi.BitbucketWebHookBuildTriggerType:
+			hasSecret = trigger.BitbucketWebHook.Secret != ""
 		}
-		if len(whTrigger) == 0 {
+		if !hasSecret {
 			continue
 		}
 		out := ""
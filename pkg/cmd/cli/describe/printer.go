@@ -484,6 +484,13 @@ func printRoute(route *routeapi.Route, w io.Writer, opts kctl.PrintOptions) erro
 		policy = ""
 	}
 	svc := route.Spec.To.Name
+	if len(route.Spec.AlternateBackends) > 0 {
+		backends := []string{fmt.Sprintf("%s(%d)", svc, routeapi.BackendWeight(route.Spec.Weight))}
+		for _, backend := range route.Spec.AlternateBackends {
+			backends = append(backends, fmt.Sprintf("%s(%d)", backend.Name, routeapi.BackendWeight(backend.Weight)))
+		}
+		svc = strings.Join(backends, ",")
+	}
 	if route.Spec.Port != nil {
 		svc = fmt.Sprintf("%s:%s", svc, route.Spec.Port.TargetPort.String())
 	}
@@ -538,6 +545,14 @@ func printDeploymentConfig(dc *deployapi.DeploymentConfig, w io.Writer, opts kct
 					triggers.Insert(fmt.Sprintf("%s(%s%s)", p.From.Kind, prefix, p.From.Name))
 				}
 			}
+		case deployapi.DeploymentTriggerOnSecretChange:
+			if p := trigger.SecretChangeParams; p != nil {
+				triggers.Insert(fmt.Sprintf("secret(%s)", p.Name))
+			}
+		case deployapi.DeploymentTriggerOnConfigMapChange:
+			if p := trigger.ConfigMapChangeParams; p != nil {
+				triggers.Insert(fmt.Sprintf("configmap(%s)", p.Name))
+			}
 		default:
 			triggers.Insert(string(t))
 		}
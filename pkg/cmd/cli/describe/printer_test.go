@@ -43,6 +43,7 @@ var PrinterCoverageExceptions = []reflect.Type{
 	reflect.TypeOf(&buildapi.BinaryBuildRequestOptions{}),
 	reflect.TypeOf(&buildapi.BuildRequest{}),
 	reflect.TypeOf(&buildapi.BuildLogOptions{}),
+	reflect.TypeOf(&deployapi.DeploymentConfigRollbackDiff{}),
 }
 
 // MissingPrinterCoverageExceptions is the list of types that were missing printer methods when I started
@@ -725,6 +725,15 @@ func describeBuildInPipeline(f formatter, build *buildapi.BuildConfig, baseImage
 			return fmt.Sprintf("bc/%s custom build ", build.Name)
 		}
 		return fmt.Sprintf("bc/%s custom build of %s", build.Name, source)
+	case build.Spec.Strategy.JenkinsPipelineStrategy != nil:
+		if len(build.Spec.Strategy.JenkinsPipelineStrategy.Jenkinsfile) != 0 {
+			return fmt.Sprintf("bc/%s pipeline build with an inline Jenkinsfile", build.Name)
+		}
+		source, ok := describeSourceInPipeline(&build.Spec.Source)
+		if !ok {
+			return fmt.Sprintf("bc/%s unconfigured pipeline build", build.Name)
+		}
+		return fmt.Sprintf("bc/%s pipeline build of %s", build.Name, source)
 	default:
 		return fmt.Sprintf("bc/%s unrecognized build", build.Name)
 	}
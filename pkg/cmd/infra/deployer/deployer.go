@@ -18,8 +18,10 @@ import (
 	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
 	"github.com/openshift/origin/pkg/deploy/strategy"
+	"github.com/openshift/origin/pkg/deploy/strategy/canary"
 	"github.com/openshift/origin/pkg/deploy/strategy/recreate"
 	"github.com/openshift/origin/pkg/deploy/strategy/rolling"
+	stratsupport "github.com/openshift/origin/pkg/deploy/strategy/support"
 	deployutil "github.com/openshift/origin/pkg/deploy/util"
 	"github.com/openshift/origin/pkg/version"
 )
@@ -92,6 +94,9 @@ func NewDeployer(client kclient.Interface, oclient client.Interface) *Deployer {
 			return client.ReplicationControllers(namespace).List(kapi.ListOptions{LabelSelector: deployutil.ConfigSelector(configName)})
 		},
 		scaler: scaler,
+		getUpdateAcceptor: func(replicas int, timeout time.Duration) strategy.UpdateAcceptor {
+			return stratsupport.NewAcceptReadyReplicas(client, replicas, timeout, AcceptorInterval)
+		},
 		strategyFor: func(config *deployapi.DeploymentConfig) (strategy.DeploymentStrategy, error) {
 			switch config.Spec.Strategy.Type {
 			case deployapi.DeploymentStrategyTypeRecreate:
@@ -99,6 +104,8 @@ func NewDeployer(client kclient.Interface, oclient client.Interface) *Deployer {
 			case deployapi.DeploymentStrategyTypeRolling:
 				recreate := recreate.NewRecreateDeploymentStrategy(client, oclient, kapi.Codecs.UniversalDecoder())
 				return rolling.NewRollingDeploymentStrategy(config.Namespace, client, oclient, kapi.Codecs.UniversalDecoder(), recreate), nil
+			case deployapi.DeploymentStrategyTypeCanary:
+				return canary.NewCanaryDeploymentStrategy(client, oclient, kapi.Codecs.UniversalDecoder()), nil
 			default:
 				return nil, fmt.Errorf("unsupported strategy type: %s", config.Spec.Strategy.Type)
 			}
@@ -114,6 +121,8 @@ func NewDeployer(client kclient.Interface, oclient client.Interface) *Deployer {
 // the last complete deployment.
 // 4. Pass the last completed deployment and the new deployment to a strategy
 // to perform the deployment.
+// 5. Wait for all the replicas of the new deployment to be ready if the config
+// has a progress deadline.
 type Deployer struct {
 	// strategyFor returns a DeploymentStrategy for config.
 	strategyFor func(config *deployapi.DeploymentConfig) (strategy.DeploymentStrategy, error)
@@ -123,10 +132,20 @@ type Deployer struct {
 	getDeployments func(namespace, configName string) (*kapi.ReplicationControllerList, error)
 	// scaler is used to scale replication controllers.
 	scaler kubectl.Scaler
+	// getUpdateAcceptor returns an UpdateAcceptor to verify that the given
+	// number of replicas of the new deployment are ready once the strategy is
+	// done.
+	getUpdateAcceptor func(replicas int, timeout time.Duration) strategy.UpdateAcceptor
 }
 
+// AcceptorInterval is how often the UpdateAcceptor should check for
+// readiness.
+const AcceptorInterval = 1 * time.Second
+
 // Deploy starts the deployment process for deploymentName.
 func (d *Deployer) Deploy(namespace, deploymentName string) error {
+	start := time.Now()
+
 	// Look up the new deployment.
 	to, err := d.getDeployment(namespace, deploymentName)
 	if err != nil {
@@ -201,5 +220,21 @@ func (d *Deployer) Deploy(namespace, deploymentName string) error {
 	} else {
 		glog.Infof("Deploying from %s to %s (replicas: %d)", deployutil.LabelForDeployment(from), deployutil.LabelForDeployment(to), desiredReplicas)
 	}
-	return strategy.Deploy(from, to, desiredReplicas)
+	if err := strategy.Deploy(from, to, desiredReplicas); err != nil {
+		return err
+	}
+
+	// The strategies only verify the first replicas of the new deployment, a
+	// deployment with a progress deadline needs all of them to be ready.
+	if deadline := config.Spec.ProgressDeadlineSeconds; deadline != nil && desiredReplicas > 0 {
+		remaining := time.Duration(*deadline)*time.Second - time.Since(start)
+		if remaining < AcceptorInterval {
+			remaining = AcceptorInterval
+		}
+		glog.Infof("Waiting for all the replicas of %s to be ready", deployutil.LabelForDeployment(to))
+		if err := d.getUpdateAcceptor(desiredReplicas, remaining).Accept(to); err != nil {
+			return fmt.Errorf("deployment %s didn't make progress within %d seconds: %v", deployutil.LabelForDeployment(to), *deadline, err)
+		}
+	}
+	return nil
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"testing"
+	"time"
 
 	kapi "k8s.io/kubernetes/pkg/api"
 
@@ -193,6 +194,52 @@ func TestDeployer_deployScenarios(t *testing.T) {
 	}
 }
 
+// TestDeployer_progressDeadline ensures that all the replicas of a deployment
+// with a progress deadline are verified once the strategy is done.
+func TestDeployer_progressDeadline(t *testing.T) {
+	deadline := int64(600)
+	config := deploytest.OkDeploymentConfig(1)
+	config.Spec.ProgressDeadlineSeconds = &deadline
+	to, _ := deployutil.MakeDeployment(config, kapi.Codecs.LegacyCodec(deployapi.SchemeGroupVersion))
+
+	var acceptTimeout time.Duration
+	acceptReplicas := 0
+	deployer := &Deployer{
+		strategyFor: func(config *deployapi.DeploymentConfig) (strategy.DeploymentStrategy, error) {
+			return &testStrategy{
+				deployFunc: func(from *kapi.ReplicationController, to *kapi.ReplicationController, desiredReplicas int) error {
+					return nil
+				},
+			}, nil
+		},
+		getDeployment: func(namespace, name string) (*kapi.ReplicationController, error) {
+			return to, nil
+		},
+		getDeployments: func(namespace, configName string) (*kapi.ReplicationControllerList, error) {
+			return &kapi.ReplicationControllerList{Items: []kapi.ReplicationController{*to}}, nil
+		},
+		scaler: &scalertest.FakeScaler{},
+		getUpdateAcceptor: func(replicas int, timeout time.Duration) strategy.UpdateAcceptor {
+			acceptReplicas, acceptTimeout = replicas, timeout
+			return &testAcceptor{
+				acceptFn: func(deployment *kapi.ReplicationController) error {
+					return fmt.Errorf("pods for deployment %q took longer than %.f seconds to become ready", deployment.Name, timeout.Seconds())
+				},
+			}
+		},
+	}
+
+	if err := deployer.Deploy(to.Namespace, to.Name); err == nil {
+		t.Fatalf("expected an error for a deployment not ready within its deadline")
+	}
+	if acceptTimeout <= 0 || acceptTimeout > time.Duration(deadline)*time.Second {
+		t.Errorf("expected the replicas to be verified within the deadline, got a timeout of %v", acceptTimeout)
+	}
+	if acceptReplicas != config.Spec.Replicas {
+		t.Errorf("expected %d ready replicas to be required, got %d", config.Spec.Replicas, acceptReplicas)
+	}
+}
+
 func mkdeployment(version int, status deployapi.DeploymentStatus) *kapi.ReplicationController {
 	deployment, _ := deployutil.MakeDeployment(deploytest.OkDeploymentConfig(version), kapi.Codecs.LegacyCodec(deployapi.SchemeGroupVersion))
 	deployment.Annotations[deployapi.DeploymentStatusAnnotation] = string(status)
@@ -206,3 +253,11 @@ type testStrategy struct {
 func (t *testStrategy) Deploy(from *kapi.ReplicationController, to *kapi.ReplicationController, desiredReplicas int) error {
 	return t.deployFunc(from, to, desiredReplicas)
 }
+
+type testAcceptor struct {
+	acceptFn func(*kapi.ReplicationController) error
+}
+
+func (t *testAcceptor) Accept(deployment *kapi.ReplicationController) error {
+	return t.acceptFn(deployment)
+}
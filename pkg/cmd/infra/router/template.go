@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/golang/glog"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
@@ -16,6 +17,8 @@ import (
 	"github.com/openshift/origin/pkg/cmd/util"
 	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
 	"github.com/openshift/origin/pkg/router/controller"
+	"github.com/openshift/origin/pkg/router/metrics"
+	haproxymetrics "github.com/openshift/origin/pkg/router/metrics/haproxy"
 	templateplugin "github.com/openshift/origin/pkg/router/template"
 	"github.com/openshift/origin/pkg/util/proc"
 	"github.com/openshift/origin/pkg/version"
@@ -36,6 +39,8 @@ namespaces (no argument). You can limit the routes to those matching a --labels
 that you must have a cluster-wide administrative role to view all namespaces.`
 	// defaultReloadInterval is how often to do reloads in seconds.
 	defaultReloadInterval = 5
+	// defaultRuntimeAPISocket is the HAProxy stats socket of the default template.
+	defaultRuntimeAPISocket = "/var/lib/haproxy/run/haproxy.sock"
 )
 
 type TemplateRouterOptions struct {
@@ -55,6 +60,10 @@ type TemplateRouter struct {
 	DefaultCertificate     string
 	DefaultCertificatePath string
 	RouterService          *ktypes.NamespacedName
+	AllowWildcardRoutes    bool
+	DynamicServerSlots     int
+	RuntimeAPISocket       string
+	MetricsAddress         string
 }
 
 // reloadInterval returns how often to run the router reloads. The interval
@@ -69,6 +78,21 @@ func reloadInterval() time.Duration {
 	return value
 }
 
+// dynamicServerSlots returns the number of server slots to add to each backend from an
+// environment variable, or zero.
+func dynamicServerSlots() int {
+	value := util.Env("ROUTER_DYNAMIC_SERVER_SLOTS", "")
+	if len(value) == 0 {
+		return 0
+	}
+	slots, err := strconv.Atoi(value)
+	if err != nil {
+		glog.Warningf("Invalid ROUTER_DYNAMIC_SERVER_SLOTS %q, applying all changes with reloads ...", value)
+		return 0
+	}
+	return slots
+}
+
 func (o *TemplateRouter) Bind(flag *pflag.FlagSet) {
 	flag.StringVar(&o.RouterName, "name", util.Env("ROUTER_SERVICE_NAME", "public"), "The name the router will identify itself with in the route status")
 	flag.StringVar(&o.WorkingDir, "working-dir", "/var/lib/containers/router", "The working directory for the router plugin")
@@ -77,6 +101,10 @@ func (o *TemplateRouter) Bind(flag *pflag.FlagSet) {
 	flag.StringVar(&o.TemplateFile, "template", util.Env("TEMPLATE_FILE", ""), "The path to the template file to use")
 	flag.StringVar(&o.ReloadScript, "reload", util.Env("RELOAD_SCRIPT", ""), "The path to the reload script to use")
 	flag.DurationVar(&o.ReloadInterval, "interval", reloadInterval(), "Controls how often router reloads are invoked. Mutiple router reload requests are coalesced for the duration of this interval since the last reload time.")
+	flag.BoolVar(&o.AllowWildcardRoutes, "allow-wildcard-routes", util.Env("ROUTER_ALLOW_WILDCARD_ROUTES", "") == "true", "If true, routes with the Subdomain wildcard policy serve all the hosts of the domain of their host that no other route serves")
+	flag.IntVar(&o.DynamicServerSlots, "dynamic-server-slots", dynamicServerSlots(), "The number of disabled servers to add to each backend so endpoint changes can be applied through the runtime API without a reload. Zero reloads the router for all changes. Requires HAProxy 1.7 or later.")
+	flag.StringVar(&o.RuntimeAPISocket, "runtime-api-socket", util.Env("ROUTER_RUNTIME_API_SOCKET", defaultRuntimeAPISocket), "The path to the HAProxy stats socket used to apply endpoint changes when --dynamic-server-slots is set and to read the backend metrics")
+	flag.StringVar(&o.MetricsAddress, "metrics-address", util.Env("ROUTER_METRICS_ADDRESS", ""), "If set, the address to serve the Prometheus metrics of the router and its HAProxy backends on at /metrics, e.g. 0.0.0.0:1935")
 }
 
 type RouterStats struct {
@@ -148,6 +176,10 @@ func (o *TemplateRouterOptions) Complete() error {
 		return fmt.Errorf("invalid reload interval: %v - must be a positive duration", nsecs)
 	}
 
+	if o.DynamicServerSlots < 0 {
+		return fmt.Errorf("invalid dynamic server slots: %d - must not be negative", o.DynamicServerSlots)
+	}
+
 	return o.RouterSelection.Complete()
 }
 
@@ -179,6 +211,9 @@ func (o *TemplateRouterOptions) Run() error {
 		StatsPassword:          o.StatsPassword,
 		PeerService:            o.RouterService,
 		IncludeUDP:             o.RouterSelection.IncludeUDP,
+		AllowWildcardRoutes:    o.AllowWildcardRoutes,
+		DynamicServerSlots:     o.DynamicServerSlots,
+		RuntimeAPISocket:       o.RuntimeAPISocket,
 	}
 
 	templatePlugin, err := templateplugin.NewTemplatePlugin(pluginCfg)
@@ -186,13 +221,18 @@ func (o *TemplateRouterOptions) Run() error {
 		return err
 	}
 
+	if len(o.MetricsAddress) > 0 {
+		prometheus.MustRegister(haproxymetrics.NewExporter(o.RuntimeAPISocket))
+		metrics.Listen(o.MetricsAddress)
+	}
+
 	oc, kc, err := o.Config.Clients()
 	if err != nil {
 		return err
 	}
 
 	statusPlugin := controller.NewStatusAdmitter(templatePlugin, oc, o.RouterName)
-	plugin := controller.NewUniqueHost(statusPlugin, o.RouteSelectionFunc(), statusPlugin)
+	plugin := controller.NewUniqueHost(statusPlugin, o.RouteSelectionFunc(), o.AllowWildcardRoutes, statusPlugin)
 
 	factory := o.RouterSelection.NewFactory(oc, kc)
 	controller := factory.Create(plugin)
@@ -255,6 +255,8 @@ type MasterConfig struct {
 	AssetConfig *AssetConfig
 	// DNSConfig, if present start the DNS server in this process
 	DNSConfig *DNSConfig
+	// BuildLogArchiveConfig, if present archive the logs of completed builds
+	BuildLogArchiveConfig *BuildLogArchiveConfig
 
 	// ServiceAccountConfig holds options related to service accounts
 	ServiceAccountConfig ServiceAccountConfig
@@ -499,6 +501,20 @@ type DNSConfig struct {
 	AllowRecursiveQueries bool
 }
 
+const (
+	// BuildLogArchiveBackendDirectory archives build logs to a local directory
+	BuildLogArchiveBackendDirectory = "Directory"
+	// BuildLogArchiveBackendMemory archives build logs in memory, they are lost when the master restarts
+	BuildLogArchiveBackendMemory = "Memory"
+)
+
+type BuildLogArchiveConfig struct {
+	// Backend is the storage the logs of completed builds are archived to: "Directory" or "Memory"
+	Backend string
+	// Directory is the directory build logs are archived to when the backend is "Directory"
+	Directory string
+}
+
 type AssetConfig struct {
 	ServingInfo HTTPServingInfo
 
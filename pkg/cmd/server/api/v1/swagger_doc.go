@@ -95,6 +95,16 @@ func (BasicAuthPasswordIdentityProvider) SwaggerDoc() map[string]string {
 	return map_BasicAuthPasswordIdentityProvider
 }
 
+var map_BuildLogArchiveConfig = map[string]string{
+	"":          "BuildLogArchiveConfig holds the necessary configuration options for archiving build logs",
+	"backend":   "Backend is the storage the logs of completed builds are archived to: \"Directory\" or \"Memory\"",
+	"directory": "Directory is the directory build logs are archived to when the backend is \"Directory\"",
+}
+
+func (BuildLogArchiveConfig) SwaggerDoc() map[string]string {
+	return map_BuildLogArchiveConfig
+}
+
 var map_CertInfo = map[string]string{
 	"":         "CertInfo relates a certificate with a private key",
 	"certFile": "CertFile is a file containing a PEM-encoded certificate",
@@ -400,6 +410,7 @@ var map_MasterConfig = map[string]string{
 	"oauthConfig":            "OAuthConfig, if present start the /oauth endpoint in this process",
 	"assetConfig":            "AssetConfig, if present start the asset server in this process",
 	"dnsConfig":              "DNSConfig, if present start the DNS server in this process",
+	"buildLogArchiveConfig":  "BuildLogArchiveConfig, if present archive the logs of completed builds",
 	"serviceAccountConfig":   "ServiceAccountConfig holds options related to service accounts",
 	"masterClients":          "MasterClients holds all the client connection information for controllers and other system components",
 	"imageConfig":            "ImageConfig holds options that describe how to build image names for system components",
@@ -195,6 +195,8 @@ type MasterConfig struct {
 	AssetConfig *AssetConfig `json:"assetConfig"`
 	// DNSConfig, if present start the DNS server in this process
 	DNSConfig *DNSConfig `json:"dnsConfig"`
+	// BuildLogArchiveConfig, if present archive the logs of completed builds
+	BuildLogArchiveConfig *BuildLogArchiveConfig `json:"buildLogArchiveConfig"`
 
 	// ServiceAccountConfig holds options related to service accounts
 	ServiceAccountConfig ServiceAccountConfig `json:"serviceAccountConfig"`
@@ -473,6 +475,14 @@ type DNSConfig struct {
 	AllowRecursiveQueries bool `json:"allowRecursiveQueries"`
 }
 
+// BuildLogArchiveConfig holds the necessary configuration options for archiving build logs
+type BuildLogArchiveConfig struct {
+	// Backend is the storage the logs of completed builds are archived to: "Directory" or "Memory"
+	Backend string `json:"backend"`
+	// Directory is the directory build logs are archived to when the backend is "Directory"
+	Directory string `json:"directory"`
+}
+
 // AssetConfig holds the necessary configuration options for serving assets
 type AssetConfig struct {
 	// ServingInfo is the HTTP serving information for these assets
@@ -102,6 +102,9 @@ assetConfig:
     maxRequestsInFlight: 0
     namedCertificates: null
     requestTimeoutSeconds: 0
+buildLogArchiveConfig:
+  backend: ""
+  directory: ""
 controllerLeaseTTL: 0
 controllers: ""
 corsAllowedOrigins: null
@@ -655,7 +658,8 @@ func TestMasterConfig(t *testing.T) {
 		AssetConfig: &internal.AssetConfig{
 			Extensions: []internal.AssetExtensionsConfig{{}},
 		},
-		DNSConfig: &internal.DNSConfig{},
+		DNSConfig:             &internal.DNSConfig{},
+		BuildLogArchiveConfig: &internal.BuildLogArchiveConfig{},
 		AdmissionConfig: internal.AdmissionConfig{
 			PluginConfig: map[string]internal.AdmissionPluginConfig{ // test config as an embedded object
 				"plugin": {
@@ -106,6 +106,10 @@ func ValidateMasterConfig(config *api.MasterConfig, fldPath *field.Path) Validat
 		}
 	}
 
+	if config.BuildLogArchiveConfig != nil {
+		validationResults.AddErrors(ValidateBuildLogArchiveConfig(config.BuildLogArchiveConfig, fldPath.Child("buildLogArchiveConfig"))...)
+	}
+
 	if config.EtcdConfig != nil {
 		etcdConfigErrs := ValidateEtcdConfig(config.EtcdConfig, fldPath.Child("etcdConfig"))
 		validationResults.Append(etcdConfigErrs)
@@ -395,6 +399,23 @@ func ValidateImageConfig(config api.ImageConfig, fldPath *field.Path) field.Erro
 	return allErrs
 }
 
+func ValidateBuildLogArchiveConfig(config *api.BuildLogArchiveConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	switch config.Backend {
+	case api.BuildLogArchiveBackendDirectory:
+		if len(config.Directory) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("directory"), "required when the backend is "+api.BuildLogArchiveBackendDirectory))
+		}
+	case api.BuildLogArchiveBackendMemory:
+		if len(config.Directory) > 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("directory"), config.Directory, "only valid when the backend is "+api.BuildLogArchiveBackendDirectory))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("backend"), config.Backend, []string{api.BuildLogArchiveBackendDirectory, api.BuildLogArchiveBackendMemory}))
+	}
+	return allErrs
+}
+
 func ValidateImagePolicyConfig(config api.ImagePolicyConfig, fldPath *field.Path) field.ErrorList {
 	errs := field.ErrorList{}
 
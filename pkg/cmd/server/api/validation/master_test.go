@@ -248,3 +248,39 @@ func TestValidateAdmissionPluginConfig(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateBuildLogArchiveConfig(t *testing.T) {
+	tests := []struct {
+		config      configapi.BuildLogArchiveConfig
+		expectError bool
+	}{
+		{
+			config: configapi.BuildLogArchiveConfig{Backend: configapi.BuildLogArchiveBackendDirectory, Directory: "/var/lib/origin/build-logs"},
+		},
+		{
+			config: configapi.BuildLogArchiveConfig{Backend: configapi.BuildLogArchiveBackendMemory},
+		},
+		{
+			config:      configapi.BuildLogArchiveConfig{Backend: configapi.BuildLogArchiveBackendDirectory},
+			expectError: true,
+		},
+		{
+			config:      configapi.BuildLogArchiveConfig{Backend: configapi.BuildLogArchiveBackendMemory, Directory: "/var/lib/origin/build-logs"},
+			expectError: true,
+		},
+		{
+			config:      configapi.BuildLogArchiveConfig{Backend: "S3"},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		errs := ValidateBuildLogArchiveConfig(&tc.config, nil)
+		if len(errs) > 0 && !tc.expectError {
+			t.Errorf("Unexpected error for %#v: %v", tc.config, errs)
+		}
+		if len(errs) == 0 && tc.expectError {
+			t.Errorf("Did not get expected error for: %#v", tc.config)
+		}
+	}
+}
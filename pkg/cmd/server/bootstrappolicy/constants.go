@@ -68,6 +68,7 @@ const (
 	ImageBuilderRoleName      = "system:image-builder"
 	ImagePrunerRoleName       = "system:image-pruner"
 	DeployerRoleName          = "system:deployer"
+	CanaryDeployerRoleName    = "system:canary-deployer"
 	RouterRoleName            = "system:router"
 	RegistryRoleName          = "system:registry"
 	MasterRoleName            = "system:master"
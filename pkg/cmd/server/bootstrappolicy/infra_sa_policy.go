@@ -106,14 +106,20 @@ func init() {
 					Resources: sets.NewString("builds"),
 				},
 				// BuildController.BuildUpdater (OSClientBuildClient)
+				// BuildController.BuildDeleter (OSClientBuildClient)
 				{
-					Verbs:     sets.NewString("update"),
+					Verbs:     sets.NewString("update", "delete"),
 					Resources: sets.NewString("builds"),
 				},
+				// BuildController.BuildConfigGetter (OSClientBuildConfigClient)
+				{
+					Verbs:     sets.NewString("get"),
+					Resources: sets.NewString("buildconfigs"),
+				},
 				// Create permission on virtual build type resources allows builds of those types to be updated
 				{
 					Verbs:     sets.NewString("create"),
-					Resources: sets.NewString("builds/docker", "builds/source", "builds/custom"),
+					Resources: sets.NewString("builds/docker", "builds/source", "builds/custom", "builds/jenkinspipeline"),
 				},
 				// BuildController.ImageStreamClient (ControllerClient)
 				{
@@ -153,7 +159,7 @@ func init() {
 				},
 				// DeploymentControllerFactory.deploymentClient
 				{
-					Verbs:     sets.NewString("get", "update"),
+					Verbs:     sets.NewString("delete", "get", "update"),
 					Resources: sets.NewString("replicationcontrollers"),
 				},
 				// DeploymentController.podClient
@@ -134,6 +134,7 @@ func GetBootstrapClusterRoles() []authorizationapi.ClusterRole {
 						authorizationapi.DockerBuildResource,
 						authorizationapi.SourceBuildResource,
 						authorizationapi.CustomBuildResource,
+						authorizationapi.JenkinsPipelineBuildResource,
 						"deploymentconfigs/scale",
 						"imagestreams/secrets",
 					),
@@ -198,6 +199,7 @@ func GetBootstrapClusterRoles() []authorizationapi.ClusterRole {
 						authorizationapi.DockerBuildResource,
 						authorizationapi.SourceBuildResource,
 						authorizationapi.CustomBuildResource,
+						authorizationapi.JenkinsPipelineBuildResource,
 						"deploymentconfigs/scale",
 						"imagestreams/secrets",
 					),
@@ -395,11 +397,30 @@ func GetBootstrapClusterRoles() []authorizationapi.ClusterRole {
 				},
 				{
 					// Deployer.After.TagImages
-					Verbs:     sets.NewString("update"),
+					// HookExecutor.waitForImage
+					Verbs:     sets.NewString("get", "update"),
 					Resources: sets.NewString("imagestreamtags"),
 				},
 			},
 		},
+		{
+			ObjectMeta: kapi.ObjectMeta{
+				Name: CanaryDeployerRoleName,
+			},
+			Rules: []authorizationapi.PolicyRule{
+				{
+					// CanaryDeploymentStrategy.ensureService
+					// CanaryDeploymentStrategy.removeService
+					Verbs:     sets.NewString("get", "create", "delete"),
+					Resources: sets.NewString("services"),
+				},
+				{
+					// CanaryDeploymentStrategy.setRouteBackends
+					Verbs:     sets.NewString("get", "update"),
+					Resources: sets.NewString("routes"),
+				},
+			},
+		},
 		{
 			ObjectMeta: kapi.ObjectMeta{
 				Name: MasterRoleName,
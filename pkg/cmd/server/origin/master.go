@@ -22,6 +22,7 @@ import (
 	"k8s.io/kubernetes/pkg/apimachinery/registered"
 	v1beta1extensions "k8s.io/kubernetes/pkg/apis/extensions/v1beta1"
 	"k8s.io/kubernetes/pkg/apiserver"
+	"k8s.io/kubernetes/pkg/client/record"
 	"k8s.io/kubernetes/pkg/client/restclient"
 	kclient "k8s.io/kubernetes/pkg/client/unversioned"
 	"k8s.io/kubernetes/pkg/genericapiserver"
@@ -41,8 +42,10 @@ import (
 	buildconfigetcd "github.com/openshift/origin/pkg/build/registry/buildconfig/etcd"
 	buildlogregistry "github.com/openshift/origin/pkg/build/registry/buildlog"
 	"github.com/openshift/origin/pkg/build/webhook"
+	"github.com/openshift/origin/pkg/build/webhook/bitbucket"
 	"github.com/openshift/origin/pkg/build/webhook/generic"
 	"github.com/openshift/origin/pkg/build/webhook/github"
+	"github.com/openshift/origin/pkg/build/webhook/gitlab"
 	"github.com/openshift/origin/pkg/cmd/server/crypto"
 	cmdutil "github.com/openshift/origin/pkg/cmd/util"
 	deployconfiggenerator "github.com/openshift/origin/pkg/deploy/generator"
@@ -372,7 +375,7 @@ func (c *MasterConfig) GetRestStorage() map[string]rest.Storage {
 	buildConfigStorage := buildconfigetcd.NewREST(c.EtcdHelper)
 	buildConfigRegistry := buildconfigregistry.NewRegistry(buildConfigStorage)
 
-	deployConfigStorage, deployConfigScaleStorage := deployconfigetcd.NewREST(c.EtcdHelper, c.DeploymentConfigScaleClient())
+	deployConfigStorage, deployConfigStatusStorage, deployConfigScaleStorage := deployconfigetcd.NewREST(c.EtcdHelper, c.DeploymentConfigScaleClient())
 	deployConfigRegistry := deployconfigregistry.NewRegistry(deployConfigStorage)
 
 	routeAllocator := c.RouteAllocator()
@@ -475,13 +478,20 @@ func (c *MasterConfig) GetRestStorage() map[string]rest.Storage {
 	projectRequestStorage := projectrequeststorage.NewREST(c.Options.ProjectConfig.ProjectRequestMessage, namespace, templateName, c.PrivilegedLoopbackOpenShiftClient, c.PrivilegedLoopbackKubernetesClient)
 
 	bcClient := c.BuildConfigWebHookClient()
+	webHookEventBroadcaster := record.NewBroadcaster()
+	webHookEventBroadcaster.StartRecordingToSink(c.PrivilegedLoopbackKubernetesClient.Events(""))
 	buildConfigWebHooks := buildconfigregistry.NewWebHookREST(
 		buildConfigRegistry,
 		buildclient.NewOSClientBuildConfigInstantiatorClient(bcClient),
+		buildclient.NewOSClientBuildClient(bcClient),
+		buildclient.NewOSClientImageStreamTagClient(bcClient),
 		map[string]webhook.Plugin{
-			"generic": generic.New(),
-			"github":  github.New(),
+			"generic":   generic.New(),
+			"github":    github.New(c.PrivilegedLoopbackKubernetesClient),
+			"gitlab":    gitlab.New(),
+			"bitbucket": bitbucket.New(),
 		},
+		webHookEventBroadcaster.NewRecorder(kapi.EventSource{Component: "buildconfig-webhook"}),
 	)
 
 	storage := map[string]rest.Storage{
@@ -496,6 +506,7 @@ func (c *MasterConfig) GetRestStorage() map[string]rest.Storage {
 
 		"deploymentConfigs":         deployConfigStorage,
 		"deploymentConfigs/scale":   deployConfigScaleStorage,
+		"deploymentConfigs/status":  deployConfigStatusStorage,
 		"generateDeploymentConfigs": deployconfiggenerator.NewREST(deployConfigGenerator, c.EtcdHelper.Codec()),
 		"deploymentConfigRollbacks": deployrollback.NewREST(deployRollbackClient, c.EtcdHelper.Codec()),
 		"deploymentConfigs/log":     deploylogregistry.NewREST(configClient, kclient, c.DeploymentLogClient(), kubeletClient),
@@ -546,7 +557,7 @@ func (c *MasterConfig) GetRestStorage() map[string]rest.Storage {
 		storage["builds/clone"] = buildclone.NewStorage(buildGenerator)
 		storage["buildConfigs/instantiate"] = buildconfiginstantiate.NewStorage(buildGenerator)
 		storage["buildConfigs/instantiatebinary"] = buildconfiginstantiate.NewBinaryStorage(buildGenerator, buildStorage, c.BuildLogClient(), kubeletClient)
-		storage["builds/log"] = buildlogregistry.NewREST(buildStorage, buildStorage, c.BuildLogClient(), kubeletClient)
+		storage["builds/log"] = buildlogregistry.NewREST(buildStorage, buildStorage, c.BuildLogClient(), kubeletClient, c.BuildLogArchive)
 		storage["builds/details"] = buildDetailsStorage
 	}
 
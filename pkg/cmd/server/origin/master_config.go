@@ -46,6 +46,7 @@ import (
 	policybindingregistry "github.com/openshift/origin/pkg/authorization/registry/policybinding"
 	policybindingetcd "github.com/openshift/origin/pkg/authorization/registry/policybinding/etcd"
 	"github.com/openshift/origin/pkg/authorization/rulevalidation"
+	"github.com/openshift/origin/pkg/build/logarchive"
 	osclient "github.com/openshift/origin/pkg/client"
 	oadmission "github.com/openshift/origin/pkg/cmd/server/admission"
 	configapi "github.com/openshift/origin/pkg/cmd/server/api"
@@ -102,6 +103,9 @@ type MasterConfig struct {
 
 	KubeletClientConfig *kubeletclient.KubeletClientConfig
 
+	// BuildLogArchive stores the logs of completed builds, if build log archiving is enabled
+	BuildLogArchive logarchive.Archive
+
 	// ClientCAs will be used to request client certificates in connections to the API.
 	// This CertPool should contain all the CAs that will be used for client certificate verification.
 	ClientCAs *x509.CertPool
@@ -239,6 +243,8 @@ func BuildMasterConfig(options configapi.MasterConfig) (*MasterConfig, error) {
 		EtcdHelper:          etcdHelper,
 		KubeletClientConfig: kubeletClientConfig,
 
+		BuildLogArchive: newBuildLogArchive(options),
+
 		ClientCAs:    clientCAs,
 		APIClientCAs: apiClientCAs,
 
@@ -252,6 +258,21 @@ func BuildMasterConfig(options configapi.MasterConfig) (*MasterConfig, error) {
 	return config, nil
 }
 
+// newBuildLogArchive returns the archive the logs of completed builds are stored
+// in, or nil if build log archiving is not enabled.
+func newBuildLogArchive(options configapi.MasterConfig) logarchive.Archive {
+	if options.BuildLogArchiveConfig == nil {
+		return nil
+	}
+	switch options.BuildLogArchiveConfig.Backend {
+	case configapi.BuildLogArchiveBackendDirectory:
+		return logarchive.NewDirectoryArchive(options.BuildLogArchiveConfig.Directory)
+	case configapi.BuildLogArchiveBackendMemory:
+		return logarchive.NewMemoryArchive()
+	}
+	return nil
+}
+
 func newControllerPlug(options configapi.MasterConfig, client *etcdclient.Client) (plug.Plug, func()) {
 	switch {
 	case options.ControllerLeaseTTL > 0:
@@ -507,6 +528,11 @@ func (c *MasterConfig) DeploymentConfigChangeControllerClients() (*osclient.Clie
 	return c.PrivilegedLoopbackOpenShiftClient, c.PrivilegedLoopbackKubernetesClient
 }
 
+// DeploymentObjectChangeTriggerControllerClients returns the deploymentConfig secret and config map change controller client objects
+func (c *MasterConfig) DeploymentObjectChangeTriggerControllerClients() (*osclient.Client, *kclient.Client) {
+	return c.PrivilegedLoopbackOpenShiftClient, c.PrivilegedLoopbackKubernetesClient
+}
+
 // DeploymentImageChangeTriggerControllerClient returns the deploymentConfig image change controller client object
 func (c *MasterConfig) DeploymentImageChangeTriggerControllerClient() *osclient.Client {
 	return c.PrivilegedLoopbackOpenShiftClient
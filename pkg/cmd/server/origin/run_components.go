@@ -34,6 +34,7 @@ import (
 	deploycontroller "github.com/openshift/origin/pkg/deploy/controller/deployment"
 	deployconfigcontroller "github.com/openshift/origin/pkg/deploy/controller/deploymentconfig"
 	imagechangecontroller "github.com/openshift/origin/pkg/deploy/controller/imagechange"
+	objectchangecontroller "github.com/openshift/origin/pkg/deploy/controller/objectchange"
 	"github.com/openshift/origin/pkg/dns"
 	imagecontroller "github.com/openshift/origin/pkg/image/controller"
 	projectcontroller "github.com/openshift/origin/pkg/project/controller"
@@ -218,10 +219,14 @@ func (c *MasterConfig) RunBuildController() {
 	admissionControl := admission.NewFromPlugins(internalclientset.FromUnversionedClient(c.PrivilegedLoopbackKubernetesClient), []string{"SecurityContextConstraint"}, "")
 
 	osclient, kclient := c.BuildControllerClients()
+	buildClient := buildclient.NewOSClientBuildClient(osclient)
 	factory := buildcontrollerfactory.BuildControllerFactory{
-		OSClient:     osclient,
-		KubeClient:   kclient,
-		BuildUpdater: buildclient.NewOSClientBuildClient(osclient),
+		OSClient:          osclient,
+		KubeClient:        kclient,
+		BuildUpdater:      buildClient,
+		BuildLister:       buildClient,
+		BuildDeleter:      buildClient,
+		BuildConfigGetter: buildclient.NewOSClientBuildConfigClient(osclient),
 		DockerBuildStrategy: &buildstrategy.DockerBuildStrategy{
 			Image: dockerImage,
 			// TODO: this will be set to --storage-version (the internal schema we use)
@@ -237,6 +242,7 @@ func (c *MasterConfig) RunBuildController() {
 			// TODO: this will be set to --storage-version (the internal schema we use)
 			Codec: codec,
 		},
+		LogArchive: c.BuildLogArchive,
 	}
 
 	controller := factory.Create()
@@ -248,10 +254,15 @@ func (c *MasterConfig) RunBuildController() {
 // RunBuildPodController starts the build/pod status sync loop for build status
 func (c *MasterConfig) RunBuildPodController() {
 	osclient, kclient := c.BuildPodControllerClients()
+	buildClient := buildclient.NewOSClientBuildClient(osclient)
 	factory := buildcontrollerfactory.BuildPodControllerFactory{
-		OSClient:     osclient,
-		KubeClient:   kclient,
-		BuildUpdater: buildclient.NewOSClientBuildClient(osclient),
+		OSClient:          osclient,
+		KubeClient:        kclient,
+		BuildUpdater:      buildClient,
+		BuildLister:       buildClient,
+		BuildDeleter:      buildClient,
+		BuildConfigGetter: buildclient.NewOSClientBuildConfigClient(osclient),
+		LogArchive:        c.BuildLogArchive,
 	}
 	controller := factory.Create()
 	controller.Run()
@@ -340,6 +351,17 @@ func (c *MasterConfig) RunDeploymentConfigChangeController() {
 	controller.Run()
 }
 
+// RunDeploymentObjectChangeTriggerController starts the secret and config map change trigger controller process.
+func (c *MasterConfig) RunDeploymentObjectChangeTriggerController() {
+	osclient, kclient := c.DeploymentObjectChangeTriggerControllerClients()
+	factory := objectchangecontroller.ObjectChangeControllerFactory{
+		Client:     osclient,
+		KubeClient: kclient,
+	}
+	controller := factory.Create()
+	controller.Run()
+}
+
 // RunDeploymentImageChangeTriggerController starts the image change trigger controller process.
 func (c *MasterConfig) RunDeploymentImageChangeTriggerController() {
 	osclient := c.DeploymentImageChangeTriggerControllerClient()
@@ -628,6 +628,7 @@ func startControllers(oc *origin.MasterConfig, kc *kubernetes.MasterConfig) erro
 	oc.RunDeploymentConfigController()
 	oc.RunDeploymentConfigChangeController()
 	oc.RunDeploymentImageChangeTriggerController()
+	oc.RunDeploymentObjectChangeTriggerController()
 	oc.RunImageImportController()
 	oc.RunOriginNamespaceController()
 	oc.RunSDNController()
@@ -35,6 +35,8 @@ type DeploymentStrategy struct {
 	RecreateParams *RecreateDeploymentStrategyParams
 	// RollingParams are the input to the Rolling deployment strategy.
 	RollingParams *RollingDeploymentStrategyParams
+	// CanaryParams are the input to the Canary deployment strategy.
+	CanaryParams *CanaryDeploymentStrategyParams
 
 	// Resources contains resource requirements to execute the deployment
 	Resources kapi.ResourceRequirements
@@ -54,6 +56,9 @@ const (
 	DeploymentStrategyTypeCustom DeploymentStrategyType = "Custom"
 	// DeploymentStrategyTypeRolling uses the Kubernetes RollingUpdater.
 	DeploymentStrategyTypeRolling DeploymentStrategyType = "Rolling"
+	// DeploymentStrategyTypeCanary brings up the new deployment alongside the
+	// previous one and shifts replicas to it in steps.
+	DeploymentStrategyTypeCanary DeploymentStrategyType = "Canary"
 )
 
 // CustomDeploymentStrategyParams are the input to the Custom deployment strategy.
@@ -93,6 +98,13 @@ type LifecycleHook struct {
 
 	// TagImages instructs the deployer to tag the current image referenced under a container onto an image stream tag if the deployment succeeds.
 	TagImages []TagImageHook
+
+	// HTTPGet specifies the options for a lifecycle hook calling an HTTP endpoint.
+	HTTPGet *HTTPGetHook
+
+	// WaitForImage specifies the options for a lifecycle hook waiting for an
+	// image stream tag to reference an image.
+	WaitForImage *WaitForImageHook
 }
 
 // LifecycleHookFailurePolicy describes possibles actions to take if a hook fails.
@@ -121,6 +133,35 @@ type ExecNewPodHook struct {
 	// Volumes is a list of named volumes from the pod template which should be
 	// copied to the hook pod.
 	Volumes []string
+	// ExtraVolumes is a list of volumes mounted only into the hook pod's
+	// container, in addition to the ones copied from the pod template.
+	ExtraVolumes []HookVolume
+	// Resources are the compute resources of the hook pod's container. If
+	// unset, the resources of the container named by ContainerName are used.
+	Resources *kapi.ResourceRequirements
+	// ServiceAccountName is the name of the service account the hook pod runs
+	// as. If empty, the default service account is used.
+	ServiceAccountName string
+	// NodeSelector selects the nodes the hook pod may run on. If empty, the
+	// node selector of the pod template is used.
+	NodeSelector map[string]string
+}
+
+// HookVolume is a volume defined only for a hook pod and mounted into its
+// container. Exactly one source of the volume must be set.
+type HookVolume struct {
+	// Name of the volume, which must not be the name of a volume copied from
+	// the pod template.
+	Name string
+	// MountPath is the path within the hook pod's container at which the
+	// volume is mounted.
+	MountPath string
+	// ReadOnly mounts the volume read-only.
+	ReadOnly bool
+	// Secret populates the volume with the keys of a secret.
+	Secret *kapi.SecretVolumeSource
+	// EmptyDir is an empty scratch directory living as long as the hook pod.
+	EmptyDir *kapi.EmptyDirVolumeSource
 }
 
 // TagImageHook is a request to tag the image in a particular container onto an ImageStreamTag.
@@ -131,6 +172,34 @@ type TagImageHook struct {
 	To kapi.ObjectReference
 }
 
+// HTTPGetHook is a hook implementation which sends a GET request to an
+// endpoint and requires a successful (2xx) response.
+type HTTPGetHook struct {
+	// URL is the http or https URL of the endpoint.
+	URL string
+	// TimeoutSeconds is how long to wait for a successful response. If the
+	// failure policy is Retry, unsuccessful requests are repeated until the
+	// timeout. If the value is nil, a default will be used.
+	TimeoutSeconds *int64
+}
+
+// WaitForImageHook is a hook implementation which waits until an
+// ImageStreamTag references an image with a digest, for example until the
+// deployed image has been promoted.
+type WaitForImageHook struct {
+	// From is the ImageStreamTag which must reference the image.
+	From kapi.ObjectReference
+	// Digest is the digest of the image the ImageStreamTag must reference.
+	Digest string
+	// ContainerName is the name of a container in the deployment whose image
+	// digest must be referenced by the ImageStreamTag. It can be set instead
+	// of Digest.
+	ContainerName string
+	// TimeoutSeconds is how long to wait for the ImageStreamTag. If the value
+	// is nil, a default will be used.
+	TimeoutSeconds *int64
+}
+
 // RollingDeploymentStrategyParams are the input to the Rolling deployment
 // strategy.
 type RollingDeploymentStrategyParams struct {
@@ -178,6 +247,74 @@ type RollingDeploymentStrategyParams struct {
 	Post *LifecycleHook
 }
 
+// CanaryDeploymentStrategyParams are the input to the Canary deployment
+// strategy.
+type CanaryDeploymentStrategyParams struct {
+	// TimeoutSeconds is the time to wait for the pods of a step to become
+	// ready, and for a step to be approved, before giving up. If the value is
+	// nil, a default will be used.
+	TimeoutSeconds *int64
+	// Traffic is how the traffic is shifted to the new deployment: Replicas
+	// shifts the replicas, RouteWeight shifts the weights of the deployments
+	// in the route named RouteName and BlueGreen switches the route at once.
+	// Defaults to Replicas.
+	Traffic CanaryTrafficType
+	// RouteName is the name of the route whose traffic is shifted by the
+	// RouteWeight and BlueGreen traffic types. During the deployment the
+	// strategy points the route to a service created for each deployment,
+	// with the ports of the service the route points to. Once the deployment
+	// completes or fails the backends of the route are restored and the
+	// services deleted, so the service of the route must select the pods of
+	// every deployment of the config. The deployer service account needs the
+	// system:canary-deployer role to manage the route and the services.
+	RouteName string
+	// Steps are the successive shares of the replicas or the route traffic
+	// given to the new deployment. The weight of the last step must be 100,
+	// BlueGreen deployments have a single step.
+	Steps []CanaryStep
+	// Pre is a lifecycle hook which is executed before the deployment process
+	// begins. All LifecycleHookFailurePolicy values are supported.
+	Pre *LifecycleHook
+	// Post is a lifecycle hook which is executed after the strategy has
+	// finished all deployment logic.
+	Post *LifecycleHook
+}
+
+// CanaryTrafficType is how the Canary strategy shifts the traffic to the new
+// deployment.
+type CanaryTrafficType string
+
+const (
+	// CanaryTrafficReplicas shifts the replicas from the previous deployment
+	// to the new one by the weights of the steps.
+	CanaryTrafficReplicas CanaryTrafficType = "Replicas"
+	// CanaryTrafficRouteWeight runs the desired replicas in both deployments
+	// and shifts the weights of their services in a route by the weights of
+	// the steps.
+	CanaryTrafficRouteWeight CanaryTrafficType = "RouteWeight"
+	// CanaryTrafficBlueGreen runs the desired replicas in both deployments
+	// and switches a route to the new deployment at once, after its single
+	// step is verified and approved.
+	CanaryTrafficBlueGreen CanaryTrafficType = "BlueGreen"
+)
+
+// CanaryStep is a step of a Canary deployment. With the Replicas traffic type
+// the new deployment is scaled up to its share of the replicas before the
+// previous deployment is scaled down to the rest. If the verification hook of
+// a step fails, the deployment is aborted and the previous deployment restored.
+type CanaryStep struct {
+	// Weight is the percentage of the desired replicas run by the new
+	// deployment, or of the traffic of the route sent to it, during the step.
+	Weight int
+	// Verify is a lifecycle hook which is executed once the replicas of the
+	// step are ready. All LifecycleHookFailurePolicy values are supported.
+	Verify *LifecycleHook
+	// Approve makes the deployment wait, once the step is verified, until the
+	// step is approved by setting the openshift.io/deployment.canary-approved-step
+	// annotation of the new deployment to the number of the step.
+	Approve bool
+}
+
 const (
 	// DefaultRollingTimeoutSeconds is the default TimeoutSeconds for RollingDeploymentStrategyParams.
 	DefaultRollingTimeoutSeconds int64 = 10 * 60
@@ -185,6 +322,10 @@ const (
 	DefaultRollingIntervalSeconds int64 = 1
 	// DefaultRollingUpdatePeriodSeconds is the default PeriodSeconds for RollingDeploymentStrategyParams.
 	DefaultRollingUpdatePeriodSeconds int64 = 1
+	// DefaultHTTPGetHookTimeoutSeconds is the default TimeoutSeconds for HTTPGetHook.
+	DefaultHTTPGetHookTimeoutSeconds int64 = 60
+	// DefaultWaitForImageHookTimeoutSeconds is the default TimeoutSeconds for WaitForImageHook.
+	DefaultWaitForImageHookTimeoutSeconds int64 = 10 * 60
 )
 
 // These constants represent keys used for correlating objects related to deployments.
@@ -236,12 +377,29 @@ const (
 	// DeploymentReplicasAnnotation is for internal use only and is for
 	// detecting external modifications to deployment replica counts.
 	DeploymentReplicasAnnotation = "openshift.io/deployment.replicas"
+	// CanaryStepAnnotation is an annotation on a deployment rolled out by the
+	// Canary strategy. The annotation value is the number of the step the
+	// deployment is in, starting at 1.
+	CanaryStepAnnotation = "openshift.io/deployment.canary-step"
+	// CanaryApprovedStepAnnotation is an annotation set on a deployment rolled
+	// out by the Canary strategy to approve the steps requiring an approval.
+	// The annotation value is the number of the last approved step.
+	CanaryApprovedStepAnnotation = "openshift.io/deployment.canary-approved-step"
+	// CanaryRouteBackendsAnnotation is an annotation set by the Canary strategy
+	// on the route whose traffic it shifts. The annotation value is the JSON of
+	// the backends of the route before the deployment, restored when the
+	// deployment completes or fails, or by the next deployment if the deployer
+	// was interrupted.
+	CanaryRouteBackendsAnnotation = "openshift.io/deployment.canary-route-backends"
 	// PostHookPodSuffix is the suffix added to all pre hook pods
 	PreHookPodSuffix = "hook-pre"
 	// PostHookPodSuffix is the suffix added to all mid hook pods
 	MidHookPodSuffix = "hook-mid"
 	// PostHookPodSuffix is the suffix added to all post hook pods
 	PostHookPodSuffix = "hook-post"
+	// VerifyHookPodSuffix is the suffix added to all canary step verification
+	// hook pods, followed by the number of the step
+	VerifyHookPodSuffix = "hook-verify"
 )
 
 // These constants represent the various reasons for cancelling a deployment
@@ -295,6 +453,26 @@ type DeploymentConfigSpec struct {
 	// or failing. Post strategy hooks and After actions can be used to integrate successful deployment with an action.
 	Test bool
 
+	// Paused indicates that the deployment config is paused. Changes to the
+	// template and to the images of the triggers do not start new deployments
+	// while the config is paused, they are deployed once it is resumed.
+	Paused bool
+
+	// ProgressDeadlineSeconds is the maximum time in seconds for a deployment
+	// to finish with all of its replicas ready. A deployment which does not
+	// finish in time is failed. Deployments are only limited by the maximum
+	// deployment duration if it is unset.
+	ProgressDeadlineSeconds *int64
+
+	// AutoRollback indicates that the config is rolled back to the last
+	// complete deployment when a deployment fails.
+	AutoRollback bool
+
+	// RevisionHistoryLimit is the number of old deployments to retain to allow
+	// for rollbacks. The active deployment and the complete deployment before
+	// it are always retained. All old deployments are retained if it is unset.
+	RevisionHistoryLimit *int
+
 	// Selector is a label query over pods that should match the Replicas count.
 	Selector map[string]string
 
@@ -311,6 +489,94 @@ type DeploymentConfigStatus struct {
 	// Details are the reasons for the update to this deployment config.
 	// This could be based on a change made by the user or caused by an automatic trigger
 	Details *DeploymentDetails
+	// ObservedGeneration is the most recent generation of the config observed
+	// by the deployment config controller.
+	ObservedGeneration int64
+	// Replicas is the total number of pods targeted by the deployments of the
+	// config.
+	Replicas int
+	// UpdatedReplicas is the number of pods targeted by the latest deployment.
+	UpdatedReplicas int
+	// AvailableReplicas is the number of ready pods targeted by the config.
+	AvailableReplicas int
+	// Conditions are the latest available observations of the state of the
+	// config.
+	Conditions []DeploymentCondition
+	// TriggeredObjects are the secrets and config maps last observed by the
+	// SecretChange and ConfigMapChange triggers of the config. Only a change to
+	// their data starts a new deployment, the first observed data is only
+	// recorded.
+	TriggeredObjects []DeploymentTriggeredObject
+}
+
+// DeploymentTriggeredObject is a secret or config map observed by a
+// SecretChange or ConfigMapChange trigger.
+type DeploymentTriggeredObject struct {
+	// Kind of the object, Secret or ConfigMap.
+	Kind string
+	// Name of the object, in the namespace of the DeploymentConfig.
+	Name string
+	// DataHash is a hash of the data of the object last observed.
+	DataHash string
+}
+
+// DeploymentConditionType is the type of a condition of a deployment config.
+type DeploymentConditionType string
+
+// These are the valid conditions of a deployment config.
+const (
+	// DeploymentAvailable means that the config has the minimum number of ready
+	// replicas required by its strategy.
+	DeploymentAvailable DeploymentConditionType = "Available"
+	// DeploymentProgressing means that the latest deployment of the config is
+	// in progress or complete.
+	DeploymentProgressing DeploymentConditionType = "Progressing"
+	// DeploymentReplicaFailure means that pods of the latest deployment of the
+	// config are failing.
+	DeploymentReplicaFailure DeploymentConditionType = "ReplicaFailure"
+)
+
+// These are the reasons of the conditions of a deployment config.
+const (
+	// NewReplicationControllerReason is the reason of the Progressing condition
+	// while the latest deployment waits to be rolled out.
+	NewReplicationControllerReason = "NewReplicationControllerCreated"
+	// ReplicationControllerUpdatedReason is the reason of the Progressing
+	// condition while the latest deployment is rolled out.
+	ReplicationControllerUpdatedReason = "ReplicationControllerUpdated"
+	// NewRcAvailableReason is the reason of the Progressing condition once the
+	// latest deployment is complete.
+	NewRcAvailableReason = "NewReplicationControllerAvailable"
+	// RolloutFailedReason is the reason of the Progressing condition when the
+	// latest deployment failed.
+	RolloutFailedReason = "RolloutFailed"
+	// RolloutCancelledReason is the reason of the Progressing condition when
+	// the latest deployment was cancelled.
+	RolloutCancelledReason = "RolloutCancelled"
+	// MinimumReplicasAvailableReason is the reason of the Available condition
+	// when enough replicas are ready.
+	MinimumReplicasAvailableReason = "MinimumReplicasAvailable"
+	// MinimumReplicasUnavailableReason is the reason of the Available
+	// condition when not enough replicas are ready.
+	MinimumReplicasUnavailableReason = "MinimumReplicasUnavailable"
+	// FailingPodsReason is the reason of the ReplicaFailure condition.
+	FailingPodsReason = "PodsFailing"
+)
+
+// DeploymentCondition describes the state of a deployment config at a certain
+// point.
+type DeploymentCondition struct {
+	// Type of the condition.
+	Type DeploymentConditionType
+	// Status of the condition, one of True, False or Unknown.
+	Status kapi.ConditionStatus
+	// LastTransitionTime is the last time the condition transitioned from one
+	// status to another.
+	LastTransitionTime unversioned.Time
+	// Reason is a brief machine readable explanation of the last transition.
+	Reason string
+	// Message is a human readable description of the last transition.
+	Message string
 }
 
 // DeploymentTriggerPolicy describes a policy for a single trigger that results in a new deployment.
@@ -319,6 +585,10 @@ type DeploymentTriggerPolicy struct {
 	Type DeploymentTriggerType
 	// ImageChangeParams represents the parameters for the ImageChange trigger.
 	ImageChangeParams *DeploymentTriggerImageChangeParams
+	// SecretChangeParams represents the parameters for the SecretChange trigger.
+	SecretChangeParams *DeploymentTriggerObjectChangeParams
+	// ConfigMapChangeParams represents the parameters for the ConfigMapChange trigger.
+	ConfigMapChangeParams *DeploymentTriggerObjectChangeParams
 }
 
 // DeploymentTriggerType refers to a specific DeploymentTriggerPolicy implementation.
@@ -333,8 +603,22 @@ const (
 	// DeploymentTriggerOnConfigChange will create new deployments in response to changes to
 	// the ControllerTemplate of a DeploymentConfig.
 	DeploymentTriggerOnConfigChange DeploymentTriggerType = "ConfigChange"
+	// DeploymentTriggerOnSecretChange will create new deployments in response to changes to
+	// the data of a secret in the namespace of the DeploymentConfig.
+	DeploymentTriggerOnSecretChange DeploymentTriggerType = "SecretChange"
+	// DeploymentTriggerOnConfigMapChange will create new deployments in response to changes to
+	// the data of a config map in the namespace of the DeploymentConfig.
+	DeploymentTriggerOnConfigMapChange DeploymentTriggerType = "ConfigMapChange"
 )
 
+// DeploymentTriggerObjectChangeParams represents the parameters to the SecretChange and
+// ConfigMapChange triggers.
+type DeploymentTriggerObjectChangeParams struct {
+	// Name of the secret or config map to watch for changes, in the namespace of the
+	// DeploymentConfig.
+	Name string
+}
+
 // DeploymentTriggerImageChangeParams represents the parameters to the ImageChange trigger.
 type DeploymentTriggerImageChangeParams struct {
 	// Automatic means that the detection of a new tag value should result in a new deployment.
@@ -363,6 +647,9 @@ type DeploymentCause struct {
 	Type DeploymentTriggerType
 	// ImageTrigger contains the image trigger details, if this trigger was fired based on an image change
 	ImageTrigger *DeploymentCauseImageTrigger
+	// ObjectTrigger contains the changed object details, if this trigger was fired based on
+	// a secret or config map change
+	ObjectTrigger *DeploymentCauseObjectTrigger
 }
 
 // DeploymentCauseImageTrigger contains information about a deployment caused by an image trigger
@@ -372,6 +659,14 @@ type DeploymentCauseImageTrigger struct {
 	From kapi.ObjectReference
 }
 
+// DeploymentCauseObjectTrigger represents details about the cause of a deployment originating
+// from a secret or config map change trigger
+type DeploymentCauseObjectTrigger struct {
+	// From is a reference to the changed Secret or ConfigMap, including the resource version
+	// which triggered the deployment.
+	From kapi.ObjectReference
+}
+
 // DeploymentConfigList is a collection of deployment configs.
 type DeploymentConfigList struct {
 	unversioned.TypeMeta
@@ -400,6 +695,59 @@ type DeploymentConfigRollbackSpec struct {
 	IncludeReplicationMeta bool
 	// IncludeStrategy specifies whether to include the deployment Strategy.
 	IncludeStrategy bool
+	// DiffOnly makes the rollback return a DeploymentConfigRollbackDiff
+	// describing the changes of the rollback instead of the rolled back config.
+	DiffOnly bool
+}
+
+// DeploymentConfigRollbackDiff describes the changes a rollback makes to a
+// deployment config.
+type DeploymentConfigRollbackDiff struct {
+	unversioned.TypeMeta
+	// Name is the name of the deployment config.
+	Name string
+	// From is the deployment the config is rolled back to.
+	From kapi.ObjectReference
+	// Changes are the differences between the current config and the rolled
+	// back config.
+	Changes []DeploymentConfigChange
+}
+
+// DeploymentConfigChangeType is the kind of a change made to a deployment
+// config.
+type DeploymentConfigChangeType string
+
+const (
+	// DeploymentConfigChangeImage is a change of the image of a container.
+	DeploymentConfigChangeImage DeploymentConfigChangeType = "Image"
+	// DeploymentConfigChangeEnv is a change of an environment variable of a
+	// container.
+	DeploymentConfigChangeEnv DeploymentConfigChangeType = "Env"
+	// DeploymentConfigChangeTemplate is any other change of the pod template.
+	DeploymentConfigChangeTemplate DeploymentConfigChangeType = "Template"
+	// DeploymentConfigChangeStrategy is a change of the deployment strategy.
+	DeploymentConfigChangeStrategy DeploymentConfigChangeType = "Strategy"
+	// DeploymentConfigChangeTriggers is a change of the triggers.
+	DeploymentConfigChangeTriggers DeploymentConfigChangeType = "Triggers"
+	// DeploymentConfigChangeReplicas is a change of the replica count.
+	DeploymentConfigChangeReplicas DeploymentConfigChangeType = "Replicas"
+	// DeploymentConfigChangeSelector is a change of the selector.
+	DeploymentConfigChangeSelector DeploymentConfigChangeType = "Selector"
+)
+
+// DeploymentConfigChange is a single difference between the current and the
+// rolled back deployment config.
+type DeploymentConfigChange struct {
+	// Type is the kind of the change.
+	Type DeploymentConfigChangeType
+	// Field is the path of the changed field.
+	Field string
+	// Current is the current value of the field. It is empty if the field is
+	// added by the rollback.
+	Current string
+	// RolledBack is the value of the field after the rollback. It is empty if
+	// the field is removed by the rollback.
+	RolledBack string
 }
 
 // DeploymentLog represents the logs for a deployment
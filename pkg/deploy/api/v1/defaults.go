@@ -46,6 +46,13 @@ func addDefaultingFuncs(scheme *runtime.Scheme) {
 					defaultTagImagesHookContainerName(p.Pre, containerName)
 					defaultTagImagesHookContainerName(p.Post, containerName)
 				}
+				if p := obj.Strategy.CanaryParams; p != nil {
+					defaultTagImagesHookContainerName(p.Pre, containerName)
+					defaultTagImagesHookContainerName(p.Post, containerName)
+					for i := range p.Steps {
+						defaultTagImagesHookContainerName(p.Steps[i].Verify, containerName)
+					}
+				}
 			}
 		},
 		func(obj *DeploymentStrategy) {
@@ -63,12 +70,33 @@ func addDefaultingFuncs(scheme *runtime.Scheme) {
 			if obj.Type == DeploymentStrategyTypeRecreate && obj.RecreateParams == nil {
 				obj.RecreateParams = &RecreateDeploymentStrategyParams{}
 			}
+			if obj.Type == DeploymentStrategyTypeCanary && obj.CanaryParams == nil {
+				obj.CanaryParams = &CanaryDeploymentStrategyParams{}
+			}
 		},
 		func(obj *RecreateDeploymentStrategyParams) {
 			if obj.TimeoutSeconds == nil {
 				obj.TimeoutSeconds = mkintp(deployapi.DefaultRollingTimeoutSeconds)
 			}
 		},
+		func(obj *CanaryDeploymentStrategyParams) {
+			if obj.TimeoutSeconds == nil {
+				obj.TimeoutSeconds = mkintp(deployapi.DefaultRollingTimeoutSeconds)
+			}
+			if len(obj.Traffic) == 0 {
+				obj.Traffic = CanaryTrafficReplicas
+			}
+		},
+		func(obj *HTTPGetHook) {
+			if obj.TimeoutSeconds == nil {
+				obj.TimeoutSeconds = mkintp(deployapi.DefaultHTTPGetHookTimeoutSeconds)
+			}
+		},
+		func(obj *WaitForImageHook) {
+			if obj.TimeoutSeconds == nil {
+				obj.TimeoutSeconds = mkintp(deployapi.DefaultWaitForImageHookTimeoutSeconds)
+			}
+		},
 		func(obj *RollingDeploymentStrategyParams) {
 			if obj.IntervalSeconds == nil {
 				obj.IntervalSeconds = mkintp(deployapi.DefaultRollingIntervalSeconds)
@@ -231,6 +231,43 @@ func TestDefaults(t *testing.T) {
 				},
 			},
 		},
+		{
+			original: &deployv1.DeploymentConfig{
+				Spec: deployv1.DeploymentConfigSpec{
+					Strategy: deployv1.DeploymentStrategy{
+						Type: deployv1.DeploymentStrategyTypeRecreate,
+					},
+					Triggers: []deployv1.DeploymentTriggerPolicy{
+						{
+							Type: deployv1.DeploymentTriggerOnImageChange,
+							ImageChangeParams: &deployv1.DeploymentTriggerImageChangeParams{
+								ContainerNames: []string{"test"},
+								From:           kapiv1.ObjectReference{Name: "test:latest"},
+							},
+						},
+					},
+				},
+			},
+			expected: &deployv1.DeploymentConfig{
+				Spec: deployv1.DeploymentConfigSpec{
+					Strategy: deployv1.DeploymentStrategy{
+						Type: deployv1.DeploymentStrategyTypeRecreate,
+						RecreateParams: &deployv1.RecreateDeploymentStrategyParams{
+							TimeoutSeconds: newInt64(deployapi.DefaultRollingTimeoutSeconds),
+						},
+					},
+					Triggers: []deployv1.DeploymentTriggerPolicy{
+						{
+							Type: deployv1.DeploymentTriggerOnImageChange,
+							ImageChangeParams: &deployv1.DeploymentTriggerImageChangeParams{
+								ContainerNames: []string{"test"},
+								From:           kapiv1.ObjectReference{Kind: "ImageStreamTag", Name: "test:latest"},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for i, test := range tests {
@@ -5,6 +5,31 @@ package v1
 // by hack/update-generated-swagger-descriptions.sh and should be run after a full build of OpenShift.
 // ==== DO NOT EDIT THIS FILE MANUALLY ====
 
+var map_CanaryDeploymentStrategyParams = map[string]string{
+	"":               "CanaryDeploymentStrategyParams are the input to the Canary deployment strategy.",
+	"timeoutSeconds": "TimeoutSeconds is the time to wait for the pods of a step to become ready, and for a step to be approved, before giving up. If the value is nil, a default will be used.",
+	"traffic":        "Traffic is how the traffic is shifted to the new deployment: Replicas shifts the replicas, RouteWeight shifts the weights of the deployments in the route named RouteName and BlueGreen switches the route at once. Defaults to Replicas.",
+	"routeName":      "RouteName is the name of the route whose traffic is shifted by the RouteWeight and BlueGreen traffic types. During the deployment the strategy points the route to a service created for each deployment, with the ports of the service the route points to. Once the deployment completes or fails the backends of the route are restored and the services deleted, so the service of the route must select the pods of every deployment of the config. The deployer service account needs the system:canary-deployer role to manage the route and the services.",
+	"steps":          "Steps are the successive shares of the replicas or the route traffic given to the new deployment. The weight of the last step must be 100, BlueGreen deployments have a single step.",
+	"pre":            "Pre is a lifecycle hook which is executed before the deployment process begins. All LifecycleHookFailurePolicy values are supported.",
+	"post":           "Post is a lifecycle hook which is executed after the strategy has finished all deployment logic.",
+}
+
+func (CanaryDeploymentStrategyParams) SwaggerDoc() map[string]string {
+	return map_CanaryDeploymentStrategyParams
+}
+
+var map_CanaryStep = map[string]string{
+	"":        "CanaryStep is a step of a Canary deployment. With the Replicas traffic type the new deployment is scaled up to its share of the replicas before the previous deployment is scaled down to the rest. If the verification hook of a step fails, the deployment is aborted and the previous deployment restored.",
+	"weight":  "Weight is the percentage of the desired replicas run by the new deployment, or of the traffic of the route sent to it, during the step.",
+	"verify":  "Verify is a lifecycle hook which is executed once the replicas of the step are ready. All LifecycleHookFailurePolicy values are supported.",
+	"approve": "Approve makes the deployment wait, once the step is verified, until the step is approved by setting the openshift.io/deployment.canary-approved-step annotation of the new deployment to the number of the step.",
+}
+
+func (CanaryStep) SwaggerDoc() map[string]string {
+	return map_CanaryStep
+}
+
 var map_CustomDeploymentStrategyParams = map[string]string{
 	"":            "CustomDeploymentStrategyParams are the input to the Custom deployment strategy.",
 	"image":       "Image specifies a Docker image which can carry out a deployment.",
@@ -17,9 +42,10 @@ func (CustomDeploymentStrategyParams) SwaggerDoc() map[string]string {
 }
 
 var map_DeploymentCause = map[string]string{
-	"":             "DeploymentCause captures information about a particular cause of a deployment.",
-	"type":         "Type of the trigger that resulted in the creation of a new deployment",
-	"imageTrigger": "ImageTrigger contains the image trigger details, if this trigger was fired based on an image change",
+	"":              "DeploymentCause captures information about a particular cause of a deployment.",
+	"type":          "Type of the trigger that resulted in the creation of a new deployment",
+	"imageTrigger":  "ImageTrigger contains the image trigger details, if this trigger was fired based on an image change",
+	"objectTrigger": "ObjectTrigger contains the changed object details, if this trigger was fired based on a secret or config map change",
 }
 
 func (DeploymentCause) SwaggerDoc() map[string]string {
@@ -35,6 +61,28 @@ func (DeploymentCauseImageTrigger) SwaggerDoc() map[string]string {
 	return map_DeploymentCauseImageTrigger
 }
 
+var map_DeploymentCauseObjectTrigger = map[string]string{
+	"":     "DeploymentCauseObjectTrigger represents details about the cause of a deployment originating from a secret or config map change trigger",
+	"from": "From is a reference to the changed Secret or ConfigMap, including the resource version which triggered the deployment.",
+}
+
+func (DeploymentCauseObjectTrigger) SwaggerDoc() map[string]string {
+	return map_DeploymentCauseObjectTrigger
+}
+
+var map_DeploymentCondition = map[string]string{
+	"":                   "DeploymentCondition describes the state of a deployment config at a certain point.",
+	"type":               "Type of the condition.",
+	"status":             "Status of the condition, one of True, False or Unknown.",
+	"lastTransitionTime": "LastTransitionTime is the last time the condition transitioned from one status to another.",
+	"reason":             "Reason is a brief machine readable explanation of the last transition.",
+	"message":            "Message is a human readable description of the last transition.",
+}
+
+func (DeploymentCondition) SwaggerDoc() map[string]string {
+	return map_DeploymentCondition
+}
+
 var map_DeploymentConfig = map[string]string{
 	"":         "DeploymentConfig represents a configuration for a single deployment (represented as a ReplicationController). It also contains details about changes which resulted in the current state of the DeploymentConfig. Each change to the DeploymentConfig which should result in a new deployment results in an increment of LatestVersion.",
 	"metadata": "Standard object's metadata.",
@@ -46,6 +94,18 @@ func (DeploymentConfig) SwaggerDoc() map[string]string {
 	return map_DeploymentConfig
 }
 
+var map_DeploymentConfigChange = map[string]string{
+	"":           "DeploymentConfigChange is a single difference between the current and the rolled back deployment config.",
+	"type":       "Type is the kind of the change.",
+	"field":      "Field is the path of the changed field.",
+	"current":    "Current is the current value of the field. It is empty if the field is added by the rollback.",
+	"rolledBack": "RolledBack is the value of the field after the rollback. It is empty if the field is removed by the rollback.",
+}
+
+func (DeploymentConfigChange) SwaggerDoc() map[string]string {
+	return map_DeploymentConfigChange
+}
+
 var map_DeploymentConfigList = map[string]string{
 	"":         "DeploymentConfigList is a collection of deployment configs.",
 	"metadata": "Standard object's metadata.",
@@ -65,6 +125,17 @@ func (DeploymentConfigRollback) SwaggerDoc() map[string]string {
 	return map_DeploymentConfigRollback
 }
 
+var map_DeploymentConfigRollbackDiff = map[string]string{
+	"":        "DeploymentConfigRollbackDiff describes the changes a rollback makes to a deployment config.",
+	"name":    "Name is the name of the deployment config.",
+	"from":    "From is the deployment the config is rolled back to.",
+	"changes": "Changes are the differences between the current config and the rolled back config.",
+}
+
+func (DeploymentConfigRollbackDiff) SwaggerDoc() map[string]string {
+	return map_DeploymentConfigRollbackDiff
+}
+
 var map_DeploymentConfigRollbackSpec = map[string]string{
 	"":                       "DeploymentConfigRollbackSpec represents the options for rollback generation.",
 	"from":                   "From points to a ReplicationController which is a deployment.",
@@ -72,6 +143,7 @@ var map_DeploymentConfigRollbackSpec = map[string]string{
 	"includeTemplate":        "IncludeTemplate specifies whether to include the PodTemplateSpec.",
 	"includeReplicationMeta": "IncludeReplicationMeta specifies whether to include the replica count and selector.",
 	"includeStrategy":        "IncludeStrategy specifies whether to include the deployment Strategy.",
+	"diffOnly":               "DiffOnly makes the rollback return a DeploymentConfigRollbackDiff describing the changes of the rollback instead of the rolled back config.",
 }
 
 func (DeploymentConfigRollbackSpec) SwaggerDoc() map[string]string {
@@ -79,13 +151,17 @@ func (DeploymentConfigRollbackSpec) SwaggerDoc() map[string]string {
 }
 
 var map_DeploymentConfigSpec = map[string]string{
-	"":         "DeploymentConfigSpec represents the desired state of the deployment.",
-	"strategy": "Strategy describes how a deployment is executed.",
-	"triggers": "Triggers determine how updates to a DeploymentConfig result in new deployments. If no triggers are defined, a new deployment can only occur as a result of an explicit client update to the DeploymentConfig with a new LatestVersion.",
-	"replicas": "Replicas is the number of desired replicas.",
-	"test":     "Test ensures that this deployment config will have zero replicas except while a deployment is running. This allows the deployment config to be used as a continuous deployment test - triggering on images, running the deployment, and then succeeding or failing. Post strategy hooks and After actions can be used to integrate successful deployment with an action.",
-	"selector": "Selector is a label query over pods that should match the Replicas count.",
-	"template": "Template is the object that describes the pod that will be created if insufficient replicas are detected.",
+	"":                        "DeploymentConfigSpec represents the desired state of the deployment.",
+	"strategy":                "Strategy describes how a deployment is executed.",
+	"triggers":                "Triggers determine how updates to a DeploymentConfig result in new deployments. If no triggers are defined, a new deployment can only occur as a result of an explicit client update to the DeploymentConfig with a new LatestVersion.",
+	"replicas":                "Replicas is the number of desired replicas.",
+	"test":                    "Test ensures that this deployment config will have zero replicas except while a deployment is running. This allows the deployment config to be used as a continuous deployment test - triggering on images, running the deployment, and then succeeding or failing. Post strategy hooks and After actions can be used to integrate successful deployment with an action.",
+	"paused":                  "Paused indicates that the deployment config is paused. Changes to the template and to the images of the triggers do not start new deployments while the config is paused, they are deployed once it is resumed.",
+	"progressDeadlineSeconds": "ProgressDeadlineSeconds is the maximum time in seconds for a deployment to finish with all of its replicas ready. A deployment which does not finish in time is failed. Deployments are only limited by the maximum deployment duration if it is unset.",
+	"autoRollback":            "AutoRollback indicates that the config is rolled back to the last complete deployment when a deployment fails.",
+	"revisionHistoryLimit":    "RevisionHistoryLimit is the number of old deployments to retain to allow for rollbacks. The active deployment and the complete deployment before it are always retained. All old deployments are retained if it is unset.",
+	"selector":                "Selector is a label query over pods that should match the Replicas count.",
+	"template":                "Template is the object that describes the pod that will be created if insufficient replicas are detected.",
 }
 
 func (DeploymentConfigSpec) SwaggerDoc() map[string]string {
@@ -93,9 +169,15 @@ func (DeploymentConfigSpec) SwaggerDoc() map[string]string {
 }
 
 var map_DeploymentConfigStatus = map[string]string{
-	"":              "DeploymentConfigStatus represents the current deployment state.",
-	"latestVersion": "LatestVersion is used to determine whether the current deployment associated with a DeploymentConfig is out of sync.",
-	"details":       "Details are the reasons for the update to this deployment config. This could be based on a change made by the user or caused by an automatic trigger",
+	"":                   "DeploymentConfigStatus represents the current deployment state.",
+	"latestVersion":      "LatestVersion is used to determine whether the current deployment associated with a DeploymentConfig is out of sync.",
+	"details":            "Details are the reasons for the update to this deployment config. This could be based on a change made by the user or caused by an automatic trigger",
+	"observedGeneration": "ObservedGeneration is the most recent generation of the config observed by the deployment config controller.",
+	"replicas":           "Replicas is the total number of pods targeted by the deployments of the config.",
+	"updatedReplicas":    "UpdatedReplicas is the number of pods targeted by the latest deployment.",
+	"availableReplicas":  "AvailableReplicas is the number of ready pods targeted by the config.",
+	"conditions":         "Conditions are the latest available observations of the state of the config.",
+	"triggeredObjects":   "TriggeredObjects are the secrets and config maps last observed by the SecretChange and ConfigMapChange triggers of the config. Only a change to their data starts a new deployment, the first observed data is only recorded.",
 }
 
 func (DeploymentConfigStatus) SwaggerDoc() map[string]string {
@@ -144,6 +226,7 @@ var map_DeploymentStrategy = map[string]string{
 	"customParams":   "CustomParams are the input to the Custom deployment strategy.",
 	"recreateParams": "RecreateParams are the input to the Recreate deployment strategy.",
 	"rollingParams":  "RollingParams are the input to the Rolling deployment strategy.",
+	"canaryParams":   "CanaryParams are the input to the Canary deployment strategy.",
 	"resources":      "Resources contains resource requirements to execute the deployment and any hooks",
 	"labels":         "Labels is a set of key, value pairs added to custom deployer and lifecycle pre/post hook pods.",
 	"annotations":    "Annotations is a set of key, value pairs added to custom deployer and lifecycle pre/post hook pods.",
@@ -165,33 +248,84 @@ func (DeploymentTriggerImageChangeParams) SwaggerDoc() map[string]string {
 	return map_DeploymentTriggerImageChangeParams
 }
 
+var map_DeploymentTriggerObjectChangeParams = map[string]string{
+	"":     "DeploymentTriggerObjectChangeParams represents the parameters to the SecretChange and ConfigMapChange triggers.",
+	"name": "Name of the secret or config map to watch for changes, in the namespace of the DeploymentConfig.",
+}
+
+func (DeploymentTriggerObjectChangeParams) SwaggerDoc() map[string]string {
+	return map_DeploymentTriggerObjectChangeParams
+}
+
 var map_DeploymentTriggerPolicy = map[string]string{
-	"":                  "DeploymentTriggerPolicy describes a policy for a single trigger that results in a new deployment.",
-	"type":              "Type of the trigger",
-	"imageChangeParams": "ImageChangeParams represents the parameters for the ImageChange trigger.",
+	"":                      "DeploymentTriggerPolicy describes a policy for a single trigger that results in a new deployment.",
+	"type":                  "Type of the trigger",
+	"imageChangeParams":     "ImageChangeParams represents the parameters for the ImageChange trigger.",
+	"secretChangeParams":    "SecretChangeParams represents the parameters for the SecretChange trigger.",
+	"configMapChangeParams": "ConfigMapChangeParams represents the parameters for the ConfigMapChange trigger.",
 }
 
 func (DeploymentTriggerPolicy) SwaggerDoc() map[string]string {
 	return map_DeploymentTriggerPolicy
 }
 
+var map_DeploymentTriggeredObject = map[string]string{
+	"":         "DeploymentTriggeredObject is a secret or config map observed by a SecretChange or ConfigMapChange trigger.",
+	"kind":     "Kind of the object, Secret or ConfigMap.",
+	"name":     "Name of the object, in the namespace of the DeploymentConfig.",
+	"dataHash": "DataHash is a hash of the data of the object last observed.",
+}
+
+func (DeploymentTriggeredObject) SwaggerDoc() map[string]string {
+	return map_DeploymentTriggeredObject
+}
+
 var map_ExecNewPodHook = map[string]string{
-	"":              "ExecNewPodHook is a hook implementation which runs a command in a new pod based on the specified container which is assumed to be part of the deployment template.",
-	"command":       "Command is the action command and its arguments.",
-	"env":           "Env is a set of environment variables to supply to the hook pod's container.",
-	"containerName": "ContainerName is the name of a container in the deployment pod template whose Docker image will be used for the hook pod's container.",
-	"volumes":       "Volumes is a list of named volumes from the pod template which should be copied to the hook pod. Volumes names not found in pod spec are ignored. An empty list means no volumes will be copied.",
+	"":                   "ExecNewPodHook is a hook implementation which runs a command in a new pod based on the specified container which is assumed to be part of the deployment template.",
+	"command":            "Command is the action command and its arguments.",
+	"env":                "Env is a set of environment variables to supply to the hook pod's container.",
+	"containerName":      "ContainerName is the name of a container in the deployment pod template whose Docker image will be used for the hook pod's container.",
+	"volumes":            "Volumes is a list of named volumes from the pod template which should be copied to the hook pod. Volumes names not found in pod spec are ignored. An empty list means no volumes will be copied.",
+	"extraVolumes":       "ExtraVolumes is a list of volumes mounted only into the hook pod's container, in addition to the ones copied from the pod template.",
+	"resources":          "Resources are the compute resources of the hook pod's container. If unset, the resources of the container named by ContainerName are used.",
+	"serviceAccountName": "ServiceAccountName is the name of the service account the hook pod runs as. If empty, the default service account is used.",
+	"nodeSelector":       "NodeSelector selects the nodes the hook pod may run on. If empty, the node selector of the pod template is used.",
 }
 
 func (ExecNewPodHook) SwaggerDoc() map[string]string {
 	return map_ExecNewPodHook
 }
 
+var map_HTTPGetHook = map[string]string{
+	"":               "HTTPGetHook is a hook implementation which sends a GET request to an endpoint and requires a successful (2xx) response.",
+	"url":            "URL is the http or https URL of the endpoint.",
+	"timeoutSeconds": "TimeoutSeconds is how long to wait for a successful response. If the failure policy is Retry, unsuccessful requests are repeated until the timeout. If the value is nil, a default will be used.",
+}
+
+func (HTTPGetHook) SwaggerDoc() map[string]string {
+	return map_HTTPGetHook
+}
+
+var map_HookVolume = map[string]string{
+	"":          "HookVolume is a volume defined only for a hook pod and mounted into its container. Exactly one source of the volume must be set.",
+	"name":      "Name of the volume, which must not be the name of a volume copied from the pod template.",
+	"mountPath": "MountPath is the path within the hook pod's container at which the volume is mounted.",
+	"readOnly":  "ReadOnly mounts the volume read-only.",
+	"secret":    "Secret populates the volume with the keys of a secret.",
+	"emptyDir":  "EmptyDir is an empty scratch directory living as long as the hook pod.",
+}
+
+func (HookVolume) SwaggerDoc() map[string]string {
+	return map_HookVolume
+}
+
 var map_LifecycleHook = map[string]string{
 	"":              "LifecycleHook defines a specific deployment lifecycle action. Only one type of action may be specified at any time.",
 	"failurePolicy": "FailurePolicy specifies what action to take if the hook fails.",
 	"execNewPod":    "ExecNewPod specifies the options for a lifecycle hook backed by a pod.",
 	"tagImages":     "TagImages instructs the deployer to tag the current image referenced under a container onto an image stream tag.",
+	"httpGet":       "HTTPGet specifies the options for a lifecycle hook calling an HTTP endpoint.",
+	"waitForImage":  "WaitForImage specifies the options for a lifecycle hook waiting for an image stream tag to reference an image.",
 }
 
 func (LifecycleHook) SwaggerDoc() map[string]string {
@@ -235,3 +369,15 @@ var map_TagImageHook = map[string]string{
 func (TagImageHook) SwaggerDoc() map[string]string {
 	return map_TagImageHook
 }
+
+var map_WaitForImageHook = map[string]string{
+	"":               "WaitForImageHook is a hook implementation which waits until an ImageStreamTag references an image with a digest, for example until the deployed image has been promoted.",
+	"from":           "From is the ImageStreamTag which must reference the image.",
+	"digest":         "Digest is the digest of the image the ImageStreamTag must reference.",
+	"containerName":  "ContainerName is the name of a container in the deployment whose image digest must be referenced by the ImageStreamTag. It can be set instead of Digest.",
+	"timeoutSeconds": "TimeoutSeconds is how long to wait for the ImageStreamTag. If the value is nil, a default will be used.",
+}
+
+func (WaitForImageHook) SwaggerDoc() map[string]string {
+	return map_WaitForImageHook
+}
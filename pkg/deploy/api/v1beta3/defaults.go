@@ -38,12 +38,33 @@ func addDefaultingFuncs(scheme *runtime.Scheme) {
 			if obj.Type == DeploymentStrategyTypeRecreate && obj.RecreateParams == nil {
 				obj.RecreateParams = &RecreateDeploymentStrategyParams{}
 			}
+			if obj.Type == DeploymentStrategyTypeCanary && obj.CanaryParams == nil {
+				obj.CanaryParams = &CanaryDeploymentStrategyParams{}
+			}
 		},
 		func(obj *RecreateDeploymentStrategyParams) {
 			if obj.TimeoutSeconds == nil {
 				obj.TimeoutSeconds = mkintp(deployapi.DefaultRollingTimeoutSeconds)
 			}
 		},
+		func(obj *CanaryDeploymentStrategyParams) {
+			if obj.TimeoutSeconds == nil {
+				obj.TimeoutSeconds = mkintp(deployapi.DefaultRollingTimeoutSeconds)
+			}
+			if len(obj.Traffic) == 0 {
+				obj.Traffic = CanaryTrafficReplicas
+			}
+		},
+		func(obj *HTTPGetHook) {
+			if obj.TimeoutSeconds == nil {
+				obj.TimeoutSeconds = mkintp(deployapi.DefaultHTTPGetHookTimeoutSeconds)
+			}
+		},
+		func(obj *WaitForImageHook) {
+			if obj.TimeoutSeconds == nil {
+				obj.TimeoutSeconds = mkintp(deployapi.DefaultWaitForImageHookTimeoutSeconds)
+			}
+		},
 		func(obj *RollingDeploymentStrategyParams) {
 			if obj.IntervalSeconds == nil {
 				obj.IntervalSeconds = mkintp(deployapi.DefaultRollingIntervalSeconds)
@@ -22,13 +22,15 @@ func addKnownTypes(scheme *runtime.Scheme) {
 		&DeploymentConfig{},
 		&DeploymentConfigList{},
 		&DeploymentConfigRollback{},
+		&DeploymentConfigRollbackDiff{},
 		&DeploymentLog{},
 		&DeploymentLogOptions{},
 	)
 }
 
-func (obj *DeploymentConfig) GetObjectKind() unversioned.ObjectKind         { return &obj.TypeMeta }
-func (obj *DeploymentConfigList) GetObjectKind() unversioned.ObjectKind     { return &obj.TypeMeta }
-func (obj *DeploymentConfigRollback) GetObjectKind() unversioned.ObjectKind { return &obj.TypeMeta }
-func (obj *DeploymentLog) GetObjectKind() unversioned.ObjectKind            { return &obj.TypeMeta }
-func (obj *DeploymentLogOptions) GetObjectKind() unversioned.ObjectKind     { return &obj.TypeMeta }
+func (obj *DeploymentConfig) GetObjectKind() unversioned.ObjectKind             { return &obj.TypeMeta }
+func (obj *DeploymentConfigList) GetObjectKind() unversioned.ObjectKind         { return &obj.TypeMeta }
+func (obj *DeploymentConfigRollback) GetObjectKind() unversioned.ObjectKind     { return &obj.TypeMeta }
+func (obj *DeploymentConfigRollbackDiff) GetObjectKind() unversioned.ObjectKind { return &obj.TypeMeta }
+func (obj *DeploymentLog) GetObjectKind() unversioned.ObjectKind                { return &obj.TypeMeta }
+func (obj *DeploymentLogOptions) GetObjectKind() unversioned.ObjectKind         { return &obj.TypeMeta }
@@ -35,6 +35,8 @@ type DeploymentStrategy struct {
 	RecreateParams *RecreateDeploymentStrategyParams `json:"recreateParams,omitempty"`
 	// RollingParams are the input to the Rolling deployment strategy.
 	RollingParams *RollingDeploymentStrategyParams `json:"rollingParams,omitempty"`
+	// CanaryParams are the input to the Canary deployment strategy.
+	CanaryParams *CanaryDeploymentStrategyParams `json:"canaryParams,omitempty"`
 
 	// Compute resource requirements to execute the deployment
 	Resources kapi.ResourceRequirements `json:"resources,omitempty"`
@@ -54,6 +56,9 @@ const (
 	DeploymentStrategyTypeCustom DeploymentStrategyType = "Custom"
 	// DeploymentStrategyTypeRolling uses the Kubernetes RollingUpdater.
 	DeploymentStrategyTypeRolling DeploymentStrategyType = "Rolling"
+	// DeploymentStrategyTypeCanary brings up the new deployment alongside the
+	// previous one and shifts replicas to it in steps.
+	DeploymentStrategyTypeCanary DeploymentStrategyType = "Canary"
 )
 
 // CustomParams are the input to the Custom deployment strategy.
@@ -94,6 +99,12 @@ type LifecycleHook struct {
 
 	// TagImages instructs the deployer to tag the current image referenced under a container onto an image stream tag if the deployment succeeds.
 	TagImages []TagImageHook `json:"tagImages,omitempty"`
+
+	// HTTPGet specifies the options for a lifecycle hook calling an HTTP endpoint.
+	HTTPGet *HTTPGetHook `json:"httpGet,omitempty"`
+
+	// WaitForImage specifies the options for a lifecycle hook waiting for an image stream tag to reference an image.
+	WaitForImage *WaitForImageHook `json:"waitForImage,omitempty"`
 }
 
 // HandlerFailurePolicy describes possibles actions to take if a hook fails.
@@ -123,6 +134,35 @@ type ExecNewPodHook struct {
 	// copied to the hook pod. Volumes names not found in pod spec are ignored.
 	// An empty list means no volumes will be copied.
 	Volumes []string `json:"volumes,omitempty"`
+	// ExtraVolumes is a list of volumes mounted only into the hook pod's
+	// container, in addition to the ones copied from the pod template.
+	ExtraVolumes []HookVolume `json:"extraVolumes,omitempty"`
+	// Resources are the compute resources of the hook pod's container. If
+	// unset, the resources of the container named by ContainerName are used.
+	Resources *kapi.ResourceRequirements `json:"resources,omitempty"`
+	// ServiceAccountName is the name of the service account the hook pod runs
+	// as. If empty, the default service account is used.
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+	// NodeSelector selects the nodes the hook pod may run on. If empty, the
+	// node selector of the pod template is used.
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+}
+
+// HookVolume is a volume defined only for a hook pod and mounted into its
+// container. Exactly one source of the volume must be set.
+type HookVolume struct {
+	// Name of the volume, which must not be the name of a volume copied from
+	// the pod template.
+	Name string `json:"name"`
+	// MountPath is the path within the hook pod's container at which the
+	// volume is mounted.
+	MountPath string `json:"mountPath"`
+	// ReadOnly mounts the volume read-only.
+	ReadOnly bool `json:"readOnly,omitempty"`
+	// Secret populates the volume with the keys of a secret.
+	Secret *kapi.SecretVolumeSource `json:"secret,omitempty"`
+	// EmptyDir is an empty scratch directory living as long as the hook pod.
+	EmptyDir *kapi.EmptyDirVolumeSource `json:"emptyDir,omitempty"`
 }
 
 // TagImageHook is a request to tag the image in a particular container onto an ImageStreamTag.
@@ -133,6 +173,29 @@ type TagImageHook struct {
 	To kapi.ObjectReference `json:"to"`
 }
 
+// HTTPGetHook is a hook implementation which sends a GET request to an endpoint and requires a successful (2xx) response.
+type HTTPGetHook struct {
+	// URL is the http or https URL of the endpoint.
+	URL string `json:"url"`
+	// TimeoutSeconds is how long to wait for a successful response. If the failure policy is Retry, unsuccessful requests are
+	// repeated until the timeout. If the value is nil, a default will be used.
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty"`
+}
+
+// WaitForImageHook is a hook implementation which waits until an ImageStreamTag references an image with a digest, for example
+// until the deployed image has been promoted.
+type WaitForImageHook struct {
+	// From is the ImageStreamTag which must reference the image.
+	From kapi.ObjectReference `json:"from"`
+	// Digest is the digest of the image the ImageStreamTag must reference.
+	Digest string `json:"digest,omitempty"`
+	// ContainerName is the name of a container in the deployment whose image digest must be referenced by the ImageStreamTag.
+	// It can be set instead of Digest.
+	ContainerName string `json:"containerName,omitempty"`
+	// TimeoutSeconds is how long to wait for the ImageStreamTag. If the value is nil, a default will be used.
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty"`
+}
+
 // RollingDeploymentStrategyParams are the input to the Rolling deployment
 // strategy.
 type RollingDeploymentStrategyParams struct {
@@ -185,6 +248,74 @@ type RollingDeploymentStrategyParams struct {
 	Post *LifecycleHook `json:"post,omitempty"`
 }
 
+// CanaryDeploymentStrategyParams are the input to the Canary deployment
+// strategy.
+type CanaryDeploymentStrategyParams struct {
+	// TimeoutSeconds is the time to wait for the pods of a step to become
+	// ready, and for a step to be approved, before giving up. If the value is
+	// nil, a default will be used.
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty"`
+	// Traffic is how the traffic is shifted to the new deployment: Replicas
+	// shifts the replicas, RouteWeight shifts the weights of the deployments
+	// in the route named RouteName and BlueGreen switches the route at once.
+	// Defaults to Replicas.
+	Traffic CanaryTrafficType `json:"traffic,omitempty"`
+	// RouteName is the name of the route whose traffic is shifted by the
+	// RouteWeight and BlueGreen traffic types. During the deployment the
+	// strategy points the route to a service created for each deployment,
+	// with the ports of the service the route points to. Once the deployment
+	// completes or fails the backends of the route are restored and the
+	// services deleted, so the service of the route must select the pods of
+	// every deployment of the config. The deployer service account needs the
+	// system:canary-deployer role to manage the route and the services.
+	RouteName string `json:"routeName,omitempty"`
+	// Steps are the successive shares of the replicas or the route traffic
+	// given to the new deployment. The weight of the last step must be 100,
+	// BlueGreen deployments have a single step.
+	Steps []CanaryStep `json:"steps"`
+	// Pre is a lifecycle hook which is executed before the deployment process
+	// begins. All LifecycleHookFailurePolicy values are supported.
+	Pre *LifecycleHook `json:"pre,omitempty"`
+	// Post is a lifecycle hook which is executed after the strategy has
+	// finished all deployment logic.
+	Post *LifecycleHook `json:"post,omitempty"`
+}
+
+// CanaryTrafficType is how the Canary strategy shifts the traffic to the new
+// deployment.
+type CanaryTrafficType string
+
+const (
+	// CanaryTrafficReplicas shifts the replicas from the previous deployment
+	// to the new one by the weights of the steps.
+	CanaryTrafficReplicas CanaryTrafficType = "Replicas"
+	// CanaryTrafficRouteWeight runs the desired replicas in both deployments
+	// and shifts the weights of their services in a route by the weights of
+	// the steps.
+	CanaryTrafficRouteWeight CanaryTrafficType = "RouteWeight"
+	// CanaryTrafficBlueGreen runs the desired replicas in both deployments
+	// and switches a route to the new deployment at once, after its single
+	// step is verified and approved.
+	CanaryTrafficBlueGreen CanaryTrafficType = "BlueGreen"
+)
+
+// CanaryStep is a step of a Canary deployment. With the Replicas traffic type
+// the new deployment is scaled up to its share of the replicas before the
+// previous deployment is scaled down to the rest. If the verification hook of
+// a step fails, the deployment is aborted and the previous deployment restored.
+type CanaryStep struct {
+	// Weight is the percentage of the desired replicas run by the new
+	// deployment, or of the traffic of the route sent to it, during the step.
+	Weight int `json:"weight"`
+	// Verify is a lifecycle hook which is executed once the replicas of the
+	// step are ready. All LifecycleHookFailurePolicy values are supported.
+	Verify *LifecycleHook `json:"verify,omitempty"`
+	// Approve makes the deployment wait, once the step is verified, until the
+	// step is approved by setting the openshift.io/deployment.canary-approved-step
+	// annotation of the new deployment to the number of the step.
+	Approve bool `json:"approve,omitempty"`
+}
+
 // These constants represent keys used for correlating objects related to deployments.
 const (
 	// DeploymentConfigAnnotation is an annotation name used to correlate a deployment with the
@@ -279,6 +410,26 @@ type DeploymentConfigSpec struct {
 	// or failing. Post strategy hooks and After actions can be used to integrate successful deployment with an action.
 	Test bool `json:"test"`
 
+	// Paused indicates that the deployment config is paused. Changes to the
+	// template and to the images of the triggers do not start new deployments
+	// while the config is paused, they are deployed once it is resumed.
+	Paused bool `json:"paused,omitempty"`
+
+	// ProgressDeadlineSeconds is the maximum time in seconds for a deployment
+	// to finish with all of its replicas ready. A deployment which does not
+	// finish in time is failed. Deployments are only limited by the maximum
+	// deployment duration if it is unset.
+	ProgressDeadlineSeconds *int64 `json:"progressDeadlineSeconds,omitempty"`
+
+	// AutoRollback indicates that the config is rolled back to the last
+	// complete deployment when a deployment fails.
+	AutoRollback bool `json:"autoRollback,omitempty"`
+
+	// RevisionHistoryLimit is the number of old deployments to retain to allow
+	// for rollbacks. The active deployment and the complete deployment before
+	// it are always retained. All old deployments are retained if it is unset.
+	RevisionHistoryLimit *int `json:"revisionHistoryLimit,omitempty"`
+
 	// Selector is a label query over pods that should match the Replicas count.
 	Selector map[string]string `json:"selector,omitempty"`
 
@@ -294,6 +445,67 @@ type DeploymentConfigStatus struct {
 	// The reasons for the update to this deployment config.
 	// This could be based on a change made by the user or caused by an automatic trigger
 	Details *DeploymentDetails `json:"details,omitempty"`
+	// ObservedGeneration is the most recent generation of the config observed
+	// by the deployment config controller.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Replicas is the total number of pods targeted by the deployments of the
+	// config.
+	Replicas int `json:"replicas,omitempty"`
+	// UpdatedReplicas is the number of pods targeted by the latest deployment.
+	UpdatedReplicas int `json:"updatedReplicas,omitempty"`
+	// AvailableReplicas is the number of ready pods targeted by the config.
+	AvailableReplicas int `json:"availableReplicas,omitempty"`
+	// Conditions are the latest available observations of the state of the
+	// config.
+	Conditions []DeploymentCondition `json:"conditions,omitempty"`
+	// TriggeredObjects are the secrets and config maps last observed by the
+	// SecretChange and ConfigMapChange triggers of the config. Only a change to
+	// their data starts a new deployment, the first observed data is only
+	// recorded.
+	TriggeredObjects []DeploymentTriggeredObject `json:"triggeredObjects,omitempty"`
+}
+
+// DeploymentTriggeredObject is a secret or config map observed by a
+// SecretChange or ConfigMapChange trigger.
+type DeploymentTriggeredObject struct {
+	// Kind of the object, Secret or ConfigMap.
+	Kind string `json:"kind"`
+	// Name of the object, in the namespace of the DeploymentConfig.
+	Name string `json:"name"`
+	// DataHash is a hash of the data of the object last observed.
+	DataHash string `json:"dataHash"`
+}
+
+// DeploymentConditionType is the type of a condition of a deployment config.
+type DeploymentConditionType string
+
+// These are the valid conditions of a deployment config.
+const (
+	// DeploymentAvailable means that the config has the minimum number of ready
+	// replicas required by its strategy.
+	DeploymentAvailable DeploymentConditionType = "Available"
+	// DeploymentProgressing means that the latest deployment of the config is
+	// in progress or complete.
+	DeploymentProgressing DeploymentConditionType = "Progressing"
+	// DeploymentReplicaFailure means that pods of the latest deployment of the
+	// config are failing.
+	DeploymentReplicaFailure DeploymentConditionType = "ReplicaFailure"
+)
+
+// DeploymentCondition describes the state of a deployment config at a certain
+// point.
+type DeploymentCondition struct {
+	// Type of the condition.
+	Type DeploymentConditionType `json:"type"`
+	// Status of the condition, one of True, False or Unknown.
+	Status kapi.ConditionStatus `json:"status"`
+	// LastTransitionTime is the last time the condition transitioned from one
+	// status to another.
+	LastTransitionTime unversioned.Time `json:"lastTransitionTime,omitempty"`
+	// Reason is a brief machine readable explanation of the last transition.
+	Reason string `json:"reason,omitempty"`
+	// Message is a human readable description of the last transition.
+	Message string `json:"message,omitempty"`
 }
 
 // DeploymentTriggerPolicy describes a policy for a single trigger that results in a new deployment.
@@ -301,6 +513,10 @@ type DeploymentTriggerPolicy struct {
 	Type DeploymentTriggerType `json:"type,omitempty"`
 	// ImageChangeParams represents the parameters for the ImageChange trigger.
 	ImageChangeParams *DeploymentTriggerImageChangeParams `json:"imageChangeParams,omitempty"`
+	// SecretChangeParams represents the parameters for the SecretChange trigger.
+	SecretChangeParams *DeploymentTriggerObjectChangeParams `json:"secretChangeParams,omitempty"`
+	// ConfigMapChangeParams represents the parameters for the ConfigMapChange trigger.
+	ConfigMapChangeParams *DeploymentTriggerObjectChangeParams `json:"configMapChangeParams,omitempty"`
 }
 
 // DeploymentTriggerType refers to a specific DeploymentTriggerPolicy implementation.
@@ -313,8 +529,22 @@ const (
 	// DeploymentTriggerOnConfigChange will create new deployments in response to changes to
 	// the ControllerTemplate of a DeploymentConfig.
 	DeploymentTriggerOnConfigChange DeploymentTriggerType = "ConfigChange"
+	// DeploymentTriggerOnSecretChange will create new deployments in response to changes to
+	// the data of a secret in the namespace of the DeploymentConfig.
+	DeploymentTriggerOnSecretChange DeploymentTriggerType = "SecretChange"
+	// DeploymentTriggerOnConfigMapChange will create new deployments in response to changes to
+	// the data of a config map in the namespace of the DeploymentConfig.
+	DeploymentTriggerOnConfigMapChange DeploymentTriggerType = "ConfigMapChange"
 )
 
+// DeploymentTriggerObjectChangeParams represents the parameters to the SecretChange and
+// ConfigMapChange triggers.
+type DeploymentTriggerObjectChangeParams struct {
+	// Name of the secret or config map to watch for changes, in the namespace of the
+	// DeploymentConfig.
+	Name string `json:"name"`
+}
+
 // DeploymentTriggerImageChangeParams represents the parameters to the ImageChange trigger.
 type DeploymentTriggerImageChangeParams struct {
 	// Automatic means that the detection of a new tag value should result in a new deployment.
@@ -343,6 +573,9 @@ type DeploymentCause struct {
 	Type DeploymentTriggerType `json:"type"`
 	// The image trigger details, if this trigger was fired based on an image change
 	ImageTrigger *DeploymentCauseImageTrigger `json:"imageTrigger,omitempty"`
+	// ObjectTrigger contains the changed object details, if this trigger was fired based on
+	// a secret or config map change
+	ObjectTrigger *DeploymentCauseObjectTrigger `json:"objectTrigger,omitempty"`
 }
 
 // DeploymentCauseImageTrigger represents details about the cause of a deployment originating
@@ -353,6 +586,14 @@ type DeploymentCauseImageTrigger struct {
 	From kapi.ObjectReference `json:"from"`
 }
 
+// DeploymentCauseObjectTrigger represents details about the cause of a deployment originating
+// from a secret or config map change trigger
+type DeploymentCauseObjectTrigger struct {
+	// From is a reference to the changed Secret or ConfigMap, including the resource version
+	// which triggered the deployment.
+	From kapi.ObjectReference `json:"from"`
+}
+
 // A DeploymentConfigList is a collection of deployment configs.
 type DeploymentConfigList struct {
 	unversioned.TypeMeta `json:",inline"`
@@ -379,6 +620,52 @@ type DeploymentConfigRollbackSpec struct {
 	IncludeReplicationMeta bool `json:"includeReplicationMeta"`
 	// IncludeStrategy specifies whether to include the deployment Strategy.
 	IncludeStrategy bool `json:"includeStrategy"`
+	// DiffOnly makes the rollback return a DeploymentConfigRollbackDiff describing the changes of the rollback instead of the
+	// rolled back config.
+	DiffOnly bool `json:"diffOnly,omitempty"`
+}
+
+// DeploymentConfigRollbackDiff describes the changes a rollback makes to a deployment config.
+type DeploymentConfigRollbackDiff struct {
+	unversioned.TypeMeta `json:",inline"`
+	// Name is the name of the deployment config.
+	Name string `json:"name"`
+	// From is the deployment the config is rolled back to.
+	From kapi.ObjectReference `json:"from"`
+	// Changes are the differences between the current config and the rolled back config.
+	Changes []DeploymentConfigChange `json:"changes"`
+}
+
+// DeploymentConfigChangeType is the kind of a change made to a deployment config.
+type DeploymentConfigChangeType string
+
+const (
+	// DeploymentConfigChangeImage is a change of the image of a container.
+	DeploymentConfigChangeImage DeploymentConfigChangeType = "Image"
+	// DeploymentConfigChangeEnv is a change of an environment variable of a container.
+	DeploymentConfigChangeEnv DeploymentConfigChangeType = "Env"
+	// DeploymentConfigChangeTemplate is any other change of the pod template.
+	DeploymentConfigChangeTemplate DeploymentConfigChangeType = "Template"
+	// DeploymentConfigChangeStrategy is a change of the deployment strategy.
+	DeploymentConfigChangeStrategy DeploymentConfigChangeType = "Strategy"
+	// DeploymentConfigChangeTriggers is a change of the triggers.
+	DeploymentConfigChangeTriggers DeploymentConfigChangeType = "Triggers"
+	// DeploymentConfigChangeReplicas is a change of the replica count.
+	DeploymentConfigChangeReplicas DeploymentConfigChangeType = "Replicas"
+	// DeploymentConfigChangeSelector is a change of the selector.
+	DeploymentConfigChangeSelector DeploymentConfigChangeType = "Selector"
+)
+
+// DeploymentConfigChange is a single difference between the current and the rolled back deployment config.
+type DeploymentConfigChange struct {
+	// Type is the kind of the change.
+	Type DeploymentConfigChangeType `json:"type"`
+	// Field is the path of the changed field.
+	Field string `json:"field"`
+	// Current is the current value of the field. It is empty if the field is added by the rollback.
+	Current string `json:"current,omitempty"`
+	// RolledBack is the value of the field after the rollback. It is empty if the field is removed by the rollback.
+	RolledBack string `json:"rolledBack,omitempty"`
 }
 
 // DeploymentLog represents the logs for a deployment
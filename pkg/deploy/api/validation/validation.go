@@ -3,12 +3,15 @@ package validation
 import (
 	"errors"
 	"fmt"
+	"net/url"
+	"path"
 	"regexp"
 	"strconv"
 
 	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/validation"
 	"k8s.io/kubernetes/pkg/util/intstr"
+	"k8s.io/kubernetes/pkg/util/sets"
 	kvalidation "k8s.io/kubernetes/pkg/util/validation"
 	"k8s.io/kubernetes/pkg/util/validation/field"
 
@@ -45,6 +48,12 @@ func ValidateDeploymentConfig(config *deployapi.DeploymentConfig) field.ErrorLis
 	if len(config.Spec.Selector) == 0 {
 		allErrs = append(allErrs, field.Invalid(specPath.Child("selector"), config.Spec.Selector, "selector cannot be empty"))
 	}
+	if config.Spec.ProgressDeadlineSeconds != nil && *config.Spec.ProgressDeadlineSeconds <= 0 {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("progressDeadlineSeconds"), *config.Spec.ProgressDeadlineSeconds, "must be greater than 0"))
+	}
+	if config.Spec.RevisionHistoryLimit != nil && *config.Spec.RevisionHistoryLimit < 0 {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("revisionHistoryLimit"), *config.Spec.RevisionHistoryLimit, isNegativeErrorMsg))
+	}
 	return allErrs
 }
 
@@ -60,6 +69,26 @@ func ValidateDeploymentConfigUpdate(newConfig *deployapi.DeploymentConfig, oldCo
 	return allErrs
 }
 
+// ValidateDeploymentConfigStatusUpdate validates an update of the status of a
+// config through the status subresource.
+func ValidateDeploymentConfigStatusUpdate(newConfig *deployapi.DeploymentConfig, oldConfig *deployapi.DeploymentConfig) field.ErrorList {
+	allErrs := validation.ValidateObjectMetaUpdate(&newConfig.ObjectMeta, &oldConfig.ObjectMeta, field.NewPath("metadata"))
+	statusPath := field.NewPath("status")
+	if newConfig.Status.ObservedGeneration < 0 {
+		allErrs = append(allErrs, field.Invalid(statusPath.Child("observedGeneration"), newConfig.Status.ObservedGeneration, isNegativeErrorMsg))
+	}
+	for name, value := range map[string]int{
+		"replicas":          newConfig.Status.Replicas,
+		"updatedReplicas":   newConfig.Status.UpdatedReplicas,
+		"availableReplicas": newConfig.Status.AvailableReplicas,
+	} {
+		if value < 0 {
+			allErrs = append(allErrs, field.Invalid(statusPath.Child(name), value, isNegativeErrorMsg))
+		}
+	}
+	return allErrs
+}
+
 func ValidateDeploymentConfigRollback(rollback *deployapi.DeploymentConfigRollback) field.ErrorList {
 	result := field.ErrorList{}
 
@@ -97,6 +126,12 @@ func validateDeploymentStrategy(strategy *deployapi.DeploymentStrategy, pod *kap
 		} else {
 			errs = append(errs, validateRollingParams(strategy.RollingParams, pod, fldPath.Child("rollingParams"))...)
 		}
+	case deployapi.DeploymentStrategyTypeCanary:
+		if strategy.CanaryParams == nil {
+			errs = append(errs, field.Required(fldPath.Child("canaryParams"), ""))
+		} else {
+			errs = append(errs, validateCanaryParams(strategy.CanaryParams, pod, fldPath.Child("canaryParams"))...)
+		}
 	case deployapi.DeploymentStrategyTypeCustom:
 		if strategy.CustomParams == nil {
 			errs = append(errs, field.Required(fldPath.Child("customParams"), ""))
@@ -154,9 +189,23 @@ func validateLifecycleHook(hook *deployapi.LifecycleHook, pod *kapi.PodSpec, fld
 		errs = append(errs, field.Required(fldPath.Child("failurePolicy"), ""))
 	}
 
+	hookTypes := 0
+	if hook.ExecNewPod != nil {
+		hookTypes++
+	}
+	if len(hook.TagImages) > 0 {
+		hookTypes++
+	}
+	if hook.HTTPGet != nil {
+		hookTypes++
+	}
+	if hook.WaitForImage != nil {
+		hookTypes++
+	}
+
 	switch {
-	case hook.ExecNewPod != nil && len(hook.TagImages) > 0:
-		errs = append(errs, field.Invalid(fldPath, "<hook>", "only one of 'execNewPod' of 'tagImages' may be specified"))
+	case hookTypes > 1:
+		errs = append(errs, field.Invalid(fldPath, "<hook>", "only one of 'execNewPod', 'tagImages', 'httpGet' or 'waitForImage' may be specified"))
 	case hook.ExecNewPod != nil:
 		errs = append(errs, validateExecNewPod(hook.ExecNewPod, fldPath.Child("execNewPod"))...)
 	case len(hook.TagImages) > 0:
@@ -175,8 +224,53 @@ func validateLifecycleHook(hook *deployapi.LifecycleHook, pod *kapi.PodSpec, fld
 				errs = append(errs, field.Required(fldPath.Child("tagImages").Index(i).Child("to", "name"), "a destination tag name is required"))
 			}
 		}
+	case hook.HTTPGet != nil:
+		errs = append(errs, validateHTTPGetHook(hook.HTTPGet, fldPath.Child("httpGet"))...)
+	case hook.WaitForImage != nil:
+		errs = append(errs, validateWaitForImageHook(hook.WaitForImage, pod, fldPath.Child("waitForImage"))...)
 	default:
-		errs = append(errs, field.Invalid(fldPath, "<empty>", "One of execNewPod or tagImages must be specified"))
+		errs = append(errs, field.Invalid(fldPath, "<empty>", "One of execNewPod, tagImages, httpGet or waitForImage must be specified"))
+	}
+
+	return errs
+}
+
+func validateHTTPGetHook(hook *deployapi.HTTPGetHook, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if len(hook.URL) == 0 {
+		errs = append(errs, field.Required(fldPath.Child("url"), ""))
+	} else if u, err := url.Parse(hook.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+		errs = append(errs, field.Invalid(fldPath.Child("url"), hook.URL, "must be an absolute http or https URL"))
+	}
+	if hook.TimeoutSeconds != nil && *hook.TimeoutSeconds < 1 {
+		errs = append(errs, field.Invalid(fldPath.Child("timeoutSeconds"), *hook.TimeoutSeconds, "must be >0"))
+	}
+
+	return errs
+}
+
+func validateWaitForImageHook(hook *deployapi.WaitForImageHook, pod *kapi.PodSpec, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if hook.From.Kind != "ImageStreamTag" {
+		errs = append(errs, field.Invalid(fldPath.Child("from", "kind"), hook.From.Kind, "Must be 'ImageStreamTag'"))
+	}
+	if len(hook.From.Name) == 0 {
+		errs = append(errs, field.Required(fldPath.Child("from", "name"), "an image stream tag name is required"))
+	}
+	switch {
+	case len(hook.Digest) > 0 && len(hook.ContainerName) > 0:
+		errs = append(errs, field.Invalid(fldPath, "<hook>", "only one of 'digest' or 'containerName' may be specified"))
+	case len(hook.ContainerName) > 0:
+		if _, err := deployapi.TemplateImageForContainer(pod, deployapi.IgnoreTriggers, hook.ContainerName); err != nil {
+			errs = append(errs, field.Invalid(fldPath.Child("containerName"), hook.ContainerName, err.Error()))
+		}
+	case len(hook.Digest) == 0:
+		errs = append(errs, field.Required(fldPath.Child("digest"), "one of digest or containerName is required"))
+	}
+	if hook.TimeoutSeconds != nil && *hook.TimeoutSeconds < 1 {
+		errs = append(errs, field.Invalid(fldPath.Child("timeoutSeconds"), *hook.TimeoutSeconds, "must be >0"))
 	}
 
 	return errs
@@ -198,6 +292,19 @@ func validateExecNewPod(hook *deployapi.ExecNewPodHook, fldPath *field.Path) fie
 	}
 
 	errs = append(errs, validateHookVolumes(hook.Volumes, fldPath.Child("volumes"))...)
+	errs = append(errs, validateHookExtraVolumes(hook.ExtraVolumes, hook.Volumes, fldPath.Child("extraVolumes"))...)
+
+	if hook.Resources != nil {
+		errs = append(errs, validation.ValidateResourceRequirements(hook.Resources, fldPath.Child("resources"))...)
+	}
+
+	if len(hook.ServiceAccountName) > 0 {
+		if ok, msg := validation.ValidateServiceAccountName(hook.ServiceAccountName, false); !ok {
+			errs = append(errs, field.Invalid(fldPath.Child("serviceAccountName"), hook.ServiceAccountName, msg))
+		}
+	}
+
+	errs = append(errs, validation.ValidateLabels(hook.NodeSelector, fldPath.Child("nodeSelector"))...)
 
 	return errs
 }
@@ -231,6 +338,45 @@ func validateHookVolumes(volumes []string, fldPath *field.Path) field.ErrorList
 	return errs
 }
 
+// validateHookExtraVolumes validates the volumes defined for a hook pod. Their
+// names must be unique and differ from the volumes copied from the template.
+func validateHookExtraVolumes(volumes []deployapi.HookVolume, copied []string, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+	names := sets.NewString(copied...)
+	for i, volume := range volumes {
+		idxPath := fldPath.Index(i)
+		switch {
+		case len(volume.Name) == 0:
+			errs = append(errs, field.Required(idxPath.Child("name"), ""))
+		case !kvalidation.IsDNS1123Label(volume.Name):
+			errs = append(errs, field.Invalid(idxPath.Child("name"), volume.Name, "must be a DNS label"))
+		case names.Has(volume.Name):
+			errs = append(errs, field.Duplicate(idxPath.Child("name"), volume.Name))
+		}
+		names.Insert(volume.Name)
+
+		if len(volume.MountPath) == 0 {
+			errs = append(errs, field.Required(idxPath.Child("mountPath"), ""))
+		} else if !path.IsAbs(volume.MountPath) {
+			errs = append(errs, field.Invalid(idxPath.Child("mountPath"), volume.MountPath, "must be an absolute path"))
+		}
+
+		switch {
+		case volume.Secret != nil && volume.EmptyDir != nil:
+			errs = append(errs, field.Invalid(idxPath, "", "only one of secret or emptyDir may be specified"))
+		case volume.Secret != nil:
+			if len(volume.Secret.SecretName) == 0 {
+				errs = append(errs, field.Required(idxPath.Child("secret", "secretName"), ""))
+			} else if ok, msg := validation.ValidateSecretName(volume.Secret.SecretName, false); !ok {
+				errs = append(errs, field.Invalid(idxPath.Child("secret", "secretName"), volume.Secret.SecretName, msg))
+			}
+		case volume.EmptyDir == nil:
+			errs = append(errs, field.Required(idxPath, "one of secret or emptyDir must be specified"))
+		}
+	}
+	return errs
+}
+
 func validateRollingParams(params *deployapi.RollingDeploymentStrategyParams, pod *kapi.PodSpec, fldPath *field.Path) field.ErrorList {
 	errs := field.ErrorList{}
 
@@ -274,6 +420,62 @@ func validateRollingParams(params *deployapi.RollingDeploymentStrategyParams, po
 	return errs
 }
 
+func validateCanaryParams(params *deployapi.CanaryDeploymentStrategyParams, pod *kapi.PodSpec, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if params.TimeoutSeconds != nil && *params.TimeoutSeconds < 1 {
+		errs = append(errs, field.Invalid(fldPath.Child("timeoutSeconds"), *params.TimeoutSeconds, "must be >0"))
+	}
+
+	switch params.Traffic {
+	case "", deployapi.CanaryTrafficReplicas:
+		if len(params.RouteName) > 0 {
+			errs = append(errs, field.Invalid(fldPath.Child("routeName"), params.RouteName, "may only be set for the RouteWeight and BlueGreen traffic types"))
+		}
+	case deployapi.CanaryTrafficRouteWeight, deployapi.CanaryTrafficBlueGreen:
+		if len(params.RouteName) == 0 {
+			errs = append(errs, field.Required(fldPath.Child("routeName"), ""))
+		} else if ok, msg := validation.NameIsDNSSubdomain(params.RouteName, false); !ok {
+			errs = append(errs, field.Invalid(fldPath.Child("routeName"), params.RouteName, msg))
+		}
+	default:
+		errs = append(errs, field.NotSupported(fldPath.Child("traffic"), params.Traffic, []string{string(deployapi.CanaryTrafficReplicas), string(deployapi.CanaryTrafficRouteWeight), string(deployapi.CanaryTrafficBlueGreen)}))
+	}
+
+	stepsPath := fldPath.Child("steps")
+	if len(params.Steps) == 0 {
+		errs = append(errs, field.Required(stepsPath, ""))
+	}
+	if params.Traffic == deployapi.CanaryTrafficBlueGreen && len(params.Steps) > 1 {
+		errs = append(errs, field.Invalid(stepsPath, len(params.Steps), "BlueGreen deployments must have a single step"))
+	}
+	lastWeight := 0
+	for i, step := range params.Steps {
+		stepPath := stepsPath.Index(i)
+		switch {
+		case step.Weight < 1 || step.Weight > 100:
+			errs = append(errs, field.Invalid(stepPath.Child("weight"), step.Weight, "must be between 1 and 100 (inclusive)"))
+		case step.Weight <= lastWeight:
+			errs = append(errs, field.Invalid(stepPath.Child("weight"), step.Weight, "must be greater than the weight of the previous step"))
+		case i == len(params.Steps)-1 && step.Weight != 100:
+			errs = append(errs, field.Invalid(stepPath.Child("weight"), step.Weight, "must be 100 for the last step"))
+		}
+		lastWeight = step.Weight
+		if step.Verify != nil {
+			errs = append(errs, validateLifecycleHook(step.Verify, pod, stepPath.Child("verify"))...)
+		}
+	}
+
+	if params.Pre != nil {
+		errs = append(errs, validateLifecycleHook(params.Pre, pod, fldPath.Child("pre"))...)
+	}
+	if params.Post != nil {
+		errs = append(errs, validateLifecycleHook(params.Post, pod, fldPath.Child("post"))...)
+	}
+
+	return errs
+}
+
 func validateTrigger(trigger *deployapi.DeploymentTriggerPolicy, fldPath *field.Path) field.ErrorList {
 	errs := field.ErrorList{}
 
@@ -289,6 +491,34 @@ func validateTrigger(trigger *deployapi.DeploymentTriggerPolicy, fldPath *field.
 		}
 	}
 
+	if trigger.Type == deployapi.DeploymentTriggerOnSecretChange {
+		if trigger.SecretChangeParams == nil {
+			errs = append(errs, field.Required(fldPath.Child("secretChangeParams"), ""))
+		} else {
+			errs = append(errs, validateObjectChangeParams(trigger.SecretChangeParams, validation.ValidateSecretName, fldPath.Child("secretChangeParams"))...)
+		}
+	}
+
+	if trigger.Type == deployapi.DeploymentTriggerOnConfigMapChange {
+		if trigger.ConfigMapChangeParams == nil {
+			errs = append(errs, field.Required(fldPath.Child("configMapChangeParams"), ""))
+		} else {
+			errs = append(errs, validateObjectChangeParams(trigger.ConfigMapChangeParams, validation.ValidateConfigMapName, fldPath.Child("configMapChangeParams"))...)
+		}
+	}
+
+	return errs
+}
+
+func validateObjectChangeParams(params *deployapi.DeploymentTriggerObjectChangeParams, validateName validation.ValidateNameFunc, fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if len(params.Name) == 0 {
+		errs = append(errs, field.Required(fldPath.Child("name"), ""))
+	} else if ok, msg := validateName(params.Name, false); !ok {
+		errs = append(errs, field.Invalid(fldPath.Child("name"), params.Name, msg))
+	}
+
 	return errs
 }
 
@@ -79,6 +79,31 @@ func TestValidateDeploymentConfigOK(t *testing.T) {
 	}
 }
 
+func canaryConfig(steps ...api.CanaryStep) api.DeploymentConfig {
+	return api.DeploymentConfig{
+		ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+		Spec: api.DeploymentConfigSpec{
+			Triggers: manualTrigger(),
+			Strategy: api.DeploymentStrategy{
+				Type: api.DeploymentStrategyTypeCanary,
+				CanaryParams: &api.CanaryDeploymentStrategyParams{
+					TimeoutSeconds: mkint64p(1),
+					Steps:          steps,
+				},
+			},
+			Template: test.OkPodTemplate(),
+			Selector: test.OkSelector(),
+		},
+	}
+}
+
+func canaryRouteConfig(traffic api.CanaryTrafficType, routeName string, steps ...api.CanaryStep) api.DeploymentConfig {
+	config := canaryConfig(steps...)
+	config.Spec.Strategy.CanaryParams.Traffic = traffic
+	config.Spec.Strategy.CanaryParams.RouteName = routeName
+	return config
+}
+
 func TestValidateDeploymentConfigMissingFields(t *testing.T) {
 	errorCases := map[string]struct {
 		DeploymentConfig api.DeploymentConfig
@@ -208,6 +233,43 @@ func TestValidateDeploymentConfigMissingFields(t *testing.T) {
 			field.ErrorTypeRequired,
 			"spec.triggers[0].imageChangeParams.containerNames",
 		},
+		"missing Trigger secretChangeParams": {
+			api.DeploymentConfig{
+				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Spec: api.DeploymentConfigSpec{
+					Replicas: 1,
+					Triggers: []api.DeploymentTriggerPolicy{
+						{
+							Type: api.DeploymentTriggerOnSecretChange,
+						},
+					},
+					Selector: test.OkSelector(),
+					Strategy: test.OkStrategy(),
+					Template: test.OkPodTemplate(),
+				},
+			},
+			field.ErrorTypeRequired,
+			"spec.triggers[0].secretChangeParams",
+		},
+		"invalid Trigger configMapChangeParams.name": {
+			api.DeploymentConfig{
+				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Spec: api.DeploymentConfigSpec{
+					Replicas: 1,
+					Triggers: []api.DeploymentTriggerPolicy{
+						{
+							Type:                  api.DeploymentTriggerOnConfigMapChange,
+							ConfigMapChangeParams: &api.DeploymentTriggerObjectChangeParams{Name: "Settings"},
+						},
+					},
+					Selector: test.OkSelector(),
+					Strategy: test.OkStrategy(),
+					Template: test.OkPodTemplate(),
+				},
+			},
+			field.ErrorTypeInvalid,
+			"spec.triggers[0].configMapChangeParams.name",
+		},
 		"missing strategy.type": {
 			api.DeploymentConfig{
 				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
@@ -371,6 +433,107 @@ func TestValidateDeploymentConfigMissingFields(t *testing.T) {
 			field.ErrorTypeInvalid,
 			"spec.strategy.recreateParams.pre.execNewPod.volumes[1]",
 		},
+		"duplicate spec.strategy.recreateParams.pre.execNewPod.extraVolumes[0].name": {
+			api.DeploymentConfig{
+				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Spec: api.DeploymentConfigSpec{
+					Replicas: 1,
+					Strategy: api.DeploymentStrategy{
+						Type: api.DeploymentStrategyTypeRecreate,
+						RecreateParams: &api.RecreateDeploymentStrategyParams{
+							Pre: &api.LifecycleHook{
+								FailurePolicy: api.LifecycleHookFailurePolicyRetry,
+								ExecNewPod: &api.ExecNewPodHook{
+									ContainerName: "container",
+									Command:       []string{"cmd"},
+									Volumes:       []string{"data"},
+									ExtraVolumes:  []api.HookVolume{{Name: "data", MountPath: "/data", EmptyDir: &kapi.EmptyDirVolumeSource{}}},
+								},
+							},
+						},
+					},
+					Template: test.OkPodTemplate(),
+					Selector: test.OkSelector(),
+				},
+			},
+			field.ErrorTypeDuplicate,
+			"spec.strategy.recreateParams.pre.execNewPod.extraVolumes[0].name",
+		},
+		"missing spec.strategy.recreateParams.pre.execNewPod.extraVolumes[0] source": {
+			api.DeploymentConfig{
+				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Spec: api.DeploymentConfigSpec{
+					Replicas: 1,
+					Strategy: api.DeploymentStrategy{
+						Type: api.DeploymentStrategyTypeRecreate,
+						RecreateParams: &api.RecreateDeploymentStrategyParams{
+							Pre: &api.LifecycleHook{
+								FailurePolicy: api.LifecycleHookFailurePolicyRetry,
+								ExecNewPod: &api.ExecNewPodHook{
+									ContainerName: "container",
+									Command:       []string{"cmd"},
+									ExtraVolumes:  []api.HookVolume{{Name: "credentials", MountPath: "/credentials"}},
+								},
+							},
+						},
+					},
+					Template: test.OkPodTemplate(),
+					Selector: test.OkSelector(),
+				},
+			},
+			field.ErrorTypeRequired,
+			"spec.strategy.recreateParams.pre.execNewPod.extraVolumes[0]",
+		},
+		"invalid spec.strategy.recreateParams.pre.execNewPod.extraVolumes[0].mountPath": {
+			api.DeploymentConfig{
+				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Spec: api.DeploymentConfigSpec{
+					Replicas: 1,
+					Strategy: api.DeploymentStrategy{
+						Type: api.DeploymentStrategyTypeRecreate,
+						RecreateParams: &api.RecreateDeploymentStrategyParams{
+							Pre: &api.LifecycleHook{
+								FailurePolicy: api.LifecycleHookFailurePolicyRetry,
+								ExecNewPod: &api.ExecNewPodHook{
+									ContainerName: "container",
+									Command:       []string{"cmd"},
+									ExtraVolumes:  []api.HookVolume{{Name: "credentials", MountPath: "credentials", Secret: &kapi.SecretVolumeSource{SecretName: "db"}}},
+								},
+							},
+						},
+					},
+					Template: test.OkPodTemplate(),
+					Selector: test.OkSelector(),
+				},
+			},
+			field.ErrorTypeInvalid,
+			"spec.strategy.recreateParams.pre.execNewPod.extraVolumes[0].mountPath",
+		},
+		"invalid spec.strategy.recreateParams.pre.execNewPod.serviceAccountName": {
+			api.DeploymentConfig{
+				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Spec: api.DeploymentConfigSpec{
+					Replicas: 1,
+					Strategy: api.DeploymentStrategy{
+						Type: api.DeploymentStrategyTypeRecreate,
+						RecreateParams: &api.RecreateDeploymentStrategyParams{
+							Pre: &api.LifecycleHook{
+								FailurePolicy: api.LifecycleHookFailurePolicyRetry,
+								ExecNewPod: &api.ExecNewPodHook{
+									ContainerName:      "container",
+									Command:            []string{"cmd"},
+									ServiceAccountName: "Migrator",
+								},
+							},
+						},
+					},
+					Template: test.OkPodTemplate(),
+					Selector: test.OkSelector(),
+				},
+			},
+			field.ErrorTypeInvalid,
+			"spec.strategy.recreateParams.pre.execNewPod.serviceAccountName",
+		},
 		"missing spec.strategy.recreateParams.mid.execNewPod": {
 			api.DeploymentConfig{
 				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
@@ -511,6 +674,91 @@ func TestValidateDeploymentConfigMissingFields(t *testing.T) {
 			field.ErrorTypeInvalid,
 			"spec.strategy.recreateParams.post",
 		},
+		"invalid spec.strategy.after.httpGet.url": {
+			api.DeploymentConfig{
+				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Spec: api.DeploymentConfigSpec{
+					Replicas: 1,
+					Strategy: api.DeploymentStrategy{
+						Type: api.DeploymentStrategyTypeRecreate,
+						RecreateParams: &api.RecreateDeploymentStrategyParams{
+							Post: &api.LifecycleHook{
+								FailurePolicy: api.LifecycleHookFailurePolicyRetry,
+								HTTPGet:       &api.HTTPGetHook{URL: "example.com/ready"},
+							},
+						},
+					},
+					Template: test.OkPodTemplate(),
+					Selector: test.OkSelector(),
+				},
+			},
+			field.ErrorTypeInvalid,
+			"spec.strategy.recreateParams.post.httpGet.url",
+		},
+		"missing spec.strategy.after.waitForImage.digest": {
+			api.DeploymentConfig{
+				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Spec: api.DeploymentConfigSpec{
+					Replicas: 1,
+					Strategy: api.DeploymentStrategy{
+						Type: api.DeploymentStrategyTypeRecreate,
+						RecreateParams: &api.RecreateDeploymentStrategyParams{
+							Post: &api.LifecycleHook{
+								FailurePolicy: api.LifecycleHookFailurePolicyRetry,
+								WaitForImage:  &api.WaitForImageHook{From: kapi.ObjectReference{Kind: "ImageStreamTag", Name: "stream:approved"}},
+							},
+						},
+					},
+					Template: test.OkPodTemplate(),
+					Selector: test.OkSelector(),
+				},
+			},
+			field.ErrorTypeRequired,
+			"spec.strategy.recreateParams.post.waitForImage.digest",
+		},
+		"missing spec.strategy.after.waitForImage.containerName": {
+			api.DeploymentConfig{
+				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Spec: api.DeploymentConfigSpec{
+					Replicas: 1,
+					Strategy: api.DeploymentStrategy{
+						Type: api.DeploymentStrategyTypeRecreate,
+						RecreateParams: &api.RecreateDeploymentStrategyParams{
+							Post: &api.LifecycleHook{
+								FailurePolicy: api.LifecycleHookFailurePolicyRetry,
+								WaitForImage:  &api.WaitForImageHook{From: kapi.ObjectReference{Kind: "ImageStreamTag", Name: "stream:approved"}, ContainerName: "missing"},
+							},
+						},
+					},
+					Template: test.OkPodTemplate(),
+					Selector: test.OkSelector(),
+				},
+			},
+			field.ErrorTypeInvalid,
+			"spec.strategy.recreateParams.post.waitForImage.containerName",
+		},
+		"can't have both httpGet and waitForImage": {
+			api.DeploymentConfig{
+				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Spec: api.DeploymentConfigSpec{
+					Replicas: 1,
+					Strategy: api.DeploymentStrategy{
+						Type: api.DeploymentStrategyTypeRecreate,
+						RecreateParams: &api.RecreateDeploymentStrategyParams{
+							Post: &api.LifecycleHook{
+								FailurePolicy: api.LifecycleHookFailurePolicyRetry,
+								HTTPGet:       &api.HTTPGetHook{URL: "http://example.com/ready"},
+								WaitForImage:  &api.WaitForImageHook{From: kapi.ObjectReference{Kind: "ImageStreamTag", Name: "stream:approved"}, Digest: "sha256:abc"},
+							},
+						},
+					},
+					Template: test.OkPodTemplate(),
+					Selector: test.OkSelector(),
+				},
+			},
+			field.ErrorTypeInvalid,
+			"spec.strategy.recreateParams.post",
+		},
 		"invalid spec.strategy.rollingParams.intervalSeconds": {
 			rollingConfig(-20, 1, 1),
 			field.ErrorTypeInvalid,
@@ -593,6 +841,61 @@ func TestValidateDeploymentConfigMissingFields(t *testing.T) {
 			field.ErrorTypeInvalid,
 			"spec.strategy.rollingParams.maxSurge",
 		},
+		"valid spec.strategy.canaryParams": {
+			canaryConfig(api.CanaryStep{Weight: 10, Approve: true}, api.CanaryStep{Weight: 100}),
+			"",
+			"",
+		},
+		"missing spec.strategy.canaryParams.steps": {
+			canaryConfig(),
+			field.ErrorTypeRequired,
+			"spec.strategy.canaryParams.steps",
+		},
+		"invalid spec.strategy.canaryParams.steps[0].weight": {
+			canaryConfig(api.CanaryStep{Weight: 0}, api.CanaryStep{Weight: 100}),
+			field.ErrorTypeInvalid,
+			"spec.strategy.canaryParams.steps[0].weight",
+		},
+		"decreasing spec.strategy.canaryParams.steps[1].weight": {
+			canaryConfig(api.CanaryStep{Weight: 50}, api.CanaryStep{Weight: 20}, api.CanaryStep{Weight: 100}),
+			field.ErrorTypeInvalid,
+			"spec.strategy.canaryParams.steps[1].weight",
+		},
+		"incomplete spec.strategy.canaryParams.steps[1].weight": {
+			canaryConfig(api.CanaryStep{Weight: 10}, api.CanaryStep{Weight: 50}),
+			field.ErrorTypeInvalid,
+			"spec.strategy.canaryParams.steps[1].weight",
+		},
+		"valid spec.strategy.canaryParams.routeName": {
+			canaryRouteConfig(api.CanaryTrafficRouteWeight, "frontend", api.CanaryStep{Weight: 10}, api.CanaryStep{Weight: 100}),
+			"",
+			"",
+		},
+		"missing spec.strategy.canaryParams.routeName": {
+			canaryRouteConfig(api.CanaryTrafficBlueGreen, "", api.CanaryStep{Weight: 100}),
+			field.ErrorTypeRequired,
+			"spec.strategy.canaryParams.routeName",
+		},
+		"invalid spec.strategy.canaryParams.routeName": {
+			canaryRouteConfig(api.CanaryTrafficReplicas, "frontend", api.CanaryStep{Weight: 100}),
+			field.ErrorTypeInvalid,
+			"spec.strategy.canaryParams.routeName",
+		},
+		"invalid spec.strategy.canaryParams.traffic": {
+			canaryRouteConfig("Unknown", "frontend", api.CanaryStep{Weight: 100}),
+			field.ErrorTypeNotSupported,
+			"spec.strategy.canaryParams.traffic",
+		},
+		"invalid spec.strategy.canaryParams.steps": {
+			canaryRouteConfig(api.CanaryTrafficBlueGreen, "frontend", api.CanaryStep{Weight: 50}, api.CanaryStep{Weight: 100}),
+			field.ErrorTypeInvalid,
+			"spec.strategy.canaryParams.steps",
+		},
+		"missing spec.strategy.canaryParams.steps[0].verify.failurePolicy": {
+			canaryConfig(api.CanaryStep{Weight: 100, Verify: &api.LifecycleHook{ExecNewPod: &api.ExecNewPodHook{Command: []string{"true"}, ContainerName: "container1"}}}),
+			field.ErrorTypeRequired,
+			"spec.strategy.canaryParams.steps[0].verify.failurePolicy",
+		},
 	}
 
 	for testName, v := range errorCases {
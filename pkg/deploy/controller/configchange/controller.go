@@ -38,6 +38,11 @@ func (c *DeploymentConfigChangeController) Handle(config *deployapi.DeploymentCo
 		return nil
 	}
 
+	if config.Spec.Paused {
+		glog.V(4).Infof("Ignoring DeploymentConfig %s; it is paused", deployutil.LabelForDeploymentConfig(config))
+		return nil
+	}
+
 	if config.Status.LatestVersion == 0 {
 		_, _, abort, err := c.generateDeployment(config)
 		if err != nil {
@@ -39,6 +39,39 @@ func TestHandle_newConfigNoTriggers(t *testing.T) {
 	}
 }
 
+// TestHandle_pausedConfig ensures that a change to a paused config doesn't
+// result in a new config version bump.
+func TestHandle_pausedConfig(t *testing.T) {
+	controller := &DeploymentConfigChangeController{
+		decodeConfig: func(deployment *kapi.ReplicationController) (*deployapi.DeploymentConfig, error) {
+			return deployutil.DecodeDeploymentConfig(deployment, kapi.Codecs.LegacyCodec(deployapi.SchemeGroupVersion))
+		},
+		changeStrategy: &changeStrategyImpl{
+			getDeploymentFunc: func(namespace, name string) (*kapi.ReplicationController, error) {
+				t.Fatalf("unexpected retrieval of deployment")
+				return nil, nil
+			},
+			generateDeploymentConfigFunc: func(namespace, name string) (*deployapi.DeploymentConfig, error) {
+				t.Fatalf("unexpected generation of deploymentConfig")
+				return nil, nil
+			},
+			updateDeploymentConfigFunc: func(namespace string, config *deployapi.DeploymentConfig) (*deployapi.DeploymentConfig, error) {
+				t.Fatalf("unexpected update of deploymentConfig")
+				return config, nil
+			},
+		},
+	}
+
+	for _, version := range []int{0, 1} {
+		config := deployapitest.OkDeploymentConfig(version)
+		config.Spec.Triggers = []deployapi.DeploymentTriggerPolicy{deployapitest.OkConfigChangeTrigger()}
+		config.Spec.Paused = true
+		if err := controller.Handle(config); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+}
+
 // TestHandle_newConfigTriggers ensures that the creation of a new config
 // (with version 0) with a config change trigger results in a version bump and
 // cause update for initial deployment.
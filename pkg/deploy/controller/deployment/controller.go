@@ -11,6 +11,7 @@ import (
 	utilruntime "k8s.io/kubernetes/pkg/util/runtime"
 
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	"github.com/openshift/origin/pkg/deploy/prune"
 	deployutil "github.com/openshift/origin/pkg/deploy/util"
 	"github.com/openshift/origin/pkg/util"
 )
@@ -24,6 +25,8 @@ import (
 //
 //   1. If the deployment finished normally, the deployer pod is deleted.
 //   2. If the deployment failed, the deployer pod is not deleted.
+//   3. If the config has a revision history limit, the old deployments beyond
+//      it are deleted.
 //
 // Use the DeploymentControllerFactory to create this controller.
 type DeploymentController struct {
@@ -186,6 +189,8 @@ func (c *DeploymentController) Handle(deployment *kapi.ReplicationController) er
 			deploymentScaled = deployment.Spec.Replicas != 0
 			deployment.Spec.Replicas = 0
 		}
+
+		c.cleanupDeployments(deployment)
 	case deployapi.DeploymentStatusComplete:
 		// Check for test deployment and ensure the deployment scale matches
 		if config, err := c.decodeConfig(deployment); err == nil && config.Spec.Test {
@@ -219,6 +224,8 @@ func (c *DeploymentController) Handle(deployment *kapi.ReplicationController) er
 		if !cleanedAll {
 			return fmt.Errorf("couldn't clean up all deployer pods for %s", deployutil.LabelForDeployment(deployment))
 		}
+
+		c.cleanupDeployments(deployment)
 	}
 
 	if currentStatus != nextStatus || deploymentScaled {
@@ -236,6 +243,48 @@ func (c *DeploymentController) Handle(deployment *kapi.ReplicationController) er
 	return nil
 }
 
+// cleanupDeployments deletes the old deployments of the config of deployment
+// beyond the revision history limit of the config, along with their deployer
+// and hook pods. Only the latest deployment of a config cleans up. Errors are
+// logged, the cleanup is retried when the deployment is resynced.
+func (c *DeploymentController) cleanupDeployments(deployment *kapi.ReplicationController) {
+	config, err := c.decodeConfig(deployment)
+	if err != nil || config.Spec.RevisionHistoryLimit == nil {
+		return
+	}
+	existing, err := c.deploymentClient.listDeploymentsForConfig(deployment.Namespace, config.Name)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("couldn't list the deployments of %s to clean up: %v", deployutil.LabelForDeploymentConfig(config), err))
+		return
+	}
+	deployments := []*kapi.ReplicationController{}
+	for i := range existing.Items {
+		if deployutil.DeploymentVersionFor(&existing.Items[i]) > deployutil.DeploymentVersionFor(deployment) {
+			return
+		}
+		deployments = append(deployments, &existing.Items[i])
+	}
+
+	pruned, _ := prune.NewRevisionHistoryResolver(deployments, *config.Spec.RevisionHistoryLimit).Resolve()
+	for _, old := range pruned {
+		deployerPods, err := c.podClient.getDeployerPodsFor(old.Namespace, old.Name)
+		if err != nil {
+			utilruntime.HandleError(fmt.Errorf("couldn't fetch deployer pods for %s: %v", deployutil.LabelForDeployment(old), err))
+			continue
+		}
+		for _, deployerPod := range deployerPods {
+			if err := c.podClient.deletePod(deployerPod.Namespace, deployerPod.Name); err != nil && !kerrors.IsNotFound(err) {
+				utilruntime.HandleError(fmt.Errorf("couldn't delete deployer pod %s/%s for deployment %s: %v", old.Namespace, deployerPod.Name, deployutil.LabelForDeployment(old), err))
+			}
+		}
+		if err := c.deploymentClient.deleteDeployment(old.Namespace, old.Name); err != nil && !kerrors.IsNotFound(err) {
+			utilruntime.HandleError(fmt.Errorf("couldn't delete deployment %s beyond the revision history limit: %v", deployutil.LabelForDeployment(old), err))
+			continue
+		}
+		glog.V(4).Infof("Deleted deployment %s beyond the revision history limit of %s", deployutil.LabelForDeployment(old), deployutil.LabelForDeploymentConfig(config))
+	}
+}
+
 // makeDeployerPod creates a pod which implements deployment behavior. The pod is correlated to
 // the deployment with an annotation.
 func (c *DeploymentController) makeDeployerPod(deployment *kapi.ReplicationController) (*kapi.Pod, error) {
@@ -259,6 +308,11 @@ func (c *DeploymentController) makeDeployerPod(deployment *kapi.ReplicationContr
 
 	// Assigning to a variable since its address is required
 	maxDeploymentDurationSeconds := deployapi.MaxDeploymentDurationSeconds
+	// A deployment which must make progress within a deadline can't run any
+	// longer than that.
+	if deadline := deploymentConfig.Spec.ProgressDeadlineSeconds; deadline != nil && *deadline < maxDeploymentDurationSeconds {
+		maxDeploymentDurationSeconds = *deadline
+	}
 
 	pod := &kapi.Pod{
 		ObjectMeta: kapi.ObjectMeta{
@@ -303,6 +357,8 @@ func (c *DeploymentController) makeDeployerPod(deployment *kapi.ReplicationContr
 type deploymentClient interface {
 	getDeployment(namespace, name string) (*kapi.ReplicationController, error)
 	updateDeployment(namespace string, deployment *kapi.ReplicationController) (*kapi.ReplicationController, error)
+	listDeploymentsForConfig(namespace, configName string) (*kapi.ReplicationControllerList, error)
+	deleteDeployment(namespace, name string) error
 }
 
 // podClient abstracts access to pods.
@@ -316,8 +372,10 @@ type podClient interface {
 
 // deploymentClientImpl is a pluggable deploymentClient.
 type deploymentClientImpl struct {
-	getDeploymentFunc    func(namespace, name string) (*kapi.ReplicationController, error)
-	updateDeploymentFunc func(namespace string, deployment *kapi.ReplicationController) (*kapi.ReplicationController, error)
+	getDeploymentFunc            func(namespace, name string) (*kapi.ReplicationController, error)
+	updateDeploymentFunc         func(namespace string, deployment *kapi.ReplicationController) (*kapi.ReplicationController, error)
+	listDeploymentsForConfigFunc func(namespace, configName string) (*kapi.ReplicationControllerList, error)
+	deleteDeploymentFunc         func(namespace, name string) error
 }
 
 func (i *deploymentClientImpl) getDeployment(namespace, name string) (*kapi.ReplicationController, error) {
@@ -328,6 +386,14 @@ func (i *deploymentClientImpl) updateDeployment(namespace string, deployment *ka
 	return i.updateDeploymentFunc(namespace, deployment)
 }
 
+func (i *deploymentClientImpl) listDeploymentsForConfig(namespace, configName string) (*kapi.ReplicationControllerList, error) {
+	return i.listDeploymentsForConfigFunc(namespace, configName)
+}
+
+func (i *deploymentClientImpl) deleteDeployment(namespace, name string) error {
+	return i.deleteDeploymentFunc(namespace, name)
+}
+
 // podClientImpl is a pluggable podClient.
 type podClientImpl struct {
 	getPodFunc             func(namespace, name string) (*kapi.Pod, error)
@@ -5,10 +5,12 @@ import (
 	"reflect"
 	"sort"
 	"testing"
+	"time"
 
 	kapi "k8s.io/kubernetes/pkg/api"
 	kerrors "k8s.io/kubernetes/pkg/api/errors"
 	"k8s.io/kubernetes/pkg/api/resource"
+	"k8s.io/kubernetes/pkg/api/unversioned"
 	"k8s.io/kubernetes/pkg/client/record"
 
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
@@ -122,6 +124,49 @@ func TestHandle_createPodOk(t *testing.T) {
 	}
 }
 
+// TestHandle_createPodProgressDeadline ensures that the deployer pod of a
+// config with a progress deadline doesn't run any longer than the deadline.
+func TestHandle_createPodProgressDeadline(t *testing.T) {
+	var createdPod *kapi.Pod
+
+	controller := &DeploymentController{
+		decodeConfig: func(deployment *kapi.ReplicationController) (*deployapi.DeploymentConfig, error) {
+			return deployutil.DecodeDeploymentConfig(deployment, kapi.Codecs.LegacyCodec(deployapi.SchemeGroupVersion))
+		},
+		deploymentClient: &deploymentClientImpl{
+			updateDeploymentFunc: func(namespace string, deployment *kapi.ReplicationController) (*kapi.ReplicationController, error) {
+				return deployment, nil
+			},
+		},
+		podClient: &podClientImpl{
+			createPodFunc: func(namespace string, pod *kapi.Pod) (*kapi.Pod, error) {
+				createdPod = pod
+				return pod, nil
+			},
+		},
+		makeContainer: func(strategy *deployapi.DeploymentStrategy) (*kapi.Container, error) {
+			return okContainer(), nil
+		},
+		recorder: &record.FakeRecorder{},
+	}
+
+	deadline := int64(600)
+	config := deploytest.OkDeploymentConfig(1)
+	config.Spec.ProgressDeadlineSeconds = &deadline
+	deployment, _ := deployutil.MakeDeployment(config, kapi.Codecs.LegacyCodec(deployapi.SchemeGroupVersion))
+	deployment.Annotations[deployapi.DeploymentStatusAnnotation] = string(deployapi.DeploymentStatusNew)
+	if err := controller.Handle(deployment); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if createdPod == nil {
+		t.Fatalf("expected a pod to be created")
+	}
+	if e, a := deadline, *createdPod.Spec.ActiveDeadlineSeconds; e != a {
+		t.Fatalf("expected ActiveDeadlineSeconds on the deployer pod to be set to %d; found: %d", e, a)
+	}
+}
+
 // TestHandle_makeContainerFail ensures that an internal (not API) failure to
 // create a deployer pod results in a fatal error.
 func TestHandle_makeContainerFail(t *testing.T) {
@@ -897,3 +942,75 @@ func ttlZeroPod() *kapi.Pod {
 		},
 	}
 }
+
+// TestHandle_cleanupRevisionHistory ensures that the latest deployment of a
+// config with a revision history limit deletes the old deployments beyond the
+// limit and their deployer pods.
+func TestHandle_cleanupRevisionHistory(t *testing.T) {
+	codec := kapi.Codecs.LegacyCodec(deployapi.SchemeGroupVersion)
+	limit := 1
+	now := unversioned.Now()
+	existing := &kapi.ReplicationControllerList{}
+	for version := 1; version <= 5; version++ {
+		config := deploytest.OkDeploymentConfig(version)
+		config.Spec.RevisionHistoryLimit = &limit
+		deployment, _ := deployutil.MakeDeployment(config, codec)
+		deployment.Annotations[deployapi.DeploymentStatusAnnotation] = string(deployapi.DeploymentStatusComplete)
+		deployment.CreationTimestamp = unversioned.NewTime(now.Add(time.Duration(version) * time.Minute))
+		deployment.Spec.Replicas = 0
+		existing.Items = append(existing.Items, *deployment)
+	}
+
+	deletedDeployments := []string{}
+	deletedPods := []string{}
+	controller := &DeploymentController{
+		decodeConfig: func(deployment *kapi.ReplicationController) (*deployapi.DeploymentConfig, error) {
+			return deployutil.DecodeDeploymentConfig(deployment, codec)
+		},
+		deploymentClient: &deploymentClientImpl{
+			listDeploymentsForConfigFunc: func(namespace, configName string) (*kapi.ReplicationControllerList, error) {
+				return existing, nil
+			},
+			deleteDeploymentFunc: func(namespace, name string) error {
+				deletedDeployments = append(deletedDeployments, name)
+				return nil
+			},
+		},
+		podClient: &podClientImpl{
+			deletePodFunc: func(namespace, name string) error {
+				deletedPods = append(deletedPods, name)
+				return nil
+			},
+			getDeployerPodsForFunc: func(namespace, name string) ([]kapi.Pod, error) {
+				if name == "config-5" {
+					return []kapi.Pod{}, nil
+				}
+				return []kapi.Pod{{ObjectMeta: kapi.ObjectMeta{Name: name + "-hook-pre"}}}, nil
+			},
+		},
+		recorder: &record.FakeRecorder{},
+	}
+
+	// Older deployments don't clean up.
+	older := existing.Items[3]
+	if err := controller.Handle(&older); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(deletedDeployments) > 0 {
+		t.Fatalf("expected no deployment to be deleted by an older deployment, got %v", deletedDeployments)
+	}
+	deletedPods = []string{}
+
+	latest := existing.Items[4]
+	if err := controller.Handle(&latest); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	sort.Strings(deletedDeployments)
+	if e, a := []string{"config-1", "config-2", "config-3"}, deletedDeployments; !reflect.DeepEqual(e, a) {
+		t.Fatalf("expected deleted deployments %v, got %v", e, a)
+	}
+	sort.Strings(deletedPods)
+	if e, a := []string{"config-1-hook-pre", "config-2-hook-pre", "config-3-hook-pre"}, deletedPods; !reflect.DeepEqual(e, a) {
+		t.Fatalf("expected deleted pods %v, got %v", e, a)
+	}
+}
@@ -61,6 +61,12 @@ func (factory *DeploymentControllerFactory) Create() controller.RunnableControll
 			updateDeploymentFunc: func(namespace string, deployment *kapi.ReplicationController) (*kapi.ReplicationController, error) {
 				return factory.KubeClient.ReplicationControllers(namespace).Update(deployment)
 			},
+			listDeploymentsForConfigFunc: func(namespace, configName string) (*kapi.ReplicationControllerList, error) {
+				return factory.KubeClient.ReplicationControllers(namespace).List(kapi.ListOptions{LabelSelector: deployutil.ConfigSelector(configName)})
+			},
+			deleteDeploymentFunc: func(namespace, name string) error {
+				return factory.KubeClient.ReplicationControllers(namespace).Delete(name)
+			},
 		},
 		podClient: &podClientImpl{
 			getPodFunc: func(namespace, name string) (*kapi.Pod, error) {
@@ -125,7 +131,7 @@ func (factory *DeploymentControllerFactory) Create() controller.RunnableControll
 
 // makeContainer creates containers in the following way:
 //
-//   1. For the Recreate and Rolling strategies, strategy, use the factory's
+//   1. For the Recreate, Rolling and Canary strategies, use the factory's
 //      DeployerImage as the container image, and the factory's Environment
 //      as the container environment.
 //   2. For all Custom strategy, use the strategy's image for the container
@@ -142,7 +148,7 @@ func (factory *DeploymentControllerFactory) makeContainer(strategy *deployapi.De
 
 	// Every strategy type should be handled here.
 	switch strategy.Type {
-	case deployapi.DeploymentStrategyTypeRecreate, deployapi.DeploymentStrategyTypeRolling:
+	case deployapi.DeploymentStrategyTypeRecreate, deployapi.DeploymentStrategyTypeRolling, deployapi.DeploymentStrategyTypeCanary:
 		// Use the factory-configured image.
 		return &kapi.Container{
 			Image: factory.DeployerImage,
@@ -3,14 +3,18 @@ package deploymentconfig
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/golang/glog"
 
 	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/unversioned"
 	"k8s.io/kubernetes/pkg/client/record"
 	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/labels"
 	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/intstr"
 
 	osclient "github.com/openshift/origin/pkg/client"
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
@@ -31,6 +35,12 @@ import (
 // If a new version is observed for which no deployment exists, any running
 // deployments will be cancelled. The controller will not attempt to scale
 // running deployments.
+//
+// If the latest deployment of a config with AutoRollback failed, the config is
+// rolled back to the active deployment.
+//
+// The status of the config reports the replicas of its deployments and the
+// Progressing, Available and ReplicaFailure conditions of the config.
 type DeploymentConfigController struct {
 	// kubeClient provides acceess to Kube resources.
 	kubeClient kclient.Interface
@@ -68,7 +78,7 @@ func (c *DeploymentConfigController) Handle(config *deployapi.DeploymentConfig)
 	// There's nothing to reconcile until the version is nonzero.
 	if config.Status.LatestVersion == 0 {
 		glog.V(5).Infof("Waiting for first version of %s", deployutil.LabelForDeploymentConfig(config))
-		return nil
+		return c.updateStatus(config, &kapi.ReplicationControllerList{})
 	}
 
 	// Find all deployments owned by the deploymentConfig.
@@ -115,9 +125,18 @@ func (c *DeploymentConfigController) Handle(config *deployapi.DeploymentConfig)
 		// If the latest deployment is still running, try again later. We don't
 		// want to compete with the deployer.
 		if !deployutil.IsTerminatedDeployment(latestDeployment) {
-			return nil
+			return c.updateStatus(config, existingDeployments)
+		}
+		if config.Spec.AutoRollback && deployutil.DeploymentStatusFor(latestDeployment) == deployapi.DeploymentStatusFailed && !deployutil.IsDeploymentCancelled(latestDeployment) {
+			// The rolled back config is handled once it is observed.
+			if rolledBack, err := c.rollback(config, latestDeployment, existingDeployments); err != nil || rolledBack {
+				return err
+			}
+		}
+		if err := c.reconcileDeployments(existingDeployments, config); err != nil {
+			return err
 		}
-		return c.reconcileDeployments(existingDeployments, config)
+		return c.updateStatus(config, existingDeployments)
 	}
 	// No deployments are running and the latest deployment doesn't exist, so
 	// create the new deployment.
@@ -136,7 +155,8 @@ func (c *DeploymentConfigController) Handle(config *deployapi.DeploymentConfig)
 		return fmt.Errorf("couldn't create deployment for deployment config %s: %v", deployutil.LabelForDeploymentConfig(config), err)
 	}
 	c.recorder.Eventf(config, kapi.EventTypeNormal, "DeploymentCreated", "Created new deployment %q for version %d", created.Name, config.Status.LatestVersion)
-	return nil
+	existingDeployments.Items = append(existingDeployments.Items, *created)
+	return c.updateStatus(config, existingDeployments)
 }
 
 // reconcileDeployments reconciles existing deployment replica counts which
@@ -262,3 +282,279 @@ func (c *DeploymentConfigController) reconcileDeployments(existingDeployments *k
 	}
 	return nil
 }
+
+// updateStatus updates the replica counts and the conditions of the status of
+// config from its deployments and their pods, and records the generation of
+// config as observed.
+func (c *DeploymentConfigController) updateStatus(config *deployapi.DeploymentConfig, existingDeployments *kapi.ReplicationControllerList) error {
+	status := config.Status
+	status.Conditions = append([]deployapi.DeploymentCondition{}, config.Status.Conditions...)
+	status.ObservedGeneration = config.Generation
+
+	_, latestDeployment := deployutil.LatestDeploymentInfo(config, existingDeployments)
+	status.Replicas, status.UpdatedReplicas = 0, 0
+	for i := range existingDeployments.Items {
+		status.Replicas += existingDeployments.Items[i].Status.Replicas
+	}
+	if latestDeployment != nil {
+		status.UpdatedReplicas = latestDeployment.Status.Replicas
+	}
+
+	pods := []kapi.Pod{}
+	if len(config.Spec.Selector) > 0 {
+		list, err := c.kubeClient.Pods(config.Namespace).List(kapi.ListOptions{LabelSelector: labels.SelectorFromSet(config.Spec.Selector)})
+		if err != nil {
+			return fmt.Errorf("couldn't list the pods of deployment config %s: %v", deployutil.LabelForDeploymentConfig(config), err)
+		}
+		if list != nil {
+			pods = list.Items
+		}
+	}
+	status.AvailableReplicas = 0
+	for i := range pods {
+		if kapi.IsPodReady(&pods[i]) {
+			status.AvailableReplicas++
+		}
+	}
+
+	now := unversioned.Now()
+	if progressing := progressingCondition(latestDeployment); progressing != nil {
+		progressing.LastTransitionTime = now
+		deployutil.SetDeploymentCondition(&status, *progressing)
+	} else {
+		deployutil.RemoveDeploymentCondition(&status, deployapi.DeploymentProgressing)
+	}
+
+	available := deployapi.DeploymentCondition{
+		Type:               deployapi.DeploymentAvailable,
+		Status:             kapi.ConditionTrue,
+		LastTransitionTime: now,
+		Reason:             deployapi.MinimumReplicasAvailableReason,
+		Message:            "Deployment config has minimum availability.",
+	}
+	if minAvailable := minAvailableReplicas(config); status.AvailableReplicas < minAvailable {
+		available.Status = kapi.ConditionFalse
+		available.Reason = deployapi.MinimumReplicasUnavailableReason
+		available.Message = fmt.Sprintf("Deployment config has %d available replicas, %d are required.", status.AvailableReplicas, minAvailable)
+	}
+	deployutil.SetDeploymentCondition(&status, available)
+
+	if failures := failingPods(latestDeployment, pods); len(failures) > 0 {
+		deployutil.SetDeploymentCondition(&status, deployapi.DeploymentCondition{
+			Type:               deployapi.DeploymentReplicaFailure,
+			Status:             kapi.ConditionTrue,
+			LastTransitionTime: now,
+			Reason:             deployapi.FailingPodsReason,
+			Message:            strings.Join(failures, "; "),
+		})
+	} else {
+		deployutil.RemoveDeploymentCondition(&status, deployapi.DeploymentReplicaFailure)
+	}
+
+	if kapi.Semantic.DeepEqual(status, config.Status) {
+		return nil
+	}
+	copied, err := kapi.Scheme.DeepCopy(config)
+	if err != nil {
+		return err
+	}
+	updated := copied.(*deployapi.DeploymentConfig)
+	updated.Status = status
+	if _, err := c.osClient.DeploymentConfigs(config.Namespace).UpdateStatus(updated); err != nil {
+		// The config was updated since it was observed, its status is updated
+		// once the newer version is handled.
+		if errors.IsConflict(err) {
+			glog.V(4).Infof("Couldn't update the status of deploymentConfig %q: %v", deployutil.LabelForDeploymentConfig(config), err)
+			return nil
+		}
+		return fmt.Errorf("couldn't update the status of deployment config %s: %v", deployutil.LabelForDeploymentConfig(config), err)
+	}
+	return nil
+}
+
+// progressingCondition returns the Progressing condition for the latest
+// deployment of a config, or nil if there is no latest deployment.
+func progressingCondition(latest *kapi.ReplicationController) *deployapi.DeploymentCondition {
+	if latest == nil {
+		return nil
+	}
+	condition := &deployapi.DeploymentCondition{
+		Type:   deployapi.DeploymentProgressing,
+		Status: kapi.ConditionTrue,
+	}
+	switch deployutil.DeploymentStatusFor(latest) {
+	case deployapi.DeploymentStatusNew, deployapi.DeploymentStatusPending:
+		condition.Reason = deployapi.NewReplicationControllerReason
+		condition.Message = fmt.Sprintf("Replication controller %q is waiting to be rolled out.", latest.Name)
+	case deployapi.DeploymentStatusRunning:
+		condition.Reason = deployapi.ReplicationControllerUpdatedReason
+		condition.Message = fmt.Sprintf("Replication controller %q is progressing.", latest.Name)
+	case deployapi.DeploymentStatusComplete:
+		condition.Reason = deployapi.NewRcAvailableReason
+		condition.Message = fmt.Sprintf("Replication controller %q has successfully progressed.", latest.Name)
+	case deployapi.DeploymentStatusFailed:
+		condition.Status = kapi.ConditionFalse
+		condition.Reason = deployapi.RolloutFailedReason
+		condition.Message = fmt.Sprintf("Replication controller %q has failed progressing.", latest.Name)
+		if deployutil.IsDeploymentCancelled(latest) {
+			condition.Reason = deployapi.RolloutCancelledReason
+			condition.Message = fmt.Sprintf("Rollout of replication controller %q was cancelled.", latest.Name)
+		}
+		if reason := deployutil.DeploymentStatusReasonFor(latest); len(reason) > 0 {
+			condition.Message = fmt.Sprintf("%s %s", condition.Message, reason)
+		}
+	}
+	return condition
+}
+
+// minAvailableReplicas returns how many replicas of config must be available
+// for config to be available, taking into account the replicas the rolling
+// strategy is allowed to take down.
+func minAvailableReplicas(config *deployapi.DeploymentConfig) int {
+	replicas := config.Spec.Replicas
+	if config.Spec.Test {
+		return 0
+	}
+	if params := config.Spec.Strategy.RollingParams; config.Spec.Strategy.Type == deployapi.DeploymentStrategyTypeRolling && params != nil {
+		maxUnavailable, err := intstr.GetValueFromIntOrPercent(&params.MaxUnavailable, replicas, false)
+		if err == nil {
+			replicas -= maxUnavailable
+		}
+	}
+	if replicas < 0 {
+		return 0
+	}
+	return replicas
+}
+
+// failingPods describes the pods of the latest deployment which fail to run
+// while the deployment is rolled out or after it failed.
+func failingPods(latest *kapi.ReplicationController, pods []kapi.Pod) []string {
+	if latest == nil || deployutil.DeploymentStatusFor(latest) == deployapi.DeploymentStatusComplete {
+		return nil
+	}
+	failures := []string{}
+	for i := range pods {
+		pod := &pods[i]
+		if pod.Labels[deployapi.DeploymentLabel] != latest.Name || kapi.IsPodReady(pod) || !isPodFailing(pod) {
+			continue
+		}
+		failures = append(failures, fmt.Sprintf("pod %s %s", pod.Name, podFailureReason(pod)))
+	}
+	return failures
+}
+
+// isPodFailing returns true if a container of pod can't start, terminated or
+// was restarted.
+func isPodFailing(pod *kapi.Pod) bool {
+	if pod.Status.Phase == kapi.PodFailed {
+		return true
+	}
+	for _, status := range pod.Status.ContainerStatuses {
+		switch {
+		case status.State.Waiting != nil && len(status.State.Waiting.Reason) > 0 && status.State.Waiting.Reason != "ContainerCreating":
+			return true
+		case status.State.Terminated != nil, status.RestartCount > 0:
+			return true
+		}
+	}
+	return false
+}
+
+// rollback rolls the template of config back to the one of the active
+// deployment after the failure of the latest deployment, the same way a
+// DeploymentConfigRollback does. It returns false if there is no deployment to
+// roll back to.
+func (c *DeploymentConfigController) rollback(config *deployapi.DeploymentConfig, failed *kapi.ReplicationController, existingDeployments *kapi.ReplicationControllerList) (bool, error) {
+	active := deployutil.ActiveDeployment(config, existingDeployments)
+	if active == nil {
+		glog.V(4).Infof("Not rolling back deploymentConfig %q; there is no complete deployment", deployutil.LabelForDeploymentConfig(config))
+		return false, nil
+	}
+
+	// Rolling back a failed rollback would deploy the same template again.
+	activeConfig, err := deployutil.DecodeDeploymentConfig(active, c.codec)
+	if err != nil {
+		return false, fatalError(fmt.Sprintf("couldn't decode deployment config from deployment %s: %v", deployutil.LabelForDeployment(active), err))
+	}
+	failedConfig, err := deployutil.DecodeDeploymentConfig(failed, c.codec)
+	if err != nil {
+		return false, fatalError(fmt.Sprintf("couldn't decode deployment config from deployment %s: %v", deployutil.LabelForDeployment(failed), err))
+	}
+	if kapi.Semantic.DeepEqual(activeConfig.Spec.Template, failedConfig.Spec.Template) {
+		glog.V(4).Infof("Not rolling back deploymentConfig %q; failed deployment %q has the template of %q", deployutil.LabelForDeploymentConfig(config), failed.Name, active.Name)
+		return false, nil
+	}
+
+	reasons := c.failureReasons(failed)
+	rollback := &deployapi.DeploymentConfigRollback{
+		Spec: deployapi.DeploymentConfigRollbackSpec{
+			From: kapi.ObjectReference{
+				Name: active.Name,
+			},
+			IncludeTemplate: true,
+		},
+	}
+	rolledBack, err := c.osClient.DeploymentConfigs(config.Namespace).Rollback(rollback)
+	if err == nil {
+		// A rollback disables the image change triggers so that they don't
+		// replace a rollback requested by the user. The triggers have already
+		// fired for the images of the failed deployment, keep them so that new
+		// images are still deployed.
+		rolledBack.Spec.Triggers = config.Spec.Triggers
+		rolledBack.Status.Details = &deployapi.DeploymentDetails{
+			Message: fmt.Sprintf("automatic rollback to %s after %s failed", active.Name, failed.Name),
+		}
+		_, err = c.osClient.DeploymentConfigs(config.Namespace).Update(rolledBack)
+	}
+	if err != nil {
+		c.recorder.Eventf(config, kapi.EventTypeWarning, "RollbackFailed", "Couldn't roll back to deployment %q after deployment %q failed: %v", active.Name, failed.Name, err)
+		return false, fmt.Errorf("couldn't roll back deployment config %s: %v", deployutil.LabelForDeploymentConfig(config), err)
+	}
+	c.recorder.Eventf(config, kapi.EventTypeWarning, "RolledBack", "Rolled back to deployment %q after deployment %q failed: %s", active.Name, failed.Name, reasons)
+	return true, nil
+}
+
+// failureReasons describes why deployment failed using the status reason of
+// the deployment and the reasons its pods aren't ready.
+func (c *DeploymentConfigController) failureReasons(deployment *kapi.ReplicationController) string {
+	reasons := []string{}
+	if reason := deployutil.DeploymentStatusReasonFor(deployment); len(reason) > 0 {
+		reasons = append(reasons, reason)
+	}
+	pods, err := c.kubeClient.Pods(deployment.Namespace).List(kapi.ListOptions{LabelSelector: labels.SelectorFromSet(deployment.Spec.Selector)})
+	if err != nil {
+		glog.V(2).Infof("Couldn't list the pods of deployment %s: %v", deployutil.LabelForDeployment(deployment), err)
+	} else if pods != nil {
+		for i := range pods.Items {
+			pod := &pods.Items[i]
+			if !kapi.IsPodReady(pod) {
+				reasons = append(reasons, fmt.Sprintf("pod %s %s", pod.Name, podFailureReason(pod)))
+			}
+		}
+	}
+	if len(reasons) == 0 {
+		return "no failing pods found"
+	}
+	return strings.Join(reasons, "; ")
+}
+
+// podFailureReason describes why pod isn't ready.
+func podFailureReason(pod *kapi.Pod) string {
+	for _, status := range pod.Status.ContainerStatuses {
+		switch {
+		case status.State.Waiting != nil && len(status.State.Waiting.Reason) > 0:
+			return fmt.Sprintf("container %s is waiting: %s", status.Name, status.State.Waiting.Reason)
+		case status.State.Terminated != nil:
+			return fmt.Sprintf("container %s terminated: %s (exit code %d)", status.Name, status.State.Terminated.Reason, status.State.Terminated.ExitCode)
+		case status.LastTerminationState.Terminated != nil:
+			return fmt.Sprintf("container %s restarted: %s (exit code %d)", status.Name, status.LastTerminationState.Terminated.Reason, status.LastTerminationState.Terminated.ExitCode)
+		case !status.Ready:
+			return fmt.Sprintf("container %s is not ready", status.Name)
+		}
+	}
+	if len(pod.Status.Reason) > 0 {
+		return fmt.Sprintf("is not ready: %s", pod.Status.Reason)
+	}
+	return "is not ready"
+}
@@ -11,6 +11,7 @@ import (
 	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
 	"k8s.io/kubernetes/pkg/runtime"
 	kutil "k8s.io/kubernetes/pkg/util"
+	"k8s.io/kubernetes/pkg/util/intstr"
 
 	"github.com/openshift/origin/pkg/client/testclient"
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
@@ -697,3 +698,279 @@ func TestHandleScenarios(t *testing.T) {
 func newint(i int) *int {
 	return &i
 }
+
+func TestHandle_autoRollback(t *testing.T) {
+	codec := kapi.Codecs.LegacyCodec(deployapi.SchemeGroupVersion)
+	mkdeployment := func(version int, status deployapi.DeploymentStatus, image string, cancelled bool) kapi.ReplicationController {
+		config := deploytest.OkDeploymentConfig(version)
+		config.Spec.Template.Spec.Containers[0].Image = image
+		deployment, _ := deployutil.MakeDeployment(config, codec)
+		deployment.Annotations[deployapi.DeploymentStatusAnnotation] = string(status)
+		if cancelled {
+			deployment.Annotations[deployapi.DeploymentCancelledAnnotation] = deployapi.DeploymentCancelledAnnotationValue
+		}
+		return *deployment
+	}
+	failingPod := kapi.Pod{
+		ObjectMeta: kapi.ObjectMeta{Name: "config-2-abcde"},
+		Status: kapi.PodStatus{
+			Phase: kapi.PodRunning,
+			ContainerStatuses: []kapi.ContainerStatus{
+				{Name: "container1", State: kapi.ContainerState{Waiting: &kapi.ContainerStateWaiting{Reason: "CrashLoopBackOff"}}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		deployments []kapi.ReplicationController
+		rollback    bool
+	}{
+		{
+			name: "failed deployment",
+			deployments: []kapi.ReplicationController{
+				mkdeployment(1, deployapi.DeploymentStatusComplete, "image:1", false),
+				mkdeployment(2, deployapi.DeploymentStatusFailed, "image:2", false),
+			},
+			rollback: true,
+		},
+		{
+			name: "cancelled deployment",
+			deployments: []kapi.ReplicationController{
+				mkdeployment(1, deployapi.DeploymentStatusComplete, "image:1", false),
+				mkdeployment(2, deployapi.DeploymentStatusFailed, "image:2", true),
+			},
+		},
+		{
+			name: "failed rollback",
+			deployments: []kapi.ReplicationController{
+				mkdeployment(1, deployapi.DeploymentStatusComplete, "image:1", false),
+				mkdeployment(2, deployapi.DeploymentStatusFailed, "image:1", false),
+			},
+		},
+		{
+			name: "no complete deployment",
+			deployments: []kapi.ReplicationController{
+				mkdeployment(2, deployapi.DeploymentStatusFailed, "image:2", false),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		kc := &ktestclient.Fake{}
+		kc.AddReactor("list", "replicationcontrollers", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+			return true, &kapi.ReplicationControllerList{Items: test.deployments}, nil
+		})
+		kc.AddReactor("update", "replicationcontrollers", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+			return true, action.(ktestclient.UpdateAction).GetObject(), nil
+		})
+		pod := failingPod
+		pod.Labels = test.deployments[len(test.deployments)-1].Spec.Selector
+		kc.AddReactor("list", "pods", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+			return true, &kapi.PodList{Items: []kapi.Pod{pod}}, nil
+		})
+
+		var rollback *deployapi.DeploymentConfigRollback
+		var updated *deployapi.DeploymentConfig
+		oc := &testclient.Fake{}
+		oc.AddReactor("create", "deploymentconfigrollbacks", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+			rollback = action.(ktestclient.CreateAction).GetObject().(*deployapi.DeploymentConfigRollback)
+			rolledBack := deploytest.OkDeploymentConfig(3)
+			trigger := deploytest.OkImageChangeTrigger()
+			trigger.ImageChangeParams.Automatic = false
+			rolledBack.Spec.Triggers = []deployapi.DeploymentTriggerPolicy{trigger}
+			return true, rolledBack, nil
+		})
+		oc.AddReactor("update", "deploymentconfigs", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+			updated = action.(ktestclient.UpdateAction).GetObject().(*deployapi.DeploymentConfig)
+			return true, updated, nil
+		})
+
+		recorder := &record.FakeRecorder{}
+		controller := &DeploymentConfigController{
+			kubeClient: kc,
+			osClient:   oc,
+			codec:      codec,
+			recorder:   recorder,
+		}
+
+		config := deploytest.OkDeploymentConfig(2)
+		config.Spec.AutoRollback = true
+		config.Spec.Triggers = []deployapi.DeploymentTriggerPolicy{deploytest.OkImageChangeTrigger()}
+		if err := controller.Handle(config); err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.name, err)
+		}
+
+		if !test.rollback {
+			if rollback != nil {
+				t.Errorf("%s: unexpected rollback to %s", test.name, rollback.Spec.From.Name)
+			}
+			continue
+		}
+		if rollback == nil || rollback.Spec.From.Name != "config-1" || !rollback.Spec.IncludeTemplate {
+			t.Fatalf("%s: expected a rollback of the template to config-1, got %#v", test.name, rollback)
+		}
+		if updated == nil || updated.Status.LatestVersion != 3 || updated.Status.Details == nil {
+			t.Fatalf("%s: expected the rolled back config to be updated, got %#v", test.name, updated)
+		}
+		if len(updated.Spec.Triggers) != 1 || !updated.Spec.Triggers[0].ImageChangeParams.Automatic {
+			t.Errorf("%s: expected the triggers of the config to be kept, got %#v", test.name, updated.Spec.Triggers)
+		}
+		found := false
+		for _, event := range recorder.Events {
+			if strings.Contains(event, "RolledBack") && strings.Contains(event, "config-2-abcde") && strings.Contains(event, "CrashLoopBackOff") {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("%s: expected an event with the reasons of the failing pods, got %v", test.name, recorder.Events)
+		}
+	}
+}
+
+func TestHandle_updateStatus(t *testing.T) {
+	codec := kapi.Codecs.LegacyCodec(deployapi.SchemeGroupVersion)
+	mkdeployment := func(version int, status deployapi.DeploymentStatus, replicas int) kapi.ReplicationController {
+		deployment, _ := deployutil.MakeDeployment(deploytest.OkDeploymentConfig(version), codec)
+		deployment.Annotations[deployapi.DeploymentStatusAnnotation] = string(status)
+		deployment.Spec.Replicas = replicas
+		deployment.Status.Replicas = replicas
+		return *deployment
+	}
+	mkpod := func(name, deployment string, ready bool, waiting string) kapi.Pod {
+		pod := kapi.Pod{
+			ObjectMeta: kapi.ObjectMeta{
+				Name:   name,
+				Labels: map[string]string{"a": "b", deployapi.DeploymentLabel: deployment},
+			},
+			Status: kapi.PodStatus{Phase: kapi.PodRunning},
+		}
+		if ready {
+			pod.Status.Conditions = []kapi.PodCondition{{Type: kapi.PodReady, Status: kapi.ConditionTrue}}
+		}
+		if len(waiting) > 0 {
+			pod.Status.ContainerStatuses = []kapi.ContainerStatus{
+				{Name: "container1", State: kapi.ContainerState{Waiting: &kapi.ContainerStateWaiting{Reason: waiting}}},
+			}
+		}
+		return pod
+	}
+	deployments := []kapi.ReplicationController{
+		mkdeployment(1, deployapi.DeploymentStatusComplete, 2),
+		mkdeployment(2, deployapi.DeploymentStatusRunning, 1),
+	}
+	pods := []kapi.Pod{
+		mkpod("config-1-abcde", "config-1", true, ""),
+		mkpod("config-1-fghij", "config-1", true, ""),
+		mkpod("config-2-abcde", "config-2", false, "CrashLoopBackOff"),
+	}
+
+	kc := &ktestclient.Fake{}
+	kc.AddReactor("list", "replicationcontrollers", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+		return true, &kapi.ReplicationControllerList{Items: deployments}, nil
+	})
+	kc.AddReactor("list", "pods", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+		return true, &kapi.PodList{Items: pods}, nil
+	})
+	var updated *deployapi.DeploymentConfig
+	oc := &testclient.Fake{}
+	oc.AddReactor("update", "deploymentconfigs/status", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+		updated = action.(ktestclient.UpdateAction).GetObject().(*deployapi.DeploymentConfig)
+		return true, updated, nil
+	})
+	controller := &DeploymentConfigController{
+		kubeClient: kc,
+		osClient:   oc,
+		codec:      codec,
+		recorder:   &record.FakeRecorder{},
+	}
+
+	config := deploytest.OkDeploymentConfig(2)
+	config.Generation = 3
+	config.Spec.Replicas = 3
+	config.Spec.Strategy = deploytest.OkRollingStrategy()
+	config.Spec.Strategy.RollingParams.MaxUnavailable = intstr.FromInt(1)
+	if err := controller.Handle(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated == nil {
+		t.Fatalf("expected the status of the config to be updated")
+	}
+	status := updated.Status
+	if status.ObservedGeneration != 3 || status.Replicas != 3 || status.UpdatedReplicas != 1 || status.AvailableReplicas != 2 {
+		t.Errorf("unexpected status %#v", status)
+	}
+	expected := map[deployapi.DeploymentConditionType]kapi.ConditionStatus{
+		deployapi.DeploymentProgressing:    kapi.ConditionTrue,
+		deployapi.DeploymentAvailable:      kapi.ConditionTrue,
+		deployapi.DeploymentReplicaFailure: kapi.ConditionTrue,
+	}
+	for condType, condStatus := range expected {
+		condition := deployutil.GetDeploymentCondition(status, condType)
+		if condition == nil || condition.Status != condStatus {
+			t.Errorf("expected condition %s to be %s, got %#v", condType, condStatus, condition)
+		}
+	}
+	if condition := deployutil.GetDeploymentCondition(status, deployapi.DeploymentProgressing); condition != nil && condition.Reason != deployapi.ReplicationControllerUpdatedReason {
+		t.Errorf("unexpected Progressing reason %s", condition.Reason)
+	}
+	if condition := deployutil.GetDeploymentCondition(status, deployapi.DeploymentReplicaFailure); condition != nil && !strings.Contains(condition.Message, "config-2-abcde") {
+		t.Errorf("expected the failing pod in the ReplicaFailure message, got %q", condition.Message)
+	}
+
+	// An unchanged status isn't updated again.
+	config, updated = updated, nil
+	if err := controller.Handle(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated != nil {
+		t.Errorf("expected an unchanged status not to be updated, got %#v", updated.Status)
+	}
+
+	// Once the latest deployment is complete, the replica failure is cleared.
+	deployments = []kapi.ReplicationController{
+		mkdeployment(1, deployapi.DeploymentStatusComplete, 0),
+		mkdeployment(2, deployapi.DeploymentStatusComplete, 3),
+	}
+	pods = []kapi.Pod{
+		mkpod("config-2-abcde", "config-2", true, ""),
+		mkpod("config-2-fghij", "config-2", true, ""),
+		mkpod("config-2-klmno", "config-2", true, ""),
+	}
+	if err := controller.Handle(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated == nil {
+		t.Fatalf("expected the status of the config to be updated")
+	}
+	if condition := deployutil.GetDeploymentCondition(updated.Status, deployapi.DeploymentProgressing); condition == nil || condition.Reason != deployapi.NewRcAvailableReason {
+		t.Errorf("expected the rollout to be reported complete, got %#v", condition)
+	}
+	if condition := deployutil.GetDeploymentCondition(updated.Status, deployapi.DeploymentReplicaFailure); condition != nil {
+		t.Errorf("expected no ReplicaFailure condition, got %#v", condition)
+	}
+}
+
+func TestPodReadinessChanged(t *testing.T) {
+	mkpod := func(phase kapi.PodPhase, ready kapi.ConditionStatus) *kapi.Pod {
+		return &kapi.Pod{Status: kapi.PodStatus{
+			Phase:      phase,
+			Conditions: []kapi.PodCondition{{Type: kapi.PodReady, Status: ready}},
+		}}
+	}
+	tests := []struct {
+		name     string
+		old, cur *kapi.Pod
+		expected bool
+	}{
+		{"became ready", mkpod(kapi.PodRunning, kapi.ConditionFalse), mkpod(kapi.PodRunning, kapi.ConditionTrue), true},
+		{"became unready", mkpod(kapi.PodRunning, kapi.ConditionTrue), mkpod(kapi.PodRunning, kapi.ConditionFalse), true},
+		{"failed", mkpod(kapi.PodPending, kapi.ConditionFalse), mkpod(kapi.PodFailed, kapi.ConditionFalse), true},
+		{"unchanged", mkpod(kapi.PodRunning, kapi.ConditionTrue), mkpod(kapi.PodRunning, kapi.ConditionTrue), false},
+	}
+	for _, test := range tests {
+		if changed := podReadinessChanged(test.old, test.cur); changed != test.expected {
+			t.Errorf("%s: expected %t, got %t", test.name, test.expected, changed)
+		}
+	}
+}
@@ -8,14 +8,18 @@ import (
 	"k8s.io/kubernetes/pkg/client/cache"
 	"k8s.io/kubernetes/pkg/client/record"
 	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/controller/framework"
+	"k8s.io/kubernetes/pkg/labels"
 	"k8s.io/kubernetes/pkg/runtime"
 	kutil "k8s.io/kubernetes/pkg/util"
 	utilruntime "k8s.io/kubernetes/pkg/util/runtime"
+	"k8s.io/kubernetes/pkg/util/wait"
 	"k8s.io/kubernetes/pkg/watch"
 
 	osclient "github.com/openshift/origin/pkg/client"
 	controller "github.com/openshift/origin/pkg/controller"
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
 )
 
 // DeploymentConfigControllerFactory can create a DeploymentConfigController which obtains
@@ -42,6 +46,69 @@ func (factory *DeploymentConfigControllerFactory) Create() controller.RunnableCo
 	queue := cache.NewFIFO(cache.MetaNamespaceKeyFunc)
 	cache.NewReflector(deploymentConfigLW, &deployapi.DeploymentConfig{}, queue, 2*time.Minute).Run()
 
+	// The status of a config follows its deployments and their pods, so
+	// changes to the deployments and to the readiness of their pods requeue
+	// their config.
+	configStore := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	cache.NewReflector(deploymentConfigLW, &deployapi.DeploymentConfig{}, configStore, 2*time.Minute).Run()
+	requeueConfig := func(namespace, name string) {
+		if len(name) == 0 {
+			return
+		}
+		if config, exists, err := configStore.GetByKey(namespace + "/" + name); err == nil && exists {
+			queue.AddIfNotPresent(config)
+		}
+	}
+	deploymentLW := &cache.ListWatch{
+		ListFunc: func(options kapi.ListOptions) (runtime.Object, error) {
+			return factory.KubeClient.ReplicationControllers(kapi.NamespaceAll).List(options)
+		},
+		WatchFunc: func(options kapi.ListOptions) (watch.Interface, error) {
+			return factory.KubeClient.ReplicationControllers(kapi.NamespaceAll).Watch(options)
+		},
+	}
+	deploymentQueue := cache.NewFIFO(cache.MetaNamespaceKeyFunc)
+	cache.NewReflector(deploymentLW, &kapi.ReplicationController{}, deploymentQueue, 2*time.Minute).Run()
+	go wait.Forever(func() {
+		deployment := deploymentQueue.Pop().(*kapi.ReplicationController)
+		requeueConfig(deployment.Namespace, deployutil.DeploymentConfigNameFor(deployment))
+	}, 0)
+
+	// The replication controllers don't report how many of their pods are
+	// ready, so the pods of the deployments are watched directly.
+	deploymentPodSelector, _ := labels.Parse(deployapi.DeploymentConfigLabel)
+	_, podController := framework.NewInformer(
+		&cache.ListWatch{
+			ListFunc: func(options kapi.ListOptions) (runtime.Object, error) {
+				options.LabelSelector = deploymentPodSelector
+				return factory.KubeClient.Pods(kapi.NamespaceAll).List(options)
+			},
+			WatchFunc: func(options kapi.ListOptions) (watch.Interface, error) {
+				options.LabelSelector = deploymentPodSelector
+				return factory.KubeClient.Pods(kapi.NamespaceAll).Watch(options)
+			},
+		},
+		&kapi.Pod{},
+		2*time.Minute,
+		framework.ResourceEventHandlerFuncs{
+			UpdateFunc: func(old, cur interface{}) {
+				oldPod, curPod := old.(*kapi.Pod), cur.(*kapi.Pod)
+				if podReadinessChanged(oldPod, curPod) {
+					requeueConfig(curPod.Namespace, curPod.Labels[deployapi.DeploymentConfigLabel])
+				}
+			},
+			DeleteFunc: func(obj interface{}) {
+				if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+					obj = tombstone.Obj
+				}
+				if pod, ok := obj.(*kapi.Pod); ok {
+					requeueConfig(pod.Namespace, pod.Labels[deployapi.DeploymentConfigLabel])
+				}
+			},
+		},
+	)
+	go podController.Run(wait.NeverStop)
+
 	eventBroadcaster := record.NewBroadcaster()
 	eventBroadcaster.StartRecordingToSink(factory.KubeClient.Events(""))
 	recorder := eventBroadcaster.NewRecorder(kapi.EventSource{Component: "deploymentconfig-controller"})
@@ -81,3 +148,10 @@ func (factory *DeploymentConfigControllerFactory) Create() controller.RunnableCo
 		},
 	}
 }
+
+// podReadinessChanged returns true if the readiness or the phase of a pod
+// changed between old and cur, which changes how many replicas of its
+// deployment config are available.
+func podReadinessChanged(old, cur *kapi.Pod) bool {
+	return kapi.IsPodReady(old) != kapi.IsPodReady(cur) || old.Status.Phase != cur.Status.Phase
+}
@@ -35,6 +35,10 @@ func (c *ImageChangeController) Handle(imageRepo *imageapi.ImageStream) error {
 	// Find any configs which should be updated based on the new image state
 	configsToUpdate := map[string]*deployapi.DeploymentConfig{}
 	for _, config := range configs {
+		if config.Spec.Paused {
+			glog.V(4).Infof("Ignoring DeploymentConfig %s; it is paused", deployutil.LabelForDeploymentConfig(config))
+			continue
+		}
 		glog.V(4).Infof("Detecting changed images for DeploymentConfig %s", deployutil.LabelForDeploymentConfig(config))
 
 		for _, trigger := range config.Spec.Triggers {
@@ -52,6 +52,43 @@ func TestHandle_changeForNonAutomaticTag(t *testing.T) {
 	}
 }
 
+// TestHandle_changeForPausedConfig ensures that an image update for which
+// there is a matching trigger results in a no-op due to the config being
+// paused.
+func TestHandle_changeForPausedConfig(t *testing.T) {
+	controller := &ImageChangeController{
+		deploymentConfigClient: &deploymentConfigClientImpl{
+			updateDeploymentConfigFunc: func(namespace string, config *deployapi.DeploymentConfig) (*deployapi.DeploymentConfig, error) {
+				t.Fatalf("unexpected DeploymentConfig update")
+				return nil, nil
+			},
+			generateDeploymentConfigFunc: func(namespace, name string) (*deployapi.DeploymentConfig, error) {
+				t.Fatalf("unexpected generator call")
+				return nil, nil
+			},
+			listDeploymentConfigsFunc: func() ([]*deployapi.DeploymentConfig, error) {
+				config := deployapitest.OkDeploymentConfig(1)
+				config.Spec.Paused = true
+
+				return []*deployapi.DeploymentConfig{config}, nil
+			},
+		},
+	}
+
+	// verify no-op
+	tagUpdate := makeRepo(
+		"test-image-repo",
+		imageapi.DefaultImageTag,
+		"registry:8080/openshift/test-image@sha256:00000000000000000000000000000001",
+		"00000000000000000000000000000001",
+	)
+	err := controller.Handle(tagUpdate)
+
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+}
+
 // TestHandle_changeForUnregisteredTag ensures that an image update for which
 // there is a matching trigger results in a no-op due to the tag specified on
 // the trigger not matching the tags defined on the image repo.
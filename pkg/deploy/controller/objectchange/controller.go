@@ -0,0 +1,240 @@
+package objectchange
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"github.com/golang/glog"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
+)
+
+// ObjectChangeController increments the version of a DeploymentConfig which
+// has a secret or config map change trigger when the data of the triggering
+// object differs from the data last observed by the trigger, as recorded in
+// the TriggeredObjects of the status of the config.
+//
+// The first data of an object observed by a trigger is only recorded, since
+// the current deployment already runs with it.
+//
+// Use the ObjectChangeControllerFactory to create this controller.
+type ObjectChangeController struct {
+	// client knows how to get the triggering objects and update
+	// DeploymentConfigs.
+	client objectChangeClient
+}
+
+// fatalError is an error which can't be retried.
+type fatalError string
+
+func (e fatalError) Error() string {
+	return fmt.Sprintf("fatal error handling DeploymentConfig: %s", string(e))
+}
+
+// Handle processes the secret and config map change triggers of config.
+func (c *ObjectChangeController) Handle(config *deployapi.DeploymentConfig) error {
+	if !hasObjectChangeTrigger(config) {
+		glog.V(5).Infof("Ignoring DeploymentConfig %s; no secret or config map change triggers detected", deployutil.LabelForDeploymentConfig(config))
+		return nil
+	}
+
+	if config.Spec.Paused {
+		glog.V(4).Infof("Ignoring DeploymentConfig %s; it is paused", deployutil.LabelForDeploymentConfig(config))
+		return nil
+	}
+
+	copied, err := kapi.Scheme.DeepCopy(config)
+	if err != nil {
+		return err
+	}
+	newConfig := copied.(*deployapi.DeploymentConfig)
+
+	var triggered []deployapi.DeploymentTriggeredObject
+	causes := []*deployapi.DeploymentCause{}
+	for _, trigger := range newConfig.Spec.Triggers {
+		kind, params := triggerObject(trigger)
+		if params == nil {
+			continue
+		}
+		last := lastTriggeredObject(config, kind, params.Name)
+
+		resourceVersion, dataHash, err := c.objectData(kind, config.Namespace, params.Name)
+		if err != nil {
+			if kerrors.IsNotFound(err) {
+				glog.V(4).Infof("Ignoring %s trigger of DeploymentConfig %s; %s %s not found", trigger.Type, deployutil.LabelForDeploymentConfig(config), kind, params.Name)
+				if last != nil {
+					triggered = append(triggered, *last)
+				}
+				continue
+			}
+			return fmt.Errorf("couldn't get %s %s/%s for DeploymentConfig %s: %v", kind, config.Namespace, params.Name, deployutil.LabelForDeploymentConfig(config), err)
+		}
+		triggered = append(triggered, deployapi.DeploymentTriggeredObject{Kind: kind, Name: params.Name, DataHash: dataHash})
+		if last == nil || last.DataHash == dataHash {
+			continue
+		}
+
+		// A change to the data of an object observed before starts a new
+		// deployment.
+		if config.Status.LatestVersion > 0 {
+			causes = append(causes, &deployapi.DeploymentCause{
+				Type: trigger.Type,
+				ObjectTrigger: &deployapi.DeploymentCauseObjectTrigger{
+					From: kapi.ObjectReference{
+						Kind:            kind,
+						Namespace:       config.Namespace,
+						Name:            params.Name,
+						ResourceVersion: resourceVersion,
+					},
+				},
+			})
+		}
+	}
+
+	if kapi.Semantic.DeepEqual(triggered, config.Status.TriggeredObjects) {
+		return nil
+	}
+	newConfig.Status.TriggeredObjects = triggered
+	if len(causes) > 0 {
+		newConfig.Status.LatestVersion++
+		newConfig.Status.Details = &deployapi.DeploymentDetails{
+			Causes: causes,
+		}
+	}
+
+	// This update is atomic. If it fails because a newer resource was already
+	// persisted, the newer config is handled once it is observed.
+	if _, err := c.client.updateDeploymentConfig(newConfig.Namespace, newConfig); err != nil {
+		if kerrors.IsConflict(err) {
+			return fatalError(fmt.Sprintf("DeploymentConfig %s updated since retrieval; aborting trigger: %v", deployutil.LabelForDeploymentConfig(config), err))
+		}
+		return fmt.Errorf("couldn't update DeploymentConfig %s: %v", deployutil.LabelForDeploymentConfig(config), err)
+	}
+	if len(causes) > 0 {
+		glog.V(4).Infof("Updated DeploymentConfig %s from version %d to %d for changed secrets or config maps", deployutil.LabelForDeploymentConfig(config), config.Status.LatestVersion, newConfig.Status.LatestVersion)
+	}
+	return nil
+}
+
+// objectData returns the current resource version of the secret or config
+// map and a hash of its data.
+func (c *ObjectChangeController) objectData(kind, namespace, name string) (string, string, error) {
+	if kind == "Secret" {
+		secret, err := c.client.getSecret(namespace, name)
+		if err != nil {
+			return "", "", err
+		}
+		return secret.ResourceVersion, dataHash(secret.Data), nil
+	}
+	configMap, err := c.client.getConfigMap(namespace, name)
+	if err != nil {
+		return "", "", err
+	}
+	data := make(map[string][]byte, len(configMap.Data))
+	for key, value := range configMap.Data {
+		data[key] = []byte(value)
+	}
+	return configMap.ResourceVersion, dataHash(data), nil
+}
+
+// dataHash returns a hash of the keys and values of the data of a secret or
+// config map.
+func dataHash(data map[string][]byte) string {
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	hash := sha256.New()
+	for _, key := range keys {
+		fmt.Fprintf(hash, "%d:%s%d:", len(key), key, len(data[key]))
+		hash.Write(data[key])
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// lastTriggeredObject returns the object of the kind with the given name last
+// observed by the triggers of config, or nil if it wasn't observed yet.
+func lastTriggeredObject(config *deployapi.DeploymentConfig, kind, name string) *deployapi.DeploymentTriggeredObject {
+	for i := range config.Status.TriggeredObjects {
+		if object := &config.Status.TriggeredObjects[i]; object.Kind == kind && object.Name == name {
+			return object
+		}
+	}
+	return nil
+}
+
+// triggerObject returns the kind of the object watched by a secret or config
+// map change trigger and the parameters of the trigger, or nil parameters for
+// any other trigger.
+func triggerObject(trigger deployapi.DeploymentTriggerPolicy) (string, *deployapi.DeploymentTriggerObjectChangeParams) {
+	switch trigger.Type {
+	case deployapi.DeploymentTriggerOnSecretChange:
+		return "Secret", trigger.SecretChangeParams
+	case deployapi.DeploymentTriggerOnConfigMapChange:
+		return "ConfigMap", trigger.ConfigMapChangeParams
+	}
+	return "", nil
+}
+
+// hasObjectChangeTrigger returns whether config has a secret or config map
+// change trigger.
+func hasObjectChangeTrigger(config *deployapi.DeploymentConfig) bool {
+	for _, trigger := range config.Spec.Triggers {
+		if _, params := triggerObject(trigger); params != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// triggerObjectKeys returns the keys of the objects config has change
+// triggers for, as returned by objectKey.
+func triggerObjectKeys(config *deployapi.DeploymentConfig) []string {
+	keys := []string{}
+	for _, trigger := range config.Spec.Triggers {
+		if kind, params := triggerObject(trigger); params != nil {
+			keys = append(keys, objectKey(kind, config.Namespace, params.Name))
+		}
+	}
+	return keys
+}
+
+// objectKey returns a key identifying the object of the kind with the given
+// namespace and name.
+func objectKey(kind, namespace, name string) string {
+	return kind + "/" + namespace + "/" + name
+}
+
+// objectChangeClient abstracts access to the triggering objects and
+// DeploymentConfigs.
+type objectChangeClient interface {
+	getSecret(namespace, name string) (*kapi.Secret, error)
+	getConfigMap(namespace, name string) (*kapi.ConfigMap, error)
+	updateDeploymentConfig(namespace string, config *deployapi.DeploymentConfig) (*deployapi.DeploymentConfig, error)
+}
+
+// objectChangeClientImpl is a pluggable objectChangeClient.
+type objectChangeClientImpl struct {
+	getSecretFunc              func(namespace, name string) (*kapi.Secret, error)
+	getConfigMapFunc           func(namespace, name string) (*kapi.ConfigMap, error)
+	updateDeploymentConfigFunc func(namespace string, config *deployapi.DeploymentConfig) (*deployapi.DeploymentConfig, error)
+}
+
+func (i *objectChangeClientImpl) getSecret(namespace, name string) (*kapi.Secret, error) {
+	return i.getSecretFunc(namespace, name)
+}
+
+func (i *objectChangeClientImpl) getConfigMap(namespace, name string) (*kapi.ConfigMap, error) {
+	return i.getConfigMapFunc(namespace, name)
+}
+
+func (i *objectChangeClientImpl) updateDeploymentConfig(namespace string, config *deployapi.DeploymentConfig) (*deployapi.DeploymentConfig, error) {
+	return i.updateDeploymentConfigFunc(namespace, config)
+}
@@ -0,0 +1,240 @@
+package objectchange
+
+import (
+	"reflect"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/client/cache"
+
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	_ "github.com/openshift/origin/pkg/deploy/api/install"
+	deployapitest "github.com/openshift/origin/pkg/deploy/api/test"
+)
+
+// objectTriggerConfig returns a config triggered by the credentials secret
+// and the settings config map, which last observed the given values of their
+// data. Objects with an empty value weren't observed yet.
+func objectTriggerConfig(version int, password, setting string) *deployapi.DeploymentConfig {
+	config := deployapitest.OkDeploymentConfig(version)
+	config.Namespace = kapi.NamespaceDefault
+	config.Spec.Triggers = []deployapi.DeploymentTriggerPolicy{
+		{
+			Type:               deployapi.DeploymentTriggerOnSecretChange,
+			SecretChangeParams: &deployapi.DeploymentTriggerObjectChangeParams{Name: "credentials"},
+		},
+		{
+			Type:                  deployapi.DeploymentTriggerOnConfigMapChange,
+			ConfigMapChangeParams: &deployapi.DeploymentTriggerObjectChangeParams{Name: "settings"},
+		},
+	}
+	if len(password) > 0 {
+		config.Status.TriggeredObjects = append(config.Status.TriggeredObjects, triggeredObject("Secret", "credentials", "password", password))
+	}
+	if len(setting) > 0 {
+		config.Status.TriggeredObjects = append(config.Status.TriggeredObjects, triggeredObject("ConfigMap", "settings", "setting", setting))
+	}
+	return config
+}
+
+func triggeredObject(kind, name, key, value string) deployapi.DeploymentTriggeredObject {
+	return deployapi.DeploymentTriggeredObject{Kind: kind, Name: name, DataHash: dataHash(map[string][]byte{key: []byte(value)})}
+}
+
+// newTestController returns a controller for the credentials secret holding
+// password and the settings config map holding setting, which is missing if
+// setting is empty.
+func newTestController(password, setting string, updated **deployapi.DeploymentConfig) *ObjectChangeController {
+	return &ObjectChangeController{
+		client: &objectChangeClientImpl{
+			getSecretFunc: func(namespace, name string) (*kapi.Secret, error) {
+				return &kapi.Secret{
+					ObjectMeta: kapi.ObjectMeta{Name: name, Namespace: namespace, ResourceVersion: "6"},
+					Data:       map[string][]byte{"password": []byte(password)},
+				}, nil
+			},
+			getConfigMapFunc: func(namespace, name string) (*kapi.ConfigMap, error) {
+				if len(setting) == 0 {
+					return nil, kerrors.NewNotFound(kapi.Resource("configmaps"), name)
+				}
+				return &kapi.ConfigMap{
+					ObjectMeta: kapi.ObjectMeta{Name: name, Namespace: namespace, ResourceVersion: "8"},
+					Data:       map[string]string{"setting": setting},
+				}, nil
+			},
+			updateDeploymentConfigFunc: func(namespace string, config *deployapi.DeploymentConfig) (*deployapi.DeploymentConfig, error) {
+				*updated = config
+				return config, nil
+			},
+		},
+	}
+}
+
+// TestHandle_noObjectTriggers ensures that a config without secret or config
+// map change triggers is ignored.
+func TestHandle_noObjectTriggers(t *testing.T) {
+	var updated *deployapi.DeploymentConfig
+	controller := newTestController("secret", "on", &updated)
+
+	if err := controller.Handle(deployapitest.OkDeploymentConfig(1)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated != nil {
+		t.Fatalf("unexpected update of deploymentConfig: %#v", updated)
+	}
+}
+
+// TestHandle_firstObservedData ensures that the first observed data of the
+// objects is recorded in the status without a new deployment.
+func TestHandle_firstObservedData(t *testing.T) {
+	var updated *deployapi.DeploymentConfig
+	controller := newTestController("secret", "on", &updated)
+
+	config := objectTriggerConfig(1, "", "")
+	if err := controller.Handle(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated == nil {
+		t.Fatalf("expected the observed data to be recorded")
+	}
+	if updated.Status.LatestVersion != 1 {
+		t.Errorf("expected no new deployment, got latestVersion %d", updated.Status.LatestVersion)
+	}
+	expected := []deployapi.DeploymentTriggeredObject{
+		triggeredObject("Secret", "credentials", "password", "secret"),
+		triggeredObject("ConfigMap", "settings", "setting", "on"),
+	}
+	if !reflect.DeepEqual(updated.Status.TriggeredObjects, expected) {
+		t.Errorf("expected triggered objects %#v, got %#v", expected, updated.Status.TriggeredObjects)
+	}
+	if updated.Generation != config.Generation || !reflect.DeepEqual(updated.Spec, config.Spec) {
+		t.Errorf("expected the spec of the config to be left unchanged")
+	}
+	if len(config.Status.TriggeredObjects) != 0 {
+		t.Errorf("expected the handled config to be left unchanged")
+	}
+}
+
+// TestHandle_changedData ensures that a change to the data of an observed
+// object starts a new deployment caused by the changed object.
+func TestHandle_changedData(t *testing.T) {
+	var updated *deployapi.DeploymentConfig
+	controller := newTestController("changed", "on", &updated)
+
+	if err := controller.Handle(objectTriggerConfig(1, "secret", "on")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated == nil {
+		t.Fatalf("expected a new deployment")
+	}
+	if updated.Status.LatestVersion != 2 {
+		t.Errorf("expected latestVersion 2, got %d", updated.Status.LatestVersion)
+	}
+	expected := []*deployapi.DeploymentCause{
+		{
+			Type: deployapi.DeploymentTriggerOnSecretChange,
+			ObjectTrigger: &deployapi.DeploymentCauseObjectTrigger{
+				From: kapi.ObjectReference{Kind: "Secret", Namespace: kapi.NamespaceDefault, Name: "credentials", ResourceVersion: "6"},
+			},
+		},
+	}
+	if updated.Status.Details == nil || !reflect.DeepEqual(updated.Status.Details.Causes, expected) {
+		t.Errorf("expected causes %#v, got %#v", expected, updated.Status.Details)
+	}
+	if object := updated.Status.TriggeredObjects[0]; object != triggeredObject("Secret", "credentials", "password", "changed") {
+		t.Errorf("expected the changed secret data to be recorded, got %#v", object)
+	}
+}
+
+// TestHandle_unchangedOrMissingObjects ensures that objects whose data didn't
+// change, whatever their resource version, and missing objects don't update
+// the config.
+func TestHandle_unchangedOrMissingObjects(t *testing.T) {
+	var updated *deployapi.DeploymentConfig
+	controller := newTestController("secret", "", &updated)
+
+	if err := controller.Handle(objectTriggerConfig(1, "secret", "on")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated != nil {
+		t.Fatalf("unexpected update of deploymentConfig: %#v", updated)
+	}
+}
+
+// TestHandle_removedTrigger ensures that the objects of removed triggers are
+// no longer recorded.
+func TestHandle_removedTrigger(t *testing.T) {
+	var updated *deployapi.DeploymentConfig
+	controller := newTestController("secret", "on", &updated)
+
+	config := objectTriggerConfig(1, "secret", "on")
+	config.Spec.Triggers = config.Spec.Triggers[:1]
+	if err := controller.Handle(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated == nil {
+		t.Fatalf("expected the removed trigger to be forgotten")
+	}
+	if updated.Status.LatestVersion != 1 {
+		t.Errorf("expected no new deployment, got latestVersion %d", updated.Status.LatestVersion)
+	}
+	expected := []deployapi.DeploymentTriggeredObject{triggeredObject("Secret", "credentials", "password", "secret")}
+	if !reflect.DeepEqual(updated.Status.TriggeredObjects, expected) {
+		t.Errorf("expected triggered objects %#v, got %#v", expected, updated.Status.TriggeredObjects)
+	}
+}
+
+// TestHandle_pausedConfig ensures that a change to an object triggering a
+// paused config doesn't start a new deployment.
+func TestHandle_pausedConfig(t *testing.T) {
+	var updated *deployapi.DeploymentConfig
+	controller := newTestController("changed", "off", &updated)
+
+	config := objectTriggerConfig(1, "secret", "on")
+	config.Spec.Paused = true
+	if err := controller.Handle(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated != nil {
+		t.Fatalf("unexpected update of deploymentConfig: %#v", updated)
+	}
+}
+
+func TestTriggerObjectKeys(t *testing.T) {
+	keys := triggerObjectKeys(objectTriggerConfig(1, "", ""))
+	expected := []string{"Secret/default/credentials", "ConfigMap/default/settings"}
+	if !reflect.DeepEqual(keys, expected) {
+		t.Errorf("expected keys %v, got %v", expected, keys)
+	}
+}
+
+func TestDataHash(t *testing.T) {
+	hash := dataHash(map[string][]byte{"a": []byte("bc"), "d": []byte("e")})
+	if other := dataHash(map[string][]byte{"d": []byte("e"), "a": []byte("bc")}); other != hash {
+		t.Errorf("expected the hash not to depend on the order of the keys")
+	}
+	if other := dataHash(map[string][]byte{"ab": []byte("c"), "d": []byte("e")}); other == hash {
+		t.Errorf("expected different keys and values to have different hashes")
+	}
+	if other := dataHash(map[string][]byte{"a": []byte("bc")}); other == hash {
+		t.Errorf("expected different data to have different hashes")
+	}
+}
+
+func TestTriggerObjectQueue(t *testing.T) {
+	queue := &triggerObjectQueue{
+		FIFO: cache.NewFIFO(cache.MetaNamespaceKeyFunc),
+		triggers: func(obj interface{}) bool {
+			return obj.(*kapi.Secret).Name == "credentials"
+		},
+	}
+	credentials := &kapi.Secret{ObjectMeta: kapi.ObjectMeta{Namespace: kapi.NamespaceDefault, Name: "credentials"}}
+	other := &kapi.Secret{ObjectMeta: kapi.ObjectMeta{Namespace: kapi.NamespaceDefault, Name: "other"}}
+	queue.Add(other)
+	queue.Update(other)
+	queue.Replace([]interface{}{credentials, other}, "1")
+	if keys := queue.ListKeys(); !reflect.DeepEqual(keys, []string{"default/credentials"}) {
+		t.Errorf("expected only the triggering secret to be queued, got %v", keys)
+	}
+}
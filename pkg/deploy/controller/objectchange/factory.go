@@ -0,0 +1,179 @@
+package objectchange
+
+import (
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/client/cache"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/runtime"
+	kutil "k8s.io/kubernetes/pkg/util"
+	utilruntime "k8s.io/kubernetes/pkg/util/runtime"
+	"k8s.io/kubernetes/pkg/util/wait"
+	"k8s.io/kubernetes/pkg/watch"
+
+	osclient "github.com/openshift/origin/pkg/client"
+	controller "github.com/openshift/origin/pkg/controller"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+)
+
+// triggerObjectIndex indexes DeploymentConfigs by the keys of the objects
+// they have change triggers for.
+const triggerObjectIndex = "triggerObject"
+
+// ObjectChangeControllerFactory can create an ObjectChangeController which
+// watches all DeploymentConfigs and the Secrets and ConfigMaps they have
+// change triggers for.
+type ObjectChangeControllerFactory struct {
+	// Client is an OpenShift client.
+	Client osclient.Interface
+	// KubeClient is a Kubernetes client.
+	KubeClient kclient.Interface
+}
+
+// Create creates an ObjectChangeController.
+func (factory *ObjectChangeControllerFactory) Create() controller.RunnableController {
+	deploymentConfigLW := &cache.ListWatch{
+		ListFunc: func(options kapi.ListOptions) (runtime.Object, error) {
+			return factory.Client.DeploymentConfigs(kapi.NamespaceAll).List(options)
+		},
+		WatchFunc: func(options kapi.ListOptions) (watch.Interface, error) {
+			return factory.Client.DeploymentConfigs(kapi.NamespaceAll).Watch(options)
+		},
+	}
+	queue := cache.NewFIFO(cache.MetaNamespaceKeyFunc)
+	cache.NewReflector(deploymentConfigLW, &deployapi.DeploymentConfig{}, queue, 2*time.Minute).Run()
+
+	// Changes to the triggering objects requeue the configs triggering on
+	// them, other objects are dropped as soon as they are observed.
+	configIndex := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{
+		triggerObjectIndex: func(obj interface{}) ([]string, error) {
+			return triggerObjectKeys(obj.(*deployapi.DeploymentConfig)), nil
+		},
+	})
+	cache.NewReflector(deploymentConfigLW, &deployapi.DeploymentConfig{}, configIndex, 2*time.Minute).Run()
+	triggeredConfigs := func(kind string, meta kapi.ObjectMeta) []interface{} {
+		configs, err := configIndex.ByIndex(triggerObjectIndex, objectKey(kind, meta.Namespace, meta.Name))
+		if err != nil {
+			utilruntime.HandleError(err)
+			return nil
+		}
+		return configs
+	}
+	requeue := func(kind string, meta kapi.ObjectMeta) {
+		for _, config := range triggeredConfigs(kind, meta) {
+			queue.AddIfNotPresent(config)
+		}
+	}
+
+	secretLW := &cache.ListWatch{
+		ListFunc: func(options kapi.ListOptions) (runtime.Object, error) {
+			return factory.KubeClient.Secrets(kapi.NamespaceAll).List(options)
+		},
+		WatchFunc: func(options kapi.ListOptions) (watch.Interface, error) {
+			return factory.KubeClient.Secrets(kapi.NamespaceAll).Watch(options)
+		},
+	}
+	secretQueue := &triggerObjectQueue{
+		FIFO: cache.NewFIFO(cache.MetaNamespaceKeyFunc),
+		triggers: func(obj interface{}) bool {
+			return len(triggeredConfigs("Secret", obj.(*kapi.Secret).ObjectMeta)) > 0
+		},
+	}
+	cache.NewReflector(secretLW, &kapi.Secret{}, secretQueue, 10*time.Minute).Run()
+	go wait.Forever(func() {
+		requeue("Secret", secretQueue.Pop().(*kapi.Secret).ObjectMeta)
+	}, 0)
+
+	configMapLW := &cache.ListWatch{
+		ListFunc: func(options kapi.ListOptions) (runtime.Object, error) {
+			return factory.KubeClient.ConfigMaps(kapi.NamespaceAll).List(options)
+		},
+		WatchFunc: func(options kapi.ListOptions) (watch.Interface, error) {
+			return factory.KubeClient.ConfigMaps(kapi.NamespaceAll).Watch(options)
+		},
+	}
+	configMapQueue := &triggerObjectQueue{
+		FIFO: cache.NewFIFO(cache.MetaNamespaceKeyFunc),
+		triggers: func(obj interface{}) bool {
+			return len(triggeredConfigs("ConfigMap", obj.(*kapi.ConfigMap).ObjectMeta)) > 0
+		},
+	}
+	cache.NewReflector(configMapLW, &kapi.ConfigMap{}, configMapQueue, 10*time.Minute).Run()
+	go wait.Forever(func() {
+		requeue("ConfigMap", configMapQueue.Pop().(*kapi.ConfigMap).ObjectMeta)
+	}, 0)
+
+	changeController := &ObjectChangeController{
+		client: &objectChangeClientImpl{
+			getSecretFunc: func(namespace, name string) (*kapi.Secret, error) {
+				return factory.KubeClient.Secrets(namespace).Get(name)
+			},
+			getConfigMapFunc: func(namespace, name string) (*kapi.ConfigMap, error) {
+				return factory.KubeClient.ConfigMaps(namespace).Get(name)
+			},
+			updateDeploymentConfigFunc: func(namespace string, config *deployapi.DeploymentConfig) (*deployapi.DeploymentConfig, error) {
+				return factory.Client.DeploymentConfigs(namespace).Update(config)
+			},
+		},
+	}
+
+	return &controller.RetryController{
+		Queue: queue,
+		RetryManager: controller.NewQueueRetryManager(
+			queue,
+			cache.MetaNamespaceKeyFunc,
+			func(obj interface{}, err error, retries controller.Retry) bool {
+				utilruntime.HandleError(err)
+				if _, isFatal := err.(fatalError); isFatal {
+					return false
+				}
+				if retries.Count > 0 {
+					return false
+				}
+				return true
+			},
+			kutil.NewTokenBucketRateLimiter(1, 10),
+		),
+		Handle: func(obj interface{}) error {
+			config := obj.(*deployapi.DeploymentConfig)
+			return changeController.Handle(config)
+		},
+	}
+}
+
+// triggerObjectQueue is a queue of the Secrets or ConfigMaps which trigger
+// DeploymentConfigs. Objects which trigger no config are not queued.
+type triggerObjectQueue struct {
+	*cache.FIFO
+	// triggers returns whether an object triggers any config.
+	triggers func(obj interface{}) bool
+}
+
+// Add queues obj if it triggers a config.
+func (q *triggerObjectQueue) Add(obj interface{}) error {
+	if !q.triggers(obj) {
+		return nil
+	}
+	return q.FIFO.Add(obj)
+}
+
+// Update queues obj if it triggers a config.
+func (q *triggerObjectQueue) Update(obj interface{}) error {
+	if !q.triggers(obj) {
+		return nil
+	}
+	return q.FIFO.Update(obj)
+}
+
+// Replace replaces the queued objects with the objects of list which trigger
+// a config.
+func (q *triggerObjectQueue) Replace(list []interface{}, resourceVersion string) error {
+	triggering := []interface{}{}
+	for _, obj := range list {
+		if q.triggers(obj) {
+			triggering = append(triggering, obj)
+		}
+	}
+	return q.FIFO.Replace(triggering, resourceVersion)
+}
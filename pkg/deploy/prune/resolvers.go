@@ -123,3 +123,53 @@ func (o *perDeploymentConfigResolver) Resolve() ([]*kapi.ReplicationController,
 	}
 	return results, nil
 }
+
+type revisionHistoryResolver struct {
+	deployments []*kapi.ReplicationController
+	limit       int
+}
+
+// NewRevisionHistoryResolver returns a Resolver that selects the deployments of
+// a single config to prune so that at most limit old deployments are retained.
+// The latest deployment, the active deployment and the complete deployment
+// before it, which is the target of a rollback, are always retained, as are
+// the deployments which are in progress or still have replicas.
+func NewRevisionHistoryResolver(deployments []*kapi.ReplicationController, limit int) Resolver {
+	return &revisionHistoryResolver{
+		deployments: deployments,
+		limit:       limit,
+	}
+}
+
+func (o *revisionHistoryResolver) Resolve() ([]*kapi.ReplicationController, error) {
+	deployments := make([]*kapi.ReplicationController, len(o.deployments))
+	copy(deployments, o.deployments)
+	sort.Sort(deployutil.ByMostRecent(deployments))
+
+	if len(deployments) == 0 {
+		return nil, nil
+	}
+	retained := sets.NewString(deployments[0].Name)
+	complete := 0
+	for _, deployment := range deployments {
+		if complete == 2 {
+			break
+		}
+		if deployutil.DeploymentStatusFor(deployment) == deployapi.DeploymentStatusComplete {
+			retained.Insert(deployment.Name)
+			complete++
+		}
+	}
+
+	results := []*kapi.ReplicationController{}
+	for i, deployment := range deployments[1:] {
+		if i < o.limit || retained.Has(deployment.Name) {
+			continue
+		}
+		if !deployutil.IsTerminatedDeployment(deployment) || !FilterZeroReplicaSize(deployment) {
+			continue
+		}
+		results = append(results, deployment)
+	}
+	return results, nil
+}
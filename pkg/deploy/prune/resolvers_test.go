@@ -187,3 +187,68 @@ func TestPerDeploymentConfigResolver(t *testing.T) {
 		}
 	}
 }
+
+func TestRevisionHistoryResolver(t *testing.T) {
+	config := mockDeploymentConfig("a", "config")
+	now := unversioned.Now()
+	mkdeployment := func(name string, age int, status deployapi.DeploymentStatus) *kapi.ReplicationController {
+		created := unversioned.NewTime(now.Add(-time.Duration(age) * time.Minute))
+		return withCreated(withStatus(mockDeployment("a", name, config), status), created)
+	}
+
+	tests := []struct {
+		name        string
+		deployments []*kapi.ReplicationController
+		limit       int
+		expected    sets.String
+	}{
+		{
+			name: "prune beyond the limit",
+			deployments: []*kapi.ReplicationController{
+				mkdeployment("config-5", 1, deployapi.DeploymentStatusComplete),
+				mkdeployment("config-4", 2, deployapi.DeploymentStatusComplete),
+				mkdeployment("config-3", 3, deployapi.DeploymentStatusFailed),
+				mkdeployment("config-2", 4, deployapi.DeploymentStatusComplete),
+				mkdeployment("config-1", 5, deployapi.DeploymentStatusComplete),
+			},
+			limit:    2,
+			expected: sets.NewString("config-2", "config-1"),
+		},
+		{
+			name: "retain the active deployment and the rollback target",
+			deployments: []*kapi.ReplicationController{
+				mkdeployment("config-5", 1, deployapi.DeploymentStatusFailed),
+				mkdeployment("config-4", 2, deployapi.DeploymentStatusFailed),
+				mkdeployment("config-3", 3, deployapi.DeploymentStatusComplete),
+				mkdeployment("config-2", 4, deployapi.DeploymentStatusFailed),
+				mkdeployment("config-1", 5, deployapi.DeploymentStatusComplete),
+			},
+			limit:    0,
+			expected: sets.NewString("config-4", "config-2"),
+		},
+		{
+			name: "retain running deployments and deployments with replicas",
+			deployments: []*kapi.ReplicationController{
+				mkdeployment("config-3", 1, deployapi.DeploymentStatusRunning),
+				mkdeployment("config-2", 2, deployapi.DeploymentStatusRunning),
+				withSize(mkdeployment("config-1", 3, deployapi.DeploymentStatusFailed), 1),
+			},
+			limit:    0,
+			expected: sets.NewString(),
+		},
+	}
+
+	for _, test := range tests {
+		results, err := NewRevisionHistoryResolver(test.deployments, test.limit).Resolve()
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.name, err)
+		}
+		actual := sets.NewString()
+		for _, deployment := range results {
+			actual.Insert(deployment.Name)
+		}
+		if !actual.Equal(test.expected) {
+			t.Errorf("%s: expected %v to be pruned, got %v", test.name, test.expected.List(), actual.List())
+		}
+	}
+}
@@ -28,8 +28,8 @@ type REST struct {
 }
 
 // NewStorage returns a DeploymentConfigStorage containing the REST storage for
-// DeploymentConfig objects and their Scale subresources.
-func NewREST(s storage.Interface, rcNamespacer kclient.ReplicationControllersNamespacer) (*REST, *ScaleREST) {
+// DeploymentConfig objects and their Status and Scale subresources.
+func NewREST(s storage.Interface, rcNamespacer kclient.ReplicationControllersNamespacer) (*REST, *StatusREST, *ScaleREST) {
 	prefix := "/deploymentconfigs"
 
 	store := &etcdgeneric.Etcd{
@@ -56,12 +56,30 @@ func NewREST(s storage.Interface, rcNamespacer kclient.ReplicationControllersNam
 	}
 
 	deploymentConfigREST := &REST{store}
+	statusStore := *store
+	statusStore.UpdateStrategy = deployconfig.StatusStrategy
 	scaleREST := &ScaleREST{
 		registry:     deployconfig.NewRegistry(deploymentConfigREST),
 		rcNamespacer: rcNamespacer,
 	}
 
-	return deploymentConfigREST, scaleREST
+	return deploymentConfigREST, &StatusREST{&statusStore}, scaleREST
+}
+
+// StatusREST implements the REST endpoint for changing the status of a
+// DeploymentConfig.
+type StatusREST struct {
+	store *etcdgeneric.Etcd
+}
+
+// New creates a new DeploymentConfig.
+func (r *StatusREST) New() runtime.Object {
+	return &api.DeploymentConfig{}
+}
+
+// Update alters the status subset of a DeploymentConfig.
+func (r *StatusREST) Update(ctx kapi.Context, obj runtime.Object) (runtime.Object, bool, error) {
+	return r.store.Update(ctx, obj)
 }
 
 // ScaleREST contains the REST storage for the Scale subresource of DeploymentConfigs.
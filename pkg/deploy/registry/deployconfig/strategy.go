@@ -39,14 +39,32 @@ func (strategy) AllowUnconditionalUpdate() bool {
 
 // PrepareForCreate clears fields that are not allowed to be set by end users on creation.
 func (strategy) PrepareForCreate(obj runtime.Object) {
-	_ = obj.(*api.DeploymentConfig)
+	dc := obj.(*api.DeploymentConfig)
+	dc.Generation = 1
 	// TODO: need to ensure status.latestVersion is not set out of order
 }
 
 // PrepareForUpdate clears fields that are not allowed to be set by end users on update.
 func (strategy) PrepareForUpdate(obj, old runtime.Object) {
-	_ = obj.(*api.DeploymentConfig)
+	newDc := obj.(*api.DeploymentConfig)
+	oldDc := old.(*api.DeploymentConfig)
 	// TODO: need to ensure status.latestVersion is not set out of order
+
+	// Any change to the spec, or a new deployment requested by bumping the
+	// latest version, increments the generation so that clients can tell when
+	// the controller has observed it.
+	newDc.Generation = oldDc.Generation
+	if !kapi.Semantic.DeepEqual(newDc.Spec, oldDc.Spec) || newDc.Status.LatestVersion != oldDc.Status.LatestVersion {
+		newDc.Generation = oldDc.Generation + 1
+	}
+
+	// The status observed by the deployment config controller is only
+	// updated through the status subresource.
+	newDc.Status.ObservedGeneration = oldDc.Status.ObservedGeneration
+	newDc.Status.Replicas = oldDc.Status.Replicas
+	newDc.Status.UpdatedReplicas = oldDc.Status.UpdatedReplicas
+	newDc.Status.AvailableReplicas = oldDc.Status.AvailableReplicas
+	newDc.Status.Conditions = oldDc.Status.Conditions
 }
 
 // Canonicalize normalizes the object after validation.
@@ -68,6 +86,34 @@ func (strategy) CheckGracefulDelete(obj runtime.Object, options *kapi.DeleteOpti
 	return false
 }
 
+// statusStrategy implements behavior for the status subresource of
+// DeploymentConfig objects.
+type statusStrategy struct {
+	strategy
+}
+
+// StatusStrategy is the default logic invoked when updating the status of
+// DeploymentConfig objects.
+var StatusStrategy = statusStrategy{Strategy}
+
+// PrepareForUpdate clears the fields that are not allowed to be set through
+// the status subresource. The latest version and its details are only
+// updated with the config, to request new deployments.
+func (statusStrategy) PrepareForUpdate(obj, old runtime.Object) {
+	newDc := obj.(*api.DeploymentConfig)
+	oldDc := old.(*api.DeploymentConfig)
+	newDc.Spec = oldDc.Spec
+	newDc.Generation = oldDc.Generation
+	newDc.Status.LatestVersion = oldDc.Status.LatestVersion
+	newDc.Status.Details = oldDc.Status.Details
+}
+
+// ValidateUpdate is the default update validation for the status of a
+// DeploymentConfig.
+func (statusStrategy) ValidateUpdate(ctx kapi.Context, obj, old runtime.Object) field.ErrorList {
+	return validation.ValidateDeploymentConfigStatusUpdate(obj.(*api.DeploymentConfig), old.(*api.DeploymentConfig))
+}
+
 // Matcher returns a generic matcher for a given label and field selector.
 func Matcher(label labels.Selector, field fields.Selector) generic.Matcher {
 	return &generic.SelectionPredicate{
@@ -47,3 +47,74 @@ func TestDeploymentConfigStrategy(t *testing.T) {
 		t.Errorf("Expected error validating")
 	}
 }
+
+func TestDeploymentConfigStrategyGeneration(t *testing.T) {
+	config := &deployapi.DeploymentConfig{
+		ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "default"},
+		Spec:       deploytest.OkDeploymentConfigSpec(),
+	}
+	Strategy.PrepareForCreate(config)
+	if config.Generation != 1 {
+		t.Fatalf("expected generation 1 on create, got %d", config.Generation)
+	}
+
+	updated := *config
+	updated.Status.Details = &deployapi.DeploymentDetails{Message: "status only"}
+	Strategy.PrepareForUpdate(&updated, config)
+	if updated.Generation != 1 {
+		t.Errorf("expected a status update to keep the generation, got %d", updated.Generation)
+	}
+
+	updated = *config
+	updated.Spec.Replicas++
+	Strategy.PrepareForUpdate(&updated, config)
+	if updated.Generation != 2 {
+		t.Errorf("expected a spec update to increment the generation, got %d", updated.Generation)
+	}
+
+	updated = *config
+	updated.Status.LatestVersion++
+	Strategy.PrepareForUpdate(&updated, config)
+	if updated.Generation != 2 {
+		t.Errorf("expected a new latest version to increment the generation, got %d", updated.Generation)
+	}
+}
+
+func TestDeploymentConfigStatusStrategy(t *testing.T) {
+	config := &deployapi.DeploymentConfig{
+		ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "default", Generation: 2, ResourceVersion: "1"},
+		Spec:       deploytest.OkDeploymentConfigSpec(),
+		Status:     deploytest.OkDeploymentConfigStatus(1),
+	}
+
+	// the status observed by the controller can't be changed with the config
+	updated := *config
+	updated.Status.ObservedGeneration = 2
+	updated.Status.AvailableReplicas = 1
+	Strategy.PrepareForUpdate(&updated, config)
+	if updated.Status.ObservedGeneration != 0 || updated.Status.AvailableReplicas != 0 {
+		t.Errorf("expected the observed status to be kept, got %#v", updated.Status)
+	}
+
+	// the spec and latest version can't be changed with the status
+	updated = *config
+	updated.Spec.Replicas++
+	updated.Status.LatestVersion++
+	updated.Status.ObservedGeneration = 2
+	updated.Status.AvailableReplicas = 1
+	StatusStrategy.PrepareForUpdate(&updated, config)
+	if updated.Spec.Replicas != config.Spec.Replicas || updated.Status.LatestVersion != config.Status.LatestVersion || updated.Generation != 2 {
+		t.Errorf("expected the spec and latest version to be kept, got %#v", updated)
+	}
+	if updated.Status.ObservedGeneration != 2 || updated.Status.AvailableReplicas != 1 {
+		t.Errorf("expected the observed status to be updated, got %#v", updated.Status)
+	}
+	if errs := StatusStrategy.ValidateUpdate(kapi.NewDefaultContext(), &updated, config); len(errs) != 0 {
+		t.Errorf("unexpected errors: %v", errs)
+	}
+
+	updated.Status.Replicas = -1
+	if errs := StatusStrategy.ValidateUpdate(kapi.NewDefaultContext(), &updated, config); len(errs) == 0 {
+		t.Errorf("expected an error for negative replicas")
+	}
+}
@@ -61,7 +61,9 @@ func (s *REST) New() runtime.Object {
 	return &deployapi.DeploymentConfigRollback{}
 }
 
-// Create generates a new DeploymentConfig representing a rollback.
+// Create generates a new DeploymentConfig representing a rollback. If the
+// rollback only asks for the diff, the changes the rollback would make to the
+// current DeploymentConfig are returned instead.
 func (s *REST) Create(ctx kapi.Context, obj runtime.Object) (runtime.Object, error) {
 	rollback, ok := obj.(*deployapi.DeploymentConfigRollback)
 	if !ok {
@@ -100,7 +102,15 @@ func (s *REST) Create(ctx kapi.Context, obj runtime.Object) (runtime.Object, err
 			fmt.Sprintf("error finding current DeploymentConfig %s/%s: %v", targetDeployment.Namespace, to.Name, err))
 	}
 
-	return s.generator.GenerateRollback(from, to, &rollback.Spec)
+	rolledBack, err := s.generator.GenerateRollback(from, to, &rollback.Spec)
+	if err != nil || !rollback.Spec.DiffOnly {
+		return rolledBack, err
+	}
+	return &deployapi.DeploymentConfigRollbackDiff{
+		Name:    from.Name,
+		From:    rollback.Spec.From,
+		Changes: diffRollback(from, rolledBack),
+	}, nil
 }
 
 func newInvalidDeploymentError(rollback *deployapi.DeploymentConfigRollback, reason string) error {
@@ -3,6 +3,7 @@ package rollback
 import (
 	"errors"
 	"fmt"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -80,6 +81,55 @@ func TestCreateOk(t *testing.T) {
 	}
 }
 
+func TestCreateDiffOnly(t *testing.T) {
+	rest := REST{
+		generator: Client{
+			GRFn: func(from, to *deployapi.DeploymentConfig, spec *deployapi.DeploymentConfigRollbackSpec) (*deployapi.DeploymentConfig, error) {
+				return to, nil
+			},
+			RCFn: func(ctx kapi.Context, name string) (*kapi.ReplicationController, error) {
+				config := deploytest.OkDeploymentConfig(1)
+				config.Spec.Template.Spec.Containers[0].Image = "registry:8080/repo1:ref0"
+				config.Spec.Template.Spec.Containers[0].Env = []kapi.EnvVar{{Name: "MODE", Value: "old"}}
+				deployment, _ := deployutil.MakeDeployment(config, kapi.Codecs.LegacyCodec(deployapi.SchemeGroupVersion))
+				return deployment, nil
+			},
+			DCFn: func(ctx kapi.Context, name string) (*deployapi.DeploymentConfig, error) {
+				config := deploytest.OkDeploymentConfig(2)
+				config.Spec.Template.Spec.Containers[0].Env = []kapi.EnvVar{{Name: "MODE", Value: "new"}, {Name: "DEBUG", Value: "1"}}
+				return config, nil
+			},
+		},
+		codec: kapi.Codecs.LegacyCodec(deployapi.SchemeGroupVersion),
+	}
+
+	obj, err := rest.Create(kapi.NewDefaultContext(), &deployapi.DeploymentConfigRollback{
+		Spec: deployapi.DeploymentConfigRollbackSpec{
+			From: kapi.ObjectReference{
+				Name:      "deployment",
+				Namespace: kapi.NamespaceDefault,
+			},
+			DiffOnly: true,
+		},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	diff, ok := obj.(*deployapi.DeploymentConfigRollbackDiff)
+	if !ok {
+		t.Fatalf("expected a DeploymentConfigRollbackDiff, got a %#v", obj)
+	}
+	expected := []deployapi.DeploymentConfigChange{
+		{Type: deployapi.DeploymentConfigChangeImage, Field: "spec.template.spec.containers[container1].image", Current: "registry:8080/repo1:ref1", RolledBack: "registry:8080/repo1:ref0"},
+		{Type: deployapi.DeploymentConfigChangeEnv, Field: "spec.template.spec.containers[container1].env[DEBUG]", Current: "1", RolledBack: ""},
+		{Type: deployapi.DeploymentConfigChangeEnv, Field: "spec.template.spec.containers[container1].env[MODE]", Current: "new", RolledBack: "old"},
+	}
+	if !reflect.DeepEqual(diff.Changes, expected) {
+		t.Errorf("expected changes %#v, got %#v", expected, diff.Changes)
+	}
+}
+
 func TestCreateGeneratorError(t *testing.T) {
 	rest := REST{
 		generator: Client{
@@ -229,3 +279,20 @@ func TestNew(t *testing.T) {
 	rest := NewREST(Client{}, kapi.Codecs.LegacyCodec(deployapi.SchemeGroupVersion))
 	rest.New()
 }
+
+func TestDiffRollbackReplicasAndSelector(t *testing.T) {
+	from := deploytest.OkDeploymentConfig(2)
+	from.Spec.Replicas = 3
+	from.Spec.Selector = map[string]string{"app": "new"}
+	rollback := deploytest.OkDeploymentConfig(1)
+	rollback.Spec.Replicas = 1
+	rollback.Spec.Selector = map[string]string{"app": "old"}
+
+	expected := []deployapi.DeploymentConfigChange{
+		{Type: deployapi.DeploymentConfigChangeReplicas, Field: "spec.replicas", Current: "3", RolledBack: "1"},
+		{Type: deployapi.DeploymentConfigChangeSelector, Field: "spec.selector", Current: "app=new", RolledBack: "app=old"},
+	}
+	if changes := diffRollback(from, rollback); !reflect.DeepEqual(changes, expected) {
+		t.Errorf("expected changes %#v, got %#v", expected, changes)
+	}
+}
@@ -0,0 +1,234 @@
+package rollback
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+)
+
+// diffRollback returns the changes made to the from config by rolling it back
+// to the rollback config: the images and environment of the containers, any
+// other change of the pod template, the strategy, the triggers and the
+// replicas.
+func diffRollback(from, rollback *deployapi.DeploymentConfig) []deployapi.DeploymentConfigChange {
+	changes := []deployapi.DeploymentConfigChange{}
+	changes = append(changes, diffTemplates(from.Spec.Template, rollback.Spec.Template)...)
+
+	if !kapi.Semantic.DeepEqual(from.Spec.Strategy, rollback.Spec.Strategy) {
+		rolledBack := string(rollback.Spec.Strategy.Type)
+		if from.Spec.Strategy.Type == rollback.Spec.Strategy.Type {
+			rolledBack += " (different parameters)"
+		}
+		changes = append(changes, deployapi.DeploymentConfigChange{
+			Type:       deployapi.DeploymentConfigChangeStrategy,
+			Field:      "spec.strategy",
+			Current:    string(from.Spec.Strategy.Type),
+			RolledBack: rolledBack,
+		})
+	}
+
+	if current, rolledBack := describeTriggers(from.Spec.Triggers), describeTriggers(rollback.Spec.Triggers); current != rolledBack {
+		changes = append(changes, deployapi.DeploymentConfigChange{
+			Type:       deployapi.DeploymentConfigChangeTriggers,
+			Field:      "spec.triggers",
+			Current:    current,
+			RolledBack: rolledBack,
+		})
+	}
+
+	if from.Spec.Replicas != rollback.Spec.Replicas {
+		changes = append(changes, deployapi.DeploymentConfigChange{
+			Type:       deployapi.DeploymentConfigChangeReplicas,
+			Field:      "spec.replicas",
+			Current:    fmt.Sprintf("%d", from.Spec.Replicas),
+			RolledBack: fmt.Sprintf("%d", rollback.Spec.Replicas),
+		})
+	}
+	if !kapi.Semantic.DeepEqual(from.Spec.Selector, rollback.Spec.Selector) {
+		changes = append(changes, deployapi.DeploymentConfigChange{
+			Type:       deployapi.DeploymentConfigChangeSelector,
+			Field:      "spec.selector",
+			Current:    describeLabels(from.Spec.Selector),
+			RolledBack: describeLabels(rollback.Spec.Selector),
+		})
+	}
+	return changes
+}
+
+// diffTemplates returns the changes of the images and the environment of the
+// containers of the templates, and a single change for any other difference.
+func diffTemplates(from, rollback *kapi.PodTemplateSpec) []deployapi.DeploymentConfigChange {
+	if from == nil || rollback == nil {
+		if from == rollback {
+			return nil
+		}
+		return []deployapi.DeploymentConfigChange{{
+			Type:       deployapi.DeploymentConfigChangeTemplate,
+			Field:      "spec.template",
+			Current:    describeTemplate(from),
+			RolledBack: describeTemplate(rollback),
+		}}
+	}
+
+	changes := []deployapi.DeploymentConfigChange{}
+	containers := map[string]*kapi.Container{}
+	for i := range from.Spec.Containers {
+		containers[from.Spec.Containers[i].Name] = &from.Spec.Containers[i]
+	}
+	rollbackContainers := map[string]*kapi.Container{}
+	for i := range rollback.Spec.Containers {
+		rollbackContainers[rollback.Spec.Containers[i].Name] = &rollback.Spec.Containers[i]
+	}
+
+	// Containers with the same name are compared, the image and environment
+	// differences are reported separately, other differences of the pod
+	// template as a whole.
+	fromCopy, rollbackCopy := *from, *rollback
+	fromCopy.Spec.Containers, rollbackCopy.Spec.Containers = nil, nil
+	otherChanges := !kapi.Semantic.DeepEqual(fromCopy, rollbackCopy)
+	for _, name := range containerNames(containers, rollbackContainers) {
+		current, rolledBack := containers[name], rollbackContainers[name]
+		field := fmt.Sprintf("spec.template.spec.containers[%s]", name)
+		if current == nil || rolledBack == nil {
+			change := deployapi.DeploymentConfigChange{Type: deployapi.DeploymentConfigChangeTemplate, Field: field}
+			if current != nil {
+				change.Current = current.Image
+			} else {
+				change.RolledBack = rolledBack.Image
+			}
+			changes = append(changes, change)
+			continue
+		}
+		if current.Image != rolledBack.Image {
+			changes = append(changes, deployapi.DeploymentConfigChange{
+				Type:       deployapi.DeploymentConfigChangeImage,
+				Field:      field + ".image",
+				Current:    current.Image,
+				RolledBack: rolledBack.Image,
+			})
+		}
+		changes = append(changes, diffEnv(field+".env", current.Env, rolledBack.Env)...)
+
+		currentCopy, rolledBackCopy := *current, *rolledBack
+		currentCopy.Image, rolledBackCopy.Image = "", ""
+		currentCopy.Env, rolledBackCopy.Env = nil, nil
+		if !kapi.Semantic.DeepEqual(currentCopy, rolledBackCopy) {
+			otherChanges = true
+		}
+	}
+	if otherChanges {
+		changes = append(changes, deployapi.DeploymentConfigChange{
+			Type:       deployapi.DeploymentConfigChangeTemplate,
+			Field:      "spec.template",
+			Current:    "current template",
+			RolledBack: "template of the rollback target",
+		})
+	}
+	return changes
+}
+
+// diffEnv returns the changes of the environment variables of a container.
+func diffEnv(field string, from, rollback []kapi.EnvVar) []deployapi.DeploymentConfigChange {
+	current, rolledBack := map[string]string{}, map[string]string{}
+	names := []string{}
+	for _, env := range from {
+		current[env.Name] = describeEnvValue(env)
+		names = append(names, env.Name)
+	}
+	for _, env := range rollback {
+		rolledBack[env.Name] = describeEnvValue(env)
+		if _, ok := current[env.Name]; !ok {
+			names = append(names, env.Name)
+		}
+	}
+	sort.Strings(names)
+
+	changes := []deployapi.DeploymentConfigChange{}
+	for _, name := range names {
+		if current[name] == rolledBack[name] {
+			continue
+		}
+		changes = append(changes, deployapi.DeploymentConfigChange{
+			Type:       deployapi.DeploymentConfigChangeEnv,
+			Field:      fmt.Sprintf("%s[%s]", field, name),
+			Current:    current[name],
+			RolledBack: rolledBack[name],
+		})
+	}
+	return changes
+}
+
+func containerNames(from, rollback map[string]*kapi.Container) []string {
+	names := []string{}
+	for name := range from {
+		names = append(names, name)
+	}
+	for name := range rollback {
+		if _, ok := from[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func describeEnvValue(env kapi.EnvVar) string {
+	switch {
+	case env.ValueFrom == nil:
+		return env.Value
+	case env.ValueFrom.SecretKeyRef != nil:
+		return fmt.Sprintf("<from secret %s key %s>", env.ValueFrom.SecretKeyRef.Name, env.ValueFrom.SecretKeyRef.Key)
+	case env.ValueFrom.ConfigMapKeyRef != nil:
+		return fmt.Sprintf("<from config map %s key %s>", env.ValueFrom.ConfigMapKeyRef.Name, env.ValueFrom.ConfigMapKeyRef.Key)
+	case env.ValueFrom.FieldRef != nil:
+		return fmt.Sprintf("<from field %s>", env.ValueFrom.FieldRef.FieldPath)
+	}
+	return "<from source>"
+}
+
+func describeTemplate(template *kapi.PodTemplateSpec) string {
+	if template == nil {
+		return ""
+	}
+	images := []string{}
+	for _, container := range template.Spec.Containers {
+		images = append(images, container.Image)
+	}
+	return strings.Join(images, ", ")
+}
+
+func describeTriggers(triggers []deployapi.DeploymentTriggerPolicy) string {
+	descriptions := []string{}
+	for _, trigger := range triggers {
+		switch trigger.Type {
+		case deployapi.DeploymentTriggerOnImageChange:
+			if trigger.ImageChangeParams != nil {
+				descriptions = append(descriptions, fmt.Sprintf("Image(%s, auto=%v)", trigger.ImageChangeParams.From.Name, trigger.ImageChangeParams.Automatic))
+			}
+		case deployapi.DeploymentTriggerOnSecretChange:
+			if trigger.SecretChangeParams != nil {
+				descriptions = append(descriptions, fmt.Sprintf("Secret(%s)", trigger.SecretChangeParams.Name))
+			}
+		case deployapi.DeploymentTriggerOnConfigMapChange:
+			if trigger.ConfigMapChangeParams != nil {
+				descriptions = append(descriptions, fmt.Sprintf("ConfigMap(%s)", trigger.ConfigMapChangeParams.Name))
+			}
+		default:
+			descriptions = append(descriptions, string(trigger.Type))
+		}
+	}
+	return strings.Join(descriptions, ", ")
+}
+
+func describeLabels(labels map[string]string) string {
+	pairs := []string{}
+	for k, v := range labels {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", k, v))
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
@@ -0,0 +1,528 @@
+package canary
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/golang/glog"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/kubectl"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/wait"
+
+	"github.com/openshift/origin/pkg/client"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	strat "github.com/openshift/origin/pkg/deploy/strategy"
+	stratsupport "github.com/openshift/origin/pkg/deploy/strategy/support"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
+	routeapi "github.com/openshift/origin/pkg/route/api"
+)
+
+// CanaryDeploymentStrategy is a Strategy which brings up the new deployment
+// alongside the previous one and shifts the traffic to it in steps. With the
+// Replicas traffic type each step scales the new deployment up to its share
+// of the desired replicas before scaling the previous deployment down to the
+// rest, so the traffic sent to the new deployment follows the weight of the
+// step. With the RouteWeight and BlueGreen traffic types both deployments run
+// the desired replicas and the weights of their services in a route are
+// shifted, by step or at once, before the previous deployment is scaled down
+// and the backends of the route are restored.
+//
+// Once the pods of a step are ready, the verification hook of the step is
+// executed and, if the step requires it, the strategy waits for the step to
+// be approved. If a step fails, the previous deployment is scaled back to its
+// original size, the route restored and the new deployment scaled down to
+// zero.
+type CanaryDeploymentStrategy struct {
+	// getReplicationController knows how to get a replication controller.
+	getReplicationController func(namespace, name string) (*kapi.ReplicationController, error)
+	// updateReplicationController knows how to update a replication controller.
+	updateReplicationController func(namespace string, rc *kapi.ReplicationController) (*kapi.ReplicationController, error)
+	// getRoute knows how to get a route.
+	getRoute func(namespace, name string) (*routeapi.Route, error)
+	// updateRoute knows how to update a route.
+	updateRoute func(namespace string, route *routeapi.Route) (*routeapi.Route, error)
+	// getService knows how to get a service.
+	getService func(namespace, name string) (*kapi.Service, error)
+	// createService knows how to create a service.
+	createService func(namespace string, service *kapi.Service) (*kapi.Service, error)
+	// deleteService knows how to delete a service.
+	deleteService func(namespace, name string) error
+	// getUpdateAcceptor returns an UpdateAcceptor to verify the replicas of
+	// each step.
+	getUpdateAcceptor func(timeout time.Duration) strat.UpdateAcceptor
+	// scaler is used to scale replication controllers.
+	scaler kubectl.Scaler
+	// decoder is used to decode DeploymentConfigs contained in deployments.
+	decoder runtime.Decoder
+	// hookExecutor can execute a lifecycle hook.
+	hookExecutor hookExecutor
+	// retryTimeout is how long to wait for the replica count update to succeed
+	// before giving up.
+	retryTimeout time.Duration
+	// retryPeriod is how often to try updating the replica count.
+	retryPeriod time.Duration
+	// approvalPeriod is how often to check whether a step has been approved.
+	approvalPeriod time.Duration
+}
+
+// AcceptorInterval is how often the UpdateAcceptor should check for
+// readiness.
+const AcceptorInterval = 1 * time.Second
+
+// ApprovalInterval is how often the approval of a step is checked.
+const ApprovalInterval = 5 * time.Second
+
+// NewCanaryDeploymentStrategy makes a CanaryDeploymentStrategy backed by a
+// real HookExecutor and client.
+func NewCanaryDeploymentStrategy(client kclient.Interface, oclient client.Interface, decoder runtime.Decoder) *CanaryDeploymentStrategy {
+	scaler, _ := kubectl.ScalerFor(kapi.Kind("ReplicationController"), client)
+	return &CanaryDeploymentStrategy{
+		getReplicationController: func(namespace, name string) (*kapi.ReplicationController, error) {
+			return client.ReplicationControllers(namespace).Get(name)
+		},
+		updateReplicationController: func(namespace string, rc *kapi.ReplicationController) (*kapi.ReplicationController, error) {
+			return client.ReplicationControllers(namespace).Update(rc)
+		},
+		getRoute: func(namespace, name string) (*routeapi.Route, error) {
+			return oclient.Routes(namespace).Get(name)
+		},
+		updateRoute: func(namespace string, route *routeapi.Route) (*routeapi.Route, error) {
+			return oclient.Routes(namespace).Update(route)
+		},
+		getService: func(namespace, name string) (*kapi.Service, error) {
+			return client.Services(namespace).Get(name)
+		},
+		createService: func(namespace string, service *kapi.Service) (*kapi.Service, error) {
+			return client.Services(namespace).Create(service)
+		},
+		deleteService: func(namespace, name string) error {
+			return client.Services(namespace).Delete(name)
+		},
+		getUpdateAcceptor: func(timeout time.Duration) strat.UpdateAcceptor {
+			return stratsupport.NewAcceptNewlyObservedReadyPods(client, timeout, AcceptorInterval)
+		},
+		scaler:         scaler,
+		decoder:        decoder,
+		hookExecutor:   stratsupport.NewHookExecutor(client, oclient, os.Stdout, decoder),
+		retryTimeout:   120 * time.Second,
+		retryPeriod:    1 * time.Second,
+		approvalPeriod: ApprovalInterval,
+	}
+}
+
+// Deploy shifts the desired replicas from the from deployment to the to
+// deployment in the steps of the strategy.
+func (s *CanaryDeploymentStrategy) Deploy(from *kapi.ReplicationController, to *kapi.ReplicationController, desiredReplicas int) error {
+	config, err := deployutil.DecodeDeploymentConfig(to, s.decoder)
+	if err != nil {
+		return fmt.Errorf("couldn't decode config from deployment %s: %v", to.Name, err)
+	}
+
+	params := config.Spec.Strategy.CanaryParams
+	if params == nil {
+		return fmt.Errorf("deployment %s has no canary strategy parameters", deployutil.LabelForDeployment(to))
+	}
+	timeout := time.Duration(deployapi.DefaultRollingTimeoutSeconds) * time.Second
+	if params.TimeoutSeconds != nil {
+		timeout = time.Duration(*params.TimeoutSeconds) * time.Second
+	}
+	updateAcceptor := s.getUpdateAcceptor(timeout)
+
+	// Execute any pre-hook.
+	if params.Pre != nil {
+		if err := s.hookExecutor.Execute(params.Pre, to, deployapi.PreHookPodSuffix); err != nil {
+			return fmt.Errorf("Pre hook failed: %s", err)
+		}
+		glog.Infof("Pre hook finished")
+	}
+
+	if from == nil {
+		// There is nothing to compare the new deployment with, roll it out
+		// at once.
+		glog.Infof("Scaling %s to %d", deployutil.LabelForDeployment(to), desiredReplicas)
+		if _, err := s.scaleAndWait(to, desiredReplicas); err != nil {
+			return fmt.Errorf("couldn't scale %s to %d: %v", deployutil.LabelForDeployment(to), desiredReplicas, err)
+		}
+	} else if params.Traffic == deployapi.CanaryTrafficRouteWeight || params.Traffic == deployapi.CanaryTrafficBlueGreen {
+		if err := s.deployRoute(from, to, desiredReplicas, params, updateAcceptor, timeout); err != nil {
+			return err
+		}
+	} else {
+		fromReplicas := from.Spec.Replicas
+		for i, step := range params.Steps {
+			if err := s.deployStep(from, to, desiredReplicas, i, step, updateAcceptor, timeout); err != nil {
+				s.abort(from, fromReplicas, to)
+				return fmt.Errorf("canary step %d failed, rolled back to %s: %v", i+1, deployutil.LabelForDeployment(from), err)
+			}
+		}
+	}
+
+	// Execute any post-hook.
+	if params.Post != nil {
+		if err := s.hookExecutor.Execute(params.Post, to, deployapi.PostHookPodSuffix); err != nil {
+			return fmt.Errorf("post hook failed: %s", err)
+		}
+		glog.Infof("Post hook finished")
+	}
+
+	glog.Infof("Deployment %s successfully made active", to.Name)
+	return nil
+}
+
+// deployStep scales the deployments to the weight of the step with the
+// number index+1, verifies the step and waits for its approval.
+func (s *CanaryDeploymentStrategy) deployStep(from, to *kapi.ReplicationController, desiredReplicas, index int, step deployapi.CanaryStep, updateAcceptor strat.UpdateAcceptor, timeout time.Duration) error {
+	number := index + 1
+	if err := s.setAnnotation(to, deployapi.CanaryStepAnnotation, strconv.Itoa(number)); err != nil {
+		return err
+	}
+
+	toReplicas := stepReplicas(desiredReplicas, step.Weight)
+	glog.Infof("Canary step %d: scaling %s to %d (%d%%) and %s to %d", number, deployutil.LabelForDeployment(to), toReplicas, step.Weight, deployutil.LabelForDeployment(from), desiredReplicas-toReplicas)
+	updatedTo, err := s.scaleAndWait(to, toReplicas)
+	if err != nil {
+		return fmt.Errorf("couldn't scale %s to %d: %v", deployutil.LabelForDeployment(to), toReplicas, err)
+	}
+	if toReplicas > 0 {
+		if err := updateAcceptor.Accept(updatedTo); err != nil {
+			return fmt.Errorf("update acceptor rejected %s: %v", deployutil.LabelForDeployment(to), err)
+		}
+	}
+	if _, err := s.scaleAndWait(from, desiredReplicas-toReplicas); err != nil {
+		return fmt.Errorf("couldn't scale %s to %d: %v", deployutil.LabelForDeployment(from), desiredReplicas-toReplicas, err)
+	}
+	return s.verifyStep(to, number, step, timeout)
+}
+
+// routeBackends are the backends of a route, recorded in the
+// CanaryRouteBackendsAnnotation of the route while the strategy shifts its
+// traffic.
+type routeBackends struct {
+	To                kapi.ObjectReference            `json:"to"`
+	Weight            *int32                          `json:"weight,omitempty"`
+	AlternateBackends []routeapi.RouteTargetReference `json:"alternateBackends,omitempty"`
+}
+
+// deployRoute scales the to deployment up to the desired replicas alongside
+// the from deployment and shifts the traffic of the route of the strategy
+// from the service of the from deployment to the service of the to deployment,
+// by the weights of the steps or, for BlueGreen deployments, at once after
+// the step is verified and approved. The from deployment is scaled down once
+// the route only sends traffic to the to deployment, then the backends of the
+// route are restored and the services of the deployments deleted.
+func (s *CanaryDeploymentStrategy) deployRoute(from, to *kapi.ReplicationController, desiredReplicas int, params *deployapi.CanaryDeploymentStrategyParams, updateAcceptor strat.UpdateAcceptor, timeout time.Duration) error {
+	route, err := s.getRoute(to.Namespace, params.RouteName)
+	if kerrors.IsForbidden(err) {
+		return fmt.Errorf("couldn't get route %s, the deployer service account needs the system:canary-deployer role: %v", params.RouteName, err)
+	}
+	if err != nil {
+		return fmt.Errorf("couldn't get route %s: %v", params.RouteName, err)
+	}
+	// A deployer interrupted while it shifted the traffic of the route left
+	// the original backends of the route in its annotation.
+	original := &routeBackends{To: route.Spec.To, Weight: route.Spec.Weight, AlternateBackends: route.Spec.AlternateBackends}
+	if value, ok := route.Annotations[deployapi.CanaryRouteBackendsAnnotation]; ok {
+		original = &routeBackends{}
+		if err := json.Unmarshal([]byte(value), original); err != nil {
+			return fmt.Errorf("couldn't decode the %s annotation of route %s: %v", deployapi.CanaryRouteBackendsAnnotation, route.Name, err)
+		}
+	}
+	recorded, err := json.Marshal(original)
+	if err != nil {
+		return fmt.Errorf("couldn't encode the backends of route %s: %v", route.Name, err)
+	}
+	target, err := s.getService(route.Namespace, original.To.Name)
+	if err != nil {
+		return fmt.Errorf("couldn't get service %s of route %s: %v", original.To.Name, route.Name, err)
+	}
+	for _, deployment := range []*kapi.ReplicationController{from, to} {
+		if err := s.ensureService(deployment, target.Spec.Ports); err != nil {
+			return err
+		}
+	}
+
+	fromReplicas := from.Spec.Replicas
+	fail := func(err error) error {
+		glog.Infof("Aborting the deployment, sending the traffic of route %s back to service %s", route.Name, original.To.Name)
+		restoreErr := s.restoreRoute(route, original)
+		if restoreErr != nil {
+			glog.Errorf("Couldn't restore route %s, the next deployment restores it: %v", route.Name, restoreErr)
+		}
+		s.abort(from, fromReplicas, to)
+		if restoreErr == nil {
+			s.removeService(from)
+			s.removeService(to)
+		}
+		return fmt.Errorf("canary deployment failed, rolled back to %s: %v", deployutil.LabelForDeployment(from), err)
+	}
+
+	// The service of the route may select the pods of every deployment of
+	// the config, send the traffic to the from deployment only until the
+	// steps shift it.
+	if err := s.setRouteWeights(route, string(recorded), from.Name, from.Name, 100); err != nil {
+		return fail(fmt.Errorf("couldn't update route %s: %v", route.Name, err))
+	}
+
+	glog.Infof("Scaling %s to %d alongside %s", deployutil.LabelForDeployment(to), desiredReplicas, deployutil.LabelForDeployment(from))
+	updatedTo, err := s.scaleAndWait(to, desiredReplicas)
+	if err != nil {
+		return fail(fmt.Errorf("couldn't scale %s to %d: %v", deployutil.LabelForDeployment(to), desiredReplicas, err))
+	}
+	if desiredReplicas > 0 {
+		if err := updateAcceptor.Accept(updatedTo); err != nil {
+			return fail(fmt.Errorf("update acceptor rejected %s: %v", deployutil.LabelForDeployment(to), err))
+		}
+	}
+
+	for i, step := range params.Steps {
+		number := i + 1
+		if err := s.setAnnotation(to, deployapi.CanaryStepAnnotation, strconv.Itoa(number)); err != nil {
+			return fail(err)
+		}
+		if params.Traffic == deployapi.CanaryTrafficRouteWeight {
+			glog.Infof("Canary step %d: sending %d%% of the traffic of route %s to %s", number, step.Weight, route.Name, deployutil.LabelForDeployment(to))
+			if err := s.setRouteWeights(route, string(recorded), from.Name, to.Name, step.Weight); err != nil {
+				return fail(fmt.Errorf("canary step %d: couldn't update route %s: %v", number, route.Name, err))
+			}
+		}
+		if err := s.verifyStep(to, number, step, timeout); err != nil {
+			return fail(fmt.Errorf("canary step %d: %v", number, err))
+		}
+	}
+	if params.Traffic == deployapi.CanaryTrafficBlueGreen {
+		glog.Infof("Switching route %s to %s", route.Name, deployutil.LabelForDeployment(to))
+	}
+	if err := s.setRouteWeights(route, string(recorded), from.Name, to.Name, 100); err != nil {
+		return fail(fmt.Errorf("couldn't update route %s: %v", route.Name, err))
+	}
+
+	glog.Infof("Scaling %s to 0", deployutil.LabelForDeployment(from))
+	if _, err := s.scaleAndWait(from, 0); err != nil {
+		return fmt.Errorf("couldn't scale %s to 0: %v", deployutil.LabelForDeployment(from), err)
+	}
+	s.removeService(from)
+
+	// The service of the route only selects the pods of the to deployment
+	// now.
+	glog.Infof("Sending the traffic of route %s back to service %s", route.Name, original.To.Name)
+	if err := s.restoreRoute(route, original); err != nil {
+		glog.Errorf("Couldn't restore route %s, the next deployment restores it: %v", route.Name, err)
+		return nil
+	}
+	s.removeService(to)
+	return nil
+}
+
+// verifyStep executes the verification hook of the step with the given
+// number and waits for the step to be approved, if it requires it.
+func (s *CanaryDeploymentStrategy) verifyStep(to *kapi.ReplicationController, number int, step deployapi.CanaryStep, timeout time.Duration) error {
+	if step.Verify != nil {
+		if err := s.hookExecutor.Execute(step.Verify, to, fmt.Sprintf("%s-%d", deployapi.VerifyHookPodSuffix, number)); err != nil {
+			return fmt.Errorf("verify hook failed: %s", err)
+		}
+		glog.Infof("Verify hook of canary step %d finished", number)
+	}
+
+	if step.Approve {
+		glog.Infof("Waiting for canary step %d to be approved by setting the %s annotation of %s to %d", number, deployapi.CanaryApprovedStepAnnotation, deployutil.LabelForDeployment(to), number)
+		if err := s.waitForApproval(to, number, timeout); err != nil {
+			return err
+		}
+		glog.Infof("Canary step %d approved", number)
+	}
+	return nil
+}
+
+// waitForApproval waits until the step with the given number is approved on
+// the deployment, the deployment is cancelled or the timeout expires.
+func (s *CanaryDeploymentStrategy) waitForApproval(deployment *kapi.ReplicationController, number int, timeout time.Duration) error {
+	err := wait.Poll(s.approvalPeriod, timeout, func() (bool, error) {
+		current, err := s.getReplicationController(deployment.Namespace, deployment.Name)
+		if err != nil {
+			glog.V(2).Infof("Couldn't get deployment %s: %v", deployutil.LabelForDeployment(deployment), err)
+			return false, nil
+		}
+		if deployutil.IsDeploymentCancelled(current) {
+			return false, fmt.Errorf("deployment %s was cancelled", deployutil.LabelForDeployment(deployment))
+		}
+		approved, err := strconv.Atoi(current.Annotations[deployapi.CanaryApprovedStepAnnotation])
+		return err == nil && approved >= number, nil
+	})
+	if err == wait.ErrWaitTimeout {
+		return fmt.Errorf("canary step %d was not approved within %v", number, timeout)
+	}
+	return err
+}
+
+// ensureService creates the service sending traffic to the pods of the
+// deployment, named after the deployment, if it doesn't exist.
+func (s *CanaryDeploymentStrategy) ensureService(deployment *kapi.ReplicationController, ports []kapi.ServicePort) error {
+	existing, err := s.getService(deployment.Namespace, deployment.Name)
+	if err == nil {
+		if existing.Labels[deployapi.DeploymentLabel] != deployment.Name {
+			return fmt.Errorf("service %s already exists and doesn't belong to %s", deployment.Name, deployutil.LabelForDeployment(deployment))
+		}
+		return nil
+	}
+	if !kerrors.IsNotFound(err) {
+		return fmt.Errorf("couldn't get service %s: %v", deployment.Name, err)
+	}
+
+	service := &kapi.Service{
+		ObjectMeta: kapi.ObjectMeta{
+			Name: deployment.Name,
+			Labels: map[string]string{
+				deployapi.DeploymentConfigLabel: deployutil.DeploymentConfigNameFor(deployment),
+				deployapi.DeploymentLabel:       deployment.Name,
+			},
+		},
+		Spec: kapi.ServiceSpec{
+			Selector: deployment.Spec.Selector,
+		},
+	}
+	for _, port := range ports {
+		port.NodePort = 0
+		service.Spec.Ports = append(service.Spec.Ports, port)
+	}
+	glog.Infof("Creating service %s for %s", service.Name, deployutil.LabelForDeployment(deployment))
+	if _, err := s.createService(deployment.Namespace, service); err != nil && !kerrors.IsAlreadyExists(err) {
+		return fmt.Errorf("couldn't create service %s: %v", service.Name, err)
+	}
+	return nil
+}
+
+// removeService deletes the service created for the deployment. Errors are
+// logged, the service only sends traffic to a deployment scaled down to zero.
+func (s *CanaryDeploymentStrategy) removeService(deployment *kapi.ReplicationController) {
+	service, err := s.getService(deployment.Namespace, deployment.Name)
+	if err != nil || service.Labels[deployapi.DeploymentLabel] != deployment.Name {
+		return
+	}
+	if err := s.deleteService(deployment.Namespace, deployment.Name); err != nil && !kerrors.IsNotFound(err) {
+		glog.Errorf("Couldn't delete service %s: %v", deployment.Name, err)
+	}
+}
+
+// setRouteWeights sends weight percent of the traffic of the route to the
+// service toService and the rest to the service fromService. The recorded
+// original backends of the route are kept in its annotation until the route
+// is restored.
+func (s *CanaryDeploymentStrategy) setRouteWeights(route *routeapi.Route, recorded, fromService, toService string, weight int) error {
+	return s.setRouteBackends(route.Namespace, route.Name, func(current *routeapi.Route) {
+		if _, ok := current.Annotations[deployapi.CanaryRouteBackendsAnnotation]; !ok {
+			if current.Annotations == nil {
+				current.Annotations = map[string]string{}
+			}
+			current.Annotations[deployapi.CanaryRouteBackendsAnnotation] = recorded
+		}
+		current.Spec.To = kapi.ObjectReference{Kind: "Service", Name: toService}
+		if weight >= 100 {
+			current.Spec.Weight, current.Spec.AlternateBackends = nil, nil
+			return
+		}
+		toWeight, fromWeight := int32(weight), int32(100-weight)
+		current.Spec.Weight = &toWeight
+		current.Spec.AlternateBackends = []routeapi.RouteTargetReference{{Kind: "Service", Name: fromService, Weight: &fromWeight}}
+	})
+}
+
+// restoreRoute restores the original backends of the route and removes their
+// record from the annotations of the route.
+func (s *CanaryDeploymentStrategy) restoreRoute(route *routeapi.Route, original *routeBackends) error {
+	return s.setRouteBackends(route.Namespace, route.Name, func(current *routeapi.Route) {
+		current.Spec.To, current.Spec.Weight, current.Spec.AlternateBackends = original.To, original.Weight, original.AlternateBackends
+		delete(current.Annotations, deployapi.CanaryRouteBackendsAnnotation)
+	})
+}
+
+// setRouteBackends updates the latest version of the route.
+func (s *CanaryDeploymentStrategy) setRouteBackends(namespace, name string, update func(*routeapi.Route)) error {
+	return wait.Poll(s.retryPeriod, s.retryTimeout, func() (bool, error) {
+		current, err := s.getRoute(namespace, name)
+		if err != nil {
+			glog.V(2).Infof("Couldn't get route %s: %v", name, err)
+			return false, nil
+		}
+		update(current)
+		if _, err := s.updateRoute(namespace, current); err != nil {
+			glog.V(2).Infof("Couldn't update route %s: %v", name, err)
+			return false, nil
+		}
+		return true, nil
+	})
+}
+
+// abort restores the size of the from deployment and scales the to deployment
+// down to zero. Errors are logged, the deployment config controller restores
+// the previous deployment once this one has failed anyway.
+func (s *CanaryDeploymentStrategy) abort(from *kapi.ReplicationController, fromReplicas int, to *kapi.ReplicationController) {
+	glog.Infof("Aborting the deployment, scaling %s back to %d", deployutil.LabelForDeployment(from), fromReplicas)
+	if _, err := s.scaleAndWait(from, fromReplicas); err != nil {
+		glog.Errorf("Couldn't scale %s to %d: %v", deployutil.LabelForDeployment(from), fromReplicas, err)
+	}
+	if _, err := s.scaleAndWait(to, 0); err != nil {
+		glog.Errorf("Couldn't scale %s to 0: %v", deployutil.LabelForDeployment(to), err)
+	}
+}
+
+// setAnnotation sets the annotation on the latest version of the deployment.
+func (s *CanaryDeploymentStrategy) setAnnotation(deployment *kapi.ReplicationController, key, value string) error {
+	return wait.Poll(s.retryPeriod, s.retryTimeout, func() (bool, error) {
+		current, err := s.getReplicationController(deployment.Namespace, deployment.Name)
+		if err != nil {
+			glog.V(2).Infof("Couldn't get deployment %s: %v", deployutil.LabelForDeployment(deployment), err)
+			return false, nil
+		}
+		if current.Annotations == nil {
+			current.Annotations = map[string]string{}
+		}
+		current.Annotations[key] = value
+		if _, err := s.updateReplicationController(current.Namespace, current); err != nil {
+			glog.V(2).Infof("Couldn't update deployment %s: %v", deployutil.LabelForDeployment(deployment), err)
+			return false, nil
+		}
+		return true, nil
+	})
+}
+
+func (s *CanaryDeploymentStrategy) scaleAndWait(deployment *kapi.ReplicationController, replicas int) (*kapi.ReplicationController, error) {
+	retry := kubectl.NewRetryParams(s.retryPeriod, s.retryTimeout)
+	waitParams := kubectl.NewRetryParams(s.retryPeriod, s.retryTimeout)
+	if err := s.scaler.Scale(deployment.Namespace, deployment.Name, uint(replicas), &kubectl.ScalePrecondition{Size: -1, ResourceVersion: ""}, retry, waitParams); err != nil {
+		return nil, err
+	}
+	return s.getReplicationController(deployment.Namespace, deployment.Name)
+}
+
+// stepReplicas returns the number of the desired replicas run by the new
+// deployment for a step weight, rounding up so that every step runs at least
+// one new replica.
+func stepReplicas(desiredReplicas, weight int) int {
+	replicas := (desiredReplicas*weight + 99) / 100
+	if replicas > desiredReplicas {
+		return desiredReplicas
+	}
+	return replicas
+}
+
+// hookExecutor knows how to execute a deployment lifecycle hook.
+type hookExecutor interface {
+	Execute(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error
+}
+
+// hookExecutorImpl is a pluggable hookExecutor.
+type hookExecutorImpl struct {
+	executeFunc func(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error
+}
+
+// Execute executes the provided lifecycle hook
+func (i *hookExecutorImpl) Execute(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error {
+	return i.executeFunc(hook, deployment, label)
+}
@@ -0,0 +1,496 @@
+package canary
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/apimachinery/registered"
+	"k8s.io/kubernetes/pkg/kubectl"
+
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	deploytest "github.com/openshift/origin/pkg/deploy/api/test"
+	scalertest "github.com/openshift/origin/pkg/deploy/scaler/test"
+	"github.com/openshift/origin/pkg/deploy/strategy"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
+	routeapi "github.com/openshift/origin/pkg/route/api"
+
+	_ "github.com/openshift/origin/pkg/api/install"
+)
+
+func canaryParams(steps ...deployapi.CanaryStep) deployapi.DeploymentStrategy {
+	timeout := int64(30)
+	return deployapi.DeploymentStrategy{
+		Type: deployapi.DeploymentStrategyTypeCanary,
+		CanaryParams: &deployapi.CanaryDeploymentStrategyParams{
+			TimeoutSeconds: &timeout,
+			Steps:          steps,
+		},
+	}
+}
+
+// newTestStrategy returns a strategy deploying from version 1 to version 2 of
+// a config with the given steps. The deployments are kept in the returned map
+// by name.
+func newTestStrategy(t *testing.T, scaler *scalertest.FakeScaler, steps ...deployapi.CanaryStep) (*CanaryDeploymentStrategy, map[string]*kapi.ReplicationController) {
+	codec := kapi.Codecs.LegacyCodec(registered.GroupOrDie(kapi.GroupName).GroupVersions[0])
+	deployments := map[string]*kapi.ReplicationController{}
+	for _, version := range []int{1, 2} {
+		config := deploytest.OkDeploymentConfig(version)
+		config.Spec.Strategy = canaryParams(steps...)
+		deployment, err := deployutil.MakeDeployment(config, codec)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		deployments[deployment.Name] = deployment
+	}
+	return &CanaryDeploymentStrategy{
+		decoder:        kapi.Codecs.UniversalDecoder(),
+		retryTimeout:   1 * time.Second,
+		retryPeriod:    1 * time.Millisecond,
+		approvalPeriod: 1 * time.Millisecond,
+		getReplicationController: func(namespace, name string) (*kapi.ReplicationController, error) {
+			return deployments[name], nil
+		},
+		updateReplicationController: func(namespace string, rc *kapi.ReplicationController) (*kapi.ReplicationController, error) {
+			deployments[rc.Name] = rc
+			return rc, nil
+		},
+		getUpdateAcceptor: getUpdateAcceptor,
+		scaler:            scaler,
+	}, deployments
+}
+
+func TestCanary_steps(t *testing.T) {
+	scaler := &scalertest.FakeScaler{}
+	verified := []string{}
+	strategy, deployments := newTestStrategy(t, scaler,
+		deployapi.CanaryStep{Weight: 25, Verify: &deployapi.LifecycleHook{FailurePolicy: deployapi.LifecycleHookFailurePolicyAbort}},
+		deployapi.CanaryStep{Weight: 100},
+	)
+	strategy.hookExecutor = &hookExecutorImpl{
+		executeFunc: func(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error {
+			verified = append(verified, label)
+			return nil
+		},
+	}
+	from, to := deployments["config-1"], deployments["config-2"]
+	from.Spec.Replicas = 4
+
+	if err := strategy.Deploy(from, to, 4); err != nil {
+		t.Fatalf("unexpected deploy error: %v", err)
+	}
+
+	expected := []scalertest.ScaleEvent{{Name: "config-2", Size: 1}, {Name: "config-1", Size: 3}, {Name: "config-2", Size: 4}, {Name: "config-1", Size: 0}}
+	if !reflect.DeepEqual(scaler.Events, expected) {
+		t.Errorf("expected scale events %v, got %v", expected, scaler.Events)
+	}
+	if !reflect.DeepEqual(verified, []string{"hook-verify-1"}) {
+		t.Errorf("expected the verify hook of the first step to be executed, got %v", verified)
+	}
+	if step := deployments["config-2"].Annotations[deployapi.CanaryStepAnnotation]; step != "2" {
+		t.Errorf("expected the deployment to be annotated with its last step, got %q", step)
+	}
+}
+
+func TestCanary_verifyHookFailureAborts(t *testing.T) {
+	scaler := &scalertest.FakeScaler{}
+	strategy, deployments := newTestStrategy(t, scaler,
+		deployapi.CanaryStep{Weight: 50, Verify: &deployapi.LifecycleHook{FailurePolicy: deployapi.LifecycleHookFailurePolicyAbort}},
+		deployapi.CanaryStep{Weight: 100},
+	)
+	strategy.hookExecutor = &hookExecutorImpl{
+		executeFunc: func(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error {
+			return fmt.Errorf("hook execution failure")
+		},
+	}
+	from, to := deployments["config-1"], deployments["config-2"]
+	from.Spec.Replicas = 2
+
+	if err := strategy.Deploy(from, to, 2); err == nil {
+		t.Fatalf("expected a deploy error")
+	}
+
+	expected := []scalertest.ScaleEvent{{Name: "config-2", Size: 1}, {Name: "config-1", Size: 1}, {Name: "config-1", Size: 2}, {Name: "config-2", Size: 0}}
+	if !reflect.DeepEqual(scaler.Events, expected) {
+		t.Errorf("expected scale events %v, got %v", expected, scaler.Events)
+	}
+}
+
+func TestCanary_approval(t *testing.T) {
+	scaler := &scalertest.FakeScaler{}
+	strategy, deployments := newTestStrategy(t, scaler,
+		deployapi.CanaryStep{Weight: 50, Approve: true},
+		deployapi.CanaryStep{Weight: 100},
+	)
+	from, to := deployments["config-1"], deployments["config-2"]
+	from.Spec.Replicas = 2
+
+	polls := 0
+	getReplicationController := strategy.getReplicationController
+	strategy.getReplicationController = func(namespace, name string) (*kapi.ReplicationController, error) {
+		deployment, err := getReplicationController(namespace, name)
+		if name == "config-2" && deployment.Annotations[deployapi.CanaryStepAnnotation] == "1" {
+			polls++
+			if polls == 3 {
+				deployment.Annotations[deployapi.CanaryApprovedStepAnnotation] = "1"
+			}
+		}
+		return deployment, err
+	}
+
+	if err := strategy.Deploy(from, to, 2); err != nil {
+		t.Fatalf("unexpected deploy error: %v", err)
+	}
+	if polls < 3 {
+		t.Errorf("expected the strategy to wait for the approval of the first step")
+	}
+	if e, a := 4, len(scaler.Events); e != a {
+		t.Errorf("expected %d scale calls, got %v", e, scaler.Events)
+	}
+}
+
+func TestCanary_cancelledWhileWaitingForApproval(t *testing.T) {
+	scaler := &scalertest.FakeScaler{}
+	strategy, deployments := newTestStrategy(t, scaler,
+		deployapi.CanaryStep{Weight: 50, Approve: true},
+		deployapi.CanaryStep{Weight: 100},
+	)
+	from, to := deployments["config-1"], deployments["config-2"]
+	from.Spec.Replicas = 2
+	to.Annotations[deployapi.DeploymentCancelledAnnotation] = deployapi.DeploymentCancelledAnnotationValue
+
+	if err := strategy.Deploy(from, to, 2); err == nil {
+		t.Fatalf("expected a deploy error")
+	}
+	expected := []scalertest.ScaleEvent{{Name: "config-2", Size: 1}, {Name: "config-1", Size: 1}, {Name: "config-1", Size: 2}, {Name: "config-2", Size: 0}}
+	if !reflect.DeepEqual(scaler.Events, expected) {
+		t.Errorf("expected scale events %v, got %v", expected, scaler.Events)
+	}
+}
+
+func TestCanary_initialDeployment(t *testing.T) {
+	scaler := &scalertest.FakeScaler{}
+	strategy, deployments := newTestStrategy(t, scaler, deployapi.CanaryStep{Weight: 10}, deployapi.CanaryStep{Weight: 100})
+
+	if err := strategy.Deploy(nil, deployments["config-2"], 3); err != nil {
+		t.Fatalf("unexpected deploy error: %v", err)
+	}
+	expected := []scalertest.ScaleEvent{{Name: "config-2", Size: 3}}
+	if !reflect.DeepEqual(scaler.Events, expected) {
+		t.Errorf("expected scale events %v, got %v", expected, scaler.Events)
+	}
+}
+
+func TestCanary_approvalTimeout(t *testing.T) {
+	scaler := &scalertest.FakeScaler{}
+	strategy, deployments := newTestStrategy(t, scaler,
+		deployapi.CanaryStep{Weight: 50, Approve: true},
+		deployapi.CanaryStep{Weight: 100},
+	)
+	from, to := deployments["config-1"], deployments["config-2"]
+	from.Spec.Replicas = 2
+	timeout := int64(1)
+	to.Annotations[deployapi.DeploymentEncodedConfigAnnotation] = encodeConfig(t, to, func(config *deployapi.DeploymentConfig) {
+		config.Spec.Strategy.CanaryParams.TimeoutSeconds = &timeout
+	})
+
+	if err := strategy.Deploy(from, to, 2); err == nil {
+		t.Fatalf("expected a deploy error")
+	}
+	expected := []scalertest.ScaleEvent{{Name: "config-2", Size: 1}, {Name: "config-1", Size: 1}, {Name: "config-1", Size: 2}, {Name: "config-2", Size: 0}}
+	if !reflect.DeepEqual(scaler.Events, expected) {
+		t.Errorf("expected scale events %v, got %v", expected, scaler.Events)
+	}
+}
+
+func TestCanary_routeWeight(t *testing.T) {
+	scaler := &scalertest.FakeScaler{}
+	strategy, deployments := newTestStrategy(t, scaler,
+		deployapi.CanaryStep{Weight: 25, Verify: &deployapi.LifecycleHook{FailurePolicy: deployapi.LifecycleHookFailurePolicyAbort}},
+		deployapi.CanaryStep{Weight: 100},
+	)
+	routes, services := withRoute(t, strategy, deployments, deployapi.CanaryTrafficRouteWeight)
+	weights := []string{}
+	var service *kapi.Service
+	strategy.hookExecutor = &hookExecutorImpl{
+		executeFunc: func(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error {
+			weights = append(weights, routeWeights(routes["route"]))
+			service = services["config-2"]
+			return nil
+		},
+	}
+	from, to := deployments["config-1"], deployments["config-2"]
+	from.Spec.Replicas = 2
+	switched := recordRouteOnScaleDown(strategy, routes)
+
+	if err := strategy.Deploy(from, to, 2); err != nil {
+		t.Fatalf("unexpected deploy error: %v", err)
+	}
+	if service == nil {
+		t.Fatalf("expected a service for the new deployment")
+	}
+
+	expected := []scalertest.ScaleEvent{{Name: "config-2", Size: 2}, {Name: "config-1", Size: 0}}
+	if !reflect.DeepEqual(scaler.Events, expected) {
+		t.Errorf("expected scale events %v, got %v", expected, scaler.Events)
+	}
+	if e, a := []string{"config-2=25 config-1=75"}, weights; !reflect.DeepEqual(e, a) {
+		t.Errorf("expected route weights %v while verifying, got %v", e, a)
+	}
+	if e, a := "config-2", *switched; e != a {
+		t.Errorf("expected the route to send its traffic to %s while scaling down, got %s", e, a)
+	}
+	if !reflect.DeepEqual(service.Spec.Selector, to.Spec.Selector) {
+		t.Errorf("expected the service to select the pods of the deployment, got %v", service.Spec.Selector)
+	}
+	if e, a := []kapi.ServicePort{{Name: "http", Port: 80}}, service.Spec.Ports; !reflect.DeepEqual(e, a) {
+		t.Errorf("expected the ports of the service of the route %v, got %v", e, a)
+	}
+	expectRestored(t, routes["route"], services)
+}
+
+func TestCanary_blueGreen(t *testing.T) {
+	scaler := &scalertest.FakeScaler{}
+	strategy, deployments := newTestStrategy(t, scaler, deployapi.CanaryStep{Weight: 100, Approve: true})
+	routes, services := withRoute(t, strategy, deployments, deployapi.CanaryTrafficBlueGreen)
+	from, to := deployments["config-1"], deployments["config-2"]
+	from.Spec.Replicas = 2
+	switched := recordRouteOnScaleDown(strategy, routes)
+
+	weights := []string{}
+	getReplicationController := strategy.getReplicationController
+	strategy.getReplicationController = func(namespace, name string) (*kapi.ReplicationController, error) {
+		deployment, err := getReplicationController(namespace, name)
+		if name == "config-2" && deployment.Annotations[deployapi.CanaryStepAnnotation] == "1" {
+			weights = append(weights, routeWeights(routes["route"]))
+			deployment.Annotations[deployapi.CanaryApprovedStepAnnotation] = "1"
+		}
+		return deployment, err
+	}
+
+	if err := strategy.Deploy(from, to, 2); err != nil {
+		t.Fatalf("unexpected deploy error: %v", err)
+	}
+	if len(weights) == 0 || weights[0] != "config-1" {
+		t.Errorf("expected the route to send its traffic to the previous deployment until approved, got %v", weights)
+	}
+	if e, a := "config-2", *switched; e != a {
+		t.Errorf("expected the route to send its traffic to %s while scaling down, got %s", e, a)
+	}
+	expected := []scalertest.ScaleEvent{{Name: "config-2", Size: 2}, {Name: "config-1", Size: 0}}
+	if !reflect.DeepEqual(scaler.Events, expected) {
+		t.Errorf("expected scale events %v, got %v", expected, scaler.Events)
+	}
+	expectRestored(t, routes["route"], services)
+}
+
+func TestCanary_routeWeightFailureRestoresRoute(t *testing.T) {
+	scaler := &scalertest.FakeScaler{}
+	strategy, deployments := newTestStrategy(t, scaler,
+		deployapi.CanaryStep{Weight: 50, Verify: &deployapi.LifecycleHook{FailurePolicy: deployapi.LifecycleHookFailurePolicyAbort}},
+		deployapi.CanaryStep{Weight: 100},
+	)
+	routes, services := withRoute(t, strategy, deployments, deployapi.CanaryTrafficRouteWeight)
+	strategy.hookExecutor = &hookExecutorImpl{
+		executeFunc: func(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error {
+			return fmt.Errorf("hook execution failure")
+		},
+	}
+	from, to := deployments["config-1"], deployments["config-2"]
+	from.Spec.Replicas = 2
+
+	if err := strategy.Deploy(from, to, 2); err == nil {
+		t.Fatalf("expected a deploy error")
+	}
+	expected := []scalertest.ScaleEvent{{Name: "config-2", Size: 2}, {Name: "config-1", Size: 2}, {Name: "config-2", Size: 0}}
+	if !reflect.DeepEqual(scaler.Events, expected) {
+		t.Errorf("expected scale events %v, got %v", expected, scaler.Events)
+	}
+	expectRestored(t, routes["route"], services)
+}
+
+func TestCanary_routeRestoredAfterInterruption(t *testing.T) {
+	scaler := &scalertest.FakeScaler{}
+	strategy, deployments := newTestStrategy(t, scaler, deployapi.CanaryStep{Weight: 100})
+	routes, services := withRoute(t, strategy, deployments, deployapi.CanaryTrafficBlueGreen)
+	from, to := deployments["config-1"], deployments["config-2"]
+	from.Spec.Replicas = 2
+
+	// an interrupted deployment left the route pointing to the service of a
+	// deployment
+	route := routes["route"]
+	route.Annotations = map[string]string{deployapi.CanaryRouteBackendsAnnotation: `{"to":{"kind":"Service","name":"frontend"}}`}
+	route.Spec.To = kapi.ObjectReference{Kind: "Service", Name: "config-1"}
+
+	if err := strategy.Deploy(from, to, 2); err != nil {
+		t.Fatalf("unexpected deploy error: %v", err)
+	}
+	expectRestored(t, routes["route"], services)
+}
+
+func TestCanary_defaultTimeout(t *testing.T) {
+	scaler := &scalertest.FakeScaler{}
+	strategy, deployments := newTestStrategy(t, scaler, deployapi.CanaryStep{Weight: 100})
+	from, to := deployments["config-1"], deployments["config-2"]
+	to.Annotations[deployapi.DeploymentEncodedConfigAnnotation] = encodeConfig(t, to, func(config *deployapi.DeploymentConfig) {
+		config.Spec.Strategy.CanaryParams.TimeoutSeconds = nil
+	})
+
+	if err := strategy.Deploy(from, to, 1); err != nil {
+		t.Fatalf("unexpected deploy error: %v", err)
+	}
+}
+
+func TestStepReplicas(t *testing.T) {
+	tests := []struct {
+		desired, weight, expected int
+	}{
+		{desired: 10, weight: 10, expected: 1},
+		{desired: 3, weight: 10, expected: 1},
+		{desired: 3, weight: 50, expected: 2},
+		{desired: 3, weight: 100, expected: 3},
+		{desired: 0, weight: 50, expected: 0},
+	}
+	for _, test := range tests {
+		if actual := stepReplicas(test.desired, test.weight); actual != test.expected {
+			t.Errorf("expected %d replicas for %d%% of %d, got %d", test.expected, test.weight, test.desired, actual)
+		}
+	}
+}
+
+// withRoute makes the deployments of the strategy use the given traffic type
+// with a route named route sending traffic to the service frontend. The
+// routes and services are kept in the returned maps by name.
+func withRoute(t *testing.T, strategy *CanaryDeploymentStrategy, deployments map[string]*kapi.ReplicationController, traffic deployapi.CanaryTrafficType) (map[string]*routeapi.Route, map[string]*kapi.Service) {
+	for _, deployment := range deployments {
+		deployment.Annotations[deployapi.DeploymentEncodedConfigAnnotation] = encodeConfig(t, deployment, func(config *deployapi.DeploymentConfig) {
+			config.Spec.Strategy.CanaryParams.Traffic = traffic
+			config.Spec.Strategy.CanaryParams.RouteName = "route"
+		})
+	}
+	routes := map[string]*routeapi.Route{
+		"route": {
+			ObjectMeta: kapi.ObjectMeta{Name: "route", Namespace: kapi.NamespaceDefault},
+			Spec:       routeapi.RouteSpec{To: kapi.ObjectReference{Kind: "Service", Name: "frontend"}},
+		},
+	}
+	services := map[string]*kapi.Service{
+		"frontend": {
+			ObjectMeta: kapi.ObjectMeta{Name: "frontend", Namespace: kapi.NamespaceDefault},
+			Spec:       kapi.ServiceSpec{Ports: []kapi.ServicePort{{Name: "http", Port: 80, NodePort: 30080}}},
+		},
+	}
+	strategy.getRoute = func(namespace, name string) (*routeapi.Route, error) {
+		route, ok := routes[name]
+		if !ok {
+			return nil, kerrors.NewNotFound(routeapi.Resource("route"), name)
+		}
+		copied := *route
+		return &copied, nil
+	}
+	strategy.updateRoute = func(namespace string, route *routeapi.Route) (*routeapi.Route, error) {
+		routes[route.Name] = route
+		return route, nil
+	}
+	strategy.getService = func(namespace, name string) (*kapi.Service, error) {
+		service, ok := services[name]
+		if !ok {
+			return nil, kerrors.NewNotFound(kapi.Resource("service"), name)
+		}
+		return service, nil
+	}
+	strategy.createService = func(namespace string, service *kapi.Service) (*kapi.Service, error) {
+		services[service.Name] = service
+		return service, nil
+	}
+	strategy.deleteService = func(namespace, name string) error {
+		delete(services, name)
+		return nil
+	}
+	return routes, services
+}
+
+// recordRouteOnScaleDown records the backends of the route named route when
+// the strategy scales the previous deployment down to zero.
+func recordRouteOnScaleDown(strategy *CanaryDeploymentStrategy, routes map[string]*routeapi.Route) *string {
+	switched := ""
+	strategy.scaler = &recordingScaler{Scaler: strategy.scaler, scale: func(name string, size uint) {
+		if name == "config-1" && size == 0 {
+			switched = routeWeights(routes["route"])
+		}
+	}}
+	return &switched
+}
+
+type recordingScaler struct {
+	kubectl.Scaler
+	scale func(name string, size uint)
+}
+
+func (s *recordingScaler) Scale(namespace, name string, newSize uint, preconditions *kubectl.ScalePrecondition, retry, wait *kubectl.RetryParams) error {
+	s.scale(name, newSize)
+	return s.Scaler.Scale(namespace, name, newSize, preconditions, retry, wait)
+}
+
+// expectRestored verifies that the route sends its traffic to the service
+// frontend again and that the services of the deployments were deleted.
+func expectRestored(t *testing.T, route *routeapi.Route, services map[string]*kapi.Service) {
+	if e, a := "frontend", routeWeights(route); e != a {
+		t.Errorf("expected the route to be restored to %s, got %s", e, a)
+	}
+	if _, ok := route.Annotations[deployapi.CanaryRouteBackendsAnnotation]; ok {
+		t.Errorf("expected the record of the backends of the route to be removed")
+	}
+	for _, name := range []string{"config-1", "config-2"} {
+		if _, ok := services[name]; ok {
+			t.Errorf("expected the service %s of the deployment to be deleted", name)
+		}
+	}
+}
+
+// encodeConfig returns the encoded config of the deployment changed by update.
+func encodeConfig(t *testing.T, deployment *kapi.ReplicationController, update func(*deployapi.DeploymentConfig)) string {
+	config, err := deployutil.DecodeDeploymentConfig(deployment, kapi.Codecs.UniversalDecoder())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	update(config)
+	codec := kapi.Codecs.LegacyCodec(registered.GroupOrDie(kapi.GroupName).GroupVersions[0])
+	encoded, err := deployutil.EncodeDeploymentConfig(config, codec)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return encoded
+}
+
+// routeWeights describes the services of the route with their weights.
+func routeWeights(route *routeapi.Route) string {
+	if route.Spec.Weight == nil {
+		return route.Spec.To.Name
+	}
+	description := fmt.Sprintf("%s=%d", route.Spec.To.Name, *route.Spec.Weight)
+	for _, backend := range route.Spec.AlternateBackends {
+		description += fmt.Sprintf(" %s=%d", backend.Name, *backend.Weight)
+	}
+	return description
+}
+
+func getUpdateAcceptor(timeout time.Duration) strategy.UpdateAcceptor {
+	return &testAcceptor{
+		acceptFn: func(deployment *kapi.ReplicationController) error {
+			return nil
+		},
+	}
+}
+
+type testAcceptor struct {
+	acceptFn func(*kapi.ReplicationController) error
+}
+
+func (t *testAcceptor) Accept(deployment *kapi.ReplicationController) error {
+	return t.acceptFn(deployment)
+}
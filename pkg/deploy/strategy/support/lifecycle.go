@@ -3,6 +3,8 @@ package support
 import (
 	"fmt"
 	"io"
+	"io/ioutil"
+	"net/http"
 	"sync"
 	"time"
 
@@ -30,6 +32,10 @@ import (
 
 const HookContainerName = "lifecycle"
 
+// HookPollInterval is how often an HTTPGet hook is retried and how often a
+// WaitForImage hook checks its image stream tag.
+const HookPollInterval = 5 * time.Second
+
 // HookExecutor executes a deployment lifecycle hook.
 type HookExecutor struct {
 	// podClient provides access to pods.
@@ -42,6 +48,10 @@ type HookExecutor struct {
 	podLogStream func(namespace, name string, opts *kapi.PodLogOptions) (io.ReadCloser, error)
 	// decoder is used for encoding/decoding.
 	decoder runtime.Decoder
+	// httpClient is used to execute HTTPGet hooks.
+	httpClient *http.Client
+	// pollInterval is how often HTTPGet and WaitForImage hooks are retried.
+	pollInterval time.Duration
 }
 
 // NewHookExecutor makes a HookExecutor from a client.
@@ -61,6 +71,8 @@ func NewHookExecutor(client kclient.PodsNamespacer, tags client.ImageStreamTagsN
 		},
 		podLogDestination: podLogDestination,
 		decoder:           decoder,
+		httpClient:        &http.Client{},
+		pollInterval:      HookPollInterval,
 	}
 }
 
@@ -73,6 +85,10 @@ func (e *HookExecutor) Execute(hook *deployapi.LifecycleHook, deployment *kapi.R
 		err = e.tagImages(hook, deployment, label)
 	case hook.ExecNewPod != nil:
 		err = e.executeExecNewPod(hook, deployment, label)
+	case hook.HTTPGet != nil:
+		err = e.executeHTTPGet(hook, label)
+	case hook.WaitForImage != nil:
+		err = e.waitForImage(hook, deployment, label)
 	}
 
 	if err == nil {
@@ -137,6 +153,102 @@ func (e *HookExecutor) tagImages(hook *deployapi.LifecycleHook, deployment *kapi
 	return utilerrors.NewAggregate(errs)
 }
 
+// executeHTTPGet sends a GET request to the URL of an HTTPGet hook and
+// requires a successful response within the timeout of the hook. If the
+// failure policy of the hook is Retry, unsuccessful requests are repeated
+// until the timeout.
+func (e *HookExecutor) executeHTTPGet(hook *deployapi.LifecycleHook, label string) error {
+	params := hook.HTTPGet
+	timeout := time.Duration(deployapi.DefaultHTTPGetHookTimeoutSeconds) * time.Second
+	if params.TimeoutSeconds != nil {
+		timeout = time.Duration(*params.TimeoutSeconds) * time.Second
+	}
+	deadline := time.Now().Add(timeout)
+	for {
+		err := e.httpGet(params.URL, deadline.Sub(time.Now()))
+		if err == nil {
+			glog.Infof("Hook %s: GET %s succeeded", label, params.URL)
+			return nil
+		}
+		if hook.FailurePolicy != deployapi.LifecycleHookFailurePolicyRetry || time.Now().Add(e.pollInterval).After(deadline) {
+			return err
+		}
+		glog.Infof("Hook %s: %v, retrying in %s", label, err, e.pollInterval)
+		time.Sleep(e.pollInterval)
+	}
+}
+
+// httpGet sends a GET request to url and returns an error unless a 2xx
+// response is received within timeout.
+func (e *HookExecutor) httpGet(url string, timeout time.Duration) error {
+	client := *e.httpClient
+	client.Timeout = timeout
+	resp, err := client.Get(url)
+	if err != nil {
+		return fmt.Errorf("GET %s failed: %v", url, err)
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("GET %s returned %s", url, resp.Status)
+	}
+	return nil
+}
+
+// waitForImage waits until the image stream tag of a WaitForImage hook
+// references the image with the digest of the hook, or the digest of the
+// image of its container in the deployment.
+func (e *HookExecutor) waitForImage(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error {
+	params := hook.WaitForImage
+	digest := params.Digest
+	if len(params.ContainerName) > 0 {
+		image, ok := findContainerImage(deployment, params.ContainerName)
+		if !ok {
+			return fmt.Errorf("unable to find image for container %q, container could not be found", params.ContainerName)
+		}
+		ref, err := imageapi.ParseDockerImageReference(image)
+		if err != nil {
+			return fmt.Errorf("couldn't parse image %q of container %q: %v", image, params.ContainerName, err)
+		}
+		if len(ref.ID) == 0 {
+			return fmt.Errorf("image %q of container %q isn't referenced by digest", image, params.ContainerName)
+		}
+		digest = ref.ID
+	}
+	namespace := params.From.Namespace
+	if len(namespace) == 0 {
+		namespace = deployment.Namespace
+	}
+	name, tag, ok := imageapi.SplitImageStreamTag(params.From.Name)
+	if !ok {
+		return fmt.Errorf("%q isn't an image stream tag", params.From.Name)
+	}
+	timeout := time.Duration(deployapi.DefaultWaitForImageHookTimeoutSeconds) * time.Second
+	if params.TimeoutSeconds != nil {
+		timeout = time.Duration(*params.TimeoutSeconds) * time.Second
+	}
+
+	glog.Infof("Hook %s: waiting for %s/%s to reference %s", label, namespace, params.From.Name, digest)
+	err := wait.PollImmediate(e.pollInterval, timeout, func() (bool, error) {
+		istag, err := e.tags.ImageStreamTags(namespace).Get(name, tag)
+		if err != nil {
+			if !kerrors.IsNotFound(err) {
+				glog.V(2).Infof("Couldn't get %s/%s: %v", namespace, params.From.Name, err)
+			}
+			return false, nil
+		}
+		return istag.Image.Name == digest, nil
+	})
+	if err == wait.ErrWaitTimeout {
+		return fmt.Errorf("%s/%s didn't reference %s within %s", namespace, params.From.Name, digest, timeout)
+	}
+	if err != nil {
+		return err
+	}
+	glog.Infof("Hook %s: %s/%s references %s", label, namespace, params.From.Name, digest)
+	return nil
+}
+
 // executeExecNewPod executes a ExecNewPod hook by creating a new pod based on
 // the hook parameters and deployment. The pod is then synchronously watched
 // until the pod completes, and if the pod failed, an error is returned.
@@ -145,7 +257,7 @@ func (e *HookExecutor) tagImages(hook *deployapi.LifecycleHook, deployment *kapi
 //
 //   * Environment (hook keys take precedence)
 //   * Working directory
-//   * Resources
+//   * Resources (unless the hook sets its own)
 func (e *HookExecutor) executeExecNewPod(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error {
 	config, err := deployutil.DecodeDeploymentConfig(deployment, e.decoder)
 	if err != nil {
@@ -252,9 +364,13 @@ func makeHookPod(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationCont
 	mergedEnv = append(mergedEnv, kapi.EnvVar{Name: "OPENSHIFT_DEPLOYMENT_NAME", Value: deployment.Name})
 	mergedEnv = append(mergedEnv, kapi.EnvVar{Name: "OPENSHIFT_DEPLOYMENT_NAMESPACE", Value: deployment.Namespace})
 
-	// Inherit resources from the base container
+	// Inherit resources from the base container unless the hook sets its own
 	resources := kapi.ResourceRequirements{}
-	if err := kapi.Scheme.Convert(&baseContainer.Resources, &resources); err != nil {
+	baseResources := &baseContainer.Resources
+	if exec.Resources != nil {
+		baseResources = exec.Resources
+	}
+	if err := kapi.Scheme.Convert(baseResources, &resources); err != nil {
 		return nil, fmt.Errorf("couldn't clone ResourceRequirements: %v", err)
 	}
 
@@ -290,6 +406,28 @@ func makeHookPod(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationCont
 		}
 	}
 
+	// Add the volumes defined only for the hook pod.
+	for _, volume := range exec.ExtraVolumes {
+		volumes = append(volumes, kapi.Volume{
+			Name: volume.Name,
+			VolumeSource: kapi.VolumeSource{
+				Secret:   volume.Secret,
+				EmptyDir: volume.EmptyDir,
+			},
+		})
+		volumeMounts = append(volumeMounts, kapi.VolumeMount{
+			Name:      volume.Name,
+			ReadOnly:  volume.ReadOnly,
+			MountPath: volume.MountPath,
+		})
+	}
+
+	// The hook may run on other nodes than the deployment pods.
+	nodeSelector := deployment.Spec.Template.Spec.NodeSelector
+	if len(exec.NodeSelector) > 0 {
+		nodeSelector = exec.NodeSelector
+	}
+
 	// Transfer image pull secrets from the pod spec.
 	imagePullSecrets := []kapi.LocalObjectReference{}
 	for _, pullSecret := range deployment.Spec.Template.Spec.ImagePullSecrets {
@@ -321,10 +459,12 @@ func makeHookPod(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationCont
 			Volumes:               volumes,
 			ActiveDeadlineSeconds: &maxDeploymentDurationSeconds,
 			// Setting the node selector on the hook pod so that it is created
-			// on the same set of nodes as the deployment pods.
-			NodeSelector:     deployment.Spec.Template.Spec.NodeSelector,
-			RestartPolicy:    restartPolicy,
-			ImagePullSecrets: imagePullSecrets,
+			// on the same set of nodes as the deployment pods, unless the hook
+			// selects other nodes.
+			NodeSelector:       nodeSelector,
+			RestartPolicy:      restartPolicy,
+			ImagePullSecrets:   imagePullSecrets,
+			ServiceAccountName: exec.ServiceAccountName,
 		},
 	}
 
@@ -384,26 +524,32 @@ func NewPodWatch(client kclient.PodsNamespacer, namespace, name, resourceVersion
 // from a real client.
 func NewAcceptNewlyObservedReadyPods(kclient kclient.PodsNamespacer, timeout time.Duration, interval time.Duration) *AcceptNewlyObservedReadyPods {
 	return &AcceptNewlyObservedReadyPods{
-		timeout:      timeout,
-		interval:     interval,
-		acceptedPods: sets.NewString(),
-		getDeploymentPodStore: func(deployment *kapi.ReplicationController) (cache.Store, chan struct{}) {
-			selector := labels.Set(deployment.Spec.Selector).AsSelector()
-			store := cache.NewStore(cache.MetaNamespaceKeyFunc)
-			lw := &cache.ListWatch{
-				ListFunc: func(options kapi.ListOptions) (runtime.Object, error) {
-					opts := kapi.ListOptions{LabelSelector: selector}
-					return kclient.Pods(deployment.Namespace).List(opts)
-				},
-				WatchFunc: func(options kapi.ListOptions) (watch.Interface, error) {
-					opts := kapi.ListOptions{LabelSelector: selector, ResourceVersion: options.ResourceVersion}
-					return kclient.Pods(deployment.Namespace).Watch(opts)
-				},
-			}
-			stop := make(chan struct{})
-			cache.NewReflector(lw, &kapi.Pod{}, store, 10*time.Second).RunUntil(stop)
-			return store, stop
-		},
+		timeout:               timeout,
+		interval:              interval,
+		acceptedPods:          sets.NewString(),
+		getDeploymentPodStore: deploymentPodStoreFunc(kclient),
+	}
+}
+
+// deploymentPodStoreFunc returns a function which returns a Store fed with
+// the pods of a deployment, and a channel to stop feeding it.
+func deploymentPodStoreFunc(kclient kclient.PodsNamespacer) func(deployment *kapi.ReplicationController) (cache.Store, chan struct{}) {
+	return func(deployment *kapi.ReplicationController) (cache.Store, chan struct{}) {
+		selector := labels.Set(deployment.Spec.Selector).AsSelector()
+		store := cache.NewStore(cache.MetaNamespaceKeyFunc)
+		lw := &cache.ListWatch{
+			ListFunc: func(options kapi.ListOptions) (runtime.Object, error) {
+				opts := kapi.ListOptions{LabelSelector: selector}
+				return kclient.Pods(deployment.Namespace).List(opts)
+			},
+			WatchFunc: func(options kapi.ListOptions) (watch.Interface, error) {
+				opts := kapi.ListOptions{LabelSelector: selector, ResourceVersion: options.ResourceVersion}
+				return kclient.Pods(deployment.Namespace).Watch(opts)
+			},
+		}
+		stop := make(chan struct{})
+		cache.NewReflector(lw, &kapi.Pod{}, store, 10*time.Second).RunUntil(stop)
+		return store, stop
 	}
 }
 
@@ -480,3 +626,60 @@ func (c *AcceptNewlyObservedReadyPods) Accept(deployment *kapi.ReplicationContro
 	}
 	return nil
 }
+
+// NewAcceptReadyReplicas makes a new AcceptReadyReplicas from a real client.
+func NewAcceptReadyReplicas(kclient kclient.PodsNamespacer, replicas int, timeout time.Duration, interval time.Duration) *AcceptReadyReplicas {
+	return &AcceptReadyReplicas{
+		replicas:              replicas,
+		timeout:               timeout,
+		interval:              interval,
+		getDeploymentPodStore: deploymentPodStoreFunc(kclient),
+	}
+}
+
+// AcceptReadyReplicas is a kubectl.UpdateAcceptor which accepts a deployment
+// once at least the given number of its pods are ready at the same time.
+// Unlike AcceptNewlyObservedReadyPods, a deployment without pods is not
+// accepted.
+type AcceptReadyReplicas struct {
+	// getDeploymentPodStore should return a Store containing all the pods for
+	// the named deployment, and a channel to stop whatever process is feeding
+	// the store.
+	getDeploymentPodStore func(deployment *kapi.ReplicationController) (cache.Store, chan struct{})
+	// replicas is how many pods must be ready.
+	replicas int
+	// timeout is how long to wait for pod readiness.
+	timeout time.Duration
+	// interval is how often to check for pod readiness
+	interval time.Duration
+}
+
+// Accept implements UpdateAcceptor.
+func (c *AcceptReadyReplicas) Accept(deployment *kapi.ReplicationController) error {
+	podStore, stopStore := c.getDeploymentPodStore(deployment)
+	defer close(stopStore)
+
+	glog.V(0).Infof("Waiting %.f seconds for %d pods owned by deployment %q to be ready", c.timeout.Seconds(), c.replicas, deployutil.LabelForDeployment(deployment))
+	ready := 0
+	err := wait.Poll(c.interval, c.timeout, func() (done bool, err error) {
+		ready = 0
+		for _, obj := range podStore.List() {
+			if kapi.IsPodReady(obj.(*kapi.Pod)) {
+				ready++
+			}
+		}
+		if ready >= c.replicas {
+			glog.V(0).Infof("%d pods ready for %s", ready, deployutil.LabelForDeployment(deployment))
+			return true, nil
+		}
+		glog.V(4).Infof("Still waiting for %d of %d pods to become ready for deployment %s", c.replicas-ready, c.replicas, deployutil.LabelForDeployment(deployment))
+		return false, nil
+	})
+	if err != nil {
+		if err == wait.ErrWaitTimeout {
+			return fmt.Errorf("only %d of %d pods for deployment %q became ready within %.f seconds", ready, c.replicas, deployutil.LabelForDeployment(deployment), c.timeout.Seconds())
+		}
+		return fmt.Errorf("pod readiness check failed for deployment %q: %v", deployutil.LabelForDeployment(deployment), err)
+	}
+	return nil
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"sort"
 	"strings"
@@ -14,12 +16,16 @@ import (
 	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/resource"
 	"k8s.io/kubernetes/pkg/client/cache"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/runtime"
 	kutil "k8s.io/kubernetes/pkg/util"
 	"k8s.io/kubernetes/pkg/util/sets"
 
+	"github.com/openshift/origin/pkg/client/testclient"
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
 	deploytest "github.com/openshift/origin/pkg/deploy/api/test"
 	deployutil "github.com/openshift/origin/pkg/deploy/util"
+	imageapi "github.com/openshift/origin/pkg/image/api"
 	namer "github.com/openshift/origin/pkg/util/namer"
 
 	_ "github.com/openshift/origin/pkg/api/install"
@@ -147,6 +153,97 @@ func TestHookExecutor_executeExecNewPodFailed(t *testing.T) {
 	t.Logf("got expected error: %s", err)
 }
 
+func TestHookExecutor_executeHTTPGet(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	executor := &HookExecutor{httpClient: &http.Client{}, pollInterval: time.Millisecond}
+	hook := &deployapi.LifecycleHook{
+		FailurePolicy: deployapi.LifecycleHookFailurePolicyAbort,
+		HTTPGet:       &deployapi.HTTPGetHook{URL: server.URL},
+	}
+	if err := executor.executeHTTPGet(hook, "hook"); err == nil {
+		t.Fatalf("expected an error for an unsuccessful response")
+	}
+	if requests != 1 {
+		t.Errorf("expected a single request without the Retry policy, got %d", requests)
+	}
+
+	hook.FailurePolicy = deployapi.LifecycleHookFailurePolicyRetry
+	if err := executor.executeHTTPGet(hook, "hook"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if requests != 3 {
+		t.Errorf("expected the request to be retried until it succeeded, got %d requests", requests)
+	}
+
+	timeout := int64(1)
+	requests = -1000
+	hook.HTTPGet.TimeoutSeconds = &timeout
+	if err := executor.executeHTTPGet(hook, "hook"); err == nil {
+		t.Errorf("expected an error once the timeout expired")
+	}
+}
+
+func TestHookExecutor_waitForImage(t *testing.T) {
+	config := deploytest.OkDeploymentConfig(1)
+	config.Spec.Template.Spec.Containers[0].Image = "registry:5000/test/app@sha256:promoted"
+	deployment, _ := deployutil.MakeDeployment(config, kapi.Codecs.LegacyCodec(deployapi.SchemeGroupVersion))
+
+	tests := []struct {
+		name        string
+		hook        *deployapi.WaitForImageHook
+		errExpected bool
+	}{
+		{
+			name: "digest",
+			hook: &deployapi.WaitForImageHook{From: kapi.ObjectReference{Kind: "ImageStreamTag", Name: "app:prod"}, Digest: "sha256:promoted"},
+		},
+		{
+			name: "container image",
+			hook: &deployapi.WaitForImageHook{From: kapi.ObjectReference{Kind: "ImageStreamTag", Name: "app:prod"}, ContainerName: "container1"},
+		},
+		{
+			name:        "never promoted",
+			hook:        &deployapi.WaitForImageHook{From: kapi.ObjectReference{Kind: "ImageStreamTag", Name: "app:prod"}, Digest: "sha256:other"},
+			errExpected: true,
+		},
+	}
+
+	for _, test := range tests {
+		gets := 0
+		client := &testclient.Fake{}
+		client.AddReactor("get", "imagestreamtags", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+			gets++
+			image := "sha256:previous"
+			if gets > 2 {
+				image = "sha256:promoted"
+			}
+			return true, &imageapi.ImageStreamTag{Image: imageapi.Image{ObjectMeta: kapi.ObjectMeta{Name: image}}}, nil
+		})
+		timeout := int64(1)
+		test.hook.TimeoutSeconds = &timeout
+		executor := &HookExecutor{tags: client, pollInterval: time.Millisecond}
+		hook := &deployapi.LifecycleHook{FailurePolicy: deployapi.LifecycleHookFailurePolicyAbort, WaitForImage: test.hook}
+
+		err := executor.waitForImage(hook, deployment, "hook")
+		if err != nil && !test.errExpected {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		}
+		if err == nil && test.errExpected {
+			t.Errorf("%s: expected an error", test.name)
+		}
+	}
+}
+
 func TestHookExecutor_makeHookPodInvalidContainerRef(t *testing.T) {
 	hook := &deployapi.LifecycleHook{
 		FailurePolicy: deployapi.LifecycleHookFailurePolicyAbort,
@@ -317,6 +414,101 @@ func TestHookExecutor_makeHookPod(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "hook pod settings",
+			hook: &deployapi.LifecycleHook{
+				FailurePolicy: deployapi.LifecycleHookFailurePolicyAbort,
+				ExecNewPod: &deployapi.ExecNewPodHook{
+					ContainerName: "container1",
+					Volumes:       []string{"volume-2"},
+					ExtraVolumes: []deployapi.HookVolume{
+						{
+							Name:      "credentials",
+							MountPath: "/var/run/credentials",
+							ReadOnly:  true,
+							Secret:    &kapi.SecretVolumeSource{SecretName: "db-admin"},
+						},
+					},
+					Resources: &kapi.ResourceRequirements{
+						Limits: kapi.ResourceList{
+							kapi.ResourceMemory: resource.MustParse("1G"),
+						},
+					},
+					ServiceAccountName: "migrator",
+					NodeSelector:       map[string]string{"role": "migration"},
+				},
+			},
+			expected: &kapi.Pod{
+				ObjectMeta: kapi.ObjectMeta{
+					Name: namer.GetPodName(deploymentName, "hook"),
+					Labels: map[string]string{
+						deployapi.DeployerPodForDeploymentLabel: deploymentName,
+					},
+					Annotations: map[string]string{
+						deployapi.DeploymentAnnotation: deploymentName,
+					},
+				},
+				Spec: kapi.PodSpec{
+					RestartPolicy: kapi.RestartPolicyNever,
+					Volumes: []kapi.Volume{
+						{
+							Name: "volume-2",
+						},
+						{
+							Name: "credentials",
+							VolumeSource: kapi.VolumeSource{
+								Secret: &kapi.SecretVolumeSource{SecretName: "db-admin"},
+							},
+						},
+					},
+					ActiveDeadlineSeconds: &maxDeploymentDurationSeconds,
+					Containers: []kapi.Container{
+						{
+							Name:  "lifecycle",
+							Image: "registry:8080/repo1:ref1",
+							Env: []kapi.EnvVar{
+								{
+									Name:  "ENV1",
+									Value: "VAL1",
+								},
+								{
+									Name:  "OPENSHIFT_DEPLOYMENT_NAME",
+									Value: deploymentName,
+								},
+								{
+									Name:  "OPENSHIFT_DEPLOYMENT_NAMESPACE",
+									Value: deploymentNamespace,
+								},
+							},
+							Resources: kapi.ResourceRequirements{
+								Limits: kapi.ResourceList{
+									kapi.ResourceMemory: resource.MustParse("1G"),
+								},
+							},
+							VolumeMounts: []kapi.VolumeMount{
+								{
+									Name:      "volume-2",
+									ReadOnly:  true,
+									MountPath: "/mnt/volume-2",
+								},
+								{
+									Name:      "credentials",
+									ReadOnly:  true,
+									MountPath: "/var/run/credentials",
+								},
+							},
+						},
+					},
+					ImagePullSecrets: []kapi.LocalObjectReference{
+						{
+							Name: "secret-1",
+						},
+					},
+					ServiceAccountName: "migrator",
+					NodeSelector:       map[string]string{"role": "migration"},
+				},
+			},
+		},
 		{
 			name: "labels and annotations",
 			hook: &deployapi.LifecycleHook{
@@ -528,6 +720,63 @@ func TestAcceptNewlyObservedReadyPods_scenarios(t *testing.T) {
 	}
 }
 
+func TestAcceptReadyReplicas(t *testing.T) {
+	scenarios := []struct {
+		name string
+		// the current pods which will be in the store; pod name -> ready
+		currentPods map[string]bool
+		// whether or not the scenario should result in acceptance
+		accepted bool
+	}{
+		{
+			name:        "no pods",
+			currentPods: map[string]bool{},
+		},
+		{
+			name:        "too few ready",
+			currentPods: map[string]bool{"pod-1": true, "pod-2": false},
+		},
+		{
+			name:        "enough ready",
+			currentPods: map[string]bool{"pod-1": true, "pod-2": true, "pod-3": false},
+			accepted:    true,
+		},
+	}
+	for _, s := range scenarios {
+		store := cache.NewStore(cache.MetaNamespaceKeyFunc)
+		for podName, ready := range s.currentPods {
+			status := kapi.ConditionTrue
+			if !ready {
+				status = kapi.ConditionFalse
+			}
+			store.Add(&kapi.Pod{
+				ObjectMeta: kapi.ObjectMeta{Name: podName},
+				Status: kapi.PodStatus{
+					Conditions: []kapi.PodCondition{{Type: kapi.PodReady, Status: status}},
+				},
+			})
+		}
+
+		acceptor := &AcceptReadyReplicas{
+			replicas: 2,
+			timeout:  10 * time.Millisecond,
+			interval: 1 * time.Millisecond,
+			getDeploymentPodStore: func(deployment *kapi.ReplicationController) (cache.Store, chan struct{}) {
+				return store, make(chan struct{})
+			},
+		}
+		deployment, _ := deployutil.MakeDeployment(deploytest.OkDeploymentConfig(1), kapi.Codecs.LegacyCodec(deployapi.SchemeGroupVersion))
+
+		err := acceptor.Accept(deployment)
+		if s.accepted && err != nil {
+			t.Errorf("%s: unexpected error: %v", s.name, err)
+		}
+		if !s.accepted && err == nil {
+			t.Errorf("%s: expected an error", s.name)
+		}
+	}
+}
+
 func deployment(name, namespace string, strategyLabels, strategyAnnotations map[string]string) (*deployapi.DeploymentConfig, *kapi.ReplicationController) {
 	config := &deployapi.DeploymentConfig{
 		ObjectMeta: kapi.ObjectMeta{
@@ -279,6 +279,46 @@ func IsTerminatedDeployment(deployment *api.ReplicationController) bool {
 	return current == deployapi.DeploymentStatusComplete || current == deployapi.DeploymentStatusFailed
 }
 
+// GetDeploymentCondition returns the condition of status with the provided
+// type, or nil if status has no such condition.
+func GetDeploymentCondition(status deployapi.DeploymentConfigStatus, condType deployapi.DeploymentConditionType) *deployapi.DeploymentCondition {
+	for i := range status.Conditions {
+		if status.Conditions[i].Type == condType {
+			return &status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// SetDeploymentCondition adds or replaces the condition of status with the
+// type of condition. The last transition time of an existing condition is
+// kept unless its status changes.
+func SetDeploymentCondition(status *deployapi.DeploymentConfigStatus, condition deployapi.DeploymentCondition) {
+	if current := GetDeploymentCondition(*status, condition.Type); current != nil {
+		if current.Status == condition.Status {
+			condition.LastTransitionTime = current.LastTransitionTime
+		}
+		*current = condition
+		return
+	}
+	status.Conditions = append(status.Conditions, condition)
+}
+
+// RemoveDeploymentCondition removes the condition of status with the provided
+// type.
+func RemoveDeploymentCondition(status *deployapi.DeploymentConfigStatus, condType deployapi.DeploymentConditionType) {
+	conditions := []deployapi.DeploymentCondition{}
+	for _, condition := range status.Conditions {
+		if condition.Type != condType {
+			conditions = append(conditions, condition)
+		}
+	}
+	if len(conditions) == 0 {
+		conditions = nil
+	}
+	status.Conditions = conditions
+}
+
 // annotationFor returns the annotation with key for obj.
 func annotationFor(obj runtime.Object, key string) string {
 	meta, err := api.ObjectMetaFor(obj)
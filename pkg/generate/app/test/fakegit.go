@@ -60,6 +60,10 @@ func (f *FakeGit) Fetch(source string) error {
 	return nil
 }
 
+func (f *FakeGit) FetchRef(source, ref string) error {
+	return nil
+}
+
 func (f *FakeGit) Init(source string, _ bool) error {
 	return nil
 }
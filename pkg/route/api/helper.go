@@ -1,6 +1,8 @@
 package api
 
 import (
+	"strings"
+
 	kapi "k8s.io/kubernetes/pkg/api"
 )
 
@@ -15,3 +17,23 @@ func IngressConditionStatus(ingress *RouteIngress, t RouteIngressConditionType)
 	}
 	return kapi.ConditionUnknown, RouteIngressCondition{}
 }
+
+// BackendWeight returns the given weight of a backend of a route, or
+// DefaultRouteWeight if it isn't set.
+func BackendWeight(weight *int32) int32 {
+	if weight == nil {
+		return DefaultRouteWeight
+	}
+	return *weight
+}
+
+// GetDomainForHost returns the domain of the given host, the host without its
+// first label. An empty string is returned if the domain would have fewer than
+// two labels, wildcard routes can't claim a top level domain such as *.com.
+func GetDomainForHost(host string) string {
+	i := strings.Index(host, ".")
+	if i < 0 || !strings.Contains(host[i+1:], ".") {
+		return ""
+	}
+	return host[i+1:]
+}
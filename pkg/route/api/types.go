@@ -29,6 +29,15 @@ type RouteSpec struct {
 	// be defaulted to Service.
 	To kapi.ObjectReference
 
+	// Weight of the service the route points to relative to the weights of the
+	// AlternateBackends, between 0 and 256. The weight is split evenly across the
+	// endpoints of the service. If not set, DefaultRouteWeight is used. Optional
+	Weight *int32
+
+	// AlternateBackends are additional services the route sends traffic to in
+	// proportion to their weights. Optional
+	AlternateBackends []RouteTargetReference
+
 	// If specified, the port to be used by the router. Most routers will use all
 	// endpoints exposed by the service by default - set this value to instruct routers
 	// which port to use.
@@ -36,8 +45,37 @@ type RouteSpec struct {
 
 	//TLS provides the ability to configure certificates and termination for the route
 	TLS *TLSConfig
+
+	// WildcardPolicy controls whether the route also serves the other hosts of the domain
+	// of its host. A Subdomain route for www.example.com serves *.example.com for the
+	// hosts that no other route serves. The domain must have at least two labels.
+	WildcardPolicy WildcardPolicyType
 }
 
+// RouteTargetReference specifies a backend of a route and its weight.
+type RouteTargetReference struct {
+	// Kind of the referenced object. Only the Service kind is allowed, and it
+	// will be defaulted to Service.
+	Kind string
+	// Name of the referenced service.
+	Name string
+	// Weight of the service relative to the other backends of the route, between
+	// 0 and 256. The weight is split evenly across the endpoints of the service.
+	// If not set, DefaultRouteWeight is used.
+	Weight *int32
+}
+
+const (
+	// DefaultRouteWeight is the weight of a backend of a route that doesn't set
+	// its weight.
+	DefaultRouteWeight int32 = 100
+	// MaxRouteWeight is the largest weight of a backend of a route.
+	MaxRouteWeight int32 = 256
+	// MaxAlternateBackends is the largest number of alternate backends of a
+	// route.
+	MaxAlternateBackends = 3
+)
+
 // RoutePort defines a port mapping from a router to an endpoint in the service endpoints.
 type RoutePort struct {
 	// The target port on pods selected by the service this route points to.
@@ -164,3 +202,16 @@ const (
 	// insecure HTTP connections will be redirected to use HTTPS.
 	InsecureEdgeTerminationPolicyRedirect InsecureEdgeTerminationPolicyType = "Redirect"
 )
+
+// WildcardPolicyType indicates the type of wildcard support provided by a route.
+type WildcardPolicyType string
+
+const (
+	// WildcardPolicyNone indicates no wildcard support is provided.
+	WildcardPolicyNone WildcardPolicyType = "None"
+	// WildcardPolicySubdomain indicates the host needs wildcard support for the subdomain.
+	// Example: For host = "www.acme.test", indicates that the router
+	//          should support requests for *.acme.test
+	//          Note that this will not match acme.test only *.acme.test
+	WildcardPolicySubdomain WildcardPolicyType = "Subdomain"
+)
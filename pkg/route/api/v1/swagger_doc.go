@@ -60,12 +60,15 @@ func (RoutePort) SwaggerDoc() map[string]string {
 }
 
 var map_RouteSpec = map[string]string{
-	"":     "RouteSpec describes the route the user wishes to exist.",
-	"host": "Host is an alias/DNS that points to the service. Optional Must follow DNS952 subdomain conventions.",
-	"path": "Path that the router watches for, to route traffic for to the service. Optional",
-	"to":   "To is an object the route points to. Only the Service kind is allowed, and it will be defaulted to Service.",
-	"port": "If specified, the port to be used by the router. Most routers will use all endpoints exposed by the service by default - set this value to instruct routers which port to use.",
-	"tls":  "TLS provides the ability to configure certificates and termination for the route",
+	"":                  "RouteSpec describes the route the user wishes to exist.",
+	"host":              "Host is an alias/DNS that points to the service. Optional Must follow DNS952 subdomain conventions.",
+	"path":              "Path that the router watches for, to route traffic for to the service. Optional",
+	"to":                "To is an object the route points to. Only the Service kind is allowed, and it will be defaulted to Service.",
+	"weight":            "Weight of the service the route points to relative to the weights of the alternate backends, between 0 and 256. The weight is split evenly across the endpoints of the service. Defaults to 100 if not set.",
+	"alternateBackends": "AlternateBackends are additional services the route sends traffic to in proportion to their weights.",
+	"port":              "If specified, the port to be used by the router. Most routers will use all endpoints exposed by the service by default - set this value to instruct routers which port to use.",
+	"tls":               "TLS provides the ability to configure certificates and termination for the route",
+	"wildcardPolicy":    "WildcardPolicy controls whether the route also serves the other hosts of the domain of its host: None (the default) or Subdomain. A Subdomain route for www.example.com serves *.example.com for the hosts that no other route serves. The domain must have at least two labels.",
 }
 
 func (RouteSpec) SwaggerDoc() map[string]string {
@@ -81,6 +84,17 @@ func (RouteStatus) SwaggerDoc() map[string]string {
 	return map_RouteStatus
 }
 
+var map_RouteTargetReference = map[string]string{
+	"":       "RouteTargetReference specifies a backend of a route and its weight.",
+	"kind":   "Kind of the referenced object. Only the Service kind is allowed, and it will be defaulted to Service.",
+	"name":   "Name of the referenced service.",
+	"weight": "Weight of the service relative to the other backends of the route, between 0 and 256. The weight is split evenly across the endpoints of the service. Defaults to 100 if not set.",
+}
+
+func (RouteTargetReference) SwaggerDoc() map[string]string {
+	return map_RouteTargetReference
+}
+
 var map_RouterShard = map[string]string{
 	"":          "RouterShard has information of a routing shard and is used to generate host names and routing table entries when a routing shard is allocated for a specific route. Caveat: This is WIP and will likely undergo modifications when sharding\n        support is added.",
 	"shardName": "ShardName uniquely identifies a router shard in the \"set\" of routers used for routing traffic to the services.",
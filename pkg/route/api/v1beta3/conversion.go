@@ -12,6 +12,14 @@ func addConversionFuncs(scheme *runtime.Scheme) {
 			if len(obj.To.Kind) == 0 {
 				obj.To.Kind = "Service"
 			}
+			if len(obj.WildcardPolicy) == 0 {
+				obj.WildcardPolicy = WildcardPolicyNone
+			}
+		},
+		func(obj *RouteTargetReference) {
+			if len(obj.Kind) == 0 {
+				obj.Kind = "Service"
+			}
 		},
 		func(obj *TLSConfig) {
 			if len(obj.Termination) == 0 && len(obj.DestinationCACertificate) == 0 {
@@ -37,6 +37,15 @@ type RouteSpec struct {
 	// be defaulted to Service.
 	To kapi.ObjectReference `json:"to"`
 
+	// Weight of the service the route points to relative to the weights of the
+	// alternate backends, between 0 and 256. The weight is split evenly across the
+	// endpoints of the service. Defaults to 100 if not set.
+	Weight *int32 `json:"weight,omitempty"`
+
+	// AlternateBackends are additional services the route sends traffic to in
+	// proportion to their weights.
+	AlternateBackends []RouteTargetReference `json:"alternateBackends,omitempty"`
+
 	// If specified, the port to be used by the router. Most routers will use all
 	// endpoints exposed by the service by default - set this value to instruct routers
 	// which port to use.
@@ -44,6 +53,25 @@ type RouteSpec struct {
 
 	// TLS provides the ability to configure certificates and termination for the route
 	TLS *TLSConfig `json:"tls,omitempty"`
+
+	// WildcardPolicy controls whether the route also serves the other hosts of the domain
+	// of its host: None (the default) or Subdomain. A Subdomain route for www.example.com
+	// serves *.example.com for the hosts that no other route serves. The domain must
+	// have at least two labels.
+	WildcardPolicy WildcardPolicyType `json:"wildcardPolicy,omitempty"`
+}
+
+// RouteTargetReference specifies a backend of a route and its weight.
+type RouteTargetReference struct {
+	// Kind of the referenced object. Only the Service kind is allowed, and it
+	// will be defaulted to Service.
+	Kind string `json:"kind"`
+	// Name of the referenced service.
+	Name string `json:"name"`
+	// Weight of the service relative to the other backends of the route, between
+	// 0 and 256. The weight is split evenly across the endpoints of the service.
+	// Defaults to 100 if not set.
+	Weight *int32 `json:"weight,omitempty"`
 }
 
 // RoutePort defines a port mapping from a router to an endpoint in the service endpoints.
@@ -156,3 +184,16 @@ const (
 	// TLSTerminationReencrypt terminate encryption at the edge router and re-encrypt it with a new certificate supplied by the destination
 	TLSTerminationReencrypt TLSTerminationType = "reencrypt"
 )
+
+// WildcardPolicyType indicates the type of wildcard support provided by a route.
+type WildcardPolicyType string
+
+const (
+	// WildcardPolicyNone indicates no wildcard support is provided.
+	WildcardPolicyNone WildcardPolicyType = "None"
+	// WildcardPolicySubdomain indicates the host needs wildcard support for the subdomain.
+	// Example: For host = "www.acme.test", indicates that the router
+	//          should support requests for *.acme.test
+	//          Note that this will not match acme.test only *.acme.test
+	WildcardPolicySubdomain WildcardPolicyType = "Subdomain"
+)
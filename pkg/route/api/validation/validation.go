@@ -6,18 +6,21 @@ import (
 
 	"k8s.io/kubernetes/pkg/api/validation"
 	kval "k8s.io/kubernetes/pkg/api/validation"
+	kerrors "k8s.io/kubernetes/pkg/util/errors"
 	"k8s.io/kubernetes/pkg/util/intstr"
 	kvalidation "k8s.io/kubernetes/pkg/util/validation"
 	"k8s.io/kubernetes/pkg/util/validation/field"
 
 	oapi "github.com/openshift/origin/pkg/api"
 	routeapi "github.com/openshift/origin/pkg/route/api"
+	"github.com/openshift/origin/pkg/router"
 )
 
 // ValidateRoute tests if required fields in the route are set.
 func ValidateRoute(route *routeapi.Route) field.ErrorList {
 	//ensure meta is set properly
 	result := kval.ValidateObjectMeta(&route.ObjectMeta, true, oapi.GetNameValidationFunc(kval.ValidatePodName), field.NewPath("metadata"))
+	result = append(result, validateRouteAnnotations(route, field.NewPath("metadata", "annotations"))...)
 
 	specPath := field.NewPath("spec")
 
@@ -43,6 +46,10 @@ func ValidateRoute(route *routeapi.Route) field.ErrorList {
 	if route.Spec.To.Kind != "Service" {
 		result = append(result, field.Invalid(specPath.Child("to", "kind"), route.Spec.To.Kind, "must reference a Service"))
 	}
+	if route.Spec.Weight != nil {
+		result = append(result, validateRouteWeight(*route.Spec.Weight, specPath.Child("weight"))...)
+	}
+	result = append(result, validateAlternateBackends(route, specPath.Child("alternateBackends"))...)
 
 	if route.Spec.Port != nil {
 		switch target := route.Spec.Port.TargetPort; {
@@ -56,6 +63,8 @@ func ValidateRoute(route *routeapi.Route) field.ErrorList {
 		result = append(result, errs...)
 	}
 
+	result = append(result, validateWildcardPolicy(route, specPath.Child("wildcardPolicy"))...)
+
 	return result
 }
 
@@ -76,6 +85,72 @@ func ValidateRouteStatusUpdate(route *routeapi.Route, older *routeapi.Route) fie
 	return allErrs
 }
 
+// validateRouteAnnotations tests that the annotations setting the settings of the route
+// the router applies have valid values.
+func validateRouteAnnotations(route *routeapi.Route, fldPath *field.Path) field.ErrorList {
+	result := field.ErrorList{}
+	_, err := router.ParseRouteSettings(route)
+	if errs, ok := err.(kerrors.Aggregate); ok {
+		for _, err := range errs.Errors() {
+			if invalid, ok := err.(*router.InvalidAnnotationError); ok {
+				result = append(result, field.Invalid(fldPath.Key(invalid.Annotation), invalid.Value, invalid.Message))
+			}
+		}
+	}
+	return result
+}
+
+// validateAlternateBackends tests that the alternate backends of the route
+// reference distinct services other than the one the route points to.
+func validateAlternateBackends(route *routeapi.Route, fldPath *field.Path) field.ErrorList {
+	result := field.ErrorList{}
+	if len(route.Spec.AlternateBackends) > routeapi.MaxAlternateBackends {
+		result = append(result, field.Invalid(fldPath, len(route.Spec.AlternateBackends), fmt.Sprintf("must have at most %d alternate backends", routeapi.MaxAlternateBackends)))
+	}
+
+	names := map[string]bool{route.Spec.To.Name: true}
+	for i, backend := range route.Spec.AlternateBackends {
+		backendPath := fldPath.Index(i)
+		switch {
+		case len(backend.Name) == 0:
+			result = append(result, field.Required(backendPath.Child("name"), ""))
+		case names[backend.Name]:
+			result = append(result, field.Duplicate(backendPath.Child("name"), backend.Name))
+		}
+		names[backend.Name] = true
+		if backend.Kind != "Service" {
+			result = append(result, field.Invalid(backendPath.Child("kind"), backend.Kind, "must reference a Service"))
+		}
+		if backend.Weight != nil {
+			result = append(result, validateRouteWeight(*backend.Weight, backendPath.Child("weight"))...)
+		}
+	}
+	return result
+}
+
+// validateRouteWeight tests that the weight of a backend is within range.
+func validateRouteWeight(weight int32, fldPath *field.Path) field.ErrorList {
+	if weight < 0 || weight > routeapi.MaxRouteWeight {
+		return field.ErrorList{field.Invalid(fldPath, weight, fmt.Sprintf("must be between 0 and %d", routeapi.MaxRouteWeight))}
+	}
+	return nil
+}
+
+// validateWildcardPolicy tests that the wildcard policy of the route is
+// supported and that wildcard routes have a host with a domain to serve.
+func validateWildcardPolicy(route *routeapi.Route, fldPath *field.Path) field.ErrorList {
+	switch route.Spec.WildcardPolicy {
+	case "", routeapi.WildcardPolicyNone:
+		return nil
+	case routeapi.WildcardPolicySubdomain:
+		if len(routeapi.GetDomainForHost(route.Spec.Host)) == 0 {
+			return field.ErrorList{field.Invalid(fldPath, route.Spec.WildcardPolicy, "host must have a domain of at least two labels to use the Subdomain wildcard policy")}
+		}
+		return nil
+	}
+	return field.ErrorList{field.NotSupported(fldPath, route.Spec.WildcardPolicy, []string{string(routeapi.WildcardPolicyNone), string(routeapi.WildcardPolicySubdomain)})}
+}
+
 // validateTLS tests fields for different types of TLS combinations are set.  Called
 // by ValidateRoute.
 func validateTLS(route *routeapi.Route, fldPath *field.Path) field.ErrorList {
@@ -7,6 +7,7 @@ import (
 	"k8s.io/kubernetes/pkg/util/intstr"
 
 	"github.com/openshift/origin/pkg/route/api"
+	"github.com/openshift/origin/pkg/router"
 )
 
 // TestValidateRouteBad ensures not specifying a required field results in error and a fully specified
@@ -228,6 +229,190 @@ func TestValidateRoute(t *testing.T) {
 			},
 			expectedErrors: 1,
 		},
+		{
+			name: "Alternate backends",
+			route: &api.Route{
+				ObjectMeta: kapi.ObjectMeta{
+					Name:      "name",
+					Namespace: "foo",
+				},
+				Spec: api.RouteSpec{
+					Host: "www.example.com",
+					To: kapi.ObjectReference{
+						Name: "serviceName",
+						Kind: "Service",
+					},
+					Weight: newInt32(0),
+					AlternateBackends: []api.RouteTargetReference{
+						{Kind: "Service", Name: "alternate", Weight: newInt32(256)},
+						{Kind: "Service", Name: "other"},
+					},
+				},
+			},
+			expectedErrors: 0,
+		},
+		{
+			name: "Invalid alternate backends",
+			route: &api.Route{
+				ObjectMeta: kapi.ObjectMeta{
+					Name:      "name",
+					Namespace: "foo",
+				},
+				Spec: api.RouteSpec{
+					Host: "www.example.com",
+					To: kapi.ObjectReference{
+						Name: "serviceName",
+						Kind: "Service",
+					},
+					Weight: newInt32(-1),
+					AlternateBackends: []api.RouteTargetReference{
+						{Kind: "Service", Name: "serviceName"},
+						{Kind: "Pod", Name: "alternate", Weight: newInt32(257)},
+						{Kind: "Service"},
+					},
+				},
+			},
+			expectedErrors: 5,
+		},
+		{
+			name: "Too many alternate backends",
+			route: &api.Route{
+				ObjectMeta: kapi.ObjectMeta{
+					Name:      "name",
+					Namespace: "foo",
+				},
+				Spec: api.RouteSpec{
+					Host: "www.example.com",
+					To: kapi.ObjectReference{
+						Name: "serviceName",
+						Kind: "Service",
+					},
+					AlternateBackends: []api.RouteTargetReference{
+						{Kind: "Service", Name: "a"},
+						{Kind: "Service", Name: "b"},
+						{Kind: "Service", Name: "c"},
+						{Kind: "Service", Name: "d"},
+					},
+				},
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "Subdomain wildcard policy",
+			route: &api.Route{
+				ObjectMeta: kapi.ObjectMeta{
+					Name:      "name",
+					Namespace: "foo",
+				},
+				Spec: api.RouteSpec{
+					Host: "www.example.com",
+					To: kapi.ObjectReference{
+						Name: "serviceName",
+						Kind: "Service",
+					},
+					WildcardPolicy: api.WildcardPolicySubdomain,
+				},
+			},
+			expectedErrors: 0,
+		},
+		{
+			name: "Subdomain wildcard policy without domain",
+			route: &api.Route{
+				ObjectMeta: kapi.ObjectMeta{
+					Name:      "name",
+					Namespace: "foo",
+				},
+				Spec: api.RouteSpec{
+					Host: "localhost",
+					To: kapi.ObjectReference{
+						Name: "serviceName",
+						Kind: "Service",
+					},
+					WildcardPolicy: api.WildcardPolicySubdomain,
+				},
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "Subdomain wildcard policy with a top level domain",
+			route: &api.Route{
+				ObjectMeta: kapi.ObjectMeta{
+					Name:      "name",
+					Namespace: "foo",
+				},
+				Spec: api.RouteSpec{
+					Host: "example.com",
+					To: kapi.ObjectReference{
+						Name: "serviceName",
+						Kind: "Service",
+					},
+					WildcardPolicy: api.WildcardPolicySubdomain,
+				},
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "Unsupported wildcard policy",
+			route: &api.Route{
+				ObjectMeta: kapi.ObjectMeta{
+					Name:      "name",
+					Namespace: "foo",
+				},
+				Spec: api.RouteSpec{
+					Host: "www.example.com",
+					To: kapi.ObjectReference{
+						Name: "serviceName",
+						Kind: "Service",
+					},
+					WildcardPolicy: "Domain",
+				},
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "Valid router annotations",
+			route: &api.Route{
+				ObjectMeta: kapi.ObjectMeta{
+					Name:      "name",
+					Namespace: "foo",
+					Annotations: map[string]string{
+						router.TimeoutAnnotation:              "30s",
+						router.RateLimitConnectionsAnnotation: "10",
+						router.IPWhitelistAnnotation:          "10.0.0.1 192.168.0.0/16",
+					},
+				},
+				Spec: api.RouteSpec{
+					Host: "www.example.com",
+					To: kapi.ObjectReference{
+						Name: "serviceName",
+						Kind: "Service",
+					},
+				},
+			},
+			expectedErrors: 0,
+		},
+		{
+			name: "Invalid router annotations",
+			route: &api.Route{
+				ObjectMeta: kapi.ObjectMeta{
+					Name:      "name",
+					Namespace: "foo",
+					Annotations: map[string]string{
+						router.TimeoutAnnotation:              "30",
+						router.RateLimitConnectionsAnnotation: "-1",
+						router.IPWhitelistAnnotation:          "10.0.0.1 example.com",
+					},
+				},
+				Spec: api.RouteSpec{
+					Host: "www.example.com",
+					To: kapi.ObjectReference{
+						Name: "serviceName",
+						Kind: "Service",
+					},
+				},
+			},
+			expectedErrors: 3,
+		},
 	}
 
 	for _, tc := range tests {
@@ -559,3 +744,7 @@ func TestValidateNoTLSInsecureEdgeTerminationPolicy(t *testing.T) {
 		}
 	}
 }
+
+func newInt32(i int32) *int32 {
+	return &i
+}
@@ -16,14 +16,21 @@ const (
 	ExposedThroughRouteEdgeKind = "ExposedThroughRoute"
 )
 
-// AddRouteEdges adds an edge that connect a service to a route in the given graph
+// AddRouteEdges adds edges that connect the services to a route in the given graph
 func AddRouteEdges(g osgraph.MutableUniqueGraph, node *routegraph.RouteNode) {
-	syntheticService := &kapi.Service{}
-	syntheticService.Namespace = node.Namespace
-	syntheticService.Name = node.Spec.To.Name
+	names := []string{node.Spec.To.Name}
+	for _, backend := range node.Spec.AlternateBackends {
+		names = append(names, backend.Name)
+	}
+
+	for _, name := range names {
+		syntheticService := &kapi.Service{}
+		syntheticService.Namespace = node.Namespace
+		syntheticService.Name = name
 
-	serviceNode := kubegraph.FindOrCreateSyntheticServiceNode(g, syntheticService)
-	g.AddEdge(node, serviceNode, ExposedThroughRouteEdgeKind)
+		serviceNode := kubegraph.FindOrCreateSyntheticServiceNode(g, syntheticService)
+		g.AddEdge(node, serviceNode, ExposedThroughRouteEdgeKind)
+	}
 }
 
 // AddAllRouteEdges adds service edges to all route nodes in the given graph
@@ -78,6 +78,9 @@ func (c *RouterController) HandleRoute() {
 	defer c.lock.Unlock()
 
 	glog.V(4).Infof("Processing Route: %s -> %s", route.Name, route.Spec.To.Name)
+	for _, backend := range route.Spec.AlternateBackends {
+		glog.V(4).Infof("       Alternate: %s (weight %d)", backend.Name, routeapi.BackendWeight(backend.Weight))
+	}
 	glog.V(4).Infof("           Alias: %s", route.Spec.Host)
 	glog.V(4).Infof("           Event: %s", eventType)
 
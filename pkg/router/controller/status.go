@@ -17,6 +17,7 @@ import (
 	"github.com/openshift/origin/pkg/client"
 	routeapi "github.com/openshift/origin/pkg/route/api"
 	"github.com/openshift/origin/pkg/router"
+	"github.com/openshift/origin/pkg/router/metrics"
 )
 
 // StatusAdmitter ensures routes added to the plugin have status set.
@@ -204,6 +205,9 @@ func (a *StatusAdmitter) admitRoute(oc client.RoutesNamespacer, route *routeapi.
 	})
 	glog.V(4).Infof("admit: admitting route by updating status: %s (%t): %s", route.Name, updated, route.Spec.Host)
 	_, err := oc.Routes(route.Namespace).UpdateStatus(route)
+	if err == nil {
+		metrics.RoutesAdmitted.Inc()
+	}
 	return a.recordIngressTouch(route, ingress.Conditions[0].LastTransitionTime, err)
 }
 
@@ -226,6 +230,9 @@ func (a *StatusAdmitter) RecordRouteRejection(route *routeapi.Route, reason, mes
 	}
 
 	_, err := a.client.Routes(route.Namespace).UpdateStatus(route)
+	if err == nil {
+		metrics.RoutesRejected.WithLabelValues(reason).Inc()
+	}
 	_, err = a.recordIngressTouch(route, ingress.Conditions[0].LastTransitionTime, err)
 	if err != nil {
 		utilruntime.HandleError(fmt.Errorf("unable to write route rejection to the status: %v", err))
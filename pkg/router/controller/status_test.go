@@ -14,8 +14,12 @@ import (
 	"k8s.io/kubernetes/pkg/util/sets"
 	"k8s.io/kubernetes/pkg/watch"
 
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+
 	"github.com/openshift/origin/pkg/client/testclient"
 	routeapi "github.com/openshift/origin/pkg/route/api"
+	"github.com/openshift/origin/pkg/router/metrics"
 )
 
 type fakePlugin struct {
@@ -68,6 +72,49 @@ func TestStatusNoOp(t *testing.T) {
 	}
 }
 
+// counterValue returns the value of a counter metric
+func counterValue(t *testing.T, counter prometheus.Counter) float64 {
+	m := &dto.Metric{}
+	if err := counter.Write(m); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return m.Counter.GetValue()
+}
+
+func TestStatusAdmittedMetric(t *testing.T) {
+	p := &fakePlugin{}
+	c := testclient.NewSimpleFake(&routeapi.Route{})
+	admitter := NewStatusAdmitter(p, c, "test")
+	route := &routeapi.Route{
+		ObjectMeta: kapi.ObjectMeta{Name: "route1", Namespace: "default", UID: types.UID("uid1")},
+		Spec:       routeapi.RouteSpec{Host: "route1.test.local"},
+	}
+
+	admitted := counterValue(t, metrics.RoutesAdmitted)
+	if err := admitter.HandleRoute(watch.Added, route); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if v := counterValue(t, metrics.RoutesAdmitted); v != admitted+1 {
+		t.Fatalf("expected the admission to be counted, got %v", v-admitted)
+	}
+
+	// the route is already admitted on a resync
+	if err := admitter.HandleRoute(watch.Modified, route); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if v := counterValue(t, metrics.RoutesAdmitted); v != admitted+1 {
+		t.Fatalf("expected the resync not to be counted, got %v", v-admitted)
+	}
+
+	// rejections are counted once for the same reason
+	rejected := counterValue(t, metrics.RoutesRejected.WithLabelValues("Test"))
+	admitter.RecordRouteRejection(route, "Test", "rejected")
+	admitter.RecordRouteRejection(route, "Test", "rejected")
+	if v := counterValue(t, metrics.RoutesRejected.WithLabelValues("Test")); v != rejected+1 {
+		t.Fatalf("expected the rejection to be counted once, got %v", v-rejected)
+	}
+}
+
 func TestStatusResetsHost(t *testing.T) {
 	now := unversioned.Now()
 	nowFn = func() unversioned.Time { return now }
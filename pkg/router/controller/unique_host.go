@@ -2,9 +2,11 @@ package controller
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/golang/glog"
 	kapi "k8s.io/kubernetes/pkg/api"
+	utilruntime "k8s.io/kubernetes/pkg/util/runtime"
 	"k8s.io/kubernetes/pkg/util/sets"
 	"k8s.io/kubernetes/pkg/watch"
 
@@ -41,6 +43,11 @@ func (_ logRecorder) RecordRouteRejection(route *routeapi.Route, reason, message
 type UniqueHost struct {
 	plugin       router.Plugin
 	hostForRoute RouteHostFunc
+	// allowWildcardRoutes admits routes with the Subdomain wildcard policy
+	allowWildcardRoutes bool
+	// unsupportedAnnotations are the route annotations the underlying plugin doesn't
+	// apply, routes setting them are rejected
+	unsupportedAnnotations sets.String
 
 	recorder RejectionRecorder
 
@@ -52,11 +59,13 @@ type UniqueHost struct {
 
 // NewUniqueHost creates a plugin wrapper that ensures only unique routes are passed into
 // the underlying plugin. Recorder is an interface for indicating why a route was
-// rejected.
-func NewUniqueHost(plugin router.Plugin, fn RouteHostFunc, recorder RejectionRecorder) *UniqueHost {
+// rejected. Routes with the Subdomain wildcard policy are rejected unless allowWildcardRoutes
+// is set.
+func NewUniqueHost(plugin router.Plugin, fn RouteHostFunc, allowWildcardRoutes bool, recorder RejectionRecorder) *UniqueHost {
 	return &UniqueHost{
-		plugin:       plugin,
-		hostForRoute: fn,
+		plugin:              plugin,
+		hostForRoute:        fn,
+		allowWildcardRoutes: allowWildcardRoutes,
 
 		recorder: recorder,
 
@@ -65,6 +74,12 @@ func NewUniqueHost(plugin router.Plugin, fn RouteHostFunc, recorder RejectionRec
 	}
 }
 
+// RejectAnnotations rejects the routes setting any of the annotations, for plugins that
+// don't apply them.
+func (p *UniqueHost) RejectAnnotations(annotations ...string) {
+	p.unsupportedAnnotations = sets.NewString(annotations...)
+}
+
 // RoutesForHost is a helper that allows routes to be retrieved.
 func (p *UniqueHost) RoutesForHost(host string) ([]*routeapi.Route, bool) {
 	routes, ok := p.hostToRoute[host]
@@ -104,6 +119,47 @@ func (p *UniqueHost) HandleRoute(eventType watch.EventType, route *routeapi.Rout
 	}
 	route.Spec.Host = host
 
+	// routes with invalid settings are rejected until their annotations are fixed, the
+	// previous version of the route remains in use
+	if eventType != watch.Deleted {
+		if _, err := router.ParseRouteSettings(route); err != nil {
+			glog.V(4).Infof("Route %s has invalid annotations: %v", routeName, err)
+			p.recorder.RecordRouteRejection(route, "InvalidAnnotation", err.Error())
+			return err
+		}
+		for _, annotation := range p.unsupportedAnnotations.List() {
+			if _, ok := route.Annotations[annotation]; ok {
+				glog.V(4).Infof("Route %s sets the unsupported annotation %s", routeName, annotation)
+				p.recorder.RecordRouteRejection(route, "UnsupportedAnnotation", fmt.Sprintf("the annotation %s is not supported by this router", annotation))
+				// a route admitted before it set the annotation stops serving its host
+				return p.removeRoute(route)
+			}
+		}
+	}
+
+	// wildcard routes claim the wildcard domain of their host, *.example.com
+	// for www.example.com
+	if route.Spec.WildcardPolicy == routeapi.WildcardPolicySubdomain {
+		if !p.allowWildcardRoutes {
+			glog.V(4).Infof("Route %s has a wildcard policy that is not allowed", routeName)
+			p.recorder.RecordRouteRejection(route, "WildcardPolicyNotAllowed", "wildcard routes are not allowed by this router")
+			// a route admitted before it was changed to a wildcard route stops serving
+			// its host
+			return p.removeRoute(route)
+		}
+		if len(routeapi.GetDomainForHost(host)) == 0 {
+			glog.V(4).Infof("Route %s has no wildcard domain of at least two labels", routeName)
+			p.recorder.RecordRouteRejection(route, "InvalidHost", fmt.Sprintf("the host %s has no domain of at least two labels to serve with the Subdomain wildcard policy", host))
+			return p.removeRoute(route)
+		}
+		host = wildcardHost(host)
+	}
+	if eventType != watch.Deleted {
+		if err := p.validateWildcardDomain(route, host); err != nil {
+			return err
+		}
+	}
+
 	// ensure hosts can only be claimed by one namespace at a time
 	// TODO: this could be abstracted above this layer?
 	if old, ok := p.hostToRoute[host]; ok {
@@ -171,26 +227,96 @@ func (p *UniqueHost) HandleRoute(eventType watch.EventType, route *routeapi.Rout
 
 	case watch.Deleted:
 		glog.V(4).Infof("Deleting routes for %s", key)
-		if old, ok := p.hostToRoute[host]; ok {
-			switch len(old) {
-			case 1, 0:
-				delete(p.hostToRoute, host)
-			default:
-				next := []*routeapi.Route{}
-				for i := range old {
-					if old[i].Name != route.Name {
-						next = append(next, old[i])
-					}
-				}
-				p.hostToRoute[host] = next
-			}
-		}
+		p.releaseHost(host, route)
 		delete(p.routeToHost, routeName)
 		return p.plugin.HandleRoute(eventType, route)
 	}
 	return nil
 }
 
+// removeRoute releases the host claimed by a previously admitted route and deletes the
+// route from the plugin.
+func (p *UniqueHost) removeRoute(route *routeapi.Route) error {
+	routeName := routeNameKey(route)
+	host, ok := p.routeToHost[routeName]
+	if !ok {
+		return nil
+	}
+	glog.V(4).Infof("Route %s no longer serves host %s", routeName, host)
+	p.releaseHost(host, route)
+	delete(p.routeToHost, routeName)
+	return p.plugin.HandleRoute(watch.Deleted, route)
+}
+
+// releaseHost removes route from the routes claiming host.
+func (p *UniqueHost) releaseHost(host string, route *routeapi.Route) {
+	old, ok := p.hostToRoute[host]
+	if !ok {
+		return
+	}
+	next := []*routeapi.Route{}
+	for i := range old {
+		if routeNameKey(old[i]) != routeNameKey(route) {
+			next = append(next, old[i])
+		}
+	}
+	if len(next) == 0 {
+		delete(p.hostToRoute, host)
+		return
+	}
+	p.hostToRoute[host] = next
+}
+
+// validateWildcardDomain ensures the hosts of a wildcard domain are only claimed by the
+// namespace owning the domain: a wildcard route can't claim a domain with hosts claimed
+// from another namespace, and a route can't claim a host of a wildcard domain claimed
+// from another namespace. As for hosts, the namespace of the oldest route owns the
+// domain, an older route reclaims the domain and its hosts from younger routes.
+func (p *UniqueHost) validateWildcardDomain(route *routeapi.Route, host string) error {
+	routeName := routeNameKey(route)
+	conflicts := []string{}
+	if isWildcardHost(host) {
+		domain := routeapi.GetDomainForHost(host)
+		for claimed, routes := range p.hostToRoute {
+			if isWildcardHost(claimed) || routeapi.GetDomainForHost(claimed) != domain || len(routes) == 0 || routes[0].Namespace == route.Namespace {
+				continue
+			}
+			if routes[0].CreationTimestamp.Before(route.CreationTimestamp) {
+				glog.V(4).Infof("Route %s cannot take %s, %s is held by namespace %s", routeName, host, claimed, routes[0].Namespace)
+				err := fmt.Errorf("a route in another namespace holds %s of the wildcard domain %s and is older than %s", claimed, host, route.Name)
+				p.recorder.RecordRouteRejection(route, "HostAlreadyClaimed", err.Error())
+				return err
+			}
+			conflicts = append(conflicts, claimed)
+		}
+	} else if len(routeapi.GetDomainForHost(host)) > 0 {
+		wildcard := wildcardHost(host)
+		if routes, ok := p.hostToRoute[wildcard]; ok && len(routes) > 0 && routes[0].Namespace != route.Namespace {
+			if routes[0].CreationTimestamp.Before(route.CreationTimestamp) {
+				glog.V(4).Infof("Route %s cannot take %s, %s is held by namespace %s", routeName, host, wildcard, routes[0].Namespace)
+				err := fmt.Errorf("a route in another namespace holds the wildcard domain %s of %s and is older than %s", wildcard, host, route.Name)
+				p.recorder.RecordRouteRejection(route, "HostAlreadyClaimed", err.Error())
+				return err
+			}
+			conflicts = append(conflicts, wildcard)
+		}
+	}
+
+	for _, claimed := range conflicts {
+		old := p.hostToRoute[claimed]
+		glog.V(4).Infof("Route %s is reclaiming %s from namespace %s", routeName, claimed, old[0].Namespace)
+		for i := range old {
+			p.recorder.RecordRouteRejection(old[i], "HostAlreadyClaimed", fmt.Sprintf("namespace %s owns the wildcard domain of %s", route.Namespace, claimed))
+			delete(p.routeToHost, routeNameKey(old[i]))
+			if err := p.plugin.HandleRoute(watch.Deleted, old[i]); err != nil {
+				utilruntime.HandleError(fmt.Errorf("unable to remove route %s claiming %s: %v", routeNameKey(old[i]), claimed, err))
+			}
+		}
+		delete(p.hostToRoute, claimed)
+	}
+	return nil
+}
+
 // HandleAllowedNamespaces limits the scope of valid routes to only those that match
 // the provided namespace list.
 func (p *UniqueHost) HandleNamespaces(namespaces sets.String) error {
@@ -221,3 +347,13 @@ func routeKey(route *routeapi.Route) string {
 func routeNameKey(route *routeapi.Route) string {
 	return fmt.Sprintf("%s/%s", route.Namespace, route.Name)
 }
+
+// wildcardHost returns the wildcard domain served by a wildcard route for host.
+func wildcardHost(host string) string {
+	return "*." + routeapi.GetDomainForHost(host)
+}
+
+// isWildcardHost returns true if host is a wildcard domain claimed by a wildcard route.
+func isWildcardHost(host string) bool {
+	return strings.HasPrefix(host, "*.")
+}
@@ -836,13 +836,25 @@ func (f5 *f5LTM) Initialize() error {
 
 // CreatePool creates a pool named poolname on F5 BIG-IP.
 func (f5 *f5LTM) CreatePool(poolname string) error {
+	return f5.createPool(poolname, "round-robin")
+}
+
+// CreateRatioPool creates a pool named poolname on F5 BIG-IP which balances the
+// load over its members by their ratios.
+func (f5 *f5LTM) CreateRatioPool(poolname string) error {
+	return f5.createPool(poolname, "ratio-member")
+}
+
+// createPool creates a pool named poolname with the given load balancing mode
+// on F5 BIG-IP.
+func (f5 *f5LTM) createPool(poolname, mode string) error {
 	url := fmt.Sprintf("https://%s/mgmt/tm/ltm/pool", f5.host)
 
 	// The http monitor is still used from the /Common partition.
 	// From @Miciah: In the future, we should allow the administrator
 	// to specify a different monitor to use.
 	payload := f5Pool{
-		Mode:    "round-robin",
+		Mode:    mode,
 		Monitor: "/Common/http",
 		Name:    poolname,
 	}
@@ -943,6 +955,58 @@ func (f5 *f5LTM) PoolHasMember(poolname, member string) (bool, error) {
 // AddPoolMember adds the given member to the specified pool on F5 BIG-IP, and
 // updates f5.poolMembers[poolname].
 func (f5 *f5LTM) AddPoolMember(poolname, member string) error {
+	return f5.addPoolMember(poolname, f5PoolMember{Name: member})
+}
+
+// AddPoolMemberWithRatio adds the given member with the given ratio to the
+// specified pool on F5 BIG-IP, and updates f5.poolMembers[poolname].
+func (f5 *f5LTM) AddPoolMemberWithRatio(poolname, member string, ratio int32) error {
+	return f5.addPoolMember(poolname, f5PoolMember{Name: member, Ratio: ratio})
+}
+
+// GetPoolMemberRatios returns the ratios of the members of the specified pool
+// on F5 BIG-IP, keyed by member.  Unlike the members, the ratios are not cached
+// and are always requested from F5 BIG-IP.
+func (f5 *f5LTM) GetPoolMemberRatios(poolname string) (map[string]int32, error) {
+	url := fmt.Sprintf("https://%s/mgmt/tm/ltm/pool/%s/members",
+		f5.host, poolname)
+
+	res := f5PoolMemberset{}
+
+	err := f5.get(url, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	ratios := map[string]int32{}
+	for _, member := range res.Members {
+		ratios[member.Name] = member.Ratio
+	}
+
+	return ratios, nil
+}
+
+// SetPoolMemberRatio sets the ratio of the given member of the specified pool
+// on F5 BIG-IP.
+func (f5 *f5LTM) SetPoolMemberRatio(poolname, member string, ratio int32) error {
+	url := fmt.Sprintf("https://%s/mgmt/tm/ltm/pool/%s/members/%s",
+		f5.host, poolname, member)
+
+	err := f5.patch(url, f5PoolMember{Name: member, Ratio: ratio}, nil)
+	if err != nil {
+		return err
+	}
+
+	glog.V(4).Infof("Set the ratio of pool member %s of pool %s to %d.",
+		member, poolname, ratio)
+
+	return nil
+}
+
+// addPoolMember adds the given member to the specified pool on F5 BIG-IP, and
+// updates f5.poolMembers[poolname].
+func (f5 *f5LTM) addPoolMember(poolname string, payload f5PoolMember) error {
+	member := payload.Name
 	hasMember, err := f5.PoolHasMember(poolname, member)
 	if err != nil {
 		return err
@@ -957,10 +1021,6 @@ func (f5 *f5LTM) AddPoolMember(poolname, member string) error {
 	url := fmt.Sprintf("https://%s/mgmt/tm/ltm/pool/%s/members",
 		f5.host, poolname)
 
-	payload := f5PoolMember{
-		Name: member,
-	}
-
 	err = f5.post(url, payload, nil)
 	if err != nil {
 		return err
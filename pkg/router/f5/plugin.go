@@ -9,6 +9,7 @@ import (
 	"k8s.io/kubernetes/pkg/watch"
 
 	routeapi "github.com/openshift/origin/pkg/route/api"
+	"github.com/openshift/origin/pkg/router"
 )
 
 // F5Plugin holds state for the f5 plugin.
@@ -16,6 +17,14 @@ type F5Plugin struct {
 	// F5Client is the object that represents the F5 BIG-IP host, holds state,
 	// and provides an interface to manipulate F5 BIG-IP.
 	F5Client *f5LTM
+
+	// endpoints caches the endpoints of the services, keyed by namespace and
+	// name, in order to populate the weighted pools of routes with alternate
+	// backends.
+	endpoints map[string]*kapi.Endpoints
+
+	// weightedRoutes are the routes with alternate backends, keyed by route name.
+	weightedRoutes map[string]*routeapi.Route
 }
 
 // F5PluginConfig holds configuration for the f5 plugin.
@@ -70,7 +79,12 @@ func NewF5Plugin(cfg F5PluginConfig) (*F5Plugin, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &F5Plugin{f5}, f5.Initialize()
+	plugin := &F5Plugin{
+		F5Client:       f5,
+		endpoints:      map[string]*kapi.Endpoints{},
+		weightedRoutes: map[string]*routeapi.Route{},
+	}
+	return plugin, f5.Initialize()
 }
 
 // ensurePoolExists checks whether the named pool already exists in F5 BIG-IP
@@ -169,6 +183,161 @@ func (p *F5Plugin) updatePool(poolname string, endpoints *kapi.Endpoints) error
 	return nil
 }
 
+// ensureWeightedPoolExists checks whether the weighted pool of the given route
+// already exists in F5 BIG-IP, creates it if it does not and updates its
+// members with the endpoints of the backends of the route.
+func (p *F5Plugin) ensureWeightedPoolExists(route *routeapi.Route) error {
+	poolname := weightedPoolName(*route)
+
+	poolExists, err := p.F5Client.PoolExists(poolname)
+	if err != nil {
+		glog.V(4).Infof("F5Client.PoolExists failed: %v", err)
+		return err
+	}
+
+	if !poolExists {
+		err = p.F5Client.CreateRatioPool(poolname)
+		if err != nil {
+			glog.V(4).Infof("Error creating weighted pool %s: %v", poolname, err)
+			return err
+		}
+	}
+
+	p.weightedRoutes[routeName(*route)] = route
+
+	return p.updateWeightedPool(poolname, p.weightedPoolMembers(route))
+}
+
+// weightedPoolMembers returns the members of the weighted pool of the given
+// route, which are the endpoints of all its backends, mapped to their ratios.
+// The weight of each backend is divided across its endpoints, an endpoint of
+// several backends gets the sum of their shares.  The endpoints of backends
+// with a weight of 0 are left out.
+func (p *F5Plugin) weightedPoolMembers(route *routeapi.Route) map[string]int32 {
+	weights := map[string]int32{
+		route.Spec.To.Name: routeapi.BackendWeight(route.Spec.Weight),
+	}
+	for _, backend := range route.Spec.AlternateBackends {
+		weights[backend.Name] = routeapi.BackendWeight(backend.Weight)
+	}
+
+	dests := map[string][]string{}
+	counts := map[string]int{}
+	for name := range weights {
+		endpoints, ok := p.endpoints[endpointsKey(route.Namespace, name)]
+		if !ok {
+			continue
+		}
+		for _, subset := range endpoints.Subsets {
+			for _, addr := range subset.Addresses {
+				for _, port := range subset.Ports {
+					dests[name] = append(dests[name], fmt.Sprintf("%s:%d", addr.IP, port.Port))
+				}
+			}
+		}
+		counts[name] = len(dests[name])
+	}
+
+	members := map[string]int32{}
+	for name, ratio := range router.EndpointWeights(weights, counts) {
+		if ratio == 0 {
+			continue
+		}
+		for _, dest := range dests[name] {
+			members[dest] += ratio
+		}
+	}
+	return members
+}
+
+// updateWeightedPool updates the named weighted pool (which must already exist
+// in F5 BIG-IP) with the given members and their ratios.  New members are
+// added, the ratios of existing members are updated in place and the members
+// that are not given are deleted.
+func (p *F5Plugin) updateWeightedPool(poolname string, members map[string]int32) error {
+	existing, err := p.F5Client.GetPoolMemberRatios(poolname)
+	if err != nil {
+		glog.V(4).Infof("F5Client.GetPoolMemberRatios failed: %v", err)
+		return err
+	}
+
+	needToDelete := []string{}
+	for member := range existing {
+		if _, ok := members[member]; !ok {
+			needToDelete = append(needToDelete, member)
+		}
+	}
+
+	for member, ratio := range members {
+		current, ok := existing[member]
+		switch {
+		case !ok:
+			glog.V(4).Infof("  Adding %s with ratio %d...", member, ratio)
+			err = p.F5Client.AddPoolMemberWithRatio(poolname, member, ratio)
+			if err != nil {
+				glog.V(4).Infof("  Error adding endpoint %s to pool %s: %v",
+					member, poolname, err)
+				return err
+			}
+		case current != ratio:
+			glog.V(4).Infof("  Changing the ratio of %s from %d to %d...",
+				member, current, ratio)
+			err = p.F5Client.SetPoolMemberRatio(poolname, member, ratio)
+			if err != nil {
+				glog.V(4).Infof("  Error changing the ratio of endpoint %s of pool %s: %v",
+					member, poolname, err)
+				return err
+			}
+		}
+	}
+
+	for _, member := range needToDelete {
+		glog.V(4).Infof("  Deleting %s...", member)
+		err = p.F5Client.DeletePoolMember(poolname, member)
+		if err != nil {
+			glog.V(4).Infof("  Error deleting endpoint %s from pool %s: %v",
+				member, poolname, err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// updateWeightedPools updates the weighted pools of the routes with a backend
+// with the given endpoints namespace and name.
+func (p *F5Plugin) updateWeightedPools(endpointsNamespace, endpointsName string) error {
+	for _, route := range p.weightedRoutes {
+		if route.Namespace != endpointsNamespace || !hasBackend(route, endpointsName) {
+			continue
+		}
+
+		poolname := weightedPoolName(*route)
+		glog.V(4).Infof("Updating endpoints for weighted pool %s", poolname)
+
+		err := p.updateWeightedPool(poolname, p.weightedPoolMembers(route))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// hasBackend returns whether the given route sends traffic to the named
+// service.
+func hasBackend(route *routeapi.Route, serviceName string) bool {
+	if route.Spec.To.Name == serviceName {
+		return true
+	}
+	for _, backend := range route.Spec.AlternateBackends {
+		if backend.Name == serviceName {
+			return true
+		}
+	}
+	return false
+}
+
 // deletePool delete the named pool from F5 BIG-IP.
 func (p *F5Plugin) deletePool(poolname string) error {
 	poolExists, err := p.F5Client.PoolExists(poolname)
@@ -188,6 +357,13 @@ func (p *F5Plugin) deletePool(poolname string) error {
 	return nil
 }
 
+// deleteWeightedPool deletes the weighted pool of the given route from F5
+// BIG-IP if it exists.
+func (p *F5Plugin) deleteWeightedPool(route *routeapi.Route) error {
+	delete(p.weightedRoutes, routeName(*route))
+	return p.deletePool(weightedPoolName(*route))
+}
+
 // deletePoolIfEmpty deletes the named pool from F5 BIG-IP if, and only if, it
 // has no members.
 func (p *F5Plugin) deletePoolIfEmpty(poolname string) error {
@@ -224,6 +400,18 @@ func poolName(endpointsNamespace, endpointsName string) string {
 	return fmt.Sprintf("openshift_%s_%s", endpointsNamespace, endpointsName)
 }
 
+// weightedPoolName returns a string that can be used as a poolname in F5 BIG-IP
+// and is distinct for the given route with alternate backends.
+func weightedPoolName(route routeapi.Route) string {
+	return fmt.Sprintf("openshift_weighted_%s_%s", route.Namespace, route.Name)
+}
+
+// endpointsKey returns the key of the endpoints with the given namespace and
+// name in the endpoints cache.
+func endpointsKey(endpointsNamespace, endpointsName string) string {
+	return fmt.Sprintf("%s/%s", endpointsNamespace, endpointsName)
+}
+
 // HandleEndpoints processes watch events on the Endpoints resource and
 // creates and deletes pools and pool members in response.
 func (p *F5Plugin) HandleEndpoints(eventType watch.EventType,
@@ -278,6 +466,19 @@ func (p *F5Plugin) HandleEndpoints(eventType watch.EventType,
 		}
 	}
 
+	// Routes with alternate backends use weighted pools comprising the endpoints
+	// of all their backends, which need to be updated as well.
+	key := endpointsKey(endpoints.Namespace, endpoints.Name)
+	if eventType == watch.Deleted {
+		delete(p.endpoints, key)
+	} else {
+		p.endpoints[key] = endpoints
+	}
+	err := p.updateWeightedPools(endpoints.Namespace, endpoints.Name)
+	if err != nil {
+		return err
+	}
+
 	glog.V(4).Infof("Done processing Endpoints for Name: %v.", endpoints.Name)
 
 	return nil
@@ -477,8 +678,13 @@ func (p *F5Plugin) HandleRoute(eventType watch.EventType,
 	glog.V(4).Infof("Processing route for service: %v (%v)",
 		route.Spec.To.Name, route)
 
-	// Name of the pool in F5.
+	// Name of the pool in F5.  Routes with alternate backends use a weighted pool
+	// of their own, comprising the endpoints of all their backends.
 	poolname := poolName(route.Namespace, route.Spec.To.Name)
+	weighted := len(route.Spec.AlternateBackends) > 0
+	if weighted {
+		poolname = weightedPoolName(*route)
+	}
 
 	// Virtual hostname for policy rule in F5.
 	hostname := route.Spec.Host
@@ -499,8 +705,17 @@ func (p *F5Plugin) HandleRoute(eventType watch.EventType,
 		}
 
 		// Ensure the pool exists in case we have been told to modify a route that
-		// did not already exist.
-		err = p.ensurePoolExists(poolname)
+		// did not already exist.  The ratios of the members of the weighted pool
+		// are updated with the weights of the backends, which may have changed.  A
+		// route without alternate backends no longer needs its weighted pool.
+		if weighted {
+			err = p.ensureWeightedPoolExists(route)
+		} else {
+			err = p.deleteWeightedPool(route)
+			if err == nil {
+				err = p.ensurePoolExists(poolname)
+			}
+		}
 		if err != nil {
 			return err
 		}
@@ -517,7 +732,12 @@ func (p *F5Plugin) HandleRoute(eventType watch.EventType,
 			return err
 		}
 
-		err = p.deletePoolIfEmpty(poolname)
+		err = p.deleteWeightedPool(route)
+		if err != nil {
+			return err
+		}
+
+		err = p.deletePoolIfEmpty(poolName(route.Namespace, route.Spec.To.Name))
 		if err != nil {
 			return err
 		}
@@ -527,7 +747,12 @@ func (p *F5Plugin) HandleRoute(eventType watch.EventType,
 		// F5 does not permit us to create a rule without a pool, so we need to
 		// create the pool here in HandleRoute if it does not already exist.
 		// However, the pool may have already been created by HandleEndpoints.
-		err := p.ensurePoolExists(poolname)
+		var err error
+		if weighted {
+			err = p.ensureWeightedPoolExists(route)
+		} else {
+			err = p.ensurePoolExists(poolname)
+		}
 		if err != nil {
 			return err
 		}
@@ -73,6 +73,10 @@ type (
 
 		// pools represents the pools that exist on the mock F5 host.
 		pools map[string]pool
+
+		// memberRatios represents the ratios of the members of the pools that
+		// exist on the mock F5 host, keyed by poolname/member.
+		memberRatios map[string]int32
 	}
 
 	mockF5 struct {
@@ -153,6 +157,7 @@ var f5Routes = []Route{
 	{"deletePool", "DELETE", "/mgmt/tm/ltm/pool/{poolName}", deletePoolHandler},
 	{"getPoolMembers", "GET", "/mgmt/tm/ltm/pool/{poolName}/members", getPoolMembersHandler},
 	{"postPoolMember", "POST", "/mgmt/tm/ltm/pool/{poolName}/members", postPoolMemberHandler},
+	{"patchPoolMember", "PATCH", "/mgmt/tm/ltm/pool/{poolName}/members/{memberName}", patchPoolMemberHandler},
 	{"deletePoolMember", "DELETE", "/mgmt/tm/ltm/pool/{poolName}/members/{memberName}", deletePoolMemberHandler},
 	{"getRules", "GET", "/mgmt/tm/ltm/policy/{policyName}/rules", getRulesHandler},
 	{"postCondition", "POST", "/mgmt/tm/ltm/policy/{policyName}/rules/{ruleName}/conditions", postConditionHandler},
@@ -252,6 +257,7 @@ func newTestRouter(partitionPath string) (*F5Plugin, *mockF5, error) {
 		// Add the default /Common partition path.
 		partitionPaths: map[string]string{pathKey: partitionPath},
 		pools:          map[string]pool{},
+		memberRatios:   map[string]int32{},
 	}
 
 	return newTestRouterWithState(state, partitionPath)
@@ -767,9 +773,13 @@ func getPoolMembersHandler(f5state mockF5State) http.HandlerFunc {
 			}
 
 			addr := strings.Split(member, ":")[0]
+			ratio, ok := f5state.memberRatios[poolName+"/"+member]
+			if !ok {
+				ratio = 1
+			}
 			fmt.Fprintf(response,
-				`{"address":"%s","connectionLimit":0,"dynamicRatio":1,"ephemeral":"false","fqdn":{"autopopulate":"disabled"},"fullPath":"/Common/%s","generation":1190,"inheritProfile":"enabled","kind":"tm:ltm:pool:members:membersstate","logging":"disabled","monitor":"default","name":"%s","partition":"Common","priorityGroup":0,"rateLimit":"disabled","ratio":1,"selfLink":"https://localhost/mgmt/tm/ltm/pool/%s/members/~Common~%s?ver=11.6.0","session":"monitor-enabled","state":"up"}`,
-				addr, member, member, member, member)
+				`{"address":"%s","connectionLimit":0,"dynamicRatio":1,"ephemeral":"false","fqdn":{"autopopulate":"disabled"},"fullPath":"/Common/%s","generation":1190,"inheritProfile":"enabled","kind":"tm:ltm:pool:members:membersstate","logging":"disabled","monitor":"default","name":"%s","partition":"Common","priorityGroup":0,"rateLimit":"disabled","ratio":%d,"selfLink":"https://localhost/mgmt/tm/ltm/pool/%s/members/~Common~%s?ver=11.6.0","session":"monitor-enabled","state":"up"}`,
+				addr, member, member, ratio, member, member)
 		}
 
 		fmt.Fprintf(response,
@@ -789,6 +799,7 @@ func postPoolMemberHandler(f5state mockF5State) http.HandlerFunc {
 
 		payload := struct {
 			Member string `json:"name"`
+			Ratio  int32  `json:"ratio"`
 		}{}
 		decoder := json.NewDecoder(request.Body)
 		decoder.Decode(&payload)
@@ -805,6 +816,40 @@ func postPoolMemberHandler(f5state mockF5State) http.HandlerFunc {
 		}
 
 		f5state.pools[poolName][memberName] = true
+		if payload.Ratio > 0 {
+			f5state.memberRatios[poolName+"/"+memberName] = payload.Ratio
+		}
+
+		OK(response)
+	}
+}
+
+func patchPoolMemberHandler(f5state mockF5State) http.HandlerFunc {
+	return func(response http.ResponseWriter, request *http.Request) {
+		vars := mux.Vars(request)
+		poolName := vars["poolName"]
+		memberName := vars["memberName"]
+
+		if !validatePoolName(response, request, f5state, poolName) {
+			return
+		}
+
+		_, foundMember := f5state.pools[poolName][memberName]
+		if !foundMember {
+			response.WriteHeader(http.StatusNotFound)
+			fmt.Fprintf(response,
+				`{"code":404,"message":"01020036:3: The requested Pool Member (/Common/%s /Common/%s) was not found.","errorStack":[]}`,
+				poolName, strings.Replace(memberName, ":", " ", 1))
+			return
+		}
+
+		payload := struct {
+			Ratio int32 `json:"ratio"`
+		}{}
+		decoder := json.NewDecoder(request.Body)
+		decoder.Decode(&payload)
+
+		f5state.memberRatios[poolName+"/"+memberName] = payload.Ratio
 
 		OK(response)
 	}
@@ -828,6 +873,7 @@ func deletePoolMemberHandler(f5state mockF5State) http.HandlerFunc {
 		}
 
 		delete(f5state.pools[poolName], memberName)
+		delete(f5state.memberRatios, poolName+"/"+memberName)
 
 		OK(response)
 	}
@@ -2254,6 +2300,138 @@ func TestHandleRouteModifications(t *testing.T) {
 	}
 }
 
+// TestHandleRouteAlternateBackends creates an F5 router instance, creates
+// services and a route with alternate backends, and verifies that the router
+// maintains a weighted pool comprising the endpoints of all the backends of the
+// route.
+func TestHandleRouteAlternateBackends(t *testing.T) {
+	router, mockF5, err := newTestRouter(F5DefaultPartitionPath)
+	if err != nil {
+		t.Fatalf("Failed to initialize test router: %v", err)
+	}
+	defer mockF5.close()
+
+	newEndpoints := func(name string, ips ...string) *kapi.Endpoints {
+		addresses := []kapi.EndpointAddress{}
+		for _, ip := range ips {
+			addresses = append(addresses, kapi.EndpointAddress{IP: ip})
+		}
+		return &kapi.Endpoints{
+			ObjectMeta: kapi.ObjectMeta{Namespace: "foo", Name: name},
+			Subsets: []kapi.EndpointSubset{{
+				Addresses: addresses,
+				Ports:     []kapi.EndpointPort{{Port: 8080}},
+			}},
+		}
+	}
+	drained := int32(0)
+	testRoute := &routeapi.Route{
+		ObjectMeta: kapi.ObjectMeta{
+			Namespace: "foo",
+			Name:      "weighted",
+		},
+		Spec: routeapi.RouteSpec{
+			Host: "www.example.com",
+			To: kapi.ObjectReference{
+				Name: "primary",
+			},
+			AlternateBackends: []routeapi.RouteTargetReference{
+				{Kind: "Service", Name: "alternate"},
+				{Kind: "Service", Name: "drained", Weight: &drained},
+			},
+		},
+	}
+	poolname := weightedPoolName(*testRoute)
+	validatePool := func(expected ...string) {
+		members := mockF5.state.pools[poolname]
+		if len(members) != len(expected) {
+			t.Fatalf("Weighted pool %s should have members %v, but has %v",
+				poolname, expected, members)
+		}
+		for _, member := range expected {
+			if !members[member] {
+				t.Fatalf("Weighted pool %s should have members %v, but has %v",
+					poolname, expected, members)
+			}
+		}
+	}
+
+	for _, endpoints := range []*kapi.Endpoints{
+		newEndpoints("primary", "10.1.1.1"),
+		newEndpoints("drained", "10.1.3.1"),
+	} {
+		err = router.HandleEndpoints(watch.Added, endpoints)
+		if err != nil {
+			t.Fatalf("HandleEndpoints failed on adding endpoints: %v", err)
+		}
+	}
+
+	err = router.HandleRoute(watch.Added, testRoute)
+	if err != nil {
+		t.Fatalf("HandleRoute failed on adding test route: %v", err)
+	}
+	validatePool("10.1.1.1:8080")
+
+	rule, ok := mockF5.state.policies[insecureRoutesPolicyName][routeName(*testRoute)]
+	if !ok || len(rule.conditions) != 1 {
+		t.Fatalf("Policy %s should have a rule for route %s: %v",
+			insecureRoutesPolicyName, testRoute.Name,
+			mockF5.state.policies[insecureRoutesPolicyName])
+	}
+
+	// Verify that the endpoints of the alternate backends are added.
+	err = router.HandleEndpoints(watch.Added, newEndpoints("alternate", "10.1.2.1", "10.1.2.2"))
+	if err != nil {
+		t.Fatalf("HandleEndpoints failed on adding endpoints: %v", err)
+	}
+	validatePool("10.1.1.1:8080", "10.1.2.1:8080", "10.1.2.2:8080")
+
+	// Verify that a changed weight is applied.
+	testRoute.Spec.AlternateBackends[1].Weight = nil
+	err = router.HandleRoute(watch.Modified, testRoute)
+	if err != nil {
+		t.Fatalf("HandleRoute failed on modifying test route: %v", err)
+	}
+	validatePool("10.1.1.1:8080", "10.1.2.1:8080", "10.1.2.2:8080", "10.1.3.1:8080")
+
+	// Verify that the ratios of existing members are updated.
+	weight := int32(50)
+	testRoute.Spec.AlternateBackends[0].Weight = &weight
+	err = router.HandleRoute(watch.Modified, testRoute)
+	if err != nil {
+		t.Fatalf("HandleRoute failed on modifying test route: %v", err)
+	}
+	validatePool("10.1.1.1:8080", "10.1.2.1:8080", "10.1.2.2:8080", "10.1.3.1:8080")
+	// The weight of each backend is divided across its endpoints.
+	ratios := map[string]int32{
+		"10.1.1.1:8080": 256,
+		"10.1.2.1:8080": 64,
+		"10.1.2.2:8080": 64,
+		"10.1.3.1:8080": 256,
+	}
+	for member, expected := range ratios {
+		if ratio := mockF5.state.memberRatios[poolname+"/"+member]; ratio != expected {
+			t.Errorf("Member %s of weighted pool %s should have ratio %d, but has %d",
+				member, poolname, expected, ratio)
+		}
+	}
+
+	// Verify that removed endpoints are deleted.
+	err = router.HandleEndpoints(watch.Deleted, newEndpoints("alternate", "10.1.2.1", "10.1.2.2"))
+	if err != nil {
+		t.Fatalf("HandleEndpoints failed on deleting endpoints: %v", err)
+	}
+	validatePool("10.1.1.1:8080", "10.1.3.1:8080")
+
+	err = router.HandleRoute(watch.Deleted, testRoute)
+	if err != nil {
+		t.Fatalf("HandleRoute failed on deleting test route: %v", err)
+	}
+	if _, ok := mockF5.state.pools[poolname]; ok {
+		t.Errorf("Weighted pool %s should have been deleted", poolname)
+	}
+}
+
 // TestF5RouterSuccessiveInstances creates an F5 router instance, creates
 // a service and a route, creates a new F5 router instance, and verifies that
 // the new instance behaves correctly picking up the state from the first
@@ -65,7 +65,8 @@ type f5VserverIRules struct {
 // request by which the F5 router creates a new pool.
 type f5Pool struct {
 	// Mode is the method of load balancing that F5 BIG-IP employs over members of
-	// the pool.  The F5 router uses round-robin; other allowed values are
+	// the pool.  The F5 router uses round-robin, and ratio-member for the pools
+	// of routes with alternate backends; other allowed values are
 	// dynamic-ratio-member, dynamic-ratio-node, fastest-app-response,
 	// fastest-node, least-connections-node, least-sessions, observed-member,
 	// observed-node, ratio-member, ratio-node, ratio-session,
@@ -78,7 +79,9 @@ type f5Pool struct {
 	Monitor string `json:"monitor"`
 
 	// Name is the name of the pool.  The F5 router uses names of the form
-	// openshift_<namespace>_<servicename>.
+	// openshift_<namespace>_<servicename>, or
+	// openshift_weighted_<namespace>_<routename> for the pools of routes with
+	// alternate backends.
 	Name string `json:"name"`
 }
 
@@ -90,6 +93,11 @@ type f5PoolMember struct {
 	// Name is the name of the pool member.  The F5 router uses names of the form
 	// ipaddr:port.
 	Name string `json:"name"`
+
+	// Ratio is the weight of the pool member in a pool using ratio load
+	// balancing.  The F5 router sets it to the weight of the route backend the
+	// member belongs to.
+	Ratio int32 `json:"ratio,omitempty"`
 }
 
 // f5PoolMemberset represents an F5 BIG-IP LTM pool.  The F5 router uses it to
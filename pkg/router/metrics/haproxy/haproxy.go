@@ -0,0 +1,259 @@
+// Package haproxy contains a Prometheus collector for the statistics of the backends of
+// the HAProxy template router.
+package haproxy
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	namespace = "haproxy"
+
+	// statsTimeout is how long to wait for the statistics of HAProxy.
+	statsTimeout = 5 * time.Second
+)
+
+// backendPrefixes are the prefixes the HAProxy template adds to the route keys,
+// namespace_name, to name the backends of the routes.
+var backendPrefixes = []string{"be_edge_http_", "be_http_", "be_tcp_", "be_secure_"}
+
+// backendLabels are the labels of the metrics of a backend.
+var backendLabels = []string{"backend", "namespace", "route"}
+
+// backendMetric is a metric of the backends read from a column of the HAProxy
+// statistics.
+type backendMetric struct {
+	desc      *prometheus.Desc
+	valueType prometheus.ValueType
+	// column is the name of the column of the statistics
+	column string
+	// scale converts the value of the column to the unit of the metric
+	scale float64
+	// extraLabel is the value of the extra label of the metric, if any
+	extraLabel string
+}
+
+func newBackendMetric(name, help, column string, valueType prometheus.ValueType, scale float64) backendMetric {
+	return backendMetric{
+		desc:      prometheus.NewDesc(prometheus.BuildFQName(namespace, "backend", name), help, backendLabels, nil),
+		valueType: valueType,
+		column:    column,
+		scale:     scale,
+	}
+}
+
+// newBackendMetrics returns the metrics read from the statistics of each backend.
+func newBackendMetrics() []backendMetric {
+	metrics := []backendMetric{
+		newBackendMetric("current_sessions", "Current number of active sessions of the backend", "scur", prometheus.GaugeValue, 1),
+		newBackendMetric("max_sessions", "Maximum observed number of active sessions of the backend", "smax", prometheus.GaugeValue, 1),
+		newBackendMetric("current_queue", "Current number of queued requests of the backend", "qcur", prometheus.GaugeValue, 1),
+		newBackendMetric("sessions_total", "Total number of sessions of the backend", "stot", prometheus.CounterValue, 1),
+		newBackendMetric("http_requests_total", "Total number of HTTP requests of the backend", "req_tot", prometheus.CounterValue, 1),
+		newBackendMetric("bytes_in_total", "Total number of bytes received by the backend", "bin", prometheus.CounterValue, 1),
+		newBackendMetric("bytes_out_total", "Total number of bytes sent by the backend", "bout", prometheus.CounterValue, 1),
+		newBackendMetric("connection_errors_total", "Total number of connection errors of the backend", "econ", prometheus.CounterValue, 1),
+		newBackendMetric("response_errors_total", "Total number of response errors of the backend", "eresp", prometheus.CounterValue, 1),
+		newBackendMetric("up", "Whether the backend has servers to send traffic to", "status", prometheus.GaugeValue, 1),
+		newBackendMetric("average_queue_time_seconds", "Average time spent in the queue by the last 1024 requests of the backend", "qtime", prometheus.GaugeValue, 0.001),
+		newBackendMetric("average_connect_time_seconds", "Average time to connect to a server of the last 1024 requests of the backend", "ctime", prometheus.GaugeValue, 0.001),
+		newBackendMetric("average_response_time_seconds", "Average response time of the last 1024 requests of the backend", "rtime", prometheus.GaugeValue, 0.001),
+		newBackendMetric("average_total_time_seconds", "Average total session time of the last 1024 requests of the backend", "ttime", prometheus.GaugeValue, 0.001),
+	}
+
+	responses := prometheus.NewDesc(prometheus.BuildFQName(namespace, "backend", "http_responses_total"),
+		"Total number of HTTP responses of the backend, broken out by class of status code", []string{"backend", "namespace", "route", "code"}, nil)
+	for _, code := range []string{"1xx", "2xx", "3xx", "4xx", "5xx", "other"} {
+		metrics = append(metrics, backendMetric{
+			desc:       responses,
+			valueType:  prometheus.CounterValue,
+			column:     "hrsp_" + code,
+			scale:      1,
+			extraLabel: code,
+		})
+	}
+	return metrics
+}
+
+// Exporter collects the statistics of the backends of the routes from HAProxy, labeled
+// with the namespace and name of their route.
+type Exporter struct {
+	// readStats returns the statistics of HAProxy in CSV format
+	readStats func() (io.ReadCloser, error)
+
+	metrics []backendMetric
+	up      prometheus.Gauge
+	// lock serializes the collections
+	lock sync.Mutex
+}
+
+// NewExporter returns an Exporter reading the statistics of HAProxy from the stats
+// socket at socketPath.
+func NewExporter(socketPath string) *Exporter {
+	return newExporter(func() (io.ReadCloser, error) {
+		return readSocketStats(socketPath)
+	})
+}
+
+func newExporter(readStats func() (io.ReadCloser, error)) *Exporter {
+	return &Exporter{
+		readStats: readStats,
+		metrics:   newBackendMetrics(),
+		up: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "up",
+			Help:      "Whether the last read of the HAProxy statistics succeeded",
+		}),
+	}
+}
+
+// readSocketStats requests the statistics with the show stat command of the stats socket
+// at socketPath.
+func readSocketStats(socketPath string) (io.ReadCloser, error) {
+	conn, err := net.DialTimeout("unix", socketPath, statsTimeout)
+	if err != nil {
+		return nil, err
+	}
+	conn.SetDeadline(time.Now().Add(statsTimeout))
+	if _, err := fmt.Fprintf(conn, "show stat\n"); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return conn, nil
+}
+
+// Describe implements prometheus.Collector.
+func (e *Exporter) Describe(ch chan<- *prometheus.Desc) {
+	described := map[*prometheus.Desc]bool{}
+	for _, metric := range e.metrics {
+		if !described[metric.desc] {
+			ch <- metric.desc
+			described[metric.desc] = true
+		}
+	}
+	ch <- e.up.Desc()
+}
+
+// Collect implements prometheus.Collector.
+func (e *Exporter) Collect(ch chan<- prometheus.Metric) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if err := e.collectBackends(ch); err != nil {
+		glog.V(2).Infof("Unable to read the HAProxy statistics: %v", err)
+		e.up.Set(0)
+	} else {
+		e.up.Set(1)
+	}
+	ch <- e.up
+}
+
+// collectBackends sends the metrics of the backends of the routes in the statistics.
+func (e *Exporter) collectBackends(ch chan<- prometheus.Metric) error {
+	stats, err := e.readStats()
+	if err != nil {
+		return err
+	}
+	defer stats.Close()
+	data, err := ioutil.ReadAll(stats)
+	if err != nil {
+		return err
+	}
+
+	// the header line of the statistics starts with "# "
+	reader := csv.NewReader(strings.NewReader(strings.TrimPrefix(string(data), "# ")))
+	reader.FieldsPerRecord = -1
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		return fmt.Errorf("no statistics were returned")
+	}
+
+	columns := map[string]int{}
+	for i, name := range rows[0] {
+		columns[name] = i
+	}
+	proxy, ok := columns["pxname"]
+	if !ok {
+		return fmt.Errorf("the statistics have no pxname column")
+	}
+	server, ok := columns["svname"]
+	if !ok {
+		return fmt.Errorf("the statistics have no svname column")
+	}
+
+	for _, row := range rows[1:] {
+		if len(row) <= proxy || len(row) <= server || row[server] != "BACKEND" {
+			continue
+		}
+		routeNamespace, routeName, ok := parseBackendName(row[proxy])
+		if !ok {
+			continue
+		}
+		for _, metric := range e.metrics {
+			i, ok := columns[metric.column]
+			if !ok || i >= len(row) {
+				continue
+			}
+			value, ok := parseValue(metric.column, row[i])
+			if !ok {
+				continue
+			}
+			labels := []string{row[proxy], routeNamespace, routeName}
+			if len(metric.extraLabel) > 0 {
+				labels = append(labels, metric.extraLabel)
+			}
+			ch <- prometheus.MustNewConstMetric(metric.desc, metric.valueType, value*metric.scale, labels...)
+		}
+	}
+	return nil
+}
+
+// parseBackendName returns the namespace and name of the route of the backend, or false
+// if the backend isn't the backend of a route.
+func parseBackendName(backend string) (string, string, bool) {
+	for _, prefix := range backendPrefixes {
+		if !strings.HasPrefix(backend, prefix) {
+			continue
+		}
+		// namespaces can't contain underscores, the route key is namespace_name
+		parts := strings.SplitN(strings.TrimPrefix(backend, prefix), "_", 2)
+		if len(parts) != 2 || len(parts[0]) == 0 || len(parts[1]) == 0 {
+			return "", "", false
+		}
+		return parts[0], parts[1], true
+	}
+	return "", "", false
+}
+
+// parseValue returns the value of a column of the statistics, or false if the column is
+// empty. The status column is 1 if the backend is up.
+func parseValue(column, value string) (float64, bool) {
+	if len(value) == 0 {
+		return 0, false
+	}
+	if column == "status" {
+		if strings.HasPrefix(value, "UP") {
+			return 1, true
+		}
+		return 0, true
+	}
+	parsed, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, false
+	}
+	return parsed, true
+}
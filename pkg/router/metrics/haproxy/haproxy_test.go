@@ -0,0 +1,131 @@
+package haproxy
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+const testStats = `# pxname,svname,qcur,qmax,scur,smax,slim,stot,bin,bout,dreq,dresp,ereq,econ,eresp,wretr,wredis,status,weight,act,bck,chkfail,chkdown,lastchg,downtime,qlimit,pid,iid,sid,throttle,lbtot,tracked,type,rate,rate_lim,rate_max,check_status,check_code,check_duration,hrsp_1xx,hrsp_2xx,hrsp_3xx,hrsp_4xx,hrsp_5xx,hrsp_other,hanafail,req_rate,req_rate_max,req_tot,cli_abrt,srv_abrt,comp_in,comp_out,comp_byp,comp_rsp,lastsess,last_chk,last_agt,qtime,ctime,rtime,ttime,
+public,FRONTEND,,,3,10,20000,100,5000,9000,0,0,0,,,,,OPEN,,,,,,,,,1,2,0,,,,0,1,0,5,,,,0,90,5,3,2,0,,1,5,100,,,0,0,0,0,,,,,,,,
+be_http_ns_route,10.0.0.1:8080,0,0,1,4,,40,2000,4000,,0,,0,0,0,0,UP,1,1,0,0,0,100,0,,1,3,1,,40,,2,0,,4,L4OK,,0,0,35,2,1,2,0,0,,,,0,0,,,,,3,,,0,1,12,30,
+be_http_ns_route,BACKEND,0,0,2,5,2000,42,2100,4200,0,0,,1,2,0,0,UP,1,1,0,,0,100,0,,1,3,0,,40,,1,0,,4,,,,0,36,2,1,3,0,,,,50,0,0,0,0,0,0,3,,,0,1,15,35,
+be_tcp_other_db,BACKEND,0,0,0,1,2000,7,100,200,0,0,,0,0,0,0,DOWN,0,0,0,,1,50,50,,1,4,0,,7,,1,0,,1,,,,,,,,,,,,,,0,0,0,0,0,0,10,,,0,0,0,0,
+openshift_default,BACKEND,0,0,0,0,2000,0,0,0,0,0,,0,0,0,0,UP,0,0,0,,0,100,0,,1,5,0,,0,,1,0,,0,,,,0,0,0,0,0,0,,,,,0,0,0,0,0,0,-1,,,0,0,0,0,
+`
+
+// collect returns the values of the metrics collected by the exporter, keyed by the
+// name and labels of the metrics
+func collect(t *testing.T, exporter *Exporter) map[string]float64 {
+	ch := make(chan prometheus.Metric)
+	go func() {
+		exporter.Collect(ch)
+		close(ch)
+	}()
+
+	values := map[string]float64{}
+	for metric := range ch {
+		m := &dto.Metric{}
+		if err := metric.Write(m); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		labels := []string{}
+		for _, pair := range m.Label {
+			labels = append(labels, fmt.Sprintf("%s=%s", pair.GetName(), pair.GetValue()))
+		}
+		sort.Strings(labels)
+		name := metric.Desc().String()
+		name = name[strings.Index(name, `"`)+1:]
+		name = name[:strings.Index(name, `"`)]
+		key := fmt.Sprintf("%s{%s}", name, strings.Join(labels, ","))
+		switch {
+		case m.Gauge != nil:
+			values[key] = m.Gauge.GetValue()
+		case m.Counter != nil:
+			values[key] = m.Counter.GetValue()
+		}
+	}
+	return values
+}
+
+func TestExporterCollect(t *testing.T) {
+	exporter := newExporter(func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(strings.NewReader(testStats)), nil
+	})
+	values := collect(t, exporter)
+
+	expected := map[string]float64{
+		"haproxy_up{}": 1,
+		"haproxy_backend_current_sessions{backend=be_http_ns_route,namespace=ns,route=route}":              2,
+		"haproxy_backend_sessions_total{backend=be_http_ns_route,namespace=ns,route=route}":                42,
+		"haproxy_backend_http_requests_total{backend=be_http_ns_route,namespace=ns,route=route}":           50,
+		"haproxy_backend_connection_errors_total{backend=be_http_ns_route,namespace=ns,route=route}":       1,
+		"haproxy_backend_up{backend=be_http_ns_route,namespace=ns,route=route}":                            1,
+		"haproxy_backend_average_response_time_seconds{backend=be_http_ns_route,namespace=ns,route=route}": 0.015,
+		"haproxy_backend_http_responses_total{backend=be_http_ns_route,code=2xx,namespace=ns,route=route}": 36,
+		"haproxy_backend_http_responses_total{backend=be_http_ns_route,code=5xx,namespace=ns,route=route}": 3,
+		"haproxy_backend_up{backend=be_tcp_other_db,namespace=other,route=db}":                             0,
+		"haproxy_backend_sessions_total{backend=be_tcp_other_db,namespace=other,route=db}":                 7,
+	}
+	for key, value := range expected {
+		actual, ok := values[key]
+		if !ok {
+			t.Errorf("expected metric %s", key)
+			continue
+		}
+		if actual != value {
+			t.Errorf("expected %s to be %v, got %v", key, value, actual)
+		}
+	}
+
+	// the tcp backend has no http requests or responses, the frontends, servers and the default
+	// backend are not reported
+	for key := range values {
+		if strings.Contains(key, "be_tcp_other_db") && strings.Contains(key, "http_") {
+			t.Errorf("unexpected metric %s", key)
+		}
+		if strings.Contains(key, "openshift_default") || strings.Contains(key, "public") || strings.Contains(key, "10.0.0.1") {
+			t.Errorf("unexpected metric %s", key)
+		}
+	}
+}
+
+func TestExporterCollectError(t *testing.T) {
+	exporter := newExporter(func() (io.ReadCloser, error) {
+		return nil, fmt.Errorf("connection refused")
+	})
+	values := collect(t, exporter)
+	if expected := map[string]float64{"haproxy_up{}": 0}; !reflect.DeepEqual(values, expected) {
+		t.Errorf("expected %v, got %v", expected, values)
+	}
+}
+
+func TestParseBackendName(t *testing.T) {
+	tests := []struct {
+		backend   string
+		namespace string
+		route     string
+		ok        bool
+	}{
+		{backend: "be_http_ns_route", namespace: "ns", route: "route", ok: true},
+		{backend: "be_edge_http_ns_my-route", namespace: "ns", route: "my-route", ok: true},
+		{backend: "be_tcp_my-ns_route", namespace: "my-ns", route: "route", ok: true},
+		{backend: "be_secure_ns_route", namespace: "ns", route: "route", ok: true},
+		{backend: "be_sni"},
+		{backend: "be_http_ns"},
+		{backend: "openshift_default"},
+	}
+	for _, test := range tests {
+		namespace, route, ok := parseBackendName(test.backend)
+		if namespace != test.namespace || route != test.route || ok != test.ok {
+			t.Errorf("%s: expected %q, %q, %t, got %q, %q, %t", test.backend, test.namespace, test.route, test.ok, namespace, route, ok)
+		}
+	}
+}
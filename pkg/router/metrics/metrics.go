@@ -0,0 +1,87 @@
+// Package metrics contains the Prometheus metrics of the routers and the HTTP endpoint
+// serving them.
+package metrics
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/golang/glog"
+	"github.com/prometheus/client_golang/prometheus"
+
+	utilruntime "k8s.io/kubernetes/pkg/util/runtime"
+)
+
+const (
+	namespace = "openshift"
+	subsystem = "router"
+)
+
+var (
+	// ReloadDuration observes how long the router takes to apply a new configuration
+	// with a reload.
+	ReloadDuration = prometheus.NewSummary(
+		prometheus.SummaryOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "reload_duration_seconds",
+			Help:      "Time taken to reload the router with a new configuration, in seconds",
+		},
+	)
+	// ReloadFailures counts the reloads of the router that failed.
+	ReloadFailures = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "reload_failures_total",
+			Help:      "Number of reloads of the router that failed",
+		},
+	)
+	// RoutesAdmitted counts the times the router changed the status of a route to
+	// admitted.
+	RoutesAdmitted = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "routes_admitted_total",
+			Help:      "Number of times the router changed the status of a route to admitted",
+		},
+	)
+	// RoutesRejected counts the times the router changed the status of a route to
+	// rejected, by the reason of the rejection.
+	RoutesRejected = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "routes_rejected_total",
+			Help:      "Number of times the router changed the status of a route to rejected, broken out by reason",
+		},
+		[]string{"reason"},
+	)
+
+	registerOnce sync.Once
+)
+
+// Register registers the router metrics with the default Prometheus registry. It may
+// be called more than once.
+func Register() {
+	registerOnce.Do(func() {
+		prometheus.MustRegister(ReloadDuration)
+		prometheus.MustRegister(ReloadFailures)
+		prometheus.MustRegister(RoutesAdmitted)
+		prometheus.MustRegister(RoutesRejected)
+	})
+}
+
+// Listen registers the router metrics and serves the metrics of the default Prometheus
+// registry on /metrics of address in the background.
+func Listen(address string) {
+	Register()
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", prometheus.Handler())
+	glog.Infof("Serving the router metrics on %s/metrics", address)
+	go func() {
+		utilruntime.HandleError(http.ListenAndServe(address, mux))
+	}()
+}
@@ -0,0 +1,120 @@
+package router
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	kerrors "k8s.io/kubernetes/pkg/util/errors"
+
+	routeapi "github.com/openshift/origin/pkg/route/api"
+)
+
+const (
+	// TimeoutAnnotation sets how long the router waits for the service of a route to
+	// respond, as a duration like 30s or 5m.
+	TimeoutAnnotation = "router.openshift.io/timeout"
+	// RateLimitConnectionsAnnotation sets the number of new connections per second a
+	// client IP address may open to a route.
+	RateLimitConnectionsAnnotation = "router.openshift.io/rate-limit-connections"
+	// IPWhitelistAnnotation sets the space separated IP addresses and CIDR ranges of the
+	// clients allowed to access a route.
+	IPWhitelistAnnotation = "router.openshift.io/ip-whitelist"
+
+	// MaxIPWhitelistEntries is the largest number of entries of the IP whitelist of a
+	// route.
+	MaxIPWhitelistEntries = 50
+)
+
+// SettingsAnnotations are the annotations setting the settings of a route.
+var SettingsAnnotations = []string{TimeoutAnnotation, RateLimitConnectionsAnnotation, IPWhitelistAnnotation}
+
+// RouteSettings are the settings of a route set with the route annotations. The zero
+// value applies the defaults of the router to the route.
+type RouteSettings struct {
+	// Timeout is how long the router waits for the service to respond, or zero for the
+	// default of the router.
+	Timeout time.Duration
+	// RateLimitConnections is the number of new connections per second a client IP
+	// address may open to the route, or zero for no limit.
+	RateLimitConnections int
+	// IPWhitelist are the IP addresses and CIDR ranges of the clients allowed to access
+	// the route, or empty to allow all clients.
+	IPWhitelist []string
+}
+
+// InvalidAnnotationError describes an annotation of a route with an invalid value.
+type InvalidAnnotationError struct {
+	// Annotation is the key of the annotation
+	Annotation string
+	// Value is the value of the annotation
+	Value string
+	// Message describes the values the annotation accepts
+	Message string
+}
+
+func (e *InvalidAnnotationError) Error() string {
+	return fmt.Sprintf("%s %s, got %q", e.Annotation, e.Message, e.Value)
+}
+
+// ParseRouteSettings returns the settings of the route set with its annotations, or an
+// aggregate of an InvalidAnnotationError for each annotation with an invalid value.
+func ParseRouteSettings(route *routeapi.Route) (RouteSettings, error) {
+	settings := RouteSettings{}
+	errs := []error{}
+	invalid := func(annotation, value, message string) {
+		errs = append(errs, &InvalidAnnotationError{Annotation: annotation, Value: value, Message: message})
+	}
+
+	if value, ok := route.Annotations[TimeoutAnnotation]; ok {
+		timeout, err := time.ParseDuration(value)
+		if err != nil || timeout < time.Millisecond {
+			invalid(TimeoutAnnotation, value, "must be a duration of at least 1ms")
+		} else {
+			settings.Timeout = timeout
+		}
+	}
+
+	if value, ok := route.Annotations[RateLimitConnectionsAnnotation]; ok {
+		rate, err := strconv.Atoi(value)
+		if err != nil || rate <= 0 {
+			invalid(RateLimitConnectionsAnnotation, value, "must be a positive integer")
+		} else {
+			settings.RateLimitConnections = rate
+		}
+	}
+
+	if value, ok := route.Annotations[IPWhitelistAnnotation]; ok {
+		whitelist := strings.Fields(value)
+		switch {
+		case len(whitelist) == 0:
+			invalid(IPWhitelistAnnotation, value, "must list at least one IP address or CIDR range")
+		case len(whitelist) > MaxIPWhitelistEntries:
+			invalid(IPWhitelistAnnotation, value, fmt.Sprintf("must list at most %d IP addresses or CIDR ranges", MaxIPWhitelistEntries))
+		default:
+			valid := true
+			for _, entry := range whitelist {
+				if !isIPOrCIDR(entry) {
+					invalid(IPWhitelistAnnotation, value, fmt.Sprintf("must list IP addresses or CIDR ranges, %q is neither", entry))
+					valid = false
+				}
+			}
+			if valid {
+				settings.IPWhitelist = whitelist
+			}
+		}
+	}
+
+	return settings, kerrors.NewAggregate(errs)
+}
+
+// isIPOrCIDR returns true if value is an IP address or a CIDR range.
+func isIPOrCIDR(value string) bool {
+	if strings.Contains(value, "/") {
+		_, _, err := net.ParseCIDR(value)
+		return err == nil
+	}
+	return net.ParseIP(value) != nil
+}
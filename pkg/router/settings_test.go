@@ -0,0 +1,69 @@
+package router
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	routeapi "github.com/openshift/origin/pkg/route/api"
+)
+
+func TestParseRouteSettings(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		expected    RouteSettings
+		errors      bool
+	}{
+		{
+			name:     "no annotations",
+			expected: RouteSettings{},
+		},
+		{
+			name: "valid annotations",
+			annotations: map[string]string{
+				TimeoutAnnotation:              "5m",
+				RateLimitConnectionsAnnotation: "20",
+				IPWhitelistAnnotation:          "10.1.2.3 192.168.0.0/16  fd00::/8",
+				"other":                        "ignored",
+			},
+			expected: RouteSettings{
+				Timeout:              5 * time.Minute,
+				RateLimitConnections: 20,
+				IPWhitelist:          []string{"10.1.2.3", "192.168.0.0/16", "fd00::/8"},
+			},
+		},
+		{
+			name: "invalid annotations",
+			annotations: map[string]string{
+				TimeoutAnnotation:              "5",
+				RateLimitConnectionsAnnotation: "0",
+				IPWhitelistAnnotation:          "10.1.2.3 example.com",
+			},
+			expected: RouteSettings{},
+			errors:   true,
+		},
+		{
+			name: "some invalid annotations",
+			annotations: map[string]string{
+				TimeoutAnnotation:     "30s",
+				IPWhitelistAnnotation: " ",
+			},
+			expected: RouteSettings{Timeout: 30 * time.Second},
+			errors:   true,
+		},
+	}
+
+	for _, test := range tests {
+		route := &routeapi.Route{ObjectMeta: kapi.ObjectMeta{Annotations: test.annotations}}
+		settings, err := ParseRouteSettings(route)
+		if (err != nil) != test.errors {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		}
+		if !reflect.DeepEqual(settings, test.expected) {
+			t.Errorf("%s: expected settings %#v, got %#v", test.name, test.expected, settings)
+		}
+	}
+}
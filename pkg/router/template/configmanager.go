@@ -0,0 +1,242 @@
+package templaterouter
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+
+	routeapi "github.com/openshift/origin/pkg/route/api"
+)
+
+const (
+	// dynamicServerPrefix prefixes the names of the server slots the templates add to
+	// each backend.
+	dynamicServerPrefix = "_dynamic-pod-"
+
+	// runtimeAPITimeout is how long to wait for a command of the runtime API.
+	runtimeAPITimeout = 10 * time.Second
+)
+
+// ConfigManager applies the changes of the endpoints of the routes to the running router
+// without reloading it.
+type ConfigManager interface {
+	// Initialize records the backends of the configuration the router was last reloaded
+	// with.
+	Initialize(state map[string]ServiceUnit)
+	// ReplaceEndpoints replaces the servers of the backend of the route keyed by routeKey
+	// with the endpoints of its service units in state, or returns an error if the router
+	// has to be reloaded to apply the change.
+	ReplaceEndpoints(routeKey string, cfg ServiceAliasConfig, state map[string]ServiceUnit) error
+}
+
+// dynamicServerNames returns the names of the server slots the templates add to each
+// backend.
+func dynamicServerNames(slots int) []string {
+	names := make([]string, 0, slots)
+	for i := 1; i <= slots; i++ {
+		names = append(names, fmt.Sprintf("%s%d", dynamicServerPrefix, i))
+	}
+	return names
+}
+
+// haproxyServer is a server of a backend of the running HAProxy configuration.
+type haproxyServer struct {
+	// name is the name of the server in the backend
+	name string
+	// weight is the weight of the server
+	weight int32
+	// dynamic is true if the server is one of the server slots of the backend
+	dynamic bool
+}
+
+// haproxyBackend tracks the servers of a backend of the running HAProxy configuration.
+type haproxyBackend struct {
+	// servers are the servers sending traffic to an endpoint, keyed by the name the
+	// templates give the server of the endpoint
+	servers map[string]haproxyServer
+	// free are the names of the server slots not sending traffic to an endpoint
+	free []string
+}
+
+// haproxyConfigManager updates the servers of the backends of HAProxy with the runtime
+// API of its stats socket. The templates add disabled server slots to each backend, the
+// slots are pointed at new endpoints and servers of removed endpoints are put in
+// maintenance. Changes needing more slots than a backend has left require a reload. The
+// templates derive the session cookies of the servers from their addresses, a slot pointed
+// at a new endpoint doesn't take over the sessions of its previous endpoint.
+type haproxyConfigManager struct {
+	// slots are the names of the server slots of each backend
+	slots []string
+	// backends are the backends of the running configuration, keyed by name
+	backends map[string]*haproxyBackend
+	// execute runs a command of the runtime API and returns its output
+	execute func(command string) (string, error)
+}
+
+// newHAProxyConfigManager returns a ConfigManager using the HAProxy stats socket at
+// socketPath. The set server addr command of the runtime API requires HAProxy 1.7, the
+// router base image installs HAProxy 1.8.
+func newHAProxyConfigManager(socketPath string, slots []string) *haproxyConfigManager {
+	return &haproxyConfigManager{
+		slots:    slots,
+		backends: make(map[string]*haproxyBackend),
+		execute: func(command string) (string, error) {
+			return executeSocketCommand(socketPath, command)
+		},
+	}
+}
+
+// executeSocketCommand runs a command on the HAProxy stats socket at socketPath. HAProxy
+// closes the connection after the output of the command.
+func executeSocketCommand(socketPath, command string) (string, error) {
+	conn, err := net.DialTimeout("unix", socketPath, runtimeAPITimeout)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(runtimeAPITimeout))
+
+	if _, err := fmt.Fprintf(conn, "%s\n", command); err != nil {
+		return "", err
+	}
+	out, err := ioutil.ReadAll(conn)
+	return string(out), err
+}
+
+// haproxyBackendName returns the name the HAProxy template gives the backend of the
+// route keyed by routeKey.
+func haproxyBackendName(routeKey string, cfg ServiceAliasConfig) string {
+	switch cfg.TLSTermination {
+	case "":
+		return "be_http_" + routeKey
+	case routeapi.TLSTerminationEdge:
+		return "be_edge_http_" + routeKey
+	case routeapi.TLSTerminationPassthrough:
+		return "be_tcp_" + routeKey
+	case routeapi.TLSTerminationReencrypt:
+		return "be_secure_" + routeKey
+	}
+	return ""
+}
+
+// backendServersByName returns the servers of the backend of cfg keyed by name.
+func backendServersByName(cfg ServiceAliasConfig, state map[string]ServiceUnit) map[string]BackendServer {
+	servers := make(map[string]BackendServer)
+	for _, server := range backendServers(cfg, state) {
+		servers[server.Name] = server
+	}
+	return servers
+}
+
+// Initialize records the servers the templates wrote for the endpoints in state and
+// frees all the server slots.
+func (m *haproxyConfigManager) Initialize(state map[string]ServiceUnit) {
+	m.backends = make(map[string]*haproxyBackend)
+	for _, serviceUnit := range state {
+		for routeKey, cfg := range serviceUnit.ServiceAliasConfigs {
+			name := haproxyBackendName(routeKey, cfg)
+			if len(name) == 0 {
+				continue
+			}
+			backend := &haproxyBackend{
+				servers: make(map[string]haproxyServer),
+				free:    append([]string(nil), m.slots...),
+			}
+			for id, endpoint := range backendServersByName(cfg, state) {
+				backend.servers[id] = haproxyServer{name: id, weight: endpoint.Weight}
+			}
+			m.backends[name] = backend
+		}
+	}
+}
+
+// ReplaceEndpoints puts the servers of the removed endpoints in maintenance and points
+// free server slots at the new endpoints.
+func (m *haproxyConfigManager) ReplaceEndpoints(routeKey string, cfg ServiceAliasConfig, state map[string]ServiceUnit) error {
+	name := haproxyBackendName(routeKey, cfg)
+	backend, ok := m.backends[name]
+	if !ok {
+		return fmt.Errorf("backend %s is not in the running configuration", name)
+	}
+	endpoints := backendServersByName(cfg, state)
+
+	for _, id := range sortedServerIDs(backend.servers) {
+		server := backend.servers[id]
+		if _, ok := endpoints[id]; ok {
+			continue
+		}
+		if err := m.run(fmt.Sprintf("set server %s/%s state maint", name, server.name)); err != nil {
+			return err
+		}
+		delete(backend.servers, id)
+		if server.dynamic {
+			backend.free = append(backend.free, server.name)
+		}
+	}
+
+	ids := make([]string, 0, len(endpoints))
+	for id := range endpoints {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		endpoint := endpoints[id]
+		if server, ok := backend.servers[id]; ok {
+			if server.weight != endpoint.Weight {
+				if err := m.run(fmt.Sprintf("set weight %s/%s %d", name, server.name, endpoint.Weight)); err != nil {
+					return err
+				}
+				server.weight = endpoint.Weight
+				backend.servers[id] = server
+			}
+			continue
+		}
+
+		if len(backend.free) == 0 {
+			return fmt.Errorf("backend %s has no free server slots for endpoint %s", name, id)
+		}
+		server := haproxyServer{name: backend.free[0], weight: endpoint.Weight, dynamic: true}
+		commands := []string{
+			fmt.Sprintf("set server %s/%s addr %s port %s", name, server.name, endpoint.IP, endpoint.Port),
+			fmt.Sprintf("set weight %s/%s %d", name, server.name, endpoint.Weight),
+			fmt.Sprintf("set server %s/%s state ready", name, server.name),
+		}
+		for _, command := range commands {
+			if err := m.run(command); err != nil {
+				return err
+			}
+		}
+		backend.free = backend.free[1:]
+		backend.servers[id] = server
+	}
+	return nil
+}
+
+// run executes a command of the runtime API and returns an error if HAProxy rejected it.
+// Successful commands print nothing, except for the changes of server addresses.
+func (m *haproxyConfigManager) run(command string) error {
+	glog.V(4).Infof("Running the HAProxy command %q", command)
+	out, err := m.execute(command)
+	if err != nil {
+		return fmt.Errorf("error running the HAProxy command %q: %v", command, err)
+	}
+	out = strings.TrimSpace(out)
+	if len(out) > 0 && !strings.Contains(out, "changed from") && !strings.Contains(out, "no need to change") {
+		return fmt.Errorf("HAProxy rejected the command %q: %s", command, out)
+	}
+	return nil
+}
+
+func sortedServerIDs(servers map[string]haproxyServer) []string {
+	ids := make([]string, 0, len(servers))
+	for id := range servers {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
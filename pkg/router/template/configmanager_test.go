@@ -0,0 +1,194 @@
+package templaterouter
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	routeapi "github.com/openshift/origin/pkg/route/api"
+)
+
+// newTestConfigManager returns a config manager with two server slots per backend that
+// records the commands it runs and rejects the commands containing reject
+func newTestConfigManager(commands *[]string, reject string) *haproxyConfigManager {
+	return &haproxyConfigManager{
+		slots:    dynamicServerNames(2),
+		backends: make(map[string]*haproxyBackend),
+		execute: func(command string) (string, error) {
+			*commands = append(*commands, command)
+			if len(reject) > 0 && strings.Contains(command, reject) {
+				return "No such server.\n", nil
+			}
+			if strings.Contains(command, " addr ") {
+				return "IP changed from '127.0.0.1' to '10.0.0.3', port changed from '8765' to '8080' by 'stats socket command'\n", nil
+			}
+			return "\n", nil
+		},
+	}
+}
+
+func TestDynamicServerNames(t *testing.T) {
+	expected := []string{"_dynamic-pod-1", "_dynamic-pod-2", "_dynamic-pod-3"}
+	if names := dynamicServerNames(3); !reflect.DeepEqual(names, expected) {
+		t.Errorf("expected %v, got %v", expected, names)
+	}
+	if names := dynamicServerNames(0); len(names) != 0 {
+		t.Errorf("expected no names, got %v", names)
+	}
+}
+
+func TestHAProxyBackendName(t *testing.T) {
+	tests := map[routeapi.TLSTerminationType]string{
+		"":                                 "be_http_ns_name",
+		routeapi.TLSTerminationEdge:        "be_edge_http_ns_name",
+		routeapi.TLSTerminationPassthrough: "be_tcp_ns_name",
+		routeapi.TLSTerminationReencrypt:   "be_secure_ns_name",
+		"unknown":                          "",
+	}
+	for termination, expected := range tests {
+		if name := haproxyBackendName("ns_name", ServiceAliasConfig{TLSTermination: termination}); name != expected {
+			t.Errorf("%q: expected backend %q, got %q", termination, expected, name)
+		}
+	}
+}
+
+func TestHAProxyConfigManagerReplaceEndpoints(t *testing.T) {
+	cfg := ServiceAliasConfig{Host: "www.example.com", ServiceUnitNames: map[string]int32{"ns/svc": 2}}
+	state := map[string]ServiceUnit{
+		"ns/svc": {
+			Name:                "ns/svc",
+			ServiceAliasConfigs: map[string]ServiceAliasConfig{"ns_route": cfg},
+			EndpointTable: []Endpoint{
+				{ID: "10.0.0.1:8080", IP: "10.0.0.1", Port: "8080"},
+				{ID: "10.0.0.2:8080", IP: "10.0.0.2", Port: "8080"},
+			},
+		},
+	}
+
+	commands := []string{}
+	manager := newTestConfigManager(&commands, "")
+	manager.Initialize(state)
+
+	// remove a server of the config file and add two endpoints
+	svc := state["ns/svc"]
+	svc.EndpointTable = []Endpoint{
+		{ID: "10.0.0.2:8080", IP: "10.0.0.2", Port: "8080"},
+		{ID: "10.0.0.3:8080", IP: "10.0.0.3", Port: "8080"},
+		{ID: "10.0.0.4:8080", IP: "10.0.0.4", Port: "8080"},
+	}
+	state["ns/svc"] = svc
+	if err := manager.ReplaceEndpoints("ns_route", cfg, state); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []string{
+		"set server be_http_ns_route/ns:svc:10.0.0.1:8080 state maint",
+		"set server be_http_ns_route/_dynamic-pod-1 addr 10.0.0.3 port 8080",
+		"set weight be_http_ns_route/_dynamic-pod-1 256",
+		"set server be_http_ns_route/_dynamic-pod-1 state ready",
+		"set server be_http_ns_route/_dynamic-pod-2 addr 10.0.0.4 port 8080",
+		"set weight be_http_ns_route/_dynamic-pod-2 256",
+		"set server be_http_ns_route/_dynamic-pod-2 state ready",
+	}
+	if !reflect.DeepEqual(commands, expected) {
+		t.Errorf("expected commands %v, got %v", expected, commands)
+	}
+
+	// removing an endpoint served by a slot frees the slot for the next endpoint
+	commands = commands[:0]
+	svc.EndpointTable = []Endpoint{
+		{ID: "10.0.0.2:8080", IP: "10.0.0.2", Port: "8080"},
+		{ID: "10.0.0.4:8080", IP: "10.0.0.4", Port: "8080"},
+		{ID: "10.0.0.5:8080", IP: "10.0.0.5", Port: "8080"},
+	}
+	state["ns/svc"] = svc
+	if err := manager.ReplaceEndpoints("ns_route", cfg, state); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected = []string{
+		"set server be_http_ns_route/_dynamic-pod-1 state maint",
+		"set server be_http_ns_route/_dynamic-pod-1 addr 10.0.0.5 port 8080",
+		"set weight be_http_ns_route/_dynamic-pod-1 256",
+		"set server be_http_ns_route/_dynamic-pod-1 state ready",
+	}
+	if !reflect.DeepEqual(commands, expected) {
+		t.Errorf("expected commands %v, got %v", expected, commands)
+	}
+
+	// all the slots are in use
+	svc.EndpointTable = append(svc.EndpointTable, Endpoint{ID: "10.0.0.6:8080", IP: "10.0.0.6", Port: "8080"})
+	state["ns/svc"] = svc
+	if err := manager.ReplaceEndpoints("ns_route", cfg, state); err == nil {
+		t.Errorf("expected an error without free server slots")
+	}
+
+	// the slots are freed when the manager is initialized after a reload
+	manager.Initialize(state)
+	if free := manager.backends["be_http_ns_route"].free; len(free) != 2 {
+		t.Errorf("expected 2 free slots after a reload, got %v", free)
+	}
+}
+
+func TestHAProxyConfigManagerWeightedServiceUnits(t *testing.T) {
+	cfg := ServiceAliasConfig{Host: "www.example.com", ServiceUnitNames: map[string]int32{"ns/a": 1, "ns/b": 1}}
+	state := map[string]ServiceUnit{
+		"ns/a": {
+			Name:                "ns/a",
+			ServiceAliasConfigs: map[string]ServiceAliasConfig{"ns_route": cfg},
+			EndpointTable:       []Endpoint{{ID: "10.0.0.1:8080", IP: "10.0.0.1", Port: "8080"}},
+		},
+		"ns/b": {
+			Name:          "ns/b",
+			EndpointTable: []Endpoint{{ID: "10.0.0.1:8080", IP: "10.0.0.1", Port: "8080"}},
+		},
+	}
+
+	commands := []string{}
+	manager := newTestConfigManager(&commands, "")
+	manager.Initialize(state)
+	servers := manager.backends["be_http_ns_route"].servers
+	if len(servers) != 2 {
+		t.Fatalf("expected a server for the endpoint of each service, got %v", servers)
+	}
+
+	// the weight of a service is divided across its endpoints
+	b := state["ns/b"]
+	b.EndpointTable = append(b.EndpointTable, Endpoint{ID: "10.0.0.2:8080", IP: "10.0.0.2", Port: "8080"})
+	state["ns/b"] = b
+	if err := manager.ReplaceEndpoints("ns_route", cfg, state); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []string{
+		"set weight be_http_ns_route/ns:b:10.0.0.1:8080 128",
+		"set server be_http_ns_route/_dynamic-pod-1 addr 10.0.0.2 port 8080",
+		"set weight be_http_ns_route/_dynamic-pod-1 128",
+		"set server be_http_ns_route/_dynamic-pod-1 state ready",
+	}
+	if !reflect.DeepEqual(commands, expected) {
+		t.Errorf("expected commands %v, got %v", expected, commands)
+	}
+}
+
+func TestHAProxyConfigManagerErrors(t *testing.T) {
+	cfg := ServiceAliasConfig{Host: "www.example.com", ServiceUnitNames: map[string]int32{"ns/svc": 1}}
+	state := map[string]ServiceUnit{
+		"ns/svc": {
+			Name:                "ns/svc",
+			ServiceAliasConfigs: map[string]ServiceAliasConfig{"ns_route": cfg},
+			EndpointTable:       []Endpoint{{ID: "10.0.0.1:8080", IP: "10.0.0.1", Port: "8080"}},
+		},
+	}
+
+	commands := []string{}
+	manager := newTestConfigManager(&commands, "state maint")
+	if err := manager.ReplaceEndpoints("ns_route", cfg, state); err == nil {
+		t.Errorf("expected an error for a backend not in the running configuration")
+	}
+
+	manager.Initialize(state)
+	svc := state["ns/svc"]
+	svc.EndpointTable = []Endpoint{}
+	state["ns/svc"] = svc
+	if err := manager.ReplaceEndpoints("ns_route", cfg, state); err == nil {
+		t.Errorf("expected an error for a rejected command")
+	}
+}
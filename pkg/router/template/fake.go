@@ -1,12 +1,15 @@
 package templaterouter
 
+import "k8s.io/kubernetes/pkg/util/sets"
+
 // newFakeTemplateRouter provides an empty template router with a simple certificate manager
 // backed by a fake cert writer for testing
 func newFakeTemplateRouter() *templateRouter {
 	fakeCertManager, _ := newSimpleCertificateManager(newFakeCertificateManagerConfig(), &fakeCertWriter{})
 	return &templateRouter{
-		state:       map[string]ServiceUnit{},
-		certManager: fakeCertManager,
+		state:               map[string]ServiceUnit{},
+		certManager:         fakeCertManager,
+		changedServiceUnits: sets.NewString(),
 	}
 }
 
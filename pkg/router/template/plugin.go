@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strconv"
 	"text/template"
 	"time"
@@ -43,6 +44,9 @@ type TemplatePluginConfig struct {
 	StatsPassword          string
 	IncludeUDP             bool
 	PeerService            *ktypes.NamespacedName
+	AllowWildcardRoutes    bool
+	DynamicServerSlots     int
+	RuntimeAPISocket       string
 }
 
 // routerInterface controls the interaction of the plugin with the underlying router implementation
@@ -66,8 +70,9 @@ type routerInterface interface {
 	// AddRoute adds a route for the given id and the calculated host.  Returns true if a
 	// change was made and the state should be stored with Commit().
 	AddRoute(id string, route *routeapi.Route, host string) bool
-	// RemoveRoute removes the given route for the given id.
-	RemoveRoute(id string, route *routeapi.Route)
+	// RemoveRoute removes the given route for the given id.  Returns true if a change was
+	// made and the state should be stored with Commit().
+	RemoveRoute(id string, route *routeapi.Route) bool
 	// Reduce the list of routes to only these namespaces
 	FilterNamespaces(namespaces sets.String)
 	// Commit applies the changes in the background. It kicks off a rate-limited
@@ -83,12 +88,31 @@ func env(name, defaultValue string) string {
 	return defaultValue
 }
 
+// genSubdomainWildcardRegexp returns a regular expression matching the hosts of the
+// domain of host, with an optional port, followed by path. For www.example.com and
+// /foo it matches bar.example.com/foo and bar.example.com:8080/foo/bar.
+func genSubdomainWildcardRegexp(host, path string) string {
+	expr := `^[^\.]*\.` + regexp.QuoteMeta(routeapi.GetDomainForHost(host)) + `(:[0-9]+)?`
+	if len(path) == 0 {
+		return expr + `(/.*)?$`
+	}
+	return expr + regexp.QuoteMeta(path)
+}
+
+// milliseconds returns the number of whole milliseconds of d, for the templates.
+func milliseconds(d time.Duration) int64 {
+	return int64(d / time.Millisecond)
+}
+
 // NewTemplatePlugin creates a new TemplatePlugin.
 func NewTemplatePlugin(cfg TemplatePluginConfig) (*TemplatePlugin, error) {
 	templateBaseName := filepath.Base(cfg.TemplatePath)
 	globalFuncs := template.FuncMap{
-		"endpointsForAlias": endpointsForAlias,
-		"env":               env,
+		"backendServers":             backendServers,
+		"endpointsForAlias":          endpointsForAlias,
+		"env":                        env,
+		"genSubdomainWildcardRegexp": genSubdomainWildcardRegexp,
+		"milliseconds":               milliseconds,
 	}
 	masterTemplate, err := template.New("config").Funcs(globalFuncs).ParseFiles(cfg.TemplatePath)
 	if err != nil {
@@ -120,6 +144,9 @@ func NewTemplatePlugin(cfg TemplatePluginConfig) (*TemplatePlugin, error) {
 		statsPassword:          cfg.StatsPassword,
 		statsPort:              cfg.StatsPort,
 		peerEndpointsKey:       peerKey,
+		allowWildcardRoutes:    cfg.AllowWildcardRoutes,
+		dynamicServerSlots:     cfg.DynamicServerSlots,
+		runtimeAPISocket:       cfg.RuntimeAPISocket,
 	}
 	router, err := newTemplateRouter(templateRouterCfg)
 	return newDefaultTemplatePlugin(router, cfg.IncludeUDP), err
@@ -164,9 +191,11 @@ func (p *TemplatePlugin) HandleRoute(eventType watch.EventType, route *routeapi.
 
 	switch eventType {
 	case watch.Added, watch.Modified:
-		if _, ok := p.Router.FindServiceUnit(key); !ok {
-			glog.V(4).Infof("Creating new frontend for key: %v", key)
-			p.Router.CreateServiceUnit(key)
+		for serviceUnitKey := range routeServiceUnitNames(route) {
+			if _, ok := p.Router.FindServiceUnit(serviceUnitKey); !ok {
+				glog.V(4).Infof("Creating new frontend for key: %v", serviceUnitKey)
+				p.Router.CreateServiceUnit(serviceUnitKey)
+			}
 		}
 
 		glog.V(4).Infof("Modifying routes for %s", key)
@@ -176,8 +205,10 @@ func (p *TemplatePlugin) HandleRoute(eventType watch.EventType, route *routeapi.
 		}
 	case watch.Deleted:
 		glog.V(4).Infof("Deleting routes for %s", key)
-		p.Router.RemoveRoute(key, route)
-		p.Router.Commit()
+		commit := p.Router.RemoveRoute(key, route)
+		if commit {
+			p.Router.Commit()
+		}
 	}
 	return nil
 }
@@ -195,6 +226,18 @@ func routeKey(route *routeapi.Route) string {
 	return fmt.Sprintf("%s/%s", route.Namespace, route.Spec.To.Name)
 }
 
+// routeServiceUnitNames returns the internal router keys of the services the given Route
+// sends traffic to, mapped to their weights.  THESE MUST FOLLOW THE KEY STRATEGY OF routeKey.
+func routeServiceUnitNames(route *routeapi.Route) map[string]int32 {
+	names := map[string]int32{
+		routeKey(route): routeapi.BackendWeight(route.Spec.Weight),
+	}
+	for _, backend := range route.Spec.AlternateBackends {
+		names[fmt.Sprintf("%s/%s", route.Namespace, backend.Name)] = routeapi.BackendWeight(backend.Weight)
+	}
+	return names
+}
+
 // endpointsKey returns the internal router key to use for the given Endpoints.
 func endpointsKey(endpoints *kapi.Endpoints) string {
 	return fmt.Sprintf("%s/%s", endpoints.Namespace, endpoints.Name)
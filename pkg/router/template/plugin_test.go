@@ -3,6 +3,7 @@ package templaterouter
 import (
 	"fmt"
 	"reflect"
+	"regexp"
 	"strings"
 	"testing"
 	"time"
@@ -89,13 +90,14 @@ func (r *TestRouter) AddRoute(id string, route *routeapi.Route, host string) boo
 }
 
 // RemoveRoute removes the service alias config for Route from the ServiceUnit
-func (r *TestRouter) RemoveRoute(id string, route *routeapi.Route) {
+func (r *TestRouter) RemoveRoute(id string, route *routeapi.Route) bool {
 	r.Committed = false //expect any call to this method to subsequently call commit
 	if _, ok := r.State[id]; !ok {
-		return
+		return false
 	} else {
 		delete(r.State[id].ServiceAliasConfigs, r.routeKey(route))
 	}
+	return true
 }
 
 func (r *TestRouter) FilterNamespaces(namespaces sets.String) {
@@ -204,7 +206,7 @@ func TestHandleEndpoints(t *testing.T) {
 	templatePlugin := newDefaultTemplatePlugin(router, true)
 	// TODO: move tests that rely on unique hosts to pkg/router/controller and remove them from
 	// here
-	plugin := controller.NewUniqueHost(templatePlugin, controller.HostForRoute, controller.LogRejections)
+	plugin := controller.NewUniqueHost(templatePlugin, controller.HostForRoute, false, controller.LogRejections)
 
 	for _, tc := range testCases {
 		plugin.HandleEndpoints(tc.eventType, tc.endpoints)
@@ -315,7 +317,7 @@ func TestHandleTCPEndpoints(t *testing.T) {
 	templatePlugin := newDefaultTemplatePlugin(router, false)
 	// TODO: move tests that rely on unique hosts to pkg/router/controller and remove them from
 	// here
-	plugin := controller.NewUniqueHost(templatePlugin, controller.HostForRoute, controller.LogRejections)
+	plugin := controller.NewUniqueHost(templatePlugin, controller.HostForRoute, false, controller.LogRejections)
 
 	for _, tc := range testCases {
 		plugin.HandleEndpoints(tc.eventType, tc.endpoints)
@@ -361,7 +363,7 @@ func TestHandleRoute(t *testing.T) {
 	templatePlugin := newDefaultTemplatePlugin(router, true)
 	// TODO: move tests that rely on unique hosts to pkg/router/controller and remove them from
 	// here
-	plugin := controller.NewUniqueHost(templatePlugin, controller.HostForRoute, rejections)
+	plugin := controller.NewUniqueHost(templatePlugin, controller.HostForRoute, false, rejections)
 
 	original := unversioned.Time{Time: time.Now()}
 
@@ -536,12 +538,252 @@ func TestHandleRoute(t *testing.T) {
 	}
 }
 
+// TestHandleRouteAlternateBackends tests that service units are created for the alternate
+// backends of a route
+func TestHandleRouteAlternateBackends(t *testing.T) {
+	router := newTestRouter(make(map[string]ServiceUnit))
+	plugin := newDefaultTemplatePlugin(router, true)
+
+	route := &routeapi.Route{
+		ObjectMeta: kapi.ObjectMeta{Namespace: "foo", Name: "test"},
+		Spec: routeapi.RouteSpec{
+			Host: "www.example.com",
+			To: kapi.ObjectReference{
+				Name: "TestService",
+			},
+			AlternateBackends: []routeapi.RouteTargetReference{
+				{Kind: "Service", Name: "TestService2"},
+			},
+		},
+	}
+	plugin.HandleRoute(watch.Added, route)
+
+	for _, key := range []string{"foo/TestService", "foo/TestService2"} {
+		if _, ok := router.FindServiceUnit(key); !ok {
+			t.Errorf("expected service unit %s to be created, got %#v", key, router.State)
+		}
+	}
+	if su := router.State["foo/TestService2"]; len(su.ServiceAliasConfigs) != 0 {
+		t.Errorf("expected the route to be added to the service unit of its primary service only, got %#v", su)
+	}
+}
+
+// TestHandleRouteWildcard tests that wildcard routes are only admitted when allowed and
+// that the namespace of the oldest route claiming a host of a wildcard domain owns the
+// domain
+func TestHandleRouteWildcard(t *testing.T) {
+	// routes are created in the order of the test
+	created := time.Now()
+	newRoute := func(namespace, name, host string, policy routeapi.WildcardPolicyType) *routeapi.Route {
+		created = created.Add(time.Second)
+		return &routeapi.Route{
+			ObjectMeta: kapi.ObjectMeta{Namespace: namespace, Name: name, CreationTimestamp: unversioned.Time{Time: created}},
+			Spec: routeapi.RouteSpec{
+				Host:           host,
+				To:             kapi.ObjectReference{Name: "TestService"},
+				WildcardPolicy: policy,
+			},
+		}
+	}
+
+	rejections := &fakeRejections{}
+	router := newTestRouter(make(map[string]ServiceUnit))
+	plugin := controller.NewUniqueHost(newDefaultTemplatePlugin(router, true), controller.HostForRoute, false, rejections)
+	if err := plugin.HandleRoute(watch.Added, newRoute("foo", "wildcard", "www.example.com", routeapi.WildcardPolicySubdomain)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := router.FindServiceUnit("foo/TestService"); ok || len(rejections.rejections) != 1 || rejections.rejections[0].reason != "WildcardPolicyNotAllowed" {
+		t.Fatalf("expected the wildcard route to be rejected: %#v", rejections)
+	}
+
+	rejections = &fakeRejections{}
+	router = newTestRouter(make(map[string]ServiceUnit))
+	plugin = controller.NewUniqueHost(newDefaultTemplatePlugin(router, true), controller.HostForRoute, true, rejections)
+	if err := plugin.HandleRoute(watch.Added, newRoute("foo", "wildcard", "www.example.com", routeapi.WildcardPolicySubdomain)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if r, ok := plugin.RoutesForHost("*.example.com"); !ok || r[0].Name != "wildcard" {
+		t.Fatalf("expected the wildcard route to claim *.example.com: %#v", r)
+	}
+
+	// wildcard routes can't claim a top level domain
+	if err := plugin.HandleRoute(watch.Added, newRoute("foo", "tld", "example.com", routeapi.WildcardPolicySubdomain)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if r, ok := plugin.RoutesForHost("*.com"); ok {
+		t.Fatalf("expected the wildcard route not to claim *.com: %#v", r)
+	}
+	if len(rejections.rejections) != 1 || rejections.rejections[0].reason != "InvalidHost" {
+		t.Fatalf("expected the wildcard route of a top level domain to be rejected: %#v", rejections)
+	}
+	rejections.rejections = nil
+
+	// routes of the owning namespace may claim hosts of the domain
+	if err := plugin.HandleRoute(watch.Added, newRoute("foo", "exact", "api.example.com", routeapi.WildcardPolicyNone)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// routes of other namespaces may not claim the domain or its hosts
+	if err := plugin.HandleRoute(watch.Added, newRoute("bar", "exact", "shop.example.com", routeapi.WildcardPolicyNone)); err == nil {
+		t.Fatalf("expected a host of the wildcard domain to be rejected")
+	}
+	if err := plugin.HandleRoute(watch.Added, newRoute("bar", "wildcard", "shop.example.com", routeapi.WildcardPolicySubdomain)); err == nil {
+		t.Fatalf("expected the claimed wildcard domain to be rejected")
+	}
+	if _, ok := router.FindServiceUnit("bar/TestService"); ok {
+		t.Fatalf("unexpected service unit: %#v", router.State)
+	}
+	if len(rejections.rejections) != 2 || rejections.rejections[0].reason != "HostAlreadyClaimed" || rejections.rejections[1].reason != "HostAlreadyClaimed" {
+		t.Fatalf("expected the routes of the other namespace to be rejected: %#v", rejections)
+	}
+
+	// a wildcard route can't claim a domain with hosts claimed by another namespace
+	if err := plugin.HandleRoute(watch.Added, newRoute("bar", "exact", "www.example.org", routeapi.WildcardPolicyNone)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := plugin.HandleRoute(watch.Added, newRoute("foo", "other", "www.example.org", routeapi.WildcardPolicySubdomain)); err == nil {
+		t.Fatalf("expected the wildcard route to be rejected")
+	}
+
+	// an older wildcard route reclaims the domain and its hosts from younger routes
+	older := newRoute("baz", "wildcard", "www.example.org", routeapi.WildcardPolicySubdomain)
+	older.CreationTimestamp = unversioned.Time{Time: older.CreationTimestamp.Add(-time.Hour)}
+	if err := plugin.HandleRoute(watch.Added, older); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if r, ok := plugin.RoutesForHost("*.example.org"); !ok || r[0].Namespace != "baz" {
+		t.Fatalf("expected the older wildcard route to claim *.example.org: %#v", r)
+	}
+	if r, ok := plugin.RoutesForHost("www.example.org"); ok {
+		t.Fatalf("expected the younger route to lose www.example.org: %#v", r)
+	}
+	if su, _ := router.FindServiceUnit("bar/TestService"); len(su.ServiceAliasConfigs) != 0 {
+		t.Fatalf("expected the younger route to be removed: %#v", su.ServiceAliasConfigs)
+	}
+
+	// an older route reclaims a host from a younger wildcard route
+	older = newRoute("bar", "shop", "shop.example.com", routeapi.WildcardPolicyNone)
+	older.CreationTimestamp = unversioned.Time{Time: older.CreationTimestamp.Add(-time.Hour)}
+	if err := plugin.HandleRoute(watch.Added, older); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if r, ok := plugin.RoutesForHost("*.example.com"); ok {
+		t.Fatalf("expected the younger wildcard route to lose *.example.com: %#v", r)
+	}
+}
+
+// TestHandleRouteWildcardNotAllowed tests that a route changed to a wildcard route on a
+// router not allowing them stops serving its host
+func TestHandleRouteWildcardNotAllowed(t *testing.T) {
+	rejections := &fakeRejections{}
+	router := newTestRouter(make(map[string]ServiceUnit))
+	plugin := controller.NewUniqueHost(newDefaultTemplatePlugin(router, true), controller.HostForRoute, false, rejections)
+
+	route := &routeapi.Route{
+		ObjectMeta: kapi.ObjectMeta{Namespace: "foo", Name: "test"},
+		Spec: routeapi.RouteSpec{
+			Host: "www.example.com",
+			To:   kapi.ObjectReference{Name: "TestService"},
+		},
+	}
+	if err := plugin.HandleRoute(watch.Added, route); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	wildcard := *route
+	wildcard.Spec.WildcardPolicy = routeapi.WildcardPolicySubdomain
+	if err := plugin.HandleRoute(watch.Modified, &wildcard); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(rejections.rejections) != 1 || rejections.rejections[0].reason != "WildcardPolicyNotAllowed" {
+		t.Fatalf("expected the wildcard route to be rejected: %#v", rejections)
+	}
+	if r, ok := plugin.RoutesForHost("www.example.com"); ok {
+		t.Fatalf("expected the route to release www.example.com: %#v", r)
+	}
+	if su, _ := router.FindServiceUnit("foo/TestService"); len(su.ServiceAliasConfigs) != 0 {
+		t.Fatalf("expected the route to be removed: %#v", su.ServiceAliasConfigs)
+	}
+
+	// another namespace may claim the released host
+	other := *route
+	other.Namespace = "bar"
+	if err := plugin.HandleRoute(watch.Added, &other); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// TestHandleRouteInvalidSettings tests that routes with invalid annotations are rejected
+func TestHandleRouteInvalidSettings(t *testing.T) {
+	rejections := &fakeRejections{}
+	router := newTestRouter(make(map[string]ServiceUnit))
+	plugin := controller.NewUniqueHost(newDefaultTemplatePlugin(router, true), controller.HostForRoute, false, rejections)
+
+	route := &routeapi.Route{
+		ObjectMeta: kapi.ObjectMeta{
+			Namespace:   "foo",
+			Name:        "test",
+			Annotations: map[string]string{"router.openshift.io/timeout": "forever"},
+		},
+		Spec: routeapi.RouteSpec{
+			Host: "www.example.com",
+			To:   kapi.ObjectReference{Name: "TestService"},
+		},
+	}
+	if err := plugin.HandleRoute(watch.Added, route); err == nil {
+		t.Fatalf("expected an error for the invalid annotation")
+	}
+	if _, ok := router.FindServiceUnit("foo/TestService"); ok {
+		t.Fatalf("unexpected service unit: %#v", router.State)
+	}
+	if len(rejections.rejections) != 1 || rejections.rejections[0].reason != "InvalidAnnotation" {
+		t.Fatalf("expected the route to be rejected: %#v", rejections)
+	}
+}
+
+// TestHandleRouteUnsupportedSettings tests that routes setting annotations the plugin
+// doesn't apply are rejected and stop serving their host
+func TestHandleRouteUnsupportedSettings(t *testing.T) {
+	rejections := &fakeRejections{}
+	router := newTestRouter(make(map[string]ServiceUnit))
+	plugin := controller.NewUniqueHost(newDefaultTemplatePlugin(router, true), controller.HostForRoute, false, rejections)
+	plugin.RejectAnnotations("router.openshift.io/timeout")
+
+	route := &routeapi.Route{
+		ObjectMeta: kapi.ObjectMeta{Namespace: "foo", Name: "test"},
+		Spec: routeapi.RouteSpec{
+			Host: "www.example.com",
+			To:   kapi.ObjectReference{Name: "TestService"},
+		},
+	}
+	if err := plugin.HandleRoute(watch.Added, route); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if plugin.HostLen() != 1 || len(rejections.rejections) != 0 {
+		t.Fatalf("expected the route to be admitted: %#v", rejections)
+	}
+
+	route.Annotations = map[string]string{"router.openshift.io/timeout": "5s"}
+	if err := plugin.HandleRoute(watch.Modified, route); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(rejections.rejections) != 1 || rejections.rejections[0].reason != "UnsupportedAnnotation" {
+		t.Fatalf("expected the route to be rejected: %#v", rejections)
+	}
+	if plugin.HostLen() != 0 {
+		t.Fatalf("expected the host of the route to be released")
+	}
+	if serviceUnit, ok := router.FindServiceUnit("foo/TestService"); ok && len(serviceUnit.ServiceAliasConfigs) != 0 {
+		t.Fatalf("expected the route to be removed from the router: %#v", serviceUnit)
+	}
+}
+
 func TestNamespaceScopingFromEmpty(t *testing.T) {
 	router := newTestRouter(make(map[string]ServiceUnit))
 	templatePlugin := newDefaultTemplatePlugin(router, true)
 	// TODO: move tests that rely on unique hosts to pkg/router/controller and remove them from
 	// here
-	plugin := controller.NewUniqueHost(templatePlugin, controller.HostForRoute, controller.LogRejections)
+	plugin := controller.NewUniqueHost(templatePlugin, controller.HostForRoute, false, controller.LogRejections)
 
 	// no namespaces allowed
 	plugin.HandleNamespaces(sets.String{})
@@ -663,3 +905,36 @@ func TestUnchangingEndpointsDoesNotCommit(t *testing.T) {
 		}
 	}
 }
+
+func TestGenSubdomainWildcardRegexp(t *testing.T) {
+	testCases := []struct {
+		host, path string
+		matches    []string
+		mismatches []string
+	}{
+		{
+			host:       "www.example.com",
+			matches:    []string{"www.example.com", "api.example.com/", "api.example.com:8080/foo"},
+			mismatches: []string{"example.com", "a.b.example.com", "api.example.org", "api-example.com"},
+		},
+		{
+			host:       "www.example.com",
+			path:       "/foo",
+			matches:    []string{"api.example.com/foo", "api.example.com:80/foo/bar"},
+			mismatches: []string{"api.example.com/bar", "api.example.com"},
+		},
+	}
+	for _, tc := range testCases {
+		expr := regexp.MustCompile(genSubdomainWildcardRegexp(tc.host, tc.path))
+		for _, s := range tc.matches {
+			if !expr.MatchString(s) {
+				t.Errorf("expected %s to match %s", expr, s)
+			}
+		}
+		for _, s := range tc.mismatches {
+			if expr.MatchString(s) {
+				t.Errorf("expected %s not to match %s", expr, s)
+			}
+		}
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"os/exec"
 	"path/filepath"
 	"reflect"
+	"sort"
 	"strings"
 	"sync"
 	"text/template"
@@ -18,6 +19,8 @@ import (
 	"k8s.io/kubernetes/pkg/util/sets"
 
 	routeapi "github.com/openshift/origin/pkg/route/api"
+	"github.com/openshift/origin/pkg/router"
+	"github.com/openshift/origin/pkg/router/metrics"
 	"github.com/openshift/origin/pkg/util/ratelimiter"
 )
 
@@ -68,6 +71,20 @@ type templateRouter struct {
 	statsPassword string
 	// if the router can expose statistics it should expose them with this port
 	statsPort int
+	// allowWildcardRoutes marks the routes with the Subdomain wildcard policy as wildcard
+	// routes in the templates
+	allowWildcardRoutes bool
+	// dynamicServers are the names of the server slots the templates add to each backend
+	// for the dynamicConfigManager
+	dynamicServers []string
+	// dynamicConfigManager applies the endpoint changes without a reload if set
+	dynamicConfigManager ConfigManager
+	// reloadRequired is true if the changes since the last reload can only be applied by
+	// writing the config and reloading the router
+	reloadRequired bool
+	// changedServiceUnits are the ids of the service units with endpoint changes since the
+	// last commit
+	changedServiceUnits sets.String
 	// rateLimitedCommitFunction is a rate limited commit (persist state + refresh the backend)
 	// function that coalesces and controls how often the router is reloaded.
 	rateLimitedCommitFunction *ratelimiter.RateLimitedFunction
@@ -90,6 +107,9 @@ type templateRouterCfg struct {
 	statsPort              int
 	peerEndpointsKey       string
 	includeUDP             bool
+	allowWildcardRoutes    bool
+	dynamicServerSlots     int
+	runtimeAPISocket       string
 }
 
 // templateConfig is a subset of the templateRouter information that should be passed to the template for generating
@@ -109,6 +129,8 @@ type templateData struct {
 	StatsPassword string
 	//port to expose stats with (if the template supports it)
 	StatsPort int
+	// names of the disabled server slots to add to each backend for dynamic endpoint changes
+	DynamicServers []string
 }
 
 func newTemplateRouter(cfg templateRouterCfg) (*templateRouter, error) {
@@ -144,11 +166,20 @@ func newTemplateRouter(cfg templateRouterCfg) (*templateRouter, error) {
 		statsPort:              cfg.statsPort,
 		peerEndpointsKey:       cfg.peerEndpointsKey,
 		peerEndpoints:          []Endpoint{},
+		allowWildcardRoutes:    cfg.allowWildcardRoutes,
+		reloadRequired:         true,
+		changedServiceUnits:    sets.NewString(),
 
 		rateLimitedCommitFunction:    nil,
 		rateLimitedCommitStopChannel: make(chan struct{}),
 	}
 
+	if cfg.dynamicServerSlots > 0 {
+		router.dynamicServers = dynamicServerNames(cfg.dynamicServerSlots)
+		router.dynamicConfigManager = newHAProxyConfigManager(cfg.runtimeAPISocket, router.dynamicServers)
+		glog.V(2).Infof("Template router will apply endpoint changes through %s with %d server slots per backend", cfg.runtimeAPISocket, cfg.dynamicServerSlots)
+	}
+
 	keyFunc := func(_ interface{}) (string, error) {
 		return "templaterouter", nil
 	}
@@ -184,6 +215,43 @@ func endpointsForAlias(alias ServiceAliasConfig, svc ServiceUnit) []Endpoint {
 	return endpoints
 }
 
+// backendServers returns the servers of the backend of alias, one for each endpoint of
+// each of its service units, sorted by name. The weight of each service unit is divided
+// across its endpoints so that the traffic is split between the service units by their
+// weights whatever their number of endpoints.
+func backendServers(alias ServiceAliasConfig, state map[string]ServiceUnit) []BackendServer {
+	endpoints := make(map[string][]Endpoint)
+	counts := make(map[string]int)
+	for name := range alias.ServiceUnitNames {
+		endpoints[name] = endpointsForAlias(alias, state[name])
+		counts[name] = len(endpoints[name])
+	}
+	servers := []BackendServer{}
+	for name, weight := range router.EndpointWeights(alias.ServiceUnitNames, counts) {
+		for _, endpoint := range endpoints[name] {
+			servers = append(servers, BackendServer{
+				Endpoint: endpoint,
+				Name:     backendServerName(name, endpoint),
+				Weight:   weight,
+			})
+		}
+	}
+	sort.Sort(byServerName(servers))
+	return servers
+}
+
+// backendServerName returns the name of the server of the endpoint of the named service
+// unit. An endpoint of several service units of a route has a server for each of them.
+func backendServerName(serviceUnitName string, endpoint Endpoint) string {
+	return strings.Replace(serviceUnitName, "/", ":", -1) + ":" + endpoint.ID
+}
+
+type byServerName []BackendServer
+
+func (s byServerName) Len() int           { return len(s) }
+func (s byServerName) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s byServerName) Less(i, j int) bool { return s[i].Name < s[j].Name }
+
 // writeDefaultCert is called a single time during init to write out the default certificate
 func (r *templateRouter) writeDefaultCert() error {
 	if len(r.defaultCertificate) == 0 {
@@ -217,7 +285,8 @@ func (r *templateRouter) Commit() {
 	r.rateLimitedCommitFunction.Invoke(r.rateLimitedCommitFunction)
 }
 
-// commitAndReload refreshes the backend and persists the router state.
+// commitAndReload refreshes the backend and persists the router state. Endpoint
+// changes are applied without a reload if the router has a dynamic config manager.
 func (r *templateRouter) commitAndReload() error {
 	r.lock.Lock()
 	defer r.lock.Unlock()
@@ -227,6 +296,16 @@ func (r *templateRouter) commitAndReload() error {
 		return err
 	}
 
+	if !r.reloadRequired && r.dynamicConfigManager != nil {
+		err := r.replaceChangedEndpoints()
+		if err == nil {
+			glog.V(4).Infof("Applied the endpoint changes without a reload")
+			r.changedServiceUnits = sets.NewString()
+			return nil
+		}
+		glog.V(2).Infof("Reloading the router to apply the endpoint changes: %v", err)
+	}
+
 	glog.V(4).Infof("Writing the router config")
 	if err := r.writeConfig(); err != nil {
 		return err
@@ -237,6 +316,39 @@ func (r *templateRouter) commitAndReload() error {
 		return err
 	}
 
+	r.reloadRequired = false
+	r.changedServiceUnits = sets.NewString()
+	if r.dynamicConfigManager != nil {
+		r.dynamicConfigManager.Initialize(r.state)
+	}
+	return nil
+}
+
+// replaceChangedEndpoints replaces the servers of the routes of the changed service units
+// through the dynamic config manager.
+func (r *templateRouter) replaceChangedEndpoints() error {
+	configs := make(map[string]ServiceAliasConfig)
+	for _, serviceUnit := range r.state {
+		for routeKey, cfg := range serviceUnit.ServiceAliasConfigs {
+			for name := range cfg.ServiceUnitNames {
+				if r.changedServiceUnits.Has(name) {
+					configs[routeKey] = cfg
+					break
+				}
+			}
+		}
+	}
+
+	routeKeys := make([]string, 0, len(configs))
+	for routeKey := range configs {
+		routeKeys = append(routeKeys, routeKey)
+	}
+	sort.Strings(routeKeys)
+	for _, routeKey := range routeKeys {
+		if err := r.dynamicConfigManager.ReplaceEndpoints(routeKey, configs[routeKey], r.state); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -279,6 +391,7 @@ func (r *templateRouter) writeConfig() error {
 			StatsUser:          r.statsUser,
 			StatsPassword:      r.statsPassword,
 			StatsPort:          r.statsPort,
+			DynamicServers:     r.dynamicServers,
 		}
 		if err := template.Execute(file, data); err != nil {
 			file.Close()
@@ -302,11 +415,14 @@ func (r *templateRouter) writeCertificates(cfg *ServiceAliasConfig) error {
 
 // reloadRouter executes the router's reload script.
 func (r *templateRouter) reloadRouter() error {
+	start := time.Now()
 	cmd := exec.Command(r.reloadScriptPath)
 	out, err := cmd.CombinedOutput()
 	if err != nil {
+		metrics.ReloadFailures.Inc()
 		return fmt.Errorf("error reloading router: %v\n%s", err, out)
 	}
+	metrics.ReloadDuration.Observe(time.Since(start).Seconds())
 	glog.Infof("Router reloaded:\n%s", out)
 	return nil
 }
@@ -314,6 +430,7 @@ func (r *templateRouter) reloadRouter() error {
 func (r *templateRouter) FilterNamespaces(namespaces sets.String) {
 	if len(namespaces) == 0 {
 		r.state = make(map[string]ServiceUnit)
+		r.reloadRequired = true
 	}
 	for k := range r.state {
 		// TODO: the id of a service unit should be defined inside this class, not passed in from the outside
@@ -352,6 +469,7 @@ func (r *templateRouter) DeleteServiceUnit(id string) {
 
 	for _, cfg := range svcUnit.ServiceAliasConfigs {
 		r.cleanUpServiceAliasConfig(&cfg)
+		r.reloadRequired = true
 	}
 	delete(r.state, id)
 }
@@ -365,6 +483,7 @@ func (r *templateRouter) DeleteEndpoints(id string) {
 	service.EndpointTable = []Endpoint{}
 
 	r.state[id] = service
+	r.endpointsChanged(id)
 
 	// TODO: this is not safe (assuming that the subset of elements we are watching includes the peer endpoints)
 	// should be a DNS lookup for endpoints of our service name.
@@ -396,10 +515,22 @@ func (r *templateRouter) AddRoute(id string, route *routeapi.Route, host string)
 	backendKey := r.routeKey(route)
 
 	config := ServiceAliasConfig{
-		Host: host,
-		Path: route.Spec.Path,
+		Host:             host,
+		Path:             route.Spec.Path,
+		ServiceUnitNames: routeServiceUnitNames(route),
+		IsWildcard:       r.allowWildcardRoutes && route.Spec.WildcardPolicy == routeapi.WildcardPolicySubdomain,
 	}
 
+	// routes with invalid annotations are rejected before they are added, keep the valid
+	// settings anyway
+	settings, err := router.ParseRouteSettings(route)
+	if err != nil {
+		glog.V(4).Infof("Ignoring the invalid annotations of route %s: %v", backendKey, err)
+	}
+	config.Timeout = settings.Timeout
+	config.RateLimitConnections = settings.RateLimitConnections
+	config.IPWhitelist = settings.IPWhitelist
+
 	if route.Spec.Port != nil {
 		config.PreferPort = route.Spec.Port.TargetPort.String()
 	}
@@ -451,9 +582,17 @@ func (r *templateRouter) AddRoute(id string, route *routeapi.Route, host string)
 	}
 
 	//create or replace
+	existing, exists := frontend.ServiceAliasConfigs[backendKey]
 	frontend.ServiceAliasConfigs[backendKey] = config
 	r.state[id] = frontend
-	r.cleanUpdates(id, backendKey)
+	moved := r.cleanUpdates(id, backendKey)
+
+	//only require a reload if there is a difference
+	if exists && !moved && reflect.DeepEqual(existing, config) {
+		glog.V(4).Infof("Ignoring change for %s, the route is the same", backendKey)
+		return false
+	}
+	r.reloadRequired = true
 	return true
 }
 
@@ -462,8 +601,9 @@ func (r *templateRouter) AddRoute(id string, route *routeapi.Route, host string)
 // for times when someone updates the service name on a route which leaves the existing old service
 // in state.
 // TODO: remove this when we refactor the model to use existing objects and integrate this into
-// the api somehow.
-func (r *templateRouter) cleanUpdates(frontendKey string, backendKey string) {
+// the api somehow. Returns true if the route was removed from another service.
+func (r *templateRouter) cleanUpdates(frontendKey string, backendKey string) bool {
+	removed := false
 	for k, v := range r.state {
 		if k == frontendKey {
 			continue
@@ -471,25 +611,32 @@ func (r *templateRouter) cleanUpdates(frontendKey string, backendKey string) {
 		for routeKey := range v.ServiceAliasConfigs {
 			if routeKey == backendKey {
 				delete(v.ServiceAliasConfigs, backendKey)
+				removed = true
 			}
 		}
 	}
+	return removed
 }
 
-// RemoveRoute removes the given route for the given id.
-func (r *templateRouter) RemoveRoute(id string, route *routeapi.Route) {
+// RemoveRoute removes the given route for the given id. Returns true if the route was
+// removed.
+func (r *templateRouter) RemoveRoute(id string, route *routeapi.Route) bool {
 	serviceUnit, ok := r.state[id]
 	if !ok {
-		return
+		glog.V(4).Infof("Ignoring removal of route %s, service %s is unknown", r.routeKey(route), id)
+		return false
 	}
 
 	routeKey := r.routeKey(route)
 	serviceAliasConfig, ok := serviceUnit.ServiceAliasConfigs[routeKey]
 	if !ok {
-		return
+		glog.V(4).Infof("Ignoring removal of route %s, the route is unknown", routeKey)
+		return false
 	}
 	r.cleanUpServiceAliasConfig(&serviceAliasConfig)
 	delete(r.state[id].ServiceAliasConfigs, routeKey)
+	r.reloadRequired = true
+	return true
 }
 
 // AddEndpoints adds new Endpoints for the given id.
@@ -504,6 +651,7 @@ func (r *templateRouter) AddEndpoints(id string, endpoints []Endpoint) bool {
 
 	frontend.EndpointTable = endpoints
 	r.state[id] = frontend
+	r.endpointsChanged(id)
 
 	if id == r.peerEndpointsKey {
 		r.peerEndpoints = frontend.EndpointTable
@@ -513,6 +661,15 @@ func (r *templateRouter) AddEndpoints(id string, endpoints []Endpoint) bool {
 	return true
 }
 
+// endpointsChanged records an endpoint change of the service unit with the given id. The
+// peer endpoints are only applied by a reload.
+func (r *templateRouter) endpointsChanged(id string) {
+	if id == r.peerEndpointsKey {
+		r.reloadRequired = true
+	}
+	r.changedServiceUnits.Insert(id)
+}
+
 // cleanUpServiceAliasConfig performs any necessary steps to clean up a service alias config before deleting it from
 // the router.  Right now the only clean up step is to remove any of the certificates on disk.
 func (r *templateRouter) cleanUpServiceAliasConfig(cfg *ServiceAliasConfig) {
@@ -2,7 +2,12 @@ package templaterouter
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
 	"testing"
+	"time"
 
 	routeapi "github.com/openshift/origin/pkg/route/api"
 	kapi "k8s.io/kubernetes/pkg/api"
@@ -258,7 +263,7 @@ func TestRouteKey(t *testing.T) {
 			},
 		}
 
-		// add route always returns true
+		// adding a new route returns true
 		added := router.AddRoute(suKey, route, route.Spec.Host)
 		if !added {
 			t.Fatalf("expected AddRoute to return true but got false")
@@ -302,7 +307,7 @@ func TestAddRoute(t *testing.T) {
 	suKey := "test"
 	router.CreateServiceUnit(suKey)
 
-	// add route always returns true
+	// adding a new route returns true
 	added := router.AddRoute(suKey, route, route.Spec.Host)
 	if !added {
 		t.Fatalf("expected AddRoute to return true but got false")
@@ -326,6 +331,174 @@ func TestAddRoute(t *testing.T) {
 	}
 }
 
+// TestAddRouteUnchanged tests that adding an unchanged route requires neither a commit nor
+// a reload
+func TestAddRouteUnchanged(t *testing.T) {
+	router := newFakeTemplateRouter()
+	route := &routeapi.Route{
+		ObjectMeta: kapi.ObjectMeta{
+			Namespace: "foo",
+			Name:      "bar",
+		},
+		Spec: routeapi.RouteSpec{
+			Host: "host",
+			Path: "/path",
+		},
+	}
+	suKey := "foo/svc"
+	router.CreateServiceUnit(suKey)
+	if !router.AddRoute(suKey, route, route.Spec.Host) {
+		t.Fatalf("expected AddRoute to return true but got false")
+	}
+
+	router.reloadRequired = false
+	if router.AddRoute(suKey, route, route.Spec.Host) {
+		t.Errorf("expected AddRoute to return false for an unchanged route")
+	}
+	if router.reloadRequired {
+		t.Errorf("expected no reload for an unchanged route")
+	}
+
+	route.Spec.Path = "/other"
+	if !router.AddRoute(suKey, route, route.Spec.Host) {
+		t.Errorf("expected AddRoute to return true for a changed route")
+	}
+	if !router.reloadRequired {
+		t.Errorf("expected a reload for a changed route")
+	}
+
+	// a route moved to another service is removed from the old one
+	router.reloadRequired = false
+	router.CreateServiceUnit("foo/other")
+	router.AddRoute("foo/other", route, route.Spec.Host)
+	router.reloadRequired = false
+	if !router.AddRoute(suKey, route, route.Spec.Host) || !router.reloadRequired {
+		t.Errorf("expected a reload for a route moved to another service")
+	}
+}
+
+// TestAddRouteAlternateBackends tests that the service alias config of a route references the
+// service units of all its backends with their weights
+func TestAddRouteAlternateBackends(t *testing.T) {
+	router := newFakeTemplateRouter()
+	weight := int32(0)
+	route := &routeapi.Route{
+		ObjectMeta: kapi.ObjectMeta{
+			Namespace: "foo",
+			Name:      "bar",
+		},
+		Spec: routeapi.RouteSpec{
+			Host:   "host",
+			To:     kapi.ObjectReference{Name: "primary"},
+			Weight: &weight,
+			AlternateBackends: []routeapi.RouteTargetReference{
+				{Kind: "Service", Name: "alternate"},
+			},
+		},
+	}
+	suKey := "foo/primary"
+	router.CreateServiceUnit(suKey)
+	router.AddRoute(suKey, route, route.Spec.Host)
+
+	su, _ := router.FindServiceUnit(suKey)
+	saCfg, ok := su.ServiceAliasConfigs[router.routeKey(route)]
+	if !ok {
+		t.Fatalf("Unable to find created service alias config for route %s", router.routeKey(route))
+	}
+	expected := map[string]int32{"foo/primary": 0, "foo/alternate": routeapi.DefaultRouteWeight}
+	if !reflect.DeepEqual(saCfg.ServiceUnitNames, expected) {
+		t.Errorf("Expected service unit names %v, got %v", expected, saCfg.ServiceUnitNames)
+	}
+}
+
+// TestBackendServers tests that the weights of the service units of a route are divided
+// across their endpoints and that an endpoint of several service units has a server for
+// each of them
+func TestBackendServers(t *testing.T) {
+	cfg := ServiceAliasConfig{ServiceUnitNames: map[string]int32{"ns/a": 1, "ns/b": 3, "ns/c": 0}}
+	state := map[string]ServiceUnit{
+		"ns/a": {EndpointTable: []Endpoint{{ID: "10.0.0.1:8080", IP: "10.0.0.1", Port: "8080"}}},
+		"ns/b": {EndpointTable: []Endpoint{
+			{ID: "10.0.0.1:8080", IP: "10.0.0.1", Port: "8080"},
+			{ID: "10.0.0.2:8080", IP: "10.0.0.2", Port: "8080"},
+			{ID: "10.0.0.3:8080", IP: "10.0.0.3", Port: "8080"},
+		}},
+		"ns/c": {EndpointTable: []Endpoint{{ID: "10.0.0.4:8080", IP: "10.0.0.4", Port: "8080"}}},
+	}
+	servers := map[string]int32{}
+	for _, server := range backendServers(cfg, state) {
+		servers[server.Name] = server.Weight
+	}
+	expected := map[string]int32{
+		"ns:a:10.0.0.1:8080": 256,
+		"ns:b:10.0.0.1:8080": 256,
+		"ns:b:10.0.0.2:8080": 256,
+		"ns:b:10.0.0.3:8080": 256,
+		"ns:c:10.0.0.4:8080": 0,
+	}
+	if !reflect.DeepEqual(servers, expected) {
+		t.Errorf("expected servers %v, got %v", expected, servers)
+	}
+}
+
+// TestAddRouteWildcard tests that wildcard routes are only marked as such when the router
+// allows wildcard routes
+func TestAddRouteWildcard(t *testing.T) {
+	route := &routeapi.Route{
+		ObjectMeta: kapi.ObjectMeta{
+			Namespace: "foo",
+			Name:      "bar",
+		},
+		Spec: routeapi.RouteSpec{
+			Host:           "www.example.com",
+			To:             kapi.ObjectReference{Name: "primary"},
+			WildcardPolicy: routeapi.WildcardPolicySubdomain,
+		},
+	}
+	for _, allowed := range []bool{false, true} {
+		router := newFakeTemplateRouter()
+		router.allowWildcardRoutes = allowed
+		suKey := "foo/primary"
+		router.CreateServiceUnit(suKey)
+		router.AddRoute(suKey, route, route.Spec.Host)
+
+		su, _ := router.FindServiceUnit(suKey)
+		if saCfg := su.ServiceAliasConfigs[router.routeKey(route)]; saCfg.IsWildcard != allowed {
+			t.Errorf("Expected IsWildcard %v when allowWildcardRoutes is %v, got %v", allowed, allowed, saCfg.IsWildcard)
+		}
+	}
+}
+
+// TestAddRouteSettings tests that the settings of a route set with annotations are added
+// to the service alias config
+func TestAddRouteSettings(t *testing.T) {
+	router := newFakeTemplateRouter()
+	route := &routeapi.Route{
+		ObjectMeta: kapi.ObjectMeta{
+			Namespace: "foo",
+			Name:      "bar",
+			Annotations: map[string]string{
+				"router.openshift.io/timeout":                "2m",
+				"router.openshift.io/rate-limit-connections": "10",
+				"router.openshift.io/ip-whitelist":           "10.0.0.0/8 192.168.1.1",
+			},
+		},
+		Spec: routeapi.RouteSpec{
+			Host: "host",
+			To:   kapi.ObjectReference{Name: "primary"},
+		},
+	}
+	suKey := "foo/primary"
+	router.CreateServiceUnit(suKey)
+	router.AddRoute(suKey, route, route.Spec.Host)
+
+	su, _ := router.FindServiceUnit(suKey)
+	saCfg := su.ServiceAliasConfigs[router.routeKey(route)]
+	if saCfg.Timeout != 2*time.Minute || saCfg.RateLimitConnections != 10 || !reflect.DeepEqual(saCfg.IPWhitelist, []string{"10.0.0.0/8", "192.168.1.1"}) {
+		t.Errorf("Unexpected settings of the service alias config: %#v", saCfg)
+	}
+}
+
 // compareTLS is a utility to help compare cert contents between an route and a config
 func compareTLS(route *routeapi.Route, saCfg ServiceAliasConfig, t *testing.T) bool {
 	return findCert(route.Spec.TLS.DestinationCACertificate, saCfg.Certificates, false, t) &&
@@ -400,7 +573,10 @@ func TestRemoveRoute(t *testing.T) {
 		t.Fatalf("Route %v did not match serivce alias config %v", route, saCfg)
 	}
 
-	router.RemoveRoute(suKey, route)
+	router.reloadRequired = false
+	if !router.RemoveRoute(suKey, route) || !router.reloadRequired {
+		t.Errorf("expected the removal of route %v to require a reload", route)
+	}
 	su, _ = router.FindServiceUnit(suKey)
 	if _, ok := su.ServiceAliasConfigs[routeKey]; ok {
 		t.Errorf("Route %v was expected to be deleted but was still found", route)
@@ -408,6 +584,12 @@ func TestRemoveRoute(t *testing.T) {
 	if _, ok := su.ServiceAliasConfigs[router.routeKey(route2)]; !ok {
 		t.Errorf("Route %v was expected to exist but was not found", route2)
 	}
+
+	// removing an unknown route is ignored
+	router.reloadRequired = false
+	if router.RemoveRoute(suKey, route) || router.RemoveRoute("unknown", route2) || router.reloadRequired {
+		t.Errorf("expected the removal of unknown routes to be ignored")
+	}
 }
 
 func TestShouldWriteCertificates(t *testing.T) {
@@ -682,7 +864,7 @@ func TestAddRouteEdgeTerminationInsecurePolicy(t *testing.T) {
 		suKey := fmt.Sprintf("%s-test", tc.Name)
 		router.CreateServiceUnit(suKey)
 
-		// add route always returns true
+		// adding a new route returns true
 		added := router.AddRoute(suKey, route, route.Spec.Host)
 		if !added {
 			t.Fatalf("InsecureEdgeTerminationPolicy test %s: expected AddRoute to return true but got false", tc.Name)
@@ -709,3 +891,76 @@ func TestAddRouteEdgeTerminationInsecurePolicy(t *testing.T) {
 		}
 	}
 }
+
+// fakeConfigManager is a ConfigManager that records the routes of the endpoint changes
+type fakeConfigManager struct {
+	initialized int
+	replaced    []string
+	err         error
+}
+
+func (m *fakeConfigManager) Initialize(state map[string]ServiceUnit) {
+	m.initialized++
+}
+
+func (m *fakeConfigManager) ReplaceEndpoints(routeKey string, cfg ServiceAliasConfig, state map[string]ServiceUnit) error {
+	m.replaced = append(m.replaced, routeKey)
+	return m.err
+}
+
+// TestCommitDynamicEndpoints tests that endpoint changes are applied by the config manager
+// and that route changes and failed endpoint changes reload the router
+func TestCommitDynamicEndpoints(t *testing.T) {
+	dir, err := ioutil.TempDir("", "templaterouter")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	reloadScript := filepath.Join(dir, "reload")
+	if err := ioutil.WriteFile(reloadScript, []byte("#!/bin/sh\nexit 0\n"), 0755); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	manager := &fakeConfigManager{}
+	router := newFakeTemplateRouter()
+	router.dir = dir
+	router.reloadScriptPath = reloadScript
+	router.dynamicConfigManager = manager
+
+	route := &routeapi.Route{
+		ObjectMeta: kapi.ObjectMeta{Namespace: "ns", Name: "route"},
+		Spec: routeapi.RouteSpec{
+			Host: "www.example.com",
+			To:   kapi.ObjectReference{Name: "svc"},
+		},
+	}
+	router.CreateServiceUnit("ns/svc")
+	router.AddRoute("ns/svc", route, route.Spec.Host)
+	if err := router.commitAndReload(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if manager.initialized != 1 || len(manager.replaced) != 0 {
+		t.Errorf("expected a reload for the route, got %d reloads and endpoint changes for %v", manager.initialized, manager.replaced)
+	}
+
+	router.AddEndpoints("ns/svc", []Endpoint{{ID: "10.0.0.1:8080", IP: "10.0.0.1", Port: "8080"}})
+	router.AddEndpoints("ns/other", []Endpoint{{ID: "10.0.0.2:8080", IP: "10.0.0.2", Port: "8080"}})
+	if err := router.commitAndReload(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if manager.initialized != 1 || !reflect.DeepEqual(manager.replaced, []string{"ns_route"}) {
+		t.Errorf("expected the endpoint change of ns_route without a reload, got %d reloads and endpoint changes for %v", manager.initialized, manager.replaced)
+	}
+	if router.changedServiceUnits.Len() != 0 {
+		t.Errorf("expected the endpoint changes to be cleared, got %v", router.changedServiceUnits.List())
+	}
+
+	manager.err = fmt.Errorf("no free server slots")
+	router.DeleteEndpoints("ns/svc")
+	if err := router.commitAndReload(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if manager.initialized != 2 {
+		t.Errorf("expected a reload for the failed endpoint change, got %d reloads", manager.initialized)
+	}
+}
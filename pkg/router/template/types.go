@@ -1,8 +1,10 @@
 package templaterouter
 
 import (
-	routeapi "github.com/openshift/origin/pkg/route/api"
 	"strings"
+	"time"
+
+	routeapi "github.com/openshift/origin/pkg/route/api"
 )
 
 // ServiceUnit is an encapsulation of a service, the endpoints that back that service, and the routes
@@ -37,6 +39,20 @@ type ServiceAliasConfig struct {
 	// insecure connections to an edge-terminated route:
 	//   none (or disable), allow or redirect
 	InsecureEdgeTerminationPolicy routeapi.InsecureEdgeTerminationPolicyType
+	// ServiceUnitNames are the ids of the service units this route sends traffic to, mapped
+	// to their weights, which are divided across the endpoints of each service unit
+	ServiceUnitNames map[string]int32
+	// IsWildcard indicates the route serves all the hosts of the domain of Host that
+	// no other route serves
+	IsWildcard bool
+	// Timeout is how long to wait for the service to respond, zero for the default timeout
+	Timeout time.Duration
+	// RateLimitConnections is the number of new connections per second a client IP address
+	// may open to the route, zero for no limit
+	RateLimitConnections int
+	// IPWhitelist are the IP addresses and CIDR ranges of the clients allowed to access the
+	// route, empty to allow all clients
+	IPWhitelist []string
 }
 
 type ServiceAliasConfigStatus string
@@ -64,6 +80,16 @@ type Endpoint struct {
 	PortName   string
 }
 
+// BackendServer is a server of the backend of a route, an endpoint of one of the service
+// units of the route with its share of the weight of the service unit.
+type BackendServer struct {
+	Endpoint
+	// Name is the name of the server, unique within the backend
+	Name string
+	// Weight is the weight of the server
+	Weight int32
+}
+
 // certificateManager provides the ability to write certificates for a ServiceAliasConfig
 type certificateManager interface {
 	// WriteCertificatesForConfig writes all certificates for all ServiceAliasConfigs in config
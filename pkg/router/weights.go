@@ -0,0 +1,39 @@
+package router
+
+// MaxEndpointWeight is the largest weight EndpointWeights gives the endpoints of a
+// backend, the largest server weight HAProxy accepts.
+const MaxEndpointWeight = 256
+
+// EndpointWeights returns the weight of each endpoint of the backends of a route, so that
+// each backend receives its share of the traffic whatever its number of endpoints. weights
+// maps the backends to their weights and counts maps them to their number of endpoints.
+// The weight of each backend is divided across its endpoints and scaled so that the
+// largest endpoint weight is MaxEndpointWeight. The endpoints of backends with a weight
+// above 0 get a weight of at least 1, backends without endpoints are left out.
+func EndpointWeights(weights map[string]int32, counts map[string]int) map[string]int32 {
+	shares := make(map[string]float64)
+	max := 0.0
+	for name, weight := range weights {
+		if counts[name] == 0 {
+			continue
+		}
+		shares[name] = float64(weight) / float64(counts[name])
+		if shares[name] > max {
+			max = shares[name]
+		}
+	}
+
+	endpointWeights := make(map[string]int32)
+	for name, share := range shares {
+		if share == 0 {
+			endpointWeights[name] = 0
+			continue
+		}
+		weight := int32(share * MaxEndpointWeight / max)
+		if weight < 1 {
+			weight = 1
+		}
+		endpointWeights[name] = weight
+	}
+	return endpointWeights
+}
@@ -0,0 +1,57 @@
+package router
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEndpointWeights(t *testing.T) {
+	tests := []struct {
+		name     string
+		weights  map[string]int32
+		counts   map[string]int
+		expected map[string]int32
+	}{
+		{
+			name:     "single backend",
+			weights:  map[string]int32{"a": 1},
+			counts:   map[string]int{"a": 3},
+			expected: map[string]int32{"a": 256},
+		},
+		{
+			name:     "weights split across endpoints",
+			weights:  map[string]int32{"a": 90, "b": 10},
+			counts:   map[string]int{"a": 9, "b": 1},
+			expected: map[string]int32{"a": 256, "b": 256},
+		},
+		{
+			name:     "same number of endpoints",
+			weights:  map[string]int32{"a": 3, "b": 1},
+			counts:   map[string]int{"a": 2, "b": 2},
+			expected: map[string]int32{"a": 256, "b": 85},
+		},
+		{
+			name:     "small share",
+			weights:  map[string]int32{"a": 256, "b": 1},
+			counts:   map[string]int{"a": 1, "b": 10},
+			expected: map[string]int32{"a": 256, "b": 1},
+		},
+		{
+			name:     "zero weight and no endpoints",
+			weights:  map[string]int32{"a": 1, "b": 0, "c": 5},
+			counts:   map[string]int{"a": 1, "b": 2},
+			expected: map[string]int32{"a": 256, "b": 0},
+		},
+		{
+			name:     "all weights zero",
+			weights:  map[string]int32{"a": 0},
+			counts:   map[string]int{"a": 1},
+			expected: map[string]int32{"a": 0},
+		},
+	}
+	for _, test := range tests {
+		if weights := EndpointWeights(test.weights, test.counts); !reflect.DeepEqual(weights, test.expected) {
+			t.Errorf("%s: expected %v, got %v", test.name, test.expected, weights)
+		}
+	}
+}